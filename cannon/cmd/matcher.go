@@ -34,12 +34,18 @@ func (m *StepMatcherFlag) Set(value string) error {
 			return true
 		}
 	} else if strings.HasPrefix(value, "=") {
-		when, err := strconv.ParseUint(value[1:], 0, 64)
-		if err != nil {
-			return fmt.Errorf("failed to parse step number: %w", err)
+		// Multiple steps may be specified as a comma separated list, e.g. =10,20,30
+		steps := make(map[uint64]struct{})
+		for _, part := range strings.Split(value[1:], ",") {
+			when, err := strconv.ParseUint(part, 0, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse step number: %w", err)
+			}
+			steps[when] = struct{}{}
 		}
 		m.matcher = func(st *mipsevm.State) bool {
-			return st.Step == when
+			_, ok := steps[st.Step]
+			return ok
 		}
 	} else if strings.HasPrefix(value, "%") {
 		when, err := strconv.ParseUint(value[1:], 0, 64)
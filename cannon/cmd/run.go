@@ -33,7 +33,7 @@ var (
 		Value:     "out.json",
 		Required:  false,
 	}
-	patternHelp    = "'never' (default), 'always', '=123' at exactly step 123, '%123' for every 123 steps"
+	patternHelp    = "'never' (default), 'always', '=123' at exactly step 123, '=123,456' at steps 123 and 456, '%123' for every 123 steps"
 	RunProofAtFlag = &cli.GenericFlag{
 		Name:     "proof-at",
 		Usage:    "step pattern to output proof at: " + patternHelp,
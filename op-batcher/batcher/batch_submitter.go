@@ -2,6 +2,7 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/urfave/cli/v2"
@@ -9,9 +10,13 @@ import (
 	"github.com/ethereum-optimism/optimism/op-batcher/flags"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/handoff"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 )
 
+// errUpgraded is the reason the batcher stops once a new process has taken over after a graceful upgrade.
+var errUpgraded = errors.New("upgraded to new process")
+
 // Main is the entrypoint into the Batch Submitter.
 // This method returns a cliapp.LifecycleAction, to create an op-service CLI-lifecycle-managed batch-submitter with.
 func Main(version string) cliapp.LifecycleAction {
@@ -29,6 +34,15 @@ func Main(version string) cliapp.LifecycleAction {
 		opservice.ValidateEnvVars(flags.EnvVarPrefix, flags.Flags, l)
 
 		l.Info("Initializing Batch Submitter")
-		return BatcherServiceFromCLIConfig(cliCtx.Context, version, cfg, l)
+		bs, err := BatcherServiceFromCLIConfig(cliCtx.Context, version, cfg, l)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.GracefulUpgrade {
+			go handoff.Process().UpgradeOnSignal(cliCtx.Context, l, handoff.DefaultUpgradeTimeout, func() {
+				closeApp(errUpgraded)
+			})
+		}
+		return bs, nil
 	}
 }
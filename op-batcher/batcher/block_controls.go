@@ -0,0 +1,80 @@
+package batcher
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrInvalidBlockRange = errors.New("invalid block range")
+	// ErrSkipNotAcknowledged is returned when skipping L2 blocks without acknowledging the consequences.
+	ErrSkipNotAcknowledged = errors.New("skipped L2 blocks and all blocks after them cannot become safe and will be " +
+		"reorged out once the sequencing window expires; acknowledge this to skip blocks")
+)
+
+// BlockRange is an inclusive range of L2 block numbers.
+type BlockRange struct {
+	Start uint64
+	End   uint64
+}
+
+func (r BlockRange) Check() error {
+	if r.Start > r.End {
+		return fmt.Errorf("%w: start %v is after end %v", ErrInvalidBlockRange, r.Start, r.End)
+	}
+	return nil
+}
+
+func (r BlockRange) Contains(n uint64) bool {
+	return r.Start <= n && n <= r.End
+}
+
+// blockControls are the L2 block ranges an operator has asked to be excluded from batching or submitted
+// immediately. They are intended for incident workflows, such as coordinated reorgs on devnets, and are not
+// persisted across restarts. It is safe for concurrent access.
+type blockControls struct {
+	mu       sync.Mutex
+	excluded []BlockRange
+	forced   []BlockRange
+}
+
+func (c *blockControls) exclude(r BlockRange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.excluded = append(c.excluded, r)
+}
+
+func (c *blockControls) force(r BlockRange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forced = append(c.forced, r)
+}
+
+func (c *blockControls) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.excluded = nil
+	c.forced = nil
+}
+
+func (c *blockControls) isExcluded(n uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return anyContains(c.excluded, n)
+}
+
+func (c *blockControls) isForced(n uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return anyContains(c.forced, n)
+}
+
+func anyContains(ranges []BlockRange, n uint64) bool {
+	for _, r := range ranges {
+		if r.Contains(n) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package batcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRange(t *testing.T) {
+	r := BlockRange{Start: 5, End: 7}
+	require.NoError(t, r.Check())
+	require.False(t, r.Contains(4))
+	require.True(t, r.Contains(5))
+	require.True(t, r.Contains(7))
+	require.False(t, r.Contains(8))
+
+	require.NoError(t, BlockRange{Start: 5, End: 5}.Check())
+	require.ErrorIs(t, BlockRange{Start: 6, End: 5}.Check(), ErrInvalidBlockRange)
+}
+
+func TestBlockControls(t *testing.T) {
+	var c blockControls
+	c.exclude(BlockRange{Start: 1, End: 2})
+	c.force(BlockRange{Start: 10, End: 10})
+	require.True(t, c.isExcluded(2))
+	require.False(t, c.isExcluded(3))
+	require.True(t, c.isForced(10))
+	require.False(t, c.isForced(2))
+
+	c.clear()
+	require.False(t, c.isExcluded(2))
+	require.False(t, c.isForced(10))
+}
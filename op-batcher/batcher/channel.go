@@ -205,3 +205,11 @@ func (s *channel) OutputFrames() error {
 func (s *channel) Close() {
 	s.channelBuilder.Close()
 }
+
+func (s *channel) CloseWithReason(reason error) {
+	s.channelBuilder.CloseWithReason(reason)
+}
+
+func (s *channel) NumBlocks() int {
+	return len(s.channelBuilder.Blocks())
+}
@@ -20,6 +20,8 @@ var (
 	ErrChannelTimeoutClose   = errors.New("close to channel timeout")
 	ErrSeqWindowClose        = errors.New("close to sequencer window timeout")
 	ErrTerminated            = errors.New("channel terminated")
+	ErrOrderingPolicySplit   = errors.New("channel split by ordering policy")
+	ErrForcedSubmission      = errors.New("channel closed to force submission")
 )
 
 type ChannelFullError struct {
@@ -62,6 +64,21 @@ type ChannelConfig struct {
 
 	// BatchType indicates whether the channel uses SingularBatch or SpanBatch.
 	BatchType uint
+
+	// OrderingPolicy is the name of the policy used to split pending blocks across channels.
+	// Must be one of OrderingPolicies. If unset, OldestFirstPolicy is used.
+	OrderingPolicy string
+	// LargeBlockThreshold is the RLP encoded block size (in bytes), including the header, at or above which a block
+	// is isolated in its own channel by the IsolateLargeBlocksPolicy.
+	LargeBlockThreshold uint64
+}
+
+// NewOrderingPolicy creates the configured [OrderingPolicy].
+func (cc *ChannelConfig) NewOrderingPolicy() OrderingPolicy {
+	if f, ok := OrderingPolicies[cc.OrderingPolicy]; ok {
+		return f(*cc)
+	}
+	return OrderingPolicies[OldestFirstPolicy](*cc)
 }
 
 // Check validates the [ChannelConfig] parameters.
@@ -91,6 +108,19 @@ func (cc *ChannelConfig) Check() error {
 		return fmt.Errorf("unrecognized batch type: %d", cc.BatchType)
 	}
 
+	if algo := cc.CompressorConfig.CompressionAlgo; algo != "" && !derive.ValidCompressionAlgo(algo) {
+		return fmt.Errorf("%w: %v", derive.ErrUnknownCompressionAlgo, algo)
+	}
+
+	if cc.OrderingPolicy != "" {
+		if _, ok := OrderingPolicies[cc.OrderingPolicy]; !ok {
+			return fmt.Errorf("unrecognized ordering policy: %s", cc.OrderingPolicy)
+		}
+	}
+	if cc.OrderingPolicy == IsolateLargeBlocksPolicy && cc.LargeBlockThreshold == 0 {
+		return errors.New("large block threshold must be set when isolating large blocks")
+	}
+
 	return nil
 }
 
@@ -314,7 +344,11 @@ func (c *channelBuilder) IsFull() bool {
 //   - ErrMaxDurationReached if the max channel duration got reached,
 //   - ErrChannelTimeoutClose if the consensus channel timeout got too close,
 //   - ErrSeqWindowClose if the end of the sequencer window got too close,
-//   - ErrTerminated if the channel was explicitly terminated.
+//   - ErrTerminated if the channel was explicitly terminated,
+//   - ErrOrderingPolicySplit if the ordering policy split the pending blocks
+//     at this channel,
+//   - ErrForcedSubmission if the channel contains blocks an operator forced
+//     to be submitted immediately.
 func (c *channelBuilder) FullErr() error {
 	return c.fullErr
 }
@@ -408,8 +442,14 @@ func (c *channelBuilder) outputFrame() error {
 // Close immediately marks the channel as full with an ErrTerminated
 // if the channel is not already full.
 func (c *channelBuilder) Close() {
+	c.CloseWithReason(ErrTerminated)
+}
+
+// CloseWithReason immediately marks the channel as full with the given reason
+// if the channel is not already full.
+func (c *channelBuilder) CloseWithReason(reason error) {
 	if !c.IsFull() {
-		c.setFullErr(ErrTerminated)
+		c.setFullErr(reason)
 	}
 }
 
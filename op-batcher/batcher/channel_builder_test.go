@@ -53,6 +53,10 @@ func TestChannelConfig_Check(t *testing.T) {
 	timeoutChannelConfig := defaultTestChannelConfig
 	timeoutChannelConfig.ChannelTimeout = 0
 	timeoutChannelConfig.SubSafetyMargin = 1
+	unknownPolicyChannelConfig := defaultTestChannelConfig
+	unknownPolicyChannelConfig.OrderingPolicy = "unknown"
+	noThresholdChannelConfig := defaultTestChannelConfig
+	noThresholdChannelConfig.OrderingPolicy = IsolateLargeBlocksPolicy
 	tests := []test{
 		{
 			input: defaultTestChannelConfig,
@@ -72,6 +76,18 @@ func TestChannelConfig_Check(t *testing.T) {
 				require.EqualError(t, output, "max frame size cannot be zero")
 			},
 		},
+		{
+			input: unknownPolicyChannelConfig,
+			assertion: func(output error) {
+				require.EqualError(t, output, "unrecognized ordering policy: unknown")
+			},
+		},
+		{
+			input: noThresholdChannelConfig,
+			assertion: func(output error) {
+				require.EqualError(t, output, "large block threshold must be set when isolating large blocks")
+			},
+		},
 	}
 	for i := 1; i < derive.FrameV0OverHeadSize; i++ {
 		smallChannelConfig := defaultTestChannelConfig
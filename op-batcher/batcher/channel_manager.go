@@ -31,6 +31,9 @@ type channelManager struct {
 	cfg  ChannelConfig
 	rcfg *rollup.Config
 
+	// decides where pending blocks are split across channels
+	policy OrderingPolicy
+
 	// All blocks since the last request for new tx data.
 	blocks []*types.Block
 	// last block hash - for reorg detection
@@ -45,6 +48,10 @@ type channelManager struct {
 
 	// if set to true, prevents production of any new channel frames
 	closed bool
+
+	// L2 block ranges to exclude from batching or submit immediately.
+	// Unlike the rest of the state, these are not reset by Clear.
+	controls blockControls
 }
 
 func NewChannelManager(log log.Logger, metr metrics.Metricer, cfg ChannelConfig, rcfg *rollup.Config) *channelManager {
@@ -53,6 +60,7 @@ func NewChannelManager(log log.Logger, metr metrics.Metricer, cfg ChannelConfig,
 		metr:       metr,
 		cfg:        cfg,
 		rcfg:       rcfg,
+		policy:     cfg.NewOrderingPolicy(),
 		txChannels: make(map[txID]*channel),
 	}
 }
@@ -109,6 +117,33 @@ func (s *channelManager) TxConfirmed(id txID, inclusionBlock eth.BlockID) {
 	s.log.Debug("marked transaction as confirmed", "id", id, "block", inclusionBlock)
 }
 
+// pendingFrameRecord returns the frame record of a pending transaction, without its L1 inclusion details.
+// It must be called before the transaction is marked as confirmed.
+func (s *channelManager) pendingFrameRecord(id txID) (FrameRecord, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channel, ok := s.txChannels[id]
+	if !ok {
+		return FrameRecord{}, false
+	}
+	data, ok := channel.pendingTransactions[id]
+	if !ok {
+		return FrameRecord{}, false
+	}
+	record := FrameRecord{
+		ChannelID:   id.chID,
+		FrameNumber: id.frameNumber,
+		// The frame follows the version byte in the transaction data.
+		DataOffset: 1,
+		DataLength: uint64(len(data.frame.data)),
+	}
+	if blocks := channel.channelBuilder.Blocks(); len(blocks) > 0 {
+		record.L2StartBlock = blocks[0].NumberU64()
+		record.L2EndBlock = blocks[len(blocks)-1].NumberU64()
+	}
+	return record, true
+}
+
 // removePendingChannel removes the given completed channel from the manager's state.
 func (s *channelManager) removePendingChannel(channel *channel) {
 	if s.currentChannel == channel {
@@ -198,7 +233,11 @@ func (s *channelManager) ensureChannelWithSpace(l1Head eth.BlockID) error {
 		return nil
 	}
 
-	pc, err := newChannel(s.log, s.metr, s.cfg, s.rcfg)
+	cfg := s.cfg
+	if cfg.CompressorConfig.CompressionAlgo.IsBrotli() && !s.pendingBlocksFjord() {
+		cfg.CompressorConfig.CompressionAlgo = derive.Zlib
+	}
+	pc, err := newChannel(s.log, s.metr, cfg, s.rcfg)
 	if err != nil {
 		return fmt.Errorf("creating new channel: %w", err)
 	}
@@ -209,12 +248,32 @@ func (s *channelManager) ensureChannelWithSpace(l1Head eth.BlockID) error {
 		"l1Head", l1Head,
 		"blocks_pending", len(s.blocks),
 		"batch_type", s.cfg.BatchType,
+		"compression_algo", cfg.CompressorConfig.CompressionAlgo,
 	)
 	s.metr.RecordChannelOpened(pc.ID(), len(s.blocks))
 
 	return nil
 }
 
+// pendingBlocksFjord returns true if Fjord is active at the L1 origin of the oldest pending block.
+// A channel is always included on L1 at or after the L1 origins of its blocks, so brotli compressed
+// channels containing the pending blocks will be accepted by the derivation pipeline.
+func (s *channelManager) pendingBlocksFjord() bool {
+	if len(s.blocks) == 0 {
+		return false
+	}
+	txs := s.blocks[0].Transactions()
+	if len(txs) == 0 || txs[0].Type() != types.DepositTxType {
+		return false
+	}
+	l1Info, err := derive.L1InfoDepositTxData(txs[0].Data())
+	if err != nil {
+		s.log.Warn("Failed to parse L1 info of pending block", "block", s.blocks[0].Hash(), "err", err)
+		return false
+	}
+	return s.rcfg.IsFjord(l1Info.Time)
+}
+
 // registerL1Block registers the given block at the pending channel.
 func (s *channelManager) registerL1Block(l1Head eth.BlockID) {
 	s.currentChannel.RegisterL1Block(l1Head.Number)
@@ -226,14 +285,21 @@ func (s *channelManager) registerL1Block(l1Head eth.BlockID) {
 }
 
 // processBlocks adds blocks from the blocks queue to the pending channel until
-// either the queue got exhausted or the channel is full.
+// either the queue got exhausted, the channel is full or the ordering policy
+// splits the channel.
 func (s *channelManager) processBlocks() error {
 	var (
 		blocksAdded int
 		_chFullErr  *ChannelFullError // throw away, just for type checking
 		latestL2ref eth.L2BlockRef
 	)
+	forced := false
 	for i, block := range s.blocks {
+		if s.policy.SplitBefore(s.currentChannel.NumBlocks(), block) {
+			s.log.Debug("Splitting channel before block", "id", s.currentChannel.ID(), "block", block.NumberU64(), "size", block.Size())
+			s.currentChannel.CloseWithReason(ErrOrderingPolicySplit)
+			break
+		}
 		l1info, err := s.currentChannel.AddBlock(block)
 		if errors.As(err, &_chFullErr) {
 			// current block didn't get added because channel is already full
@@ -244,12 +310,23 @@ func (s *channelManager) processBlocks() error {
 		s.log.Debug("Added block to channel", "id", s.currentChannel.ID(), "block", block)
 
 		blocksAdded += 1
+		forced = forced || s.controls.isForced(block.NumberU64())
 		latestL2ref = l2BlockRefFromBlockAndL1Info(block, l1info)
 		s.metr.RecordL2BlockInChannel(block)
 		// current block got added but channel is now full
 		if s.currentChannel.IsFull() {
 			break
 		}
+		if s.policy.SplitAfter(block) {
+			s.log.Debug("Splitting channel after block", "id", s.currentChannel.ID(), "block", block.NumberU64(), "size", block.Size())
+			s.currentChannel.CloseWithReason(ErrOrderingPolicySplit)
+			break
+		}
+	}
+
+	if forced && !s.currentChannel.IsFull() {
+		s.log.Info("Closing channel to force submission of blocks", "id", s.currentChannel.ID())
+		s.currentChannel.CloseWithReason(ErrForcedSubmission)
 	}
 
 	if blocksAdded == len(s.blocks) {
@@ -311,13 +388,20 @@ func (s *channelManager) outputFrames() error {
 
 // AddL2Block adds an L2 block to the internal blocks queue. It returns ErrReorg
 // if the block does not extend the last block loaded into the state. If no
-// blocks were added yet, the parent hash check is skipped.
+// blocks were added yet, the parent hash check is skipped. Blocks excluded from
+// batching are not queued but still become the tip.
 func (s *channelManager) AddL2Block(block *types.Block) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.tip != (common.Hash{}) && s.tip != block.ParentHash() {
 		return ErrReorg
 	}
+	if s.controls.isExcluded(block.NumberU64()) {
+		// Track the skipped block as the tip so the blocks after it are still checked for reorgs.
+		s.log.Warn("Excluding L2 block from batching", "block", eth.ToBlockID(block))
+		s.tip = block.Hash()
+		return nil
+	}
 
 	s.metr.RecordL2BlockInPendingQueue(block)
 	s.blocks = append(s.blocks, block)
@@ -337,6 +421,23 @@ func l2BlockRefFromBlockAndL1Info(block *types.Block, l1info derive.L1BlockInfo)
 	}
 }
 
+// ExcludeL2Blocks excludes the L2 blocks in r from batching. Blocks that were already added to the state are still
+// submitted.
+func (s *channelManager) ExcludeL2Blocks(r BlockRange) {
+	s.controls.exclude(r)
+}
+
+// ForceSubmitL2Blocks forces the L2 blocks in r to be submitted immediately by closing the channel they are added to
+// rather than waiting for it to fill up or time out.
+func (s *channelManager) ForceSubmitL2Blocks(r BlockRange) {
+	s.controls.force(r)
+}
+
+// ClearL2BlockControls removes all exclusions and forced submissions.
+func (s *channelManager) ClearL2BlockControls() {
+	s.controls.clear()
+}
+
 var ErrPendingAfterClose = errors.New("pending channels remain after closing channel-manager")
 
 // Close clears any pending channels that are not in-flight already, to leave a clean derivation state.
@@ -478,3 +478,219 @@ func ChannelManagerCloseAllTxsFailed(t *testing.T, batchType uint) {
 	_, err = m.TxData(eth.BlockID{})
 	require.ErrorIs(err, io.EOF, "Expected closed channel manager to produce no more tx data")
 }
+
+// TestChannelManager_IsolateLargeBlocks ensures that the isolate-large-blocks ordering
+// policy submits a large block in a channel of its own, without delaying the small
+// blocks queued before or after it.
+func TestChannelManager_IsolateLargeBlocks(t *testing.T) {
+	require := require.New(t)
+	rng := rand.New(rand.NewSource(123))
+	log := testlog.Logger(t, log.LvlError)
+
+	small1 := derivetest.RandomL2BlockWithChainId(rng, 1, defaultTestRollupConfig.L2ChainID)
+	large := derivetest.RandomL2BlockWithChainId(rng, 50, defaultTestRollupConfig.L2ChainID)
+	small2 := derivetest.RandomL2BlockWithChainId(rng, 1, defaultTestRollupConfig.L2ChainID)
+	small3 := derivetest.RandomL2BlockWithChainId(rng, 1, defaultTestRollupConfig.L2ChainID)
+	blocks := []*types.Block{small1, large, small2, small3}
+	for i := 1; i < len(blocks); i++ {
+		header := blocks[i].Header()
+		header.Number = new(big.Int).Add(blocks[i-1].Number(), big.NewInt(1))
+		header.ParentHash = blocks[i-1].Hash()
+		blocks[i] = blocks[i].WithSeal(header)
+	}
+	require.Less(small1.Size(), large.Size())
+
+	const framesize = 1_000_000
+	m := NewChannelManager(log, metrics.NoopMetrics,
+		ChannelConfig{
+			MaxFrameSize:   framesize,
+			ChannelTimeout: 1000,
+			CompressorConfig: compressor.Config{
+				TargetNumFrames:  1,
+				TargetFrameSize:  framesize,
+				ApproxComprRatio: 1.0,
+				Kind:             "none",
+			},
+			OrderingPolicy:      IsolateLargeBlocksPolicy,
+			LargeBlockThreshold: large.Size(),
+		},
+		&defaultTestRollupConfig,
+	)
+	m.Clear()
+	for _, block := range blocks {
+		require.NoError(m.AddL2Block(block))
+	}
+
+	// Each call to TxData closes the current channel at the next split point
+	// and returns its single frame.
+	for i, expected := range [][]*types.Block{{blocks[0]}, {blocks[1]}} {
+		txdata, err := m.TxData(eth.BlockID{})
+		require.NoError(err)
+		ch := m.txChannels[txdata.ID()]
+		require.Equal(expected, ch.channelBuilder.Blocks(), "unexpected blocks in channel %v", i)
+		require.ErrorIs(ch.FullErr(), ErrOrderingPolicySplit)
+		m.TxConfirmed(txdata.ID(), eth.BlockID{})
+	}
+
+	// The small blocks after the large block share a channel which remains open for more blocks.
+	_, err := m.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF)
+	require.Equal([]*types.Block{blocks[2], blocks[3]}, m.currentChannel.channelBuilder.Blocks())
+	require.False(m.currentChannel.IsFull())
+}
+
+func TestChannelManager_OldestFirstDoesNotSplit(t *testing.T) {
+	require := require.New(t)
+	rng := rand.New(rand.NewSource(123))
+	log := testlog.Logger(t, log.LvlError)
+
+	a := derivetest.RandomL2BlockWithChainId(rng, 1, defaultTestRollupConfig.L2ChainID)
+	b := derivetest.RandomL2BlockWithChainId(rng, 50, defaultTestRollupConfig.L2ChainID)
+	bHeader := b.Header()
+	bHeader.Number = new(big.Int).Add(a.Number(), big.NewInt(1))
+	bHeader.ParentHash = a.Hash()
+	b = b.WithSeal(bHeader)
+
+	m := NewChannelManager(log, metrics.NoopMetrics,
+		ChannelConfig{
+			MaxFrameSize:   1_000_000,
+			ChannelTimeout: 1000,
+			CompressorConfig: compressor.Config{
+				TargetNumFrames:  1,
+				TargetFrameSize:  1_000_000,
+				ApproxComprRatio: 1.0,
+				Kind:             "none",
+			},
+			LargeBlockThreshold: 1,
+		},
+		&defaultTestRollupConfig,
+	)
+	m.Clear()
+	require.NoError(m.AddL2Block(a))
+	require.NoError(m.AddL2Block(b))
+
+	_, err := m.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF)
+	require.Equal([]*types.Block{a, b}, m.currentChannel.channelBuilder.Blocks())
+	require.False(m.currentChannel.IsFull())
+}
+
+// chainedTestBlocks returns n random blocks, each a child of the previous block.
+func chainedTestBlocks(rng *rand.Rand, n int) []*types.Block {
+	blocks := make([]*types.Block, n)
+	for i := range blocks {
+		blocks[i] = derivetest.RandomL2BlockWithChainId(rng, 1, defaultTestRollupConfig.L2ChainID)
+		if i > 0 {
+			header := blocks[i].Header()
+			header.Number = new(big.Int).Add(blocks[i-1].Number(), big.NewInt(1))
+			header.ParentHash = blocks[i-1].Hash()
+			blocks[i] = blocks[i].WithSeal(header)
+		}
+	}
+	return blocks
+}
+
+func newLargeChannelTestManager(t *testing.T) *channelManager {
+	m := NewChannelManager(testlog.Logger(t, log.LvlError), metrics.NoopMetrics,
+		ChannelConfig{
+			MaxFrameSize:   1_000_000,
+			ChannelTimeout: 1000,
+			CompressorConfig: compressor.Config{
+				TargetNumFrames:  1,
+				TargetFrameSize:  1_000_000,
+				ApproxComprRatio: 1.0,
+				Kind:             "none",
+			},
+		},
+		&defaultTestRollupConfig,
+	)
+	m.Clear()
+	return m
+}
+
+func TestChannelManager_ExcludeL2Blocks(t *testing.T) {
+	require := require.New(t)
+	blocks := chainedTestBlocks(rand.New(rand.NewSource(123)), 4)
+	m := newLargeChannelTestManager(t)
+	m.ExcludeL2Blocks(BlockRange{Start: blocks[1].NumberU64(), End: blocks[2].NumberU64()})
+	for _, block := range blocks {
+		require.NoError(m.AddL2Block(block))
+	}
+	require.Equal([]*types.Block{blocks[0], blocks[3]}, m.blocks)
+
+	// Exclusions are not reset by Clear, but are by ClearL2BlockControls.
+	m.Clear()
+	require.NoError(m.AddL2Block(blocks[1]))
+	require.Empty(m.blocks)
+	m.ClearL2BlockControls()
+	require.NoError(m.AddL2Block(blocks[2]))
+	require.Equal([]*types.Block{blocks[2]}, m.blocks)
+}
+
+func TestChannelManager_ForceSubmitL2Blocks(t *testing.T) {
+	require := require.New(t)
+	blocks := chainedTestBlocks(rand.New(rand.NewSource(123)), 3)
+	m := newLargeChannelTestManager(t)
+	m.ForceSubmitL2Blocks(BlockRange{Start: blocks[1].NumberU64(), End: blocks[1].NumberU64()})
+	for _, block := range blocks[:2] {
+		require.NoError(m.AddL2Block(block))
+	}
+
+	// The channel containing the forced block is closed immediately instead of waiting for more blocks.
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+	ch := m.txChannels[txdata.ID()]
+	require.Equal(blocks[:2], ch.channelBuilder.Blocks())
+	require.ErrorIs(ch.FullErr(), ErrForcedSubmission)
+	m.TxConfirmed(txdata.ID(), eth.BlockID{})
+
+	// Later blocks are batched as normal.
+	require.NoError(m.AddL2Block(blocks[2]))
+	_, err = m.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF)
+	require.False(m.currentChannel.IsFull())
+}
+
+func TestChannelManager_BrotliFjordActivation(t *testing.T) {
+	fjordAt := func(time uint64) *uint64 { return &time }
+	tests := []struct {
+		name      string
+		fjordTime *uint64
+		algo      derive.CompressionAlgo
+		expected  derive.CompressionAlgo
+	}{
+		{name: "ZlibPreFjord", algo: derive.Zlib, expected: derive.Zlib},
+		{name: "BrotliNoFjord", algo: derive.Brotli10, expected: derive.Zlib},
+		{name: "BrotliPreFjord", fjordTime: fjordAt(1), algo: derive.Brotli10, expected: derive.Zlib},
+		{name: "BrotliFjord", fjordTime: fjordAt(0), algo: derive.Brotli10, expected: derive.Brotli10},
+		{name: "ZlibFjord", fjordTime: fjordAt(0), algo: derive.Zlib, expected: derive.Zlib},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			log := testlog.Logger(t, log.LvlCrit)
+			rollupCfg := defaultTestRollupConfig
+			rollupCfg.FjordTime = test.fjordTime
+			m := NewChannelManager(log, metrics.NoopMetrics,
+				ChannelConfig{
+					MaxFrameSize:   1000,
+					ChannelTimeout: 1000,
+					CompressorConfig: compressor.Config{
+						TargetNumFrames:  1,
+						TargetFrameSize:  1000,
+						ApproxComprRatio: 1.0,
+						CompressionAlgo:  test.algo,
+					},
+				},
+				&rollupCfg,
+			)
+			m.Clear()
+			// The L1 origin of the block has timestamp 0
+			require.NoError(t, m.AddL2Block(newMiniL2Block(0)))
+
+			_, err := m.TxData(eth.BlockID{})
+			require.ErrorIs(t, err, io.EOF)
+			require.Equal(t, test.expected, m.currentChannel.cfg.CompressorConfig.CompressionAlgo)
+		})
+	}
+}
@@ -55,8 +55,23 @@ type CLIConfig struct {
 
 	Stopped bool
 
+	// GracefulUpgrade restarts the batcher as a new process when SIGUSR2 is received, handing over its listeners.
+	GracefulUpgrade bool
+
 	BatchType uint
 
+	// BatchOrderingPolicy is the name of the policy used to split pending L2 blocks across channels.
+	// If empty, the oldest-first policy is used.
+	BatchOrderingPolicy string
+
+	// LargeBlockThreshold is the RLP encoded L2 block size, including the header, at or above which the
+	// isolate-large-blocks ordering policy submits a block in a channel of its own.
+	LargeBlockThreshold uint64
+
+	// FrameRecordFile is the path of the file the L1 location of every confirmed frame is appended to.
+	// If empty, frames are not recorded.
+	FrameRecordFile string
+
 	TxMgrConfig      txmgr.CLIConfig
 	LogConfig        oplog.CLIConfig
 	MetricsConfig    opmetrics.CLIConfig
@@ -118,7 +133,11 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		MaxChannelDuration:     ctx.Uint64(flags.MaxChannelDurationFlag.Name),
 		MaxL1TxSize:            ctx.Uint64(flags.MaxL1TxSizeBytesFlag.Name),
 		Stopped:                ctx.Bool(flags.StoppedFlag.Name),
+		GracefulUpgrade:        ctx.Bool(flags.GracefulUpgradeFlag.Name),
 		BatchType:              ctx.Uint(flags.BatchTypeFlag.Name),
+		BatchOrderingPolicy:    ctx.String(flags.BatchOrderingPolicyFlag.Name),
+		LargeBlockThreshold:    ctx.Uint64(flags.LargeBlockThresholdFlag.Name),
+		FrameRecordFile:        ctx.String(flags.FrameRecordFileFlag.Name),
 		TxMgrConfig:            txmgr.ReadCLIConfig(ctx),
 		LogConfig:              oplog.ReadCLIConfig(ctx),
 		MetricsConfig:          opmetrics.ReadCLIConfig(ctx),
@@ -46,6 +46,8 @@ type DriverSetup struct {
 	L1Client         L1Client
 	EndpointProvider dial.L2EndpointProvider
 	ChannelConfig    ChannelConfig
+	// FrameRecorder, if set, is given the L1 location of every confirmed frame.
+	FrameRecorder FrameRecorder
 }
 
 // BatchSubmitter encapsulates a service responsible for submitting L2 tx
@@ -138,6 +140,39 @@ func (l *BatchSubmitter) StopBatchSubmitting(ctx context.Context) error {
 	return nil
 }
 
+// SkipL2Blocks excludes the L2 blocks from start to end, inclusive, from batching. Excluded blocks, and all blocks
+// after them, can't become safe so acknowledgeConsequences must be true to confirm this is intended.
+func (l *BatchSubmitter) SkipL2Blocks(start uint64, end uint64, acknowledgeConsequences bool) error {
+	r := BlockRange{Start: start, End: end}
+	if err := r.Check(); err != nil {
+		return err
+	}
+	if !acknowledgeConsequences {
+		return ErrSkipNotAcknowledged
+	}
+	l.Log.Warn("Excluding L2 blocks from batching", "start", start, "end", end)
+	l.state.ExcludeL2Blocks(r)
+	return nil
+}
+
+// ForceSubmitL2Blocks submits the L2 blocks from start to end, inclusive, as soon as they are loaded rather than
+// waiting for their channel to fill up or time out.
+func (l *BatchSubmitter) ForceSubmitL2Blocks(start uint64, end uint64) error {
+	r := BlockRange{Start: start, End: end}
+	if err := r.Check(); err != nil {
+		return err
+	}
+	l.Log.Info("Forcing submission of L2 blocks", "start", start, "end", end)
+	l.state.ForceSubmitL2Blocks(r)
+	return nil
+}
+
+// ClearL2BlockControls removes all L2 block exclusions and forced submissions.
+func (l *BatchSubmitter) ClearL2BlockControls() {
+	l.Log.Info("Clearing L2 block controls")
+	l.state.ClearL2BlockControls()
+}
+
 // loadBlocksIntoState loads all blocks since the previous stored block
 // It does the following:
 // 1. Fetch the sync status of the sequencer
@@ -369,6 +404,7 @@ func (l *BatchSubmitter) sendTransaction(txdata txData, queue *txmgr.Queue[txDat
 		To:       &l.RollupConfig.BatchInboxAddress,
 		TxData:   data,
 		GasLimit: intrinsicGas,
+		Label:    "batch",
 	}
 	queue.Send(txdata, candidate, receiptsCh)
 }
@@ -400,9 +436,29 @@ func (l *BatchSubmitter) recordFailedTx(id txID, err error) {
 func (l *BatchSubmitter) recordConfirmedTx(id txID, receipt *types.Receipt) {
 	l.Log.Info("Transaction confirmed", "tx_hash", receipt.TxHash, "status", receipt.Status, "block_hash", receipt.BlockHash, "block_number", receipt.BlockNumber)
 	l1block := eth.BlockID{Number: receipt.BlockNumber.Uint64(), Hash: receipt.BlockHash}
+	l.recordFrame(id, receipt, l1block)
 	l.state.TxConfirmed(id, l1block)
 }
 
+// recordFrame passes the L1 location of the confirmed frame to the FrameRecorder, if one is configured.
+// Failing to record a frame does not affect batch submission.
+func (l *BatchSubmitter) recordFrame(id txID, receipt *types.Receipt, l1block eth.BlockID) {
+	if l.FrameRecorder == nil {
+		return
+	}
+	record, ok := l.state.pendingFrameRecord(id)
+	if !ok {
+		l.Log.Warn("Not recording frame from unknown channel", "id", id)
+		return
+	}
+	record.L1TxHash = receipt.TxHash
+	record.L1Block = l1block
+	record.L1TxIndex = receipt.TransactionIndex
+	if err := l.FrameRecorder.RecordFrame(record); err != nil {
+		l.Log.Error("Failed to record frame", "id", id, "tx_hash", receipt.TxHash, "err", err)
+	}
+}
+
 // l1Tip gets the current L1 tip as a L1BlockRef. The passed context is assumed
 // to be a lifetime context, so it is internally wrapped with a network timeout.
 func (l *BatchSubmitter) l1Tip(ctx context.Context) (eth.L1BlockRef, error) {
@@ -0,0 +1,180 @@
+package batcher
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// FrameRecord describes where a single submitted frame was included on L1.
+type FrameRecord struct {
+	ChannelID   derive.ChannelID `json:"channelId"`
+	FrameNumber uint16           `json:"frameNumber"`
+	// L2StartBlock and L2EndBlock are the first and last L2 block numbers carried by the frame's channel.
+	L2StartBlock uint64 `json:"l2StartBlock"`
+	L2EndBlock   uint64 `json:"l2EndBlock"`
+
+	L1TxHash  common.Hash `json:"l1TxHash"`
+	L1Block   eth.BlockID `json:"l1Block"`
+	L1TxIndex uint        `json:"l1TxIndex"`
+	// DataOffset is the byte offset of the frame within the transaction calldata, after the version byte.
+	DataOffset uint64 `json:"dataOffset"`
+	DataLength uint64 `json:"dataLength"`
+}
+
+// FrameRecorder persists a FrameRecord for every confirmed frame.
+type FrameRecorder interface {
+	RecordFrame(record FrameRecord) error
+}
+
+// FileFrameRecorder appends frame records to a file, one JSON object per line.
+type FileFrameRecorder struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileFrameRecorder(path string) (*FileFrameRecorder, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open frame record file: %w", err)
+	}
+	return &FileFrameRecorder{file: file}, nil
+}
+
+func (r *FileFrameRecorder) RecordFrame(record FrameRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode frame record: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write frame record: %w", err)
+	}
+	return nil
+}
+
+func (r *FileFrameRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+// ReadFrameRecords reads all frame records previously written by a FileFrameRecorder.
+func ReadFrameRecords(in io.Reader) ([]FrameRecord, error) {
+	var records []FrameRecord
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 4096), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record FrameRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("invalid frame record on line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read frame records: %w", err)
+	}
+	return records, nil
+}
+
+// FrameLocation identifies the L1 data holding a single frame.
+type FrameLocation struct {
+	FrameNumber uint16      `json:"frameNumber"`
+	L1TxHash    common.Hash `json:"l1TxHash"`
+	L1Block     eth.BlockID `json:"l1Block"`
+	L1TxIndex   uint        `json:"l1TxIndex"`
+	DataOffset  uint64      `json:"dataOffset"`
+	DataLength  uint64      `json:"dataLength"`
+}
+
+// ChannelAudit maps a range of L2 blocks to the L1 locations of the frames of the channel that carried them.
+type ChannelAudit struct {
+	ChannelID    derive.ChannelID `json:"channelId"`
+	L2StartBlock uint64           `json:"l2StartBlock"`
+	L2EndBlock   uint64           `json:"l2EndBlock"`
+	Frames       []FrameLocation  `json:"frames"`
+}
+
+// BuildFrameAudit groups frame records by channel, ordered by L2 start block with frames in frame number order.
+// If a frame was recorded more than once, e.g. after a reorg, the latest record is used.
+func BuildFrameAudit(records []FrameRecord) []ChannelAudit {
+	byChannel := make(map[derive.ChannelID]*ChannelAudit)
+	frames := make(map[frameID]int)
+	for _, record := range records {
+		audit, ok := byChannel[record.ChannelID]
+		if !ok {
+			audit = &ChannelAudit{
+				ChannelID:    record.ChannelID,
+				L2StartBlock: record.L2StartBlock,
+				L2EndBlock:   record.L2EndBlock,
+			}
+			byChannel[record.ChannelID] = audit
+		}
+		audit.L2StartBlock = min(audit.L2StartBlock, record.L2StartBlock)
+		audit.L2EndBlock = max(audit.L2EndBlock, record.L2EndBlock)
+		location := FrameLocation{
+			FrameNumber: record.FrameNumber,
+			L1TxHash:    record.L1TxHash,
+			L1Block:     record.L1Block,
+			L1TxIndex:   record.L1TxIndex,
+			DataOffset:  record.DataOffset,
+			DataLength:  record.DataLength,
+		}
+		id := frameID{chID: record.ChannelID, frameNumber: record.FrameNumber}
+		if idx, ok := frames[id]; ok {
+			audit.Frames[idx] = location
+			continue
+		}
+		frames[id] = len(audit.Frames)
+		audit.Frames = append(audit.Frames, location)
+	}
+
+	audits := make([]ChannelAudit, 0, len(byChannel))
+	for _, audit := range byChannel {
+		sort.Slice(audit.Frames, func(i, j int) bool {
+			return audit.Frames[i].FrameNumber < audit.Frames[j].FrameNumber
+		})
+		audits = append(audits, *audit)
+	}
+	sort.Slice(audits, func(i, j int) bool {
+		if audits[i].L2StartBlock != audits[j].L2StartBlock {
+			return audits[i].L2StartBlock < audits[j].L2StartBlock
+		}
+		return audits[i].ChannelID.String() < audits[j].ChannelID.String()
+	})
+	return audits
+}
+
+// ExportFrameAudit reads frame records from recordPath and writes the audit file to outPath as JSON.
+func ExportFrameAudit(recordPath string, outPath string) error {
+	in, err := os.Open(recordPath)
+	if err != nil {
+		return fmt.Errorf("failed to open frame record file: %w", err)
+	}
+	defer in.Close()
+	records, err := ReadFrameRecords(in)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(BuildFrameAudit(records), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode frame audit: %w", err)
+	}
+	if err := os.WriteFile(outPath, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write frame audit: %w", err)
+	}
+	return nil
+}
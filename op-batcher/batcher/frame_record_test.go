@@ -0,0 +1,183 @@
+package batcher
+
+import (
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	derivetest "github.com/ethereum-optimism/optimism/op-node/rollup/derive/test"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileFrameRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "frames.jsonl")
+	first := FrameRecord{
+		ChannelID:    derive.ChannelID{0x01},
+		FrameNumber:  0,
+		L2StartBlock: 10,
+		L2EndBlock:   12,
+		L1TxHash:     common.Hash{0xaa},
+		L1Block:      eth.BlockID{Hash: common.Hash{0xbb}, Number: 100},
+		L1TxIndex:    3,
+		DataOffset:   1,
+		DataLength:   500,
+	}
+	second := first
+	second.FrameNumber = 1
+	second.L1TxHash = common.Hash{0xcc}
+
+	recorder, err := NewFileFrameRecorder(path)
+	require.NoError(t, err)
+	require.NoError(t, recorder.RecordFrame(first))
+	require.NoError(t, recorder.Close())
+
+	// Records are appended to an existing file
+	recorder, err = NewFileFrameRecorder(path)
+	require.NoError(t, err)
+	require.NoError(t, recorder.RecordFrame(second))
+	require.NoError(t, recorder.Close())
+
+	in, err := os.Open(path)
+	require.NoError(t, err)
+	defer in.Close()
+	records, err := ReadFrameRecords(in)
+	require.NoError(t, err)
+	require.Equal(t, []FrameRecord{first, second}, records)
+}
+
+func TestReadFrameRecordsInvalid(t *testing.T) {
+	_, err := ReadFrameRecords(strings.NewReader("{}\nnot json\n"))
+	require.ErrorContains(t, err, "line 2")
+}
+
+func TestBuildFrameAudit(t *testing.T) {
+	chA := derive.ChannelID{0x0a}
+	chB := derive.ChannelID{0x0b}
+	record := func(ch derive.ChannelID, frame uint16, start, end uint64, tx byte) FrameRecord {
+		return FrameRecord{
+			ChannelID:    ch,
+			FrameNumber:  frame,
+			L2StartBlock: start,
+			L2EndBlock:   end,
+			L1TxHash:     common.Hash{tx},
+			L1Block:      eth.BlockID{Number: uint64(tx)},
+			DataOffset:   1,
+			DataLength:   100,
+		}
+	}
+	location := func(r FrameRecord) FrameLocation {
+		return FrameLocation{
+			FrameNumber: r.FrameNumber,
+			L1TxHash:    r.L1TxHash,
+			L1Block:     r.L1Block,
+			L1TxIndex:   r.L1TxIndex,
+			DataOffset:  r.DataOffset,
+			DataLength:  r.DataLength,
+		}
+	}
+
+	b0 := record(chB, 0, 20, 25, 1)
+	a1 := record(chA, 1, 10, 19, 2)
+	a0 := record(chA, 0, 10, 19, 3)
+	// Frame 0 of channel A is included again after a reorg
+	a0Reincluded := record(chA, 0, 10, 19, 4)
+
+	audit := BuildFrameAudit([]FrameRecord{b0, a1, a0, a0Reincluded})
+	require.Equal(t, []ChannelAudit{
+		{
+			ChannelID:    chA,
+			L2StartBlock: 10,
+			L2EndBlock:   19,
+			Frames:       []FrameLocation{location(a0Reincluded), location(a1)},
+		},
+		{
+			ChannelID:    chB,
+			L2StartBlock: 20,
+			L2EndBlock:   25,
+			Frames:       []FrameLocation{location(b0)},
+		},
+	}, audit)
+}
+
+func TestExportFrameAudit(t *testing.T) {
+	dir := t.TempDir()
+	recordPath := filepath.Join(dir, "frames.jsonl")
+	outPath := filepath.Join(dir, "audit.json")
+	rec := FrameRecord{ChannelID: derive.ChannelID{0x01}, L2StartBlock: 5, L2EndBlock: 6, DataOffset: 1, DataLength: 10}
+
+	recorder, err := NewFileFrameRecorder(recordPath)
+	require.NoError(t, err)
+	require.NoError(t, recorder.RecordFrame(rec))
+	require.NoError(t, recorder.Close())
+
+	require.NoError(t, ExportFrameAudit(recordPath, outPath))
+	data, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	var audit []ChannelAudit
+	require.NoError(t, json.Unmarshal(data, &audit))
+	require.Equal(t, BuildFrameAudit([]FrameRecord{rec}), audit)
+}
+
+func TestChannelManager_PendingFrameRecord(t *testing.T) {
+	require := require.New(t)
+	rng := rand.New(rand.NewSource(123))
+	log := testlog.Logger(t, log.LvlError)
+	m := NewChannelManager(log, metrics.NoopMetrics,
+		ChannelConfig{
+			MaxFrameSize:   1_000_000,
+			ChannelTimeout: 1000,
+			CompressorConfig: compressor.Config{
+				TargetNumFrames:  1,
+				TargetFrameSize:  1_000_000,
+				ApproxComprRatio: 1.0,
+				Kind:             "none",
+			},
+		},
+		&defaultTestRollupConfig,
+	)
+	m.Clear()
+
+	a := derivetest.RandomL2BlockWithChainId(rng, 4, defaultTestRollupConfig.L2ChainID)
+	b := derivetest.RandomL2BlockWithChainId(rng, 4, defaultTestRollupConfig.L2ChainID)
+	bHeader := b.Header()
+	bHeader.Number = new(big.Int).Add(a.Number(), big.NewInt(1))
+	bHeader.ParentHash = a.Hash()
+	b = b.WithSeal(bHeader)
+	require.NoError(m.AddL2Block(a))
+	require.NoError(m.AddL2Block(b))
+	// Put both blocks in a single channel and force it to output its frame
+	require.NoError(m.ensureChannelWithSpace(eth.BlockID{}))
+	require.NoError(m.processBlocks())
+	m.currentChannel.Close()
+	require.NoError(m.outputFrames())
+
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+
+	record, ok := m.pendingFrameRecord(txdata.ID())
+	require.True(ok)
+	require.Equal(FrameRecord{
+		ChannelID:    txdata.ID().chID,
+		FrameNumber:  txdata.ID().frameNumber,
+		L2StartBlock: a.NumberU64(),
+		L2EndBlock:   b.NumberU64(),
+		DataOffset:   1,
+		DataLength:   uint64(len(txdata.Frame().data)),
+	}, record)
+	require.Equal(txdata.Bytes()[record.DataOffset:], txdata.Frame().data)
+
+	m.TxConfirmed(txdata.ID(), eth.BlockID{})
+	_, ok = m.pendingFrameRecord(txdata.ID())
+	require.False(ok, "confirmed frames are no longer pending")
+}
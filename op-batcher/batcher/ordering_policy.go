@@ -0,0 +1,63 @@
+package batcher
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// OldestFirstPolicy fills each channel with pending blocks, oldest first, until it is full or times out.
+	OldestFirstPolicy = "oldest-first"
+	// IsolateLargeBlocksPolicy places every block whose RLP encoded size, including its header, is at or above the
+	// configured LargeBlockThreshold into a channel of its own. Smaller blocks queued before a large block are submitted without waiting for it to be compressed and
+	// the small blocks after it do not have to wait for all of its frames to land before being included.
+	IsolateLargeBlocksPolicy = "isolate-large-blocks"
+)
+
+// OrderingPolicy decides how pending L2 blocks are split across channels.
+// Blocks are always added to channels in order, a policy only controls where one channel ends and the next begins.
+type OrderingPolicy interface {
+	// SplitBefore returns true if the current channel, which already contains channelBlocks blocks,
+	// should be closed before block is added so that block starts a new channel.
+	SplitBefore(channelBlocks int, block *types.Block) bool
+	// SplitAfter returns true if the current channel should be closed immediately after block was added to it.
+	SplitAfter(block *types.Block) bool
+}
+
+type OrderingPolicyFactory func(cfg ChannelConfig) OrderingPolicy
+
+var OrderingPolicies = map[string]OrderingPolicyFactory{
+	OldestFirstPolicy: func(ChannelConfig) OrderingPolicy {
+		return oldestFirst{}
+	},
+	IsolateLargeBlocksPolicy: func(cfg ChannelConfig) OrderingPolicy {
+		return &isolateLargeBlocks{threshold: cfg.LargeBlockThreshold}
+	},
+}
+
+type oldestFirst struct{}
+
+func (oldestFirst) SplitBefore(int, *types.Block) bool {
+	return false
+}
+
+func (oldestFirst) SplitAfter(*types.Block) bool {
+	return false
+}
+
+type isolateLargeBlocks struct {
+	threshold uint64
+}
+
+// isLarge returns true if the RLP encoded size of block is at or above the threshold. This is larger than the size
+// of the block's batch, which omits most of the header, but is cached by the block so is cheap to check.
+func (p *isolateLargeBlocks) isLarge(block *types.Block) bool {
+	return block.Size() >= p.threshold
+}
+
+func (p *isolateLargeBlocks) SplitBefore(channelBlocks int, block *types.Block) bool {
+	return channelBlocks > 0 && p.isLarge(block)
+}
+
+func (p *isolateLargeBlocks) SplitAfter(block *types.Block) bool {
+	return p.isLarge(block)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/handoff"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
@@ -62,10 +63,14 @@ type BatcherService struct {
 	rpcServer  *oprpc.Server
 
 	balanceMetricer io.Closer
+	frameRecorder   *FileFrameRecorder
 
 	stopped atomic.Bool
 
 	NotSubmittingOnStart bool
+
+	// handoff passes listeners to a new process when upgrading. It is nil if graceful upgrades are disabled.
+	handoff *handoff.Handoff
 }
 
 // BatcherServiceFromCLIConfig creates a new BatcherService from a CLIConfig.
@@ -83,6 +88,9 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	bs.Version = version
 	bs.Log = log
 	bs.NotSubmittingOnStart = cfg.Stopped
+	if cfg.GracefulUpgrade {
+		bs.handoff = handoff.Process()
+	}
 
 	bs.initMetrics(cfg)
 
@@ -109,6 +117,12 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	if err := bs.initPProf(cfg); err != nil {
 		return fmt.Errorf("failed to start pprof server: %w", err)
 	}
+	if err := bs.waitForParent(ctx); err != nil {
+		return err
+	}
+	if err := bs.initFrameRecorder(cfg); err != nil {
+		return fmt.Errorf("failed to init frame recorder: %w", err)
+	}
 	bs.initDriver()
 	if err := bs.initRPCServer(cfg); err != nil {
 		return fmt.Errorf("failed to start RPC server: %w", err)
@@ -176,13 +190,15 @@ func (bs *BatcherService) initRollupConfig(ctx context.Context) error {
 
 func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 	bs.ChannelConfig = ChannelConfig{
-		SeqWindowSize:      bs.RollupConfig.SeqWindowSize,
-		ChannelTimeout:     bs.RollupConfig.ChannelTimeout,
-		MaxChannelDuration: cfg.MaxChannelDuration,
-		SubSafetyMargin:    cfg.SubSafetyMargin,
-		MaxFrameSize:       cfg.MaxL1TxSize - 1, // subtract 1 byte for version
-		CompressorConfig:   cfg.CompressorConfig.Config(),
-		BatchType:          cfg.BatchType,
+		SeqWindowSize:       bs.RollupConfig.SeqWindowSize,
+		ChannelTimeout:      bs.RollupConfig.ChannelTimeout,
+		MaxChannelDuration:  cfg.MaxChannelDuration,
+		SubSafetyMargin:     cfg.SubSafetyMargin,
+		MaxFrameSize:        cfg.MaxL1TxSize - 1, // subtract 1 byte for version
+		CompressorConfig:    cfg.CompressorConfig.Config(),
+		BatchType:           cfg.BatchType,
+		OrderingPolicy:      cfg.BatchOrderingPolicy,
+		LargeBlockThreshold: cfg.LargeBlockThreshold,
 	}
 	if err := bs.ChannelConfig.Check(); err != nil {
 		return fmt.Errorf("invalid channel configuration: %w", err)
@@ -199,12 +215,38 @@ func (bs *BatcherService) initTxManager(cfg *CLIConfig) error {
 	return nil
 }
 
+// httpOptions returns the options for the batcher's HTTP servers, reusing listeners inherited from the previous
+// process if graceful upgrades are enabled.
+func (bs *BatcherService) httpOptions() []httputil.HTTPOption {
+	if bs.handoff == nil {
+		return nil
+	}
+	return []httputil.HTTPOption{httputil.WithListenFunc(bs.handoff.Listen)}
+}
+
+// waitForParent completes a graceful upgrade by waiting for the process being upgraded from, if any, to stop.
+// Two batchers must never submit at once, so the frame recorder and driver are only set up once it has stopped.
+func (bs *BatcherService) waitForParent(ctx context.Context) error {
+	if bs.handoff == nil || !bs.handoff.HasParent() {
+		return nil
+	}
+	if err := bs.handoff.Ready(); err != nil {
+		return err
+	}
+	bs.Log.Info("Waiting for previous process to stop")
+	if err := bs.handoff.WaitForParent(ctx); err != nil {
+		return fmt.Errorf("failed to wait for previous process: %w", err)
+	}
+	bs.Log.Info("Previous process stopped, taking over")
+	return nil
+}
+
 func (bs *BatcherService) initPProf(cfg *CLIConfig) error {
 	if !cfg.PprofConfig.Enabled {
 		return nil
 	}
 	log.Debug("starting pprof server", "addr", net.JoinHostPort(cfg.PprofConfig.ListenAddr, strconv.Itoa(cfg.PprofConfig.ListenPort)))
-	srv, err := oppprof.StartServer(cfg.PprofConfig.ListenAddr, cfg.PprofConfig.ListenPort)
+	srv, err := oppprof.StartServer(cfg.PprofConfig.ListenAddr, cfg.PprofConfig.ListenPort, bs.httpOptions()...)
 	if err != nil {
 		return err
 	}
@@ -223,7 +265,7 @@ func (bs *BatcherService) initMetricsServer(cfg *CLIConfig) error {
 		return fmt.Errorf("metrics were enabled, but metricer %T does not expose registry for metrics-server", bs.Metrics)
 	}
 	bs.Log.Debug("starting metrics server", "addr", cfg.MetricsConfig.ListenAddr, "port", cfg.MetricsConfig.ListenPort)
-	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.MetricsConfig.ListenAddr, cfg.MetricsConfig.ListenPort)
+	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.MetricsConfig.ListenAddr, cfg.MetricsConfig.ListenPort, bs.httpOptions()...)
 	if err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
 	}
@@ -232,8 +274,21 @@ func (bs *BatcherService) initMetricsServer(cfg *CLIConfig) error {
 	return nil
 }
 
+func (bs *BatcherService) initFrameRecorder(cfg *CLIConfig) error {
+	if cfg.FrameRecordFile == "" {
+		return nil
+	}
+	recorder, err := NewFileFrameRecorder(cfg.FrameRecordFile)
+	if err != nil {
+		return err
+	}
+	bs.Log.Info("Recording frame L1 locations", "file", cfg.FrameRecordFile)
+	bs.frameRecorder = recorder
+	return nil
+}
+
 func (bs *BatcherService) initDriver() {
-	bs.driver = NewBatchSubmitter(DriverSetup{
+	setup := DriverSetup{
 		Log:              bs.Log,
 		Metr:             bs.Metrics,
 		RollupConfig:     bs.RollupConfig,
@@ -242,21 +297,32 @@ func (bs *BatcherService) initDriver() {
 		L1Client:         bs.L1Client,
 		EndpointProvider: bs.EndpointProvider,
 		ChannelConfig:    bs.ChannelConfig,
-	})
+	}
+	if bs.frameRecorder != nil {
+		setup.FrameRecorder = bs.frameRecorder
+	}
+	bs.driver = NewBatchSubmitter(setup)
 }
 
 func (bs *BatcherService) initRPCServer(cfg *CLIConfig) error {
+	opts := []oprpc.ServerOption{oprpc.WithLogger(bs.Log)}
+	if bs.handoff != nil {
+		opts = append(opts, oprpc.WithListenFunc(bs.handoff.Listen))
+	}
 	server := oprpc.NewServer(
 		cfg.RPC.ListenAddr,
 		cfg.RPC.ListenPort,
 		bs.Version,
-		oprpc.WithLogger(bs.Log),
+		opts...,
 	)
 	if cfg.RPC.EnableAdmin {
 		adminAPI := rpc.NewAdminAPI(bs.driver, bs.Metrics, bs.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
 		bs.Log.Info("Admin RPC enabled")
 	}
+	if usage, ok := bs.TxManager.(txmgr.GasUsageSource); ok {
+		server.AddAPI(txmgr.GetGasUsageAPI(usage))
+	}
 	bs.Log.Info("Starting JSON-RPC server")
 	if err := server.Start(); err != nil {
 		return fmt.Errorf("unable to start RPC server: %w", err)
@@ -323,6 +389,11 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 	if bs.TxManager != nil {
 		bs.TxManager.Close()
 	}
+	if bs.frameRecorder != nil {
+		if err := bs.frameRecorder.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close frame recorder: %w", err))
+		}
+	}
 
 	if bs.metricsSrv != nil {
 		if err := bs.metricsSrv.Stop(ctx); err != nil {
@@ -38,6 +38,26 @@ func main() {
 			Name:        "doc",
 			Subcommands: doc.NewSubcommands(metrics.NewMetrics("default")),
 		},
+		{
+			Name:        "export-frames",
+			Usage:       "Exports an audit file mapping L2 block ranges to the L1 location of their frames",
+			Description: "Reads the file written by the batcher when --frame-record-file is set and writes a JSON audit file grouping confirmed frames by channel.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "frame-record-file",
+					Usage:    "Path of the frame record file written by the batcher",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "out",
+					Usage:    "Path to write the audit file to",
+					Required: true,
+				},
+			},
+			Action: func(ctx *cli.Context) error {
+				return batcher.ExportFrameAudit(ctx.String("frame-record-file"), ctx.String("out"))
+			},
+		},
 	}
 
 	ctx := opio.WithInterruptBlocker(context.Background())
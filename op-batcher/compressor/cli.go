@@ -3,6 +3,7 @@ package compressor
 import (
 	"strings"
 
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/urfave/cli/v2"
 )
@@ -12,6 +13,7 @@ const (
 	TargetNumFramesFlagName     = "target-num-frames"
 	ApproxComprRatioFlagName    = "approx-compr-ratio"
 	KindFlagName                = "compressor"
+	CompressionAlgoFlagName     = "compression-algo"
 )
 
 func CLIFlags(envPrefix string) []cli.Flag {
@@ -40,6 +42,16 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "COMPRESSOR"),
 			Value:   ShadowKind,
 		},
+		&cli.StringFlag{
+			Name:    CompressionAlgoFlagName,
+			Usage:   "The compression algorithm to use. Brotli is only used once Fjord is active, zlib is used until then. Valid options: " + compressionAlgoNames(),
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "COMPRESSION_ALGO"),
+			Value:   derive.Zlib.String(),
+			Action: func(_ *cli.Context, s string) error {
+				_, err := derive.ParseCompressionAlgo(s)
+				return err
+			},
+		},
 	}
 }
 
@@ -57,6 +69,8 @@ type CLIConfig struct {
 	ApproxComprRatio float64
 	// Type of compressor to use. Must be one of KindKeys.
 	Kind string
+	// CompressionAlgo to compress channel data with. Must be one of derive.CompressionAlgos.
+	CompressionAlgo derive.CompressionAlgo
 }
 
 func (c *CLIConfig) Config() Config {
@@ -65,6 +79,7 @@ func (c *CLIConfig) Config() Config {
 		TargetNumFrames:  c.TargetNumFrames,
 		ApproxComprRatio: c.ApproxComprRatio,
 		Kind:             c.Kind,
+		CompressionAlgo:  c.CompressionAlgo,
 	}
 }
 
@@ -74,5 +89,14 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 		TargetL1TxSizeBytes: ctx.Uint64(TargetL1TxSizeBytesFlagName),
 		TargetNumFrames:     ctx.Int(TargetNumFramesFlagName),
 		ApproxComprRatio:    ctx.Float64(ApproxComprRatioFlagName),
+		CompressionAlgo:     derive.CompressionAlgo(ctx.String(CompressionAlgoFlagName)),
+	}
+}
+
+func compressionAlgoNames() string {
+	names := make([]string, 0, len(derive.CompressionAlgos))
+	for _, algo := range derive.CompressionAlgos {
+		names = append(names, algo.String())
 	}
+	return strings.Join(names, ", ")
 }
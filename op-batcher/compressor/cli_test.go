@@ -0,0 +1,40 @@
+package compressor
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestReadCLIConfigCompressionAlgo(t *testing.T) {
+	readConfig := func(args ...string) (CLIConfig, error) {
+		var cfg CLIConfig
+		app := cli.NewApp()
+		app.Flags = CLIFlags("TEST")
+		app.Action = func(ctx *cli.Context) error {
+			cfg = ReadCLIConfig(ctx)
+			return nil
+		}
+		err := app.Run(append([]string{"test"}, args...))
+		return cfg, err
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		cfg, err := readConfig()
+		require.NoError(t, err)
+		require.Equal(t, derive.Zlib, cfg.CompressionAlgo)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg, err := readConfig("--" + CompressionAlgoFlagName + "=brotli-10")
+		require.NoError(t, err)
+		require.Equal(t, derive.Brotli10, cfg.CompressionAlgo)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := readConfig("--" + CompressionAlgoFlagName + "=gzip")
+		require.ErrorIs(t, err, derive.ErrUnknownCompressionAlgo)
+	})
+}
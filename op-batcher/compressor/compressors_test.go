@@ -0,0 +1,66 @@
+package compressor
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+func TestCompressorAlgos(t *testing.T) {
+	for _, kind := range []string{RatioKind, ShadowKind} {
+		for _, algo := range derive.CompressionAlgos {
+			kind, algo := kind, algo
+			t.Run(kind+"-"+algo.String(), func(t *testing.T) {
+				c, err := Config{
+					TargetFrameSize:  100_000,
+					TargetNumFrames:  1,
+					ApproxComprRatio: 0.4,
+					Kind:             kind,
+					CompressionAlgo:  algo,
+				}.NewCompressor()
+				require.NoError(t, err)
+
+				rng := rand.New(rand.NewSource(42))
+				// Write the channel twice to check the version byte is written again after a reset.
+				for i := 0; i < 2; i++ {
+					data := make([]byte, 1000)
+					rng.Read(data[:500])
+					_, err = c.Write(data)
+					require.NoError(t, err)
+					require.NoError(t, c.Close())
+
+					out, err := io.ReadAll(c)
+					require.NoError(t, err)
+					if algo.IsBrotli() {
+						require.Equal(t, derive.ChannelVersionBrotli, out[0])
+					} else {
+						require.Equal(t, derive.ZlibCM8, int(out[0]&0x0F))
+					}
+					require.Equal(t, data, decompress(t, out))
+					c.Reset()
+				}
+			})
+		}
+	}
+}
+
+func decompress(t *testing.T, data []byte) []byte {
+	var r io.Reader
+	if data[0] == derive.ChannelVersionBrotli {
+		r = brotli.NewReader(bytes.NewReader(data[1:]))
+	} else {
+		zr, err := zlib.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		r = zr
+	}
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return out
+}
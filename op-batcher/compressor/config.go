@@ -19,6 +19,9 @@ type Config struct {
 	// Kind of compressor to use. Must be one of KindKeys. If unset, NewCompressor
 	// will default to RatioKind.
 	Kind string
+	// CompressionAlgo used to compress channel data. If unset, derive.Zlib is used.
+	// NonCompressor always uses zlib.
+	CompressionAlgo derive.CompressionAlgo
 }
 
 func (c Config) NewCompressor() (derive.Compressor, error) {
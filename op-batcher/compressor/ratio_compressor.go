@@ -12,7 +12,7 @@ type RatioCompressor struct {
 
 	inputBytes int
 	buf        bytes.Buffer
-	compress   *zlib.Writer
+	compress   derive.ChannelCompressWriter
 }
 
 // NewRatioCompressor creates a new derive.Compressor implementation that uses the target
@@ -25,7 +25,7 @@ func NewRatioCompressor(config Config) (derive.Compressor, error) {
 		config: config,
 	}
 
-	compress, err := zlib.NewWriterLevel(&c.buf, zlib.BestCompression)
+	compress, err := derive.NewChannelCompressWriter(config.CompressionAlgo, zlib.BestCompression, &c.buf)
 	if err != nil {
 		return nil, err
 	}
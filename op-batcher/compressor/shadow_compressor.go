@@ -14,7 +14,8 @@ const (
 	// data. Assuming frames are max 128k size (the current max blob size) this is 2+4+5+(5*8) = 51
 	// bytes.  If we start using larger frames (e.g. should max blob size increase) a larger blowup
 	// might be possible, but it would be highly unlikely, and the system still works if our
-	// estimate is wrong -- we just end up writing one more tx for the overflow.
+	// estimate is wrong -- we just end up writing one more tx for the overflow. The brotli version
+	// byte and meta-block headers add less overhead than this.
 	safeCompressionOverhead = 51
 )
 
@@ -22,10 +23,10 @@ type ShadowCompressor struct {
 	config Config
 
 	buf      bytes.Buffer
-	compress *zlib.Writer
+	compress derive.ChannelCompressWriter
 
 	shadowBuf      bytes.Buffer
-	shadowCompress *zlib.Writer
+	shadowCompress derive.ChannelCompressWriter
 
 	fullErr error
 
@@ -45,11 +46,11 @@ func NewShadowCompressor(config Config) (derive.Compressor, error) {
 	}
 
 	var err error
-	c.compress, err = zlib.NewWriterLevel(&c.buf, zlib.BestCompression)
+	c.compress, err = derive.NewChannelCompressWriter(config.CompressionAlgo, zlib.BestCompression, &c.buf)
 	if err != nil {
 		return nil, err
 	}
-	c.shadowCompress, err = zlib.NewWriterLevel(&c.shadowBuf, zlib.BestCompression)
+	c.shadowCompress, err = derive.NewChannelCompressWriter(config.CompressionAlgo, zlib.BestCompression, &c.shadowBuf)
 	if err != nil {
 		return nil, err
 	}
@@ -76,12 +76,36 @@ var (
 		Usage:   "Initialize the batcher in a stopped state. The batcher can be started using the admin_startBatcher RPC",
 		EnvVars: prefixEnvVars("STOPPED"),
 	}
+	GracefulUpgradeFlag = &cli.BoolFlag{
+		Name: "graceful-upgrade",
+		Usage: "Restart as a new process, such as after replacing the binary, when SIGUSR2 is received. The RPC, metrics " +
+			"and pprof listeners are handed to the new process, which starts submitting once the current process has " +
+			"stopped. The process supervisor must not treat the exit of the original process as the service stopping.",
+		EnvVars: prefixEnvVars("GRACEFUL_UPGRADE"),
+	}
 	BatchTypeFlag = &cli.UintFlag{
 		Name:    "batch-type",
 		Usage:   "The batch type. 0 for SingularBatch and 1 for SpanBatch.",
 		Value:   0,
 		EnvVars: prefixEnvVars("BATCH_TYPE"),
 	}
+	BatchOrderingPolicyFlag = &cli.StringFlag{
+		Name:    "batch-ordering-policy",
+		Usage:   "How pending L2 blocks are split across channels. Valid options: oldest-first, isolate-large-blocks",
+		Value:   "oldest-first",
+		EnvVars: prefixEnvVars("BATCH_ORDERING_POLICY"),
+	}
+	LargeBlockThresholdFlag = &cli.Uint64Flag{
+		Name:    "large-block-threshold",
+		Usage:   "The RLP encoded L2 block size (in bytes), including the header, at or above which a block is submitted in a channel of its own (isolate-large-blocks ordering policy only)",
+		Value:   100_000,
+		EnvVars: prefixEnvVars("LARGE_BLOCK_THRESHOLD"),
+	}
+	FrameRecordFileFlag = &cli.StringFlag{
+		Name:    "frame-record-file",
+		Usage:   "Path of a file to append the L1 location of every confirmed frame to, for use with the export-frames command. Disabled if empty",
+		EnvVars: prefixEnvVars("FRAME_RECORD_FILE"),
+	}
 	// Legacy Flags
 	SequencerHDPathFlag = txmgr.SequencerHDPathFlag
 )
@@ -99,8 +123,12 @@ var optionalFlags = []cli.Flag{
 	MaxChannelDurationFlag,
 	MaxL1TxSizeBytesFlag,
 	StoppedFlag,
+	GracefulUpgradeFlag,
 	SequencerHDPathFlag,
 	BatchTypeFlag,
+	BatchOrderingPolicyFlag,
+	LargeBlockThresholdFlag,
+	FrameRecordFileFlag,
 }
 
 func init() {
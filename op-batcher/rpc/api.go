@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
@@ -13,6 +14,9 @@ import (
 type BatcherDriver interface {
 	StartBatchSubmitting() error
 	StopBatchSubmitting(ctx context.Context) error
+	SkipL2Blocks(start uint64, end uint64, acknowledgeConsequences bool) error
+	ForceSubmitL2Blocks(start uint64, end uint64) error
+	ClearL2BlockControls()
 }
 
 type adminAPI struct {
@@ -41,3 +45,21 @@ func (a *adminAPI) StartBatcher(_ context.Context) error {
 func (a *adminAPI) StopBatcher(ctx context.Context) error {
 	return a.b.StopBatchSubmitting(ctx)
 }
+
+// SkipL2Blocks excludes the L2 blocks from start to end, inclusive, from batching.
+// The excluded blocks, and all blocks after them, can't become safe and will be reorged out once the sequencing
+// window expires, so acknowledgeConsequences must be true.
+func (a *adminAPI) SkipL2Blocks(_ context.Context, start hexutil.Uint64, end hexutil.Uint64, acknowledgeConsequences bool) error {
+	return a.b.SkipL2Blocks(uint64(start), uint64(end), acknowledgeConsequences)
+}
+
+// ForceSubmitL2Blocks submits the L2 blocks from start to end, inclusive, as soon as they are loaded.
+func (a *adminAPI) ForceSubmitL2Blocks(_ context.Context, start hexutil.Uint64, end hexutil.Uint64) error {
+	return a.b.ForceSubmitL2Blocks(uint64(start), uint64(end))
+}
+
+// ClearL2BlockControls removes all L2 block exclusions and forced submissions.
+func (a *adminAPI) ClearL2BlockControls(_ context.Context) error {
+	a.b.ClearL2BlockControls()
+	return nil
+}
@@ -34,6 +34,10 @@ func (g *gossipConfig) P2PSequencerAddress() common.Address {
 	return common.Address{}
 }
 
+func (g *gossipConfig) P2PSignerRotation() (common.Address, uint64, uint64) {
+	return common.Address{}, 0, 0
+}
+
 type l2Chain struct{}
 
 func (l *l2Chain) PayloadByNumber(_ context.Context, _ uint64) (*eth.ExecutionPayload, error) {
@@ -15,6 +15,11 @@ func Main(ctx context.Context, logger log.Logger, cfg *config.Config) (cliapp.Li
 	if err := cfg.Check(); err != nil {
 		return nil, err
 	}
+	for _, override := range cfg.UnsafeOverrides() {
+		logger.Warn("Configured value is less safe than the recommended default for the network",
+			"network", cfg.Network, "option", override.Option, "value", override.Value,
+			"recommended", override.Recommended, "risk", override.Reason)
+	}
 	srv, err := game.NewService(ctx, logger, cfg)
 	return srv, err
 }
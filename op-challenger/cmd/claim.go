@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+)
+
+var ErrInvalidGIndex = errors.New("invalid gindex")
+
+var GIndexFlag = &cli.StringFlag{
+	Name:     "gindex",
+	Usage:    "Generalized index of the position to compute the honest claim for. Accepts decimal or 0x prefixed hex.",
+	Required: true,
+}
+
+var ClaimCommand = &cli.Command{
+	Name:        "claim",
+	Usage:       "Computes the honest claim at a position in a dispute game",
+	Description: "Computes the claim value the challenger would post at the specified position of a dispute game, using the same trace configuration as the challenger. Reports the trace index and, for leaf positions, whether stepping requires preimage oracle data.",
+	Flags:       append(cliapp.ProtectFlags(flags.Flags), GameAddressFlag, GIndexFlag, OutputFlag),
+	Action:      computeClaim,
+}
+
+// HonestClaim is the honest claim at a position in a game.
+type HonestClaim struct {
+	GIndex       *hexutil.Big `json:"gindex"`
+	Depth        int          `json:"depth"`
+	IndexAtDepth *hexutil.Big `json:"indexAtDepth"`
+	TraceIndex   *hexutil.Big `json:"traceIndex"`
+	Value        common.Hash  `json:"value"`
+	// RequiresOracleData is true if stepping against a claim at this position requires data to be loaded into
+	// the preimage oracle first. Only leaf positions can be stepped against.
+	RequiresOracleData bool          `json:"requiresOracleData"`
+	OracleKey          hexutil.Bytes `json:"oracleKey,omitempty"`
+}
+
+func computeClaim(ctx *cli.Context) error {
+	addr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	gindex, ok := new(big.Int).SetString(ctx.String(GIndexFlag.Name), 0)
+	if !ok || gindex.Sign() <= 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidGIndex, ctx.String(GIndexFlag.Name))
+	}
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, err := flags.NewConfigFromCLI(ctx)
+	if err != nil {
+		return err
+	}
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, cfg.L1EthRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	contract, err := gameContract(ctx.Context, addr, caller)
+	if err != nil {
+		return err
+	}
+	gameType, err := contract.GetGameType(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to load game type: %w", err)
+	}
+	maxDepth, err := contract.GetMaxGameDepth(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to load max game depth: %w", err)
+	}
+	claims, err := contract.GetAllClaims(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to load claims: %w", err)
+	}
+	if len(claims) == 0 {
+		return errors.New("game has no claims")
+	}
+	game, err := types.NewValidatedGameState(claims, maxDepth)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(cfg.Datadir, "claims", addr.Hex())
+	accessor, closeAccessor, err := honestTraceAccessor(ctx.Context, logger, cfg, caller, gameType, addr, dir)
+	if err != nil {
+		return err
+	}
+	defer closeAccessor()
+	claim, err := honestClaim(ctx.Context, game, accessor, types.NewPositionFromGIndex(gindex))
+	if err != nil {
+		return err
+	}
+	return writeOutput(ctx.Path(OutputFlag.Name), func(out io.Writer) error {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(claim)
+	})
+}
+
+// honestClaim computes the honest claim at pos. The value is evaluated in the context of the existing claim
+// closest to pos, so that positions below the split depth use the output roots disputed by that claim.
+func honestClaim(ctx context.Context, game types.Game, accessor types.TraceAccessor, pos types.Position) (*HonestClaim, error) {
+	maxDepth := game.MaxDepth()
+	if err := pos.Validate(maxDepth); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidGIndex, err)
+	}
+	ref := referenceClaim(game, pos)
+	value, err := accessor.Get(ctx, game, ref, pos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute claim value: %w", err)
+	}
+	result := &HonestClaim{
+		GIndex:       (*hexutil.Big)(pos.ToGIndex()),
+		Depth:        pos.Depth(),
+		IndexAtDepth: (*hexutil.Big)(pos.IndexAtDepth()),
+		TraceIndex:   (*hexutil.Big)(pos.TraceIndex(int(maxDepth))),
+		Value:        value,
+	}
+	if uint64(pos.Depth()) == maxDepth {
+		_, _, oracleData, err := accessor.GetStepData(ctx, game, ref, pos)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load step data: %w", err)
+		}
+		if oracleData != nil {
+			result.RequiresOracleData = true
+			result.OracleKey = oracleData.OracleKey
+		}
+	}
+	return result, nil
+}
+
+// referenceClaim returns the deepest claim in the game that is at pos or at one of its ancestors.
+// The root claim is returned if no deeper claim exists.
+func referenceClaim(game types.Game, pos types.Position) types.Claim {
+	claims := game.Claims()
+	ref := claims[0]
+	target := pos.ToGIndex()
+	for _, claim := range claims {
+		depth := claim.Depth()
+		if depth <= ref.Depth() || depth > pos.Depth() {
+			continue
+		}
+		ancestor := new(big.Int).Rsh(target, uint(pos.Depth()-depth))
+		if ancestor.Cmp(claim.Position.ToGIndex()) == 0 {
+			ref = claim
+		}
+	}
+	return ref
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	faulttest "github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaim(t *testing.T) {
+	t.Run("RequiresGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "game-address", append([]string{"claim", "--gindex", "1"}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	})
+
+	t.Run("RequiresGIndex", func(t *testing.T) {
+		verifyArgsInvalid(t, "gindex", append([]string{"claim", "--game", gameAddress}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	})
+
+	t.Run("RejectsInvalidGIndex", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid gindex", append([]string{"claim", "--game", gameAddress, "--gindex", "foo"}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	})
+
+	t.Run("RejectsZeroGIndex", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid gindex", append([]string{"claim", "--game", gameAddress, "--gindex", "0"}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	})
+}
+
+const gameAddress = "0x1234567890123456789012345678901234567890"
+
+func TestHonestClaim(t *testing.T) {
+	maxDepth := 3
+	provider := alphabet.NewTraceProvider("abcdefgh", uint64(maxDepth))
+	accessor := trace.NewSimpleTraceAccessor(provider)
+	builder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+	game := types.NewGameState([]types.Claim{builder.CreateRootClaim(true)}, uint64(maxDepth))
+
+	t.Run("Root", func(t *testing.T) {
+		claim, err := honestClaim(context.Background(), game, accessor, types.NewPositionFromGIndex(big.NewInt(1)))
+		require.NoError(t, err)
+		require.Equal(t, builder.CorrectClaimAtPosition(types.NewPositionFromGIndex(big.NewInt(1))), claim.Value)
+		require.Equal(t, 0, claim.Depth)
+		require.Equal(t, int64(7), claim.TraceIndex.ToInt().Int64())
+		require.False(t, claim.RequiresOracleData)
+	})
+
+	t.Run("Leaf", func(t *testing.T) {
+		pos := types.NewPosition(maxDepth, big.NewInt(2))
+		claim, err := honestClaim(context.Background(), game, accessor, pos)
+		require.NoError(t, err)
+		require.Equal(t, builder.CorrectClaimAtPosition(pos), claim.Value)
+		require.Equal(t, int64(10), claim.GIndex.ToInt().Int64())
+		require.Equal(t, int64(2), claim.TraceIndex.ToInt().Int64())
+		require.True(t, claim.RequiresOracleData)
+		require.NotEmpty(t, claim.OracleKey)
+	})
+
+	t.Run("TooDeep", func(t *testing.T) {
+		_, err := honestClaim(context.Background(), game, accessor, types.NewPosition(maxDepth+1, big.NewInt(0)))
+		require.ErrorIs(t, err, ErrInvalidGIndex)
+	})
+}
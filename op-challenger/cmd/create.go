@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+)
+
+var (
+	ErrUnsupportedCreateGameType = errors.New("only output root game types can be created")
+	ErrGameExists                = errors.New("game already exists")
+	ErrGameCreatedLogNotFound    = errors.New("no DisputeGameCreated log found")
+)
+
+var (
+	CreateGameTypeFlag = &cli.UintFlag{
+		Name:     "game-type",
+		Usage:    "Type of game to create. Must be an output root game type.",
+		Required: true,
+	}
+	L2BlockNumFlag = &cli.Uint64Flag{
+		Name:     "l2-block-num",
+		Usage:    "L2 block number to create the game for. The root claim is the honest output root at this block.",
+		Required: true,
+	}
+)
+
+var CreateGameCommand = &cli.Command{
+	Name:  "create-game",
+	Usage: "Creates a dispute game for an L2 block",
+	Description: "Creates a dispute game via the dispute game factory, using the configured signer, with the honest output root " +
+		"for the specified L2 block as the root claim. The root claim is computed from the rollup node using the same " +
+		"trace provider as the challenger. Prints the address of the new game.",
+	Flags:  append(txFlags(), flags.FactoryAddressFlag, flags.RollupRpcFlag, CreateGameTypeFlag, L2BlockNumFlag),
+	Action: createGame,
+}
+
+// CreatedGame is a game created by the create-game subcommand.
+type CreatedGame struct {
+	Address       common.Address `json:"address"`
+	GameType      uint8          `json:"gameType"`
+	RootClaim     common.Hash    `json:"rootClaim"`
+	L2BlockNumber uint64         `json:"l2BlockNumber"`
+	TxHash        common.Hash    `json:"txHash"`
+}
+
+func createGame(ctx *cli.Context) error {
+	if ctx.String(flags.FactoryAddressFlag.Name) == "" {
+		return fmt.Errorf("flag %s is required", flags.FactoryAddressFlag.Name)
+	}
+	factoryAddr, err := opservice.ParseAddress(ctx.String(flags.FactoryAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	if ctx.String(flags.RollupRpcFlag.Name) == "" {
+		return fmt.Errorf("flag %s is required", flags.RollupRpcFlag.Name)
+	}
+	gameTypeArg := ctx.Uint(CreateGameTypeFlag.Name)
+	if gameTypeArg > math.MaxUint8 || !fault.IsOutputGameType(uint8(gameTypeArg)) {
+		return fmt.Errorf("%w: %v", ErrUnsupportedCreateGameType, gameTypeArg)
+	}
+	gameType := uint8(gameTypeArg)
+	l2BlockNum := ctx.Uint64(L2BlockNumFlag.Name)
+	txCfg, err := readTxConfig(ctx)
+	if err != nil {
+		return err
+	}
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup client: %w", err)
+	}
+	defer rollupClient.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	factory, err := contracts.NewDisputeGameFactoryContract(factoryAddr, caller)
+	if err != nil {
+		return err
+	}
+
+	// The game parameters are immutable so are read from the implementation the new game will be cloned from.
+	implAddr, err := factory.GetGameImpl(ctx.Context, gameType)
+	if err != nil {
+		return err
+	}
+	if implAddr == (common.Address{}) {
+		return fmt.Errorf("%w: %v", ErrGameTypeNotRegistered, gameType)
+	}
+	impl, err := contracts.NewOutputBisectionGameContract(implAddr, caller)
+	if err != nil {
+		return err
+	}
+	genesisBlock, err := impl.GetGenesisBlockNumber(ctx.Context)
+	if err != nil {
+		return err
+	}
+	if l2BlockNum <= genesisBlock {
+		return fmt.Errorf("l2 block %v must be after the game genesis block %v", l2BlockNum, genesisBlock)
+	}
+	splitDepth, err := impl.GetSplitDepth(ctx.Context)
+	if err != nil {
+		return err
+	}
+	provider := outputs.NewTraceProviderFromInputs(logger, nil, rollupClient, splitDepth, genesisBlock, l2BlockNum)
+	rootPos := types.NewPosition(0, big.NewInt(0))
+	if block, err := provider.BlockNumber(rootPos); err != nil {
+		return err
+	} else if block != l2BlockNum {
+		return fmt.Errorf("l2 block %v is too far after the game genesis block %v for split depth %v", l2BlockNum, genesisBlock, splitDepth)
+	}
+	rootClaim, err := provider.Get(ctx.Context, rootPos)
+	if err != nil {
+		return fmt.Errorf("failed to compute root claim: %w", err)
+	}
+
+	extraData := outputGameExtraData(l2BlockNum)
+	if existing, err := factory.GetGameAddress(ctx.Context, gameType, rootClaim, extraData); err != nil {
+		return err
+	} else if existing != (common.Address{}) {
+		return fmt.Errorf("%w: %v", ErrGameExists, existing)
+	}
+	candidate, err := factory.CreateTx(gameType, rootClaim, extraData)
+	if err != nil {
+		return err
+	}
+	logger.Info("Creating game", "gameType", gameType, "l2Block", l2BlockNum, "rootClaim", rootClaim)
+	receipt, err := sendTx(ctx.Context, logger, txCfg, candidate)
+	if err != nil {
+		return err
+	}
+	if !receiptSuccess(receipt) {
+		return fmt.Errorf("%w: %v", ErrTxReverted, receipt.TxHash)
+	}
+	for _, log := range receipt.Logs {
+		game, err := factory.DecodeDisputeGameCreated(log, 0)
+		if err != nil {
+			continue
+		}
+		return writeJSON("", CreatedGame{
+			Address:       game.Proxy,
+			GameType:      gameType,
+			RootClaim:     rootClaim,
+			L2BlockNumber: l2BlockNum,
+			TxHash:        receipt.TxHash,
+		})
+	}
+	return fmt.Errorf("%w: %v", ErrGameCreatedLogNotFound, receipt.TxHash)
+}
+
+// outputGameExtraData returns the extra data for an output root game, which is the L2 block number the root claim
+// is for, encoded as a uint256.
+func outputGameExtraData(l2BlockNum uint64) []byte {
+	extraData := make([]byte, 32)
+	binary.BigEndian.PutUint64(extraData[24:], l2BlockNum)
+	return extraData
+}
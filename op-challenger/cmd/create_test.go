@@ -0,0 +1,56 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGame(t *testing.T) {
+	createArgs := func(except string, extra ...string) []string {
+		args := []string{"create-game"}
+		for _, arg := range [][]string{
+			{"--l1-eth-rpc", l1EthRpc},
+			{"--game-factory-address", gameFactoryAddressValue},
+			{"--rollup-rpc", rollupRpc},
+			{"--game-type", "1"},
+			{"--l2-block-num", "100"},
+		} {
+			if arg[0] != except {
+				args = append(args, arg...)
+			}
+		}
+		return append(args, extra...)
+	}
+
+	t.Run("RequiresFactoryAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag game-factory-address is required", createArgs("--game-factory-address"))
+	})
+
+	t.Run("RequiresRollupRpc", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag rollup-rpc is required", createArgs("--rollup-rpc"))
+	})
+
+	t.Run("RequiresGameType", func(t *testing.T) {
+		verifyArgsInvalid(t, "game-type", createArgs("--game-type"))
+	})
+
+	t.Run("RequiresL2BlockNum", func(t *testing.T) {
+		verifyArgsInvalid(t, "l2-block-num", createArgs("--l2-block-num"))
+	})
+
+	t.Run("RejectsNonOutputGameType", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrUnsupportedCreateGameType.Error(), append(createArgs("--game-type"), "--game-type", "0"))
+	})
+
+	t.Run("RequiresSigner", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrNoSigner.Error(), createArgs(""))
+	})
+}
+
+func TestOutputGameExtraData(t *testing.T) {
+	extraData := outputGameExtraData(0x1234)
+	require.Len(t, extraData, 32)
+	require.Equal(t, []byte{0x12, 0x34}, extraData[30:])
+	require.Equal(t, make([]byte, 30), extraData[:30])
+}
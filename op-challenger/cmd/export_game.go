@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	openum "github.com/ethereum-optimism/optimism/op-service/enum"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+)
+
+var FormatFlag = &cli.StringFlag{
+	Name:  "format",
+	Usage: "Format to render the claim tree in. Valid options: " + openum.EnumString(types.RenderFormats),
+	Value: types.RenderFormatASCII.String(),
+}
+
+var ExportGameCommand = &cli.Command{
+	Name:        "export-game",
+	Usage:       "Renders the claim tree of a dispute game",
+	Description: "Renders the claim tree of a dispute game as a Graphviz DOT graph or ASCII tree, showing the position, value and parent of each claim and whether the challenger agrees with it. Uses the same trace configuration as the challenger to assess the claims.",
+	Flags:       append(cliapp.ProtectFlags(flags.Flags), GameAddressFlag, FormatFlag, OutputFlag),
+	Action:      exportGame,
+}
+
+func exportGame(ctx *cli.Context) error {
+	var format types.RenderFormat
+	if err := format.Set(ctx.String(FormatFlag.Name)); err != nil {
+		return err
+	}
+	addr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, err := flags.NewConfigFromCLI(ctx)
+	if err != nil {
+		return err
+	}
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, cfg.L1EthRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	contract, err := gameContract(ctx.Context, addr, caller)
+	if err != nil {
+		return err
+	}
+	gameType, err := contract.GetGameType(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to load game type: %w", err)
+	}
+	maxDepth, err := contract.GetMaxGameDepth(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to load max game depth: %w", err)
+	}
+	claims, err := contract.GetAllClaims(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to load claims: %w", err)
+	}
+	game, err := types.NewValidatedGameState(claims, maxDepth)
+	if err != nil {
+		return fmt.Errorf("invalid game state: %w", err)
+	}
+
+	dir := filepath.Join(cfg.Datadir, "exports", addr.Hex())
+	accessor, closeAccessor, err := honestTraceAccessor(ctx.Context, logger, cfg, caller, gameType, addr, dir)
+	if err != nil {
+		return err
+	}
+	defer closeAccessor()
+	assessments, err := solver.NewGameSolver(logger, int(maxDepth), accessor).AssessClaims(ctx.Context, game)
+	if err != nil {
+		return fmt.Errorf("failed to assess claims: %w", err)
+	}
+	return writeOutput(ctx.Path(OutputFlag.Name), func(out io.Writer) error {
+		return game.Render(out, format, assessments)
+	})
+}
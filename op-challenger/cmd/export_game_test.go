@@ -0,0 +1,25 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+)
+
+func TestExportGame(t *testing.T) {
+	t.Run("RequiresGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "game-address", append([]string{"export-game"}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	})
+
+	t.Run("RejectsInvalidGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address", append([]string{"export-game", "--game-address", "foo"}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	})
+
+	t.Run("RejectsUnknownFormat", func(t *testing.T) {
+		verifyArgsInvalid(t, "unknown render format", append([]string{"export-game", "--game-address", "0x1234567890123456789012345678901234567890", "--format", "svg"}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	})
+
+	t.Run("RequiresChallengerConfig", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag l1-eth-rpc is required", []string{"export-game", "--game-address", "0x1234567890123456789012345678901234567890"})
+	})
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/loader"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+)
+
+var ListGamesCommand = &cli.Command{
+	Name:        "list-games",
+	Usage:       "Lists the dispute games created by the dispute game factory",
+	Description: "Lists every game created by the dispute game factory with its game type, creation time, status and number of claims.",
+	Flags: append(
+		cliapp.ProtectFlags(append([]cli.Flag{flags.L1EthRpcFlag, flags.FactoryAddressFlag}, oplog.CLIFlags(flags.EnvVarPrefix)...)),
+		OutputFlag),
+	Action: listGames,
+}
+
+var ListClaimsCommand = &cli.Command{
+	Name:        "list-claims",
+	Usage:       "Lists the claims in a dispute game",
+	Description: "Lists every claim in a dispute game with its position, parent and clock. Claim indices can be used with the move and resolve-claim subcommands.",
+	Flags: append(
+		cliapp.ProtectFlags(append([]cli.Flag{flags.L1EthRpcFlag}, oplog.CLIFlags(flags.EnvVarPrefix)...)),
+		GameAddressFlag,
+		OutputFlag),
+	Action: listClaims,
+}
+
+// GameInfo is a game created by the dispute game factory.
+type GameInfo struct {
+	Address   common.Address `json:"address"`
+	GameType  uint8          `json:"gameType"`
+	Timestamp uint64         `json:"timestamp"`
+	Status    string         `json:"status"`
+	Claims    uint64         `json:"claims"`
+}
+
+// ClaimInfo is a claim in a dispute game.
+type ClaimInfo struct {
+	Index        int          `json:"index"`
+	ParentIndex  int          `json:"parentIndex"`
+	Depth        int          `json:"depth"`
+	IndexAtDepth *hexutil.Big `json:"indexAtDepth"`
+	GIndex       *hexutil.Big `json:"gindex"`
+	Value        common.Hash  `json:"value"`
+	Countered    bool         `json:"countered"`
+	Clock        uint64       `json:"clock"`
+}
+
+func listGames(ctx *cli.Context) error {
+	if ctx.String(flags.FactoryAddressFlag.Name) == "" {
+		return fmt.Errorf("flag %s is required", flags.FactoryAddressFlag.Name)
+	}
+	factoryAddr, err := opservice.ParseAddress(ctx.String(flags.FactoryAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	factory, err := contracts.NewDisputeGameFactoryContract(factoryAddr, caller)
+	if err != nil {
+		return err
+	}
+	head, err := l1Client.HeaderByNumber(ctx.Context, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	games, err := loader.NewGameLoader(factory).FetchAllGamesAtBlock(ctx.Context, 0, head.Hash())
+	if err != nil {
+		return fmt.Errorf("failed to load games: %w", err)
+	}
+	infos := make([]GameInfo, 0, len(games))
+	for _, game := range games {
+		contract, err := gameContract(ctx.Context, game.Proxy, caller)
+		if err != nil {
+			return err
+		}
+		status, err := contract.GetStatus(ctx.Context)
+		if err != nil {
+			return fmt.Errorf("failed to load status of game %v: %w", game.Proxy, err)
+		}
+		claimCount, err := contract.GetClaimCount(ctx.Context)
+		if err != nil {
+			return fmt.Errorf("failed to load claim count of game %v: %w", game.Proxy, err)
+		}
+		infos = append(infos, GameInfo{
+			Address:   game.Proxy,
+			GameType:  game.GameType,
+			Timestamp: game.Timestamp,
+			Status:    status.String(),
+			Claims:    claimCount,
+		})
+	}
+	return writeJSON(ctx.Path(OutputFlag.Name), infos)
+}
+
+func listClaims(ctx *cli.Context) error {
+	addr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	contract, err := gameContract(ctx.Context, addr, caller)
+	if err != nil {
+		return err
+	}
+	claims, err := contract.GetAllClaims(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to load claims: %w", err)
+	}
+	return writeJSON(ctx.Path(OutputFlag.Name), claimInfos(claims))
+}
+
+func claimInfos(claims []types.Claim) []ClaimInfo {
+	infos := make([]ClaimInfo, len(claims))
+	for i, claim := range claims {
+		infos[i] = ClaimInfo{
+			Index:        claim.ContractIndex,
+			ParentIndex:  claim.ParentContractIndex,
+			Depth:        claim.Depth(),
+			IndexAtDepth: (*hexutil.Big)(new(big.Int).Set(claim.IndexAtDepth())),
+			GIndex:       (*hexutil.Big)(claim.Position.ToGIndex()),
+			Value:        claim.Value,
+			Countered:    claim.Countered,
+			Clock:        claim.Clock,
+		}
+	}
+	return infos
+}
+
+func writeJSON(path string, value any) error {
+	return writeOutput(path, func(out io.Writer) error {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(value)
+	})
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+)
+
+func TestListGames(t *testing.T) {
+	t.Run("RequiresFactoryAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag game-factory-address is required", []string{"list-games", "--l1-eth-rpc", l1EthRpc})
+	})
+
+	t.Run("RejectsInvalidFactoryAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address", []string{"list-games", "--l1-eth-rpc", l1EthRpc, "--game-factory-address", "foo"})
+	})
+}
+
+func TestListClaims(t *testing.T) {
+	t.Run("RequiresGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "game-address", []string{"list-claims", "--l1-eth-rpc", l1EthRpc})
+	})
+
+	t.Run("RejectsInvalidGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address", []string{"list-claims", "--l1-eth-rpc", l1EthRpc, "--game-address", "foo"})
+	})
+}
+
+func TestClaimInfos(t *testing.T) {
+	claims := []types.Claim{
+		{
+			ClaimData:           types.ClaimData{Value: common.Hash{0x01}, Position: types.NewPosition(0, big.NewInt(0))},
+			Clock:               1000,
+			ContractIndex:       0,
+			ParentContractIndex: -1,
+		},
+		{
+			ClaimData:           types.ClaimData{Value: common.Hash{0x02}, Position: types.NewPosition(2, big.NewInt(3))},
+			Countered:           true,
+			Clock:               1001,
+			ContractIndex:       1,
+			ParentContractIndex: 0,
+		},
+	}
+	require.Equal(t, []ClaimInfo{
+		{Index: 0, ParentIndex: -1, Depth: 0, IndexAtDepth: (*hexutil.Big)(big.NewInt(0)), GIndex: (*hexutil.Big)(big.NewInt(1)), Value: common.Hash{0x01}, Clock: 1000},
+		{Index: 1, ParentIndex: 0, Depth: 2, IndexAtDepth: (*hexutil.Big)(big.NewInt(3)), GIndex: (*hexutil.Big)(big.NewInt(7)), Value: common.Hash{0x02}, Countered: true, Clock: 1001},
+	}, claimInfos(claims))
+}
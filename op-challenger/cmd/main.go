@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"os"
 
 	"github.com/urfave/cli/v2"
@@ -14,10 +15,14 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/version"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/handoff"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	"github.com/ethereum-optimism/optimism/op-service/opio"
 )
 
+// errUpgraded is the reason the challenger stops once a new process has taken over after a graceful upgrade.
+var errUpgraded = errors.New("upgraded to new process")
+
 var (
 	GitCommit = ""
 	GitDate   = ""
@@ -56,8 +61,32 @@ func run(ctx context.Context, args []string, action ConfiguredLifecycle) error {
 		if err != nil {
 			return nil, err
 		}
-		return action(ctx.Context, logger, cfg)
+		lifecycle, err := action(ctx.Context, logger, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.GracefulUpgrade {
+			go handoff.Process().UpgradeOnSignal(ctx.Context, logger, handoff.DefaultUpgradeTimeout, func() {
+				close(errUpgraded)
+			})
+		}
+		return lifecycle, nil
 	})
+	app.Commands = []*cli.Command{
+		ExportTranscriptCommand,
+		VerifyTranscriptCommand,
+		ExportGameCommand,
+		ClaimCommand,
+		PrestateHashCommand,
+		ListGamesCommand,
+		ListClaimsCommand,
+		MoveCommand,
+		ResolveCommand,
+		ResolveClaimCommand,
+		CreateGameCommand,
+		SelfTestCommand,
+		VerifyProposalCommand,
+	}
 	return app.RunContext(ctx, args)
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
 	"testing"
 	"time"
 
@@ -161,6 +162,40 @@ func TestGameAllowlist(t *testing.T) {
 	})
 }
 
+func TestMulticallAddress(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeAlphabet, "--multicall-address"))
+		require.Equal(t, common.Address{}, cfg.MulticallAddress)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr := common.Address{0xbb, 0xcc, 0xdd}
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--multicall-address="+addr.Hex()))
+		require.Equal(t, addr, cfg.MulticallAddress)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address: foo", addRequiredArgs(config.TraceTypeAlphabet, "--multicall-address=foo"))
+	})
+}
+
+func TestGameFactoryStartBlock(t *testing.T) {
+	t.Run("DefaultsToZero", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Zero(t, cfg.GameFactoryStartBlock)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--game-factory-start-block=1234"))
+		require.Equal(t, uint64(1234), cfg.GameFactoryStartBlock)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid value \"abc\" for flag -game-factory-start-block",
+			addRequiredArgs(config.TraceTypeAlphabet, "--game-factory-start-block=abc"))
+	})
+}
+
 func TestTxManagerFlagsSupported(t *testing.T) {
 	// Not a comprehensive list of flags, just enough to sanity check the txmgr.CLIFlags were defined
 	cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--"+txmgr.NumConfirmationsFlagName, "7"))
@@ -189,6 +224,61 @@ func TestMaxConcurrency(t *testing.T) {
 	})
 }
 
+func TestL1BatchSize(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, config.DefaultL1BatchSize, cfg.L1BatchSize)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--l1-batch-size", "500"))
+		require.Equal(t, uint(500), cfg.L1BatchSize)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -l1-batch-size",
+			addRequiredArgs(config.TraceTypeAlphabet, "--l1-batch-size", "abc"))
+	})
+}
+
+func TestTraceCacheSize(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, config.DefaultTraceCacheSize, cfg.TraceCacheSize)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--trace-cache-size", "50"))
+		require.Equal(t, uint(50), cfg.TraceCacheSize)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--trace-cache-size", "0"))
+		require.Zero(t, cfg.TraceCacheSize)
+	})
+}
+
+func TestTaskIntervals(t *testing.T) {
+	t.Run("DefaultToZero", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Zero(t, cfg.GameDiscoveryInterval)
+		require.Zero(t, cfg.ClaimRefreshInterval)
+		require.Zero(t, cfg.ResolutionCheckInterval)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--game-discovery-interval", "5m",
+			"--claim-refresh-interval", "1m",
+			"--resolution-check-interval", "10m"))
+		require.Equal(t, 5*time.Minute, cfg.GameDiscoveryInterval)
+		require.Equal(t, time.Minute, cfg.ClaimRefreshInterval)
+		require.Equal(t, 10*time.Minute, cfg.ResolutionCheckInterval)
+	})
+}
+
 func TestPollInterval(t *testing.T) {
 	t.Run("UsesDefault", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
@@ -209,6 +299,39 @@ func TestPollInterval(t *testing.T) {
 	})
 }
 
+func TestNetworkDefaults(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, "", cfg.Network)
+		require.Equal(t, uint(runtime.NumCPU()), cfg.MaxConcurrency)
+	})
+
+	t.Run("AppliesDefaults", func(t *testing.T) {
+		defaults, ok := config.DefaultsForNetwork("op-sepolia")
+		require.True(t, ok)
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon, "--network=op-sepolia"))
+		require.Equal(t, "op-sepolia", cfg.Network)
+		require.Equal(t, defaults.PollInterval, cfg.PollInterval)
+		require.Equal(t, defaults.MaxConcurrency, cfg.MaxConcurrency)
+		require.Equal(t, defaults.GameWindow, cfg.GameWindow)
+		require.Equal(t, defaults.CannonSnapshotFreq, cfg.CannonSnapshotFreq)
+		require.Equal(t, defaults.FeeLimitMultiplier, cfg.TxMgrConfig.FeeLimitMultiplier)
+	})
+
+	t.Run("ExplicitValuesTakePrecedence", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon, "--network=op-sepolia",
+			"--max-concurrency=7", "--game-window=1h", "--cannon-snapshot-freq=5", "--fee-limit-multiplier=2"))
+		require.Equal(t, uint(7), cfg.MaxConcurrency)
+		require.Equal(t, time.Hour, cfg.GameWindow)
+		require.Equal(t, uint(5), cfg.CannonSnapshotFreq)
+		require.Equal(t, uint64(2), cfg.TxMgrConfig.FeeLimitMultiplier)
+	})
+
+	t.Run("UnknownNetwork", func(t *testing.T) {
+		verifyArgsInvalid(t, "no recommended defaults for network: foo", addRequiredArgs(config.TraceTypeAlphabet, "--network=foo"))
+	})
+}
+
 func TestCannonBin(t *testing.T) {
 	t.Run("NotRequiredForAlphabetTrace", func(t *testing.T) {
 		configForArgs(t, addRequiredArgsExcept(config.TraceTypeAlphabet, "--cannon-bin"))
@@ -337,6 +460,23 @@ func TestCannonInfoFreq(t *testing.T) {
 	})
 }
 
+func TestCannonMemoryLimit(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
+		require.Zero(t, cfg.CannonMemoryLimit)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon, "--cannon-memory-limit=2048"))
+		require.Equal(t, uint64(2048*1024*1024), cfg.CannonMemoryLimit)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid value \"abc\" for flag -cannon-memory-limit",
+			addRequiredArgs(config.TraceTypeCannon, "--cannon-memory-limit=abc"))
+	})
+}
+
 func TestGameWindow(t *testing.T) {
 	t.Run("UsesDefault", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
@@ -354,6 +494,199 @@ func TestGameWindow(t *testing.T) {
 	})
 }
 
+func TestExecutionDepthOnly(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeOutputAlphabet))
+		require.False(t, cfg.ExecutionDepthOnly)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeOutputAlphabet, "--execution-depth-only"))
+		require.True(t, cfg.ExecutionDepthOnly)
+	})
+}
+
+func TestIgnoreValidRootClaims(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.IgnoreValidRootClaims)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--ignore-valid-root-claims"))
+		require.True(t, cfg.IgnoreValidRootClaims)
+	})
+}
+
+func TestIncidentMode(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.IncidentMode)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--incident-mode"))
+		require.True(t, cfg.IncidentMode)
+	})
+}
+
+func TestDryRun(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.DryRun)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--dry-run"))
+		require.True(t, cfg.DryRun)
+	})
+}
+
+func TestGracefulUpgrade(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.GracefulUpgrade)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--graceful-upgrade"))
+		require.True(t, cfg.GracefulUpgrade)
+	})
+}
+
+func TestMonitorOnly(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.MonitorOnly)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--monitor-only"))
+		require.True(t, cfg.MonitorOnly)
+		require.True(t, cfg.ReadOnly())
+	})
+}
+
+func TestSelfTest(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.SelfTest)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--self-test"))
+		require.True(t, cfg.SelfTest)
+	})
+
+	t.Run("Command", func(t *testing.T) {
+		_, _, err := dryRunWithArgs([]string{"self-test", "--log.level=error"})
+		require.NoError(t, err)
+	})
+}
+
+func TestAdditionalPrivateKeys(t *testing.T) {
+	t.Run("NoneByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.AdditionalPrivateKeys)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--private-key=0x1234", "--additional-private-keys=0x5678,0x9abc"))
+		require.Equal(t, []string{"0x5678", "0x9abc"}, cfg.AdditionalPrivateKeys)
+	})
+}
+
+func TestArchive(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.ArchiveURL)
+		require.Empty(t, cfg.ArchiveAuthToken)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--archive.url=https://example.com/games", "--archive.auth-token=secret"))
+		require.Equal(t, "https://example.com/games", cfg.ArchiveURL)
+		require.Equal(t, "secret", cfg.ArchiveAuthToken)
+	})
+}
+
+func TestNotifyURLs(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.NotifyWebhookURL)
+		require.Empty(t, cfg.NotifySlackWebhookURL)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--notify.webhook-url=https://example.com/challenger",
+			"--notify.slack-webhook-url=https://hooks.slack.com/services/T000"))
+		require.Equal(t, "https://example.com/challenger", cfg.NotifyWebhookURL)
+		require.Equal(t, "https://hooks.slack.com/services/T000", cfg.NotifySlackWebhookURL)
+	})
+}
+
+func TestL1EthWs(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.L1EthWs)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--l1-eth-ws=wss://example.com"))
+		require.Equal(t, "wss://example.com", cfg.L1EthWs)
+	})
+}
+
+func TestMaxDiskUsage(t *testing.T) {
+	t.Run("UnlimitedByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Zero(t, cfg.MaxDiskUsage)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--max-disk-usage=2048"))
+		require.Equal(t, uint64(2048*1024*1024), cfg.MaxDiskUsage)
+	})
+}
+
+func TestAdminRPC(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.RPCConfig.EnableAdmin)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-admin", "--rpc.port=9999"))
+		require.True(t, cfg.RPCConfig.EnableAdmin)
+		require.Equal(t, 9999, cfg.RPCConfig.ListenPort)
+	})
+
+	t.Run("JWTSecret", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.RPCJWTSecretPath)
+
+		cfg = configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--rpc.enable-admin", "--rpc.jwt-secret=/jwt.txt"))
+		require.Equal(t, "/jwt.txt", cfg.RPCJWTSecretPath)
+	})
+}
+
+func TestTracing(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.TracingConfig.Enabled)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--tracing.enabled", "--tracing.endpoint=collector:4318", "--tracing.insecure", "--tracing.sample-ratio=0.25"))
+		require.True(t, cfg.TracingConfig.Enabled)
+		require.Equal(t, "collector:4318", cfg.TracingConfig.Endpoint)
+		require.True(t, cfg.TracingConfig.Insecure)
+		require.Equal(t, 0.25, cfg.TracingConfig.SampleRatio)
+	})
+}
+
 func TestRequireEitherCannonNetworkOrRollupAndGenesis(t *testing.T) {
 	verifyArgsInvalid(
 		t,
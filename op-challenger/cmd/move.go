@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+)
+
+var (
+	ErrInvalidMoveDirection = errors.New("exactly one of --attack or --defend must be specified")
+	ErrInvalidClaimValue    = errors.New("invalid claim value")
+	ErrTxReverted           = errors.New("transaction reverted")
+	ErrNoSigner             = errors.New("no signer configured, specify a private key, mnemonic or remote signer")
+)
+
+var (
+	AttackFlag = &cli.BoolFlag{
+		Name:  "attack",
+		Usage: "Attack the parent claim.",
+	}
+	DefendFlag = &cli.BoolFlag{
+		Name:  "defend",
+		Usage: "Defend the parent claim.",
+	}
+	ParentIndexFlag = &cli.Uint64Flag{
+		Name:     "parent-index",
+		Usage:    "Index of the claim to attack or defend.",
+		Required: true,
+	}
+	ClaimValueFlag = &cli.StringFlag{
+		Name:     "claim",
+		Usage:    "Claim value to post, as a 0x prefixed 32 byte hash.",
+		Required: true,
+	}
+	ClaimIndexFlag = &cli.Uint64Flag{
+		Name:     "claim-index",
+		Usage:    "Index of the claim to resolve.",
+		Required: true,
+	}
+)
+
+// txFlags returns the flags required to send transactions to a game with the configured signer.
+func txFlags() []cli.Flag {
+	return cliapp.ProtectFlags(append(append([]cli.Flag{flags.L1EthRpcFlag}, oplog.CLIFlags(flags.EnvVarPrefix)...),
+		txmgr.CLIFlagsWithDefaults(flags.EnvVarPrefix, txmgr.DefaultChallengerFlagValues)...))
+}
+
+var MoveCommand = &cli.Command{
+	Name:        "move",
+	Usage:       "Attacks or defends a claim in a dispute game",
+	Description: "Posts a claim attacking or defending the specified parent claim, using the configured signer. The required bond is paid from the signer's account.",
+	Flags:       append(txFlags(), GameAddressFlag, AttackFlag, DefendFlag, ParentIndexFlag, ClaimValueFlag),
+	Action:      move,
+}
+
+var ResolveCommand = &cli.Command{
+	Name:        "resolve",
+	Usage:       "Resolves a dispute game",
+	Description: "Resolves a dispute game using the configured signer. The resolution is simulated first so no transaction is sent if the game cannot yet be resolved.",
+	Flags:       append(txFlags(), GameAddressFlag),
+	Action:      resolve,
+}
+
+var ResolveClaimCommand = &cli.Command{
+	Name:        "resolve-claim",
+	Usage:       "Resolves a claim in a dispute game",
+	Description: "Resolves a claim in a dispute game using the configured signer. The resolution is simulated first so no transaction is sent if the claim cannot yet be resolved.",
+	Flags:       append(txFlags(), GameAddressFlag, ClaimIndexFlag),
+	Action:      resolveClaim,
+}
+
+// TxResult is the outcome of a transaction sent to a game.
+type TxResult struct {
+	TxHash      common.Hash `json:"txHash"`
+	BlockNumber uint64      `json:"blockNumber"`
+	Success     bool        `json:"success"`
+}
+
+func move(ctx *cli.Context) error {
+	addr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	attack := ctx.Bool(AttackFlag.Name)
+	if attack == ctx.Bool(DefendFlag.Name) {
+		return ErrInvalidMoveDirection
+	}
+	var value common.Hash
+	if err := value.UnmarshalText([]byte(ctx.String(ClaimValueFlag.Name))); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidClaimValue, err)
+	}
+	parentIdx := ctx.Uint64(ParentIndexFlag.Name)
+	return sendGameTx(ctx, addr, func(contract GameContract) (txmgr.TxCandidate, error) {
+		claimCount, err := contract.GetClaimCount(ctx.Context)
+		if err != nil {
+			return txmgr.TxCandidate{}, fmt.Errorf("failed to load claim count: %w", err)
+		}
+		if parentIdx >= claimCount {
+			return txmgr.TxCandidate{}, fmt.Errorf("parent index %v out of range, game has %v claims", parentIdx, claimCount)
+		}
+		if attack {
+			return contract.AttackTx(parentIdx, value)
+		}
+		return contract.DefendTx(parentIdx, value)
+	})
+}
+
+func resolve(ctx *cli.Context) error {
+	addr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	return sendGameTx(ctx, addr, func(contract GameContract) (txmgr.TxCandidate, error) {
+		if _, err := contract.CallResolve(ctx.Context); err != nil {
+			return txmgr.TxCandidate{}, fmt.Errorf("game is not resolvable: %w", err)
+		}
+		return contract.ResolveTx()
+	})
+}
+
+func resolveClaim(ctx *cli.Context) error {
+	addr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	claimIdx := ctx.Uint64(ClaimIndexFlag.Name)
+	return sendGameTx(ctx, addr, func(contract GameContract) (txmgr.TxCandidate, error) {
+		if err := contract.CallResolveClaim(ctx.Context, claimIdx); err != nil {
+			return txmgr.TxCandidate{}, fmt.Errorf("claim %v is not resolvable: %w", claimIdx, err)
+		}
+		return contract.ResolveClaimTx(claimIdx)
+	})
+}
+
+// sendGameTx sends the transaction created by createTx for the game at addr with the configured signer and
+// writes the result to stdout. Returns ErrTxReverted if the transaction is included but reverts.
+func sendGameTx(ctx *cli.Context, addr common.Address, createTx func(contract GameContract) (txmgr.TxCandidate, error)) error {
+	txCfg, err := readTxConfig(ctx)
+	if err != nil {
+		return err
+	}
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	contract, err := gameContract(ctx.Context, addr, caller)
+	if err != nil {
+		return err
+	}
+	candidate, err := createTx(contract)
+	if err != nil {
+		return err
+	}
+	receipt, err := sendTx(ctx.Context, logger, txCfg, candidate)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON("", TxResult{TxHash: receipt.TxHash, BlockNumber: receipt.BlockNumber.Uint64(), Success: receiptSuccess(receipt)}); err != nil {
+		return err
+	}
+	if !receiptSuccess(receipt) {
+		return fmt.Errorf("%w: %v", ErrTxReverted, receipt.TxHash)
+	}
+	return nil
+}
+
+// readTxConfig reads and validates the transaction manager config, requiring a signer to be configured.
+func readTxConfig(ctx *cli.Context) (txmgr.CLIConfig, error) {
+	txCfg := txmgr.ReadCLIConfig(ctx)
+	if err := txCfg.Check(); err != nil {
+		return txmgr.CLIConfig{}, fmt.Errorf("invalid transaction manager config: %w", err)
+	}
+	if txCfg.PrivateKey == "" && txCfg.Mnemonic == "" && !txCfg.SignerCLIConfig.Enabled() {
+		return txmgr.CLIConfig{}, ErrNoSigner
+	}
+	return txCfg, nil
+}
+
+// sendTx sends candidate with the configured signer and waits for it to be included.
+func sendTx(ctx context.Context, logger log.Logger, txCfg txmgr.CLIConfig, candidate txmgr.TxCandidate) (*ethtypes.Receipt, error) {
+	txMgr, err := txmgr.NewSimpleTxManager("challenger", logger, &txmetrics.NoopTxMetrics{}, txCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the transaction manager: %w", err)
+	}
+	defer txMgr.Close()
+	logger.Info("Sending transaction", "to", candidate.To, "from", txMgr.From())
+	receipt, err := txMgr.Send(ctx, candidate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %w", err)
+	}
+	logger.Info("Transaction included", "tx", receipt.TxHash, "block", receipt.BlockNumber, "success", receiptSuccess(receipt))
+	return receipt, nil
+}
+
+func receiptSuccess(receipt *ethtypes.Receipt) bool {
+	return receipt.Status == ethtypes.ReceiptStatusSuccessful
+}
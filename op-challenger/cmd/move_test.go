@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+)
+
+const testClaimValue = "0x0100000000000000000000000000000000000000000000000000000000000000"
+
+func TestMove(t *testing.T) {
+	moveArgs := func(extra ...string) []string {
+		return append([]string{"move", "--l1-eth-rpc", l1EthRpc, "--game-address", gameFactoryAddressValue, "--parent-index", "0"}, extra...)
+	}
+
+	t.Run("RequiresGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "game-address", []string{"move", "--l1-eth-rpc", l1EthRpc, "--attack", "--parent-index", "0", "--claim", testClaimValue})
+	})
+
+	t.Run("RequiresParentIndex", func(t *testing.T) {
+		verifyArgsInvalid(t, "parent-index", []string{"move", "--l1-eth-rpc", l1EthRpc, "--game-address", gameFactoryAddressValue, "--attack", "--claim", testClaimValue})
+	})
+
+	t.Run("RequiresClaim", func(t *testing.T) {
+		verifyArgsInvalid(t, "claim", moveArgs("--attack"))
+	})
+
+	t.Run("RequiresDirection", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrInvalidMoveDirection.Error(), moveArgs("--claim", testClaimValue))
+	})
+
+	t.Run("RejectsAttackAndDefend", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrInvalidMoveDirection.Error(), moveArgs("--attack", "--defend", "--claim", testClaimValue))
+	})
+
+	t.Run("RejectsInvalidClaim", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrInvalidClaimValue.Error(), moveArgs("--attack", "--claim", "0x1234"))
+	})
+
+	t.Run("RequiresSigner", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrNoSigner.Error(), moveArgs("--attack", "--claim", testClaimValue))
+	})
+}
+
+func TestResolve(t *testing.T) {
+	t.Run("RequiresGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "game-address", []string{"resolve", "--l1-eth-rpc", l1EthRpc})
+	})
+
+	t.Run("RequiresL1EthRpc", func(t *testing.T) {
+		verifyArgsInvalid(t, "must provide a L1 RPC url", []string{"resolve", "--game-address", gameFactoryAddressValue})
+	})
+}
+
+func TestResolveClaim(t *testing.T) {
+	t.Run("RequiresClaimIndex", func(t *testing.T) {
+		verifyArgsInvalid(t, "claim-index", []string{"resolve-claim", "--l1-eth-rpc", l1EthRpc, "--game-address", gameFactoryAddressValue})
+	})
+
+	t.Run("RejectsInvalidGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address", []string{"resolve-claim", "--l1-eth-rpc", l1EthRpc, "--game-address", "foo", "--claim-index", "1"})
+	})
+}
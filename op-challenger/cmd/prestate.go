@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+)
+
+var (
+	ErrPrestateMismatch      = errors.New("prestate does not match on-chain absolute prestate")
+	ErrGameTypeNotRegistered = errors.New("game type not registered with factory")
+	ErrConflictingSources    = errors.New("only one of game-address and game-factory-address may be specified")
+)
+
+var (
+	PrestateInputFlag = &cli.PathFlag{
+		Name:     "input",
+		Usage:    "Path to the Cannon prestate file. May be gzip compressed.",
+		Required: true,
+	}
+	PrestateGameAddressFlag = &cli.StringFlag{
+		Name:    "game-address",
+		Aliases: []string{"game"},
+		Usage:   "Address of a dispute game to compare the prestate hash to.",
+	}
+	PrestateGameTypeFlag = &cli.UintFlag{
+		Name:  "game-type",
+		Usage: "Game type to compare the prestate hash to when game-factory-address is specified.",
+	}
+)
+
+var PrestateHashCommand = &cli.Command{
+	Name:  "prestate-hash",
+	Usage: "Computes the absolute prestate hash of a prestate file",
+	Description: "Computes the absolute prestate hash of a Cannon prestate file in the same way as the VM contract. " +
+		"If a game address, or a factory address and game type, is specified the hash is compared to the absolute prestate " +
+		"of that game or of the game implementation registered with the factory and the command fails if they differ.",
+	Flags: []cli.Flag{
+		PrestateInputFlag,
+		flags.L1EthRpcFlag,
+		PrestateGameAddressFlag,
+		flags.FactoryAddressFlag,
+		PrestateGameTypeFlag,
+		OutputFlag,
+	},
+	Action: prestateHash,
+}
+
+// PrestateHash is the absolute prestate hash computed from a prestate file, and the on-chain value it was
+// compared to, if any.
+type PrestateHash struct {
+	Hash common.Hash `json:"hash"`
+	// Contract is the game or game implementation the on-chain prestate was loaded from.
+	Contract *common.Address `json:"contract,omitempty"`
+	OnChain  *common.Hash    `json:"onChain,omitempty"`
+	Match    *bool           `json:"match,omitempty"`
+}
+
+func prestateHash(ctx *cli.Context) error {
+	hash, err := cannon.NewPrestateProvider(ctx.Path(PrestateInputFlag.Name)).AbsolutePreStateCommitment(ctx.Context)
+	if err != nil {
+		return err
+	}
+	result := &PrestateHash{Hash: hash}
+
+	if gameType := ctx.Uint(PrestateGameTypeFlag.Name); gameType > math.MaxUint8 {
+		return fmt.Errorf("invalid game type: %v", gameType)
+	}
+	gameAddrStr := ctx.String(PrestateGameAddressFlag.Name)
+	factoryAddrStr := ctx.String(flags.FactoryAddressFlag.Name)
+	if gameAddrStr != "" && factoryAddrStr != "" {
+		return ErrConflictingSources
+	}
+	if gameAddrStr != "" || factoryAddrStr != "" {
+		if !ctx.IsSet(flags.L1EthRpcFlag.Name) {
+			return fmt.Errorf("flag %s is required to compare to an on-chain prestate", flags.L1EthRpcFlag.Name)
+		}
+		logger, err := setupLogging(ctx)
+		if err != nil {
+			return err
+		}
+		l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.L1EthRpcFlag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to dial L1: %w", err)
+		}
+		defer l1Client.Close()
+		caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+
+		var gameAddr common.Address
+		if gameAddrStr != "" {
+			gameAddr, err = opservice.ParseAddress(gameAddrStr)
+			if err != nil {
+				return err
+			}
+		} else {
+			factoryAddr, err := opservice.ParseAddress(factoryAddrStr)
+			if err != nil {
+				return err
+			}
+			gameAddr, err = gameImpl(ctx.Context, caller, factoryAddr, uint8(ctx.Uint(PrestateGameTypeFlag.Name)))
+			if err != nil {
+				return err
+			}
+		}
+		onChain, err := onChainPrestate(ctx.Context, caller, gameAddr)
+		if err != nil {
+			return err
+		}
+		match := onChain == hash
+		result.Contract = &gameAddr
+		result.OnChain = &onChain
+		result.Match = &match
+	}
+
+	err = writeOutput(ctx.Path(OutputFlag.Name), func(out io.Writer) error {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	})
+	if err != nil {
+		return err
+	}
+	if result.Match != nil && !*result.Match {
+		return fmt.Errorf("%w: computed %v but %v has %v", ErrPrestateMismatch, hash, *result.Contract, *result.OnChain)
+	}
+	return nil
+}
+
+// gameImpl returns the address of the implementation the factory uses to create games of the specified type.
+func gameImpl(ctx context.Context, caller *batching.MultiCaller, factoryAddr common.Address, gameType uint8) (common.Address, error) {
+	factory, err := contracts.NewDisputeGameFactoryContract(factoryAddr, caller)
+	if err != nil {
+		return common.Address{}, err
+	}
+	impl, err := factory.GetGameImpl(ctx, gameType)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if impl == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrGameTypeNotRegistered, gameType)
+	}
+	return impl, nil
+}
+
+// onChainPrestate loads the absolute prestate hash from the game or game implementation at addr.
+func onChainPrestate(ctx context.Context, caller *batching.MultiCaller, addr common.Address) (common.Hash, error) {
+	contract, err := gameContract(ctx, addr, caller)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return contract.GetAbsolutePrestateHash(ctx)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+)
+
+const prestateFile = "../game/fault/trace/cannon/test_data/state.json"
+
+func TestPrestateHash(t *testing.T) {
+	t.Run("RequiresInput", func(t *testing.T) {
+		verifyArgsInvalid(t, "input", []string{"prestate-hash"})
+	})
+
+	t.Run("RejectsInvalidPrestate", func(t *testing.T) {
+		verifyArgsInvalid(t, "cannot load absolute pre-state", []string{"prestate-hash", "--input", "../game/fault/trace/cannon/test_data/invalid.json"})
+	})
+
+	t.Run("RejectsGameAndFactory", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrConflictingSources.Error(), []string{"prestate-hash", "--input", prestateFile,
+			"--l1-eth-rpc", l1EthRpc, "--game-address", gameAddress, "--game-factory-address", gameFactoryAddressValue})
+	})
+
+	t.Run("RequiresL1RpcToCompare", func(t *testing.T) {
+		verifyArgsInvalid(t, "l1-eth-rpc is required", []string{"prestate-hash", "--input", prestateFile, "--game-address", gameAddress})
+	})
+
+	t.Run("RejectsInvalidGameType", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid game type", []string{"prestate-hash", "--input", prestateFile,
+			"--l1-eth-rpc", l1EthRpc, "--game-factory-address", gameFactoryAddressValue, "--game-type", "256"})
+	})
+
+	t.Run("ComputesHash", func(t *testing.T) {
+		expected, err := cannon.NewPrestateProvider(prestateFile).AbsolutePreStateCommitment(context.Background())
+		require.NoError(t, err)
+
+		output := filepath.Join(t.TempDir(), "prestate.json")
+		_, _, err = dryRunWithArgs([]string{"prestate-hash", "--input", prestateFile, "--output", output})
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		var result PrestateHash
+		require.NoError(t, json.Unmarshal(data, &result))
+		require.Equal(t, expected, result.Hash)
+		require.Nil(t, result.OnChain)
+		require.Nil(t, result.Match)
+	})
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+)
+
+var SelfTestCommand = &cli.Command{
+	Name:  "self-test",
+	Usage: "Plays a synthetic game in memory to check the challenger works",
+	Description: "Plays a synthetic alphabet game in memory against an opponent that posts an invalid root claim and " +
+		"counters every claim, using the same agent, solver and trace provider as real games but without sending " +
+		"transactions. Fails if the challenger does not win. No network access is required.",
+	Flags:  cliapp.ProtectFlags(oplog.CLIFlags(flags.EnvVarPrefix)),
+	Action: selfTest,
+}
+
+func selfTest(ctx *cli.Context) error {
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	return fault.RunSelfTest(ctx.Context, logger)
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/transcript"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+var ErrResolutionMismatch = errors.New("game resolution does not match the honest trace")
+
+var (
+	GameAddressFlag = &cli.StringFlag{
+		Name:     "game-address",
+		Aliases:  []string{"game"},
+		Usage:    "Address of the dispute game.",
+		Required: true,
+	}
+	TranscriptFlag = &cli.PathFlag{
+		Name:     "transcript",
+		Usage:    "Path to the game transcript to verify.",
+		Required: true,
+	}
+	OutputFlag = &cli.PathFlag{
+		Name:  "output",
+		Usage: "Path to write the output to. Defaults to stdout.",
+	}
+)
+
+var ExportTranscriptCommand = &cli.Command{
+	Name:        "export-transcript",
+	Usage:       "Exports a JSON transcript of a dispute game",
+	Description: "Exports a versioned JSON transcript of the claims and resolution of a dispute game, suitable for publication and verification with verify-transcript.",
+	Flags: append(
+		cliapp.ProtectFlags(append([]cli.Flag{flags.L1EthRpcFlag}, oplog.CLIFlags(flags.EnvVarPrefix)...)),
+		GameAddressFlag,
+		OutputFlag),
+	Action: exportTranscript,
+}
+
+var VerifyTranscriptCommand = &cli.Command{
+	Name:        "verify-transcript",
+	Usage:       "Verifies a dispute game transcript against the honest trace",
+	Description: "Replays every claim in a game transcript against the honest trace, using the same trace configuration as the challenger, and reports which claims are honest. Fails if the game parameters recorded in the transcript differ from the game contract or the recorded resolution does not match the honest trace.",
+	Flags:       append(cliapp.ProtectFlags(flags.Flags), TranscriptFlag, OutputFlag),
+	Action:      verifyTranscript,
+}
+
+func exportTranscript(ctx *cli.Context) error {
+	addr, err := opservice.ParseAddress(ctx.String(GameAddressFlag.Name))
+	if err != nil {
+		return err
+	}
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	contract, err := gameContract(ctx.Context, addr, caller)
+	if err != nil {
+		return err
+	}
+	t, err := transcript.Export(ctx.Context, addr, contract)
+	if err != nil {
+		return fmt.Errorf("failed to export transcript: %w", err)
+	}
+	return writeOutput(ctx.Path(OutputFlag.Name), t.Write)
+}
+
+func verifyTranscript(ctx *cli.Context) error {
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, err := flags.NewConfigFromCLI(ctx)
+	if err != nil {
+		return err
+	}
+	in, err := os.Open(ctx.Path(TranscriptFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to open transcript: %w", err)
+	}
+	defer in.Close()
+	t, err := transcript.Read(in)
+	if err != nil {
+		return err
+	}
+
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, cfg.L1EthRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+	contract, err := gameContract(ctx.Context, t.Game, caller)
+	if err != nil {
+		return err
+	}
+	if err := transcript.CheckGame(ctx.Context, t, contract); err != nil {
+		return err
+	}
+
+	dir := filepath.Join(cfg.Datadir, "transcripts", t.Game.Hex())
+	accessor, closeAccessor, err := honestTraceAccessor(ctx.Context, logger, cfg, caller, t.GameType, t.Game, dir)
+	if err != nil {
+		return err
+	}
+	defer closeAccessor()
+	report, err := transcript.Verify(ctx.Context, t, accessor)
+	if err != nil {
+		return fmt.Errorf("failed to verify transcript: %w", err)
+	}
+	err = writeOutput(ctx.Path(OutputFlag.Name), func(out io.Writer) error {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	})
+	if err != nil {
+		return err
+	}
+	if !report.ResolutionMatches() {
+		return fmt.Errorf("%w: resolved %v but expected %v", ErrResolutionMismatch, report.Resolution, report.ExpectedResolution)
+	}
+	return nil
+}
+
+// honestTraceAccessor creates the trace accessor the challenger would use for the game at addr, dialing the rollup
+// and L2 clients required by the enabled trace types. The returned function closes those clients.
+func honestTraceAccessor(ctx context.Context, logger log.Logger, cfg *config.Config, caller *batching.MultiCaller, gameType uint8, addr common.Address, dir string) (types.TraceAccessor, func(), error) {
+	var closers []func()
+	closeAll := func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+	var rollupClient fault.RollupClient
+	if cfg.RollupRpc != "" {
+		client, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, logger, cfg.RollupRpc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to dial rollup client: %w", err)
+		}
+		closers = append(closers, client.Close)
+		rollupClient = client
+	}
+	var l2Client cannon.L2HeaderSource
+	if fault.RequiresL2Client(cfg) {
+		client, err := ethclient.DialContext(ctx, cfg.CannonL2)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("dial l2 client %v: %w", cfg.CannonL2, err)
+		}
+		closers = append(closers, client.Close)
+		l2Client = client
+	}
+	accessor, err := fault.NewTraceAccessor(ctx, logger, metrics.NoopMetrics, cfg, caller, rollupClient, l2Client, gameType, addr, dir)
+	if err != nil {
+		closeAll()
+		return nil, nil, fmt.Errorf("failed to create trace accessor: %w", err)
+	}
+	return accessor, closeAll, nil
+}
+
+// GameContract is the subset of the dispute game bindings used by the subcommands.
+type GameContract interface {
+	transcript.GameSource
+	GetClaimCount(ctx context.Context) (uint64, error)
+	AttackTx(parentContractIndex uint64, pivot common.Hash) (txmgr.TxCandidate, error)
+	DefendTx(parentContractIndex uint64, pivot common.Hash) (txmgr.TxCandidate, error)
+	CallResolve(ctx context.Context) (gameTypes.GameStatus, error)
+	ResolveTx() (txmgr.TxCandidate, error)
+	CallResolveClaim(ctx context.Context, claimIdx uint64) error
+	ResolveClaimTx(claimIdx uint64) (txmgr.TxCandidate, error)
+}
+
+// gameContract creates the bindings for the game at addr based on its game type.
+// The gameType function has the same selector in all game contracts so the fault dispute game bindings are used to load it.
+func gameContract(ctx context.Context, addr common.Address, caller *batching.MultiCaller) (GameContract, error) {
+	fdg, err := contracts.NewFaultDisputeGameContract(addr, caller)
+	if err != nil {
+		return nil, err
+	}
+	gameType, err := fdg.GetGameType(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if fault.IsOutputGameType(gameType) {
+		return contracts.NewOutputBisectionGameContract(addr, caller)
+	}
+	return fdg, nil
+}
+
+func writeOutput(path string, write func(out io.Writer) error) error {
+	if path == "" {
+		return write(os.Stdout)
+	}
+	out, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer out.Close()
+	return write(out)
+}
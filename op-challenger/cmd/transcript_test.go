@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+)
+
+func TestExportTranscript(t *testing.T) {
+	t.Run("RequiresGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "game-address", []string{"export-transcript", "--l1-eth-rpc", l1EthRpc})
+	})
+
+	t.Run("RejectsInvalidGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address", []string{"export-transcript", "--l1-eth-rpc", l1EthRpc, "--game-address", "foo"})
+	})
+}
+
+func TestVerifyTranscript(t *testing.T) {
+	t.Run("RequiresTranscript", func(t *testing.T) {
+		verifyArgsInvalid(t, "transcript", append([]string{"verify-transcript"}, addRequiredArgs(config.TraceTypeAlphabet)...))
+	})
+
+	t.Run("RequiresChallengerConfig", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag l1-eth-rpc is required", []string{"verify-transcript", "--transcript", "transcript.json"})
+	})
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/flags"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/bigint"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+)
+
+var (
+	ErrProposalMismatch           = errors.New("proposed output root does not match the trusted L2 node")
+	ErrMissingProposalSource      = errors.New("one of game-address or l2-output-oracle must be specified")
+	ErrConflictingProposalSources = errors.New("only one of game-address and l2-output-oracle may be specified")
+	ErrProposalBlockMismatch      = errors.New("game proposes an output root for a different L2 block")
+)
+
+const (
+	proposalSourceGame   = "game"
+	proposalSourceOracle = "l2-output-oracle"
+)
+
+var (
+	VerifyGameAddressFlag = &cli.StringFlag{
+		Name:    "game-address",
+		Aliases: []string{"game"},
+		Usage:   "Address of the output bisection game whose root claim is verified.",
+	}
+	VerifyL2OutputOracleFlag = &cli.StringFlag{
+		Name:  "l2-output-oracle",
+		Usage: "Address of the L2OutputOracle to load the proposal to verify from.",
+	}
+	VerifyL2BlockFlag = &cli.Uint64Flag{
+		Name: "l2-block",
+		Usage: "L2 block number to verify the output root for. Required for the L2OutputOracle, where the first " +
+			"proposal at or after the block is verified. Optional for games, which must propose the output at this block.",
+	}
+)
+
+var VerifyProposalCommand = &cli.Command{
+	Name:  "verify-proposal",
+	Usage: "Verifies a proposed output root against a trusted L2 node",
+	Description: "Computes the expected output root using a trusted rollup node and compares it to the root claim of an " +
+		"output bisection game or a proposal in the L2OutputOracle. Prints a report of the proposed and expected " +
+		"values and fails if they differ. Useful to check a proposal before deciding to challenge it.",
+	Flags: append(
+		cliapp.ProtectFlags(append([]cli.Flag{flags.L1EthRpcFlag, flags.RollupRpcFlag}, oplog.CLIFlags(flags.EnvVarPrefix)...)),
+		VerifyGameAddressFlag,
+		VerifyL2OutputOracleFlag,
+		VerifyL2BlockFlag,
+		OutputFlag,
+	),
+	Action: verifyProposal,
+}
+
+// ProposalVerification reports whether a proposed output root matches the output root computed by a trusted L2 node.
+type ProposalVerification struct {
+	SourceType string         `json:"sourceType"`
+	Source     common.Address `json:"source"`
+	// RequestedL2Block is the L2 block the proposal was requested for. The verified block may be later when the
+	// proposal was loaded from the L2OutputOracle.
+	RequestedL2Block   *uint64     `json:"requestedL2Block,omitempty"`
+	L2Block            uint64      `json:"l2Block"`
+	ProposedOutputRoot common.Hash `json:"proposedOutputRoot"`
+	ExpectedOutputRoot common.Hash `json:"expectedOutputRoot"`
+	Match              bool        `json:"match"`
+	// Expected contains the components of the expected output root as reported by the trusted L2 node.
+	Expected ExpectedOutput `json:"expected"`
+	// Safe is true if the L2 block is safe according to the trusted L2 node, meaning it was derived from data on L1.
+	// The expected output root of an unsafe block may change if the node reorgs.
+	Safe bool `json:"safe"`
+}
+
+type ExpectedOutput struct {
+	Version                  eth.Bytes32 `json:"version"`
+	BlockHash                common.Hash `json:"blockHash"`
+	StateRoot                common.Hash `json:"stateRoot"`
+	MessagePasserStorageRoot common.Hash `json:"messagePasserStorageRoot"`
+}
+
+func verifyProposal(ctx *cli.Context) error {
+	gameAddrStr := ctx.String(VerifyGameAddressFlag.Name)
+	oracleAddrStr := ctx.String(VerifyL2OutputOracleFlag.Name)
+	if gameAddrStr != "" && oracleAddrStr != "" {
+		return ErrConflictingProposalSources
+	}
+	if gameAddrStr == "" && oracleAddrStr == "" {
+		return ErrMissingProposalSource
+	}
+	sourceType, sourceStr := proposalSourceGame, gameAddrStr
+	if oracleAddrStr != "" {
+		sourceType, sourceStr = proposalSourceOracle, oracleAddrStr
+	}
+	source, err := opservice.ParseAddress(sourceStr)
+	if err != nil {
+		return err
+	}
+	var requestedBlock *uint64
+	if ctx.IsSet(VerifyL2BlockFlag.Name) {
+		block := ctx.Uint64(VerifyL2BlockFlag.Name)
+		requestedBlock = &block
+	} else if sourceType == proposalSourceOracle {
+		return fmt.Errorf("flag %s is required to verify a proposal from the L2OutputOracle", VerifyL2BlockFlag.Name)
+	}
+	for _, flag := range []string{flags.L1EthRpcFlag.Name, flags.RollupRpcFlag.Name} {
+		if ctx.String(flag) == "" {
+			return fmt.Errorf("flag %s is required", flag)
+		}
+	}
+
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	l1Client, err := dial.DialEthClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1: %w", err)
+	}
+	defer l1Client.Close()
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx.Context, dial.DefaultDialTimeout, logger, ctx.String(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup node: %w", err)
+	}
+	defer rollupClient.Close()
+	caller := batching.NewMultiCaller(l1Client.Client(), batching.DefaultBatchSize)
+
+	var proposal contracts.Proposal
+	if sourceType == proposalSourceGame {
+		proposal, err = gameProposal(ctx.Context, caller, source)
+		if err != nil {
+			return err
+		}
+		if requestedBlock != nil && proposal.L2BlockNumber.Uint64() != *requestedBlock {
+			return fmt.Errorf("%w: game %v proposes block %v, not %v", ErrProposalBlockMismatch, source, proposal.L2BlockNumber, *requestedBlock)
+		}
+	} else {
+		oracle, err := contracts.NewL2OutputOracleContract(source, caller)
+		if err != nil {
+			return err
+		}
+		proposal, err = oracle.GetL2OutputAfter(ctx.Context, *requestedBlock)
+		if err != nil {
+			return err
+		}
+	}
+	l2Block, err := bigint.ToUint64(proposal.L2BlockNumber)
+	if err != nil {
+		return fmt.Errorf("invalid proposal block number: %w", err)
+	}
+	output, err := rollupClient.OutputAtBlock(ctx.Context, l2Block)
+	if err != nil {
+		return fmt.Errorf("failed to load output at block %v: %w", l2Block, err)
+	}
+
+	result := newProposalVerification(sourceType, source, requestedBlock, l2Block, proposal.OutputRoot, output)
+	err = writeOutput(ctx.Path(OutputFlag.Name), func(out io.Writer) error {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	})
+	if err != nil {
+		return err
+	}
+	if !result.Match {
+		return fmt.Errorf("%w: block %v proposed %v but expected %v", ErrProposalMismatch, l2Block, result.ProposedOutputRoot, result.ExpectedOutputRoot)
+	}
+	return nil
+}
+
+// gameProposal loads the output root proposed by the root claim of the output bisection game at addr.
+func gameProposal(ctx context.Context, caller *batching.MultiCaller, addr common.Address) (contracts.Proposal, error) {
+	game, err := contracts.NewOutputBisectionGameContract(addr, caller)
+	if err != nil {
+		return contracts.Proposal{}, err
+	}
+	_, l2Block, err := game.GetBlockRange(ctx)
+	if err != nil {
+		return contracts.Proposal{}, err
+	}
+	root, err := game.GetClaim(ctx, 0)
+	if err != nil {
+		return contracts.Proposal{}, fmt.Errorf("failed to load root claim: %w", err)
+	}
+	return contracts.Proposal{
+		L2BlockNumber: new(big.Int).SetUint64(l2Block),
+		OutputRoot:    root.Value,
+	}, nil
+}
+
+func newProposalVerification(sourceType string, source common.Address, requestedBlock *uint64, l2Block uint64, proposed common.Hash, output *eth.OutputResponse) *ProposalVerification {
+	expected := common.Hash(output.OutputRoot)
+	result := &ProposalVerification{
+		SourceType:         sourceType,
+		Source:             source,
+		RequestedL2Block:   requestedBlock,
+		L2Block:            l2Block,
+		ProposedOutputRoot: proposed,
+		ExpectedOutputRoot: expected,
+		Match:              proposed == expected,
+		Expected: ExpectedOutput{
+			Version:                  output.Version,
+			BlockHash:                output.BlockRef.Hash,
+			StateRoot:                output.StateRoot,
+			MessagePasserStorageRoot: output.WithdrawalStorageRoot,
+		},
+	}
+	if output.Status != nil {
+		result.Safe = output.Status.SafeL2.Number >= l2Block
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func TestVerifyProposal(t *testing.T) {
+	oracleAddress := "0xcc00000000000000000000000000000000000000"
+
+	t.Run("RequiresSource", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrMissingProposalSource.Error(), []string{"verify-proposal",
+			"--l1-eth-rpc", l1EthRpc, "--rollup-rpc", rollupRpc, "--l2-block", "100"})
+	})
+
+	t.Run("RejectsGameAndOracle", func(t *testing.T) {
+		verifyArgsInvalid(t, ErrConflictingProposalSources.Error(), []string{"verify-proposal",
+			"--l1-eth-rpc", l1EthRpc, "--rollup-rpc", rollupRpc, "--game-address", gameAddress, "--l2-output-oracle", oracleAddress})
+	})
+
+	t.Run("RejectsInvalidGameAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address", []string{"verify-proposal",
+			"--l1-eth-rpc", l1EthRpc, "--rollup-rpc", rollupRpc, "--game-address", "foo"})
+	})
+
+	t.Run("RejectsInvalidOracleAddress", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address", []string{"verify-proposal",
+			"--l1-eth-rpc", l1EthRpc, "--rollup-rpc", rollupRpc, "--l2-output-oracle", "foo", "--l2-block", "100"})
+	})
+
+	t.Run("RequiresL2BlockForOracle", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag l2-block is required", []string{"verify-proposal",
+			"--l1-eth-rpc", l1EthRpc, "--rollup-rpc", rollupRpc, "--l2-output-oracle", oracleAddress})
+	})
+
+	t.Run("RequiresL1Rpc", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag l1-eth-rpc is required", []string{"verify-proposal",
+			"--rollup-rpc", rollupRpc, "--game-address", gameAddress})
+	})
+
+	t.Run("RequiresRollupRpc", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag rollup-rpc is required", []string{"verify-proposal",
+			"--l1-eth-rpc", l1EthRpc, "--game-address", gameAddress})
+	})
+}
+
+func TestNewProposalVerification(t *testing.T) {
+	source := common.Address{0xaa}
+	requested := uint64(90)
+	output := &eth.OutputResponse{
+		Version:               eth.Bytes32{0x01},
+		OutputRoot:            eth.Bytes32{0x02},
+		BlockRef:              eth.L2BlockRef{Hash: common.Hash{0x03}, Number: 100},
+		WithdrawalStorageRoot: common.Hash{0x04},
+		StateRoot:             common.Hash{0x05},
+		Status:                &eth.SyncStatus{SafeL2: eth.L2BlockRef{Number: 100}},
+	}
+
+	t.Run("Match", func(t *testing.T) {
+		result := newProposalVerification(proposalSourceOracle, source, &requested, 100, common.Hash{0x02}, output)
+		require.Equal(t, &ProposalVerification{
+			SourceType:         proposalSourceOracle,
+			Source:             source,
+			RequestedL2Block:   &requested,
+			L2Block:            100,
+			ProposedOutputRoot: common.Hash{0x02},
+			ExpectedOutputRoot: common.Hash{0x02},
+			Match:              true,
+			Expected: ExpectedOutput{
+				Version:                  eth.Bytes32{0x01},
+				BlockHash:                common.Hash{0x03},
+				StateRoot:                common.Hash{0x05},
+				MessagePasserStorageRoot: common.Hash{0x04},
+			},
+			Safe: true,
+		}, result)
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		result := newProposalVerification(proposalSourceGame, source, nil, 100, common.Hash{0xff}, output)
+		require.False(t, result.Match)
+		require.Equal(t, common.Hash{0xff}, result.ProposedOutputRoot)
+		require.Equal(t, common.Hash{0x02}, result.ExpectedOutputRoot)
+	})
+
+	t.Run("Unsafe", func(t *testing.T) {
+		result := newProposalVerification(proposalSourceGame, source, nil, 101, common.Hash{0x02}, output)
+		require.False(t, result.Safe)
+	})
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"runtime"
 	"slices"
 	"time"
@@ -12,6 +13,9 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	opsigner "github.com/ethereum-optimism/optimism/op-service/signer"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -33,7 +37,15 @@ var (
 	ErrCannonNetworkAndRollupConfig  = errors.New("only specify one of network or rollup config path")
 	ErrCannonNetworkAndL2Genesis     = errors.New("only specify one of network or l2 genesis path")
 	ErrCannonNetworkUnknown          = errors.New("unknown cannon network")
+	ErrNetworkDefaultsUnknown        = errors.New("no recommended defaults for network")
 	ErrMissingRollupRpc              = errors.New("missing rollup rpc url")
+	ErrExecutionDepthOnlyNoSplit     = errors.New("execution depth only requires a trace type with output bisection")
+	ErrAdditionalKeysWithoutSigner   = errors.New("additional private keys require a signing key")
+	ErrInvalidArchiveURL             = errors.New("invalid archive url")
+	ErrInvalidNotifyURL              = errors.New("invalid notification webhook url")
+	ErrInvalidL1EthWs                = errors.New("invalid l1 eth websocket url")
+	ErrL1BatchSizeZero               = errors.New("l1 batch size must not be 0")
+	ErrNegativeTaskInterval          = errors.New("task intervals must not be negative")
 )
 
 type TraceType string
@@ -90,6 +102,11 @@ const (
 	DefaultPollInterval       = time.Second * 12
 	DefaultCannonSnapshotFreq = uint(1_000_000_000)
 	DefaultCannonInfoFreq     = uint(10_000_000)
+	// DefaultL1BatchSize is the default maximum number of calls, such as loading claims, sent in a single batch
+	// request to the L1 node.
+	DefaultL1BatchSize = uint(100)
+	// DefaultTraceCacheSize is the default number of claim values cached by each trace provider.
+	DefaultTraceCacheSize = uint(1000)
 	// DefaultGameWindow is the default maximum time duration in the past
 	// that the challenger will look for games to progress.
 	// The default value is 11 days, which is a 4 day resolution buffer
@@ -102,12 +119,87 @@ const (
 // It is used to initialize the challenger.
 type Config struct {
 	L1EthRpc           string           // L1 RPC Url
+	L1EthWs            string           // Optional L1 websocket Url used to subscribe to move events on tracked games
 	GameFactoryAddress common.Address   // Address of the dispute game factory
 	GameAllowlist      []common.Address // Allowlist of fault game addresses
+	MulticallAddress   common.Address   // Optional Multicall3 contract used to batch claim resolution
 	GameWindow         time.Duration    // Maximum time duration to look for games to progress
 	Datadir            string           // Data Directory
 	MaxConcurrency     uint             // Maximum number of threads to use when progressing games
+	L1BatchSize        uint             // Maximum number of contract calls sent to the L1 node in a single batch request
 	PollInterval       time.Duration    // Polling interval for latest-block subscription when using an HTTP RPC provider
+	Network            string           // Network the recommended defaults were applied for, if any
+
+	// GameFactoryStartBlock is the L1 block to start discovering games from using the factory's DisputeGameCreated
+	// events. If 0, games are discovered by reading the factory's list of games instead.
+	GameFactoryStartBlock uint64
+
+	// ExecutionDepthOnly limits responses to the execution (bottom) half of output bisection games.
+	// Claims in the output bisection (top) half are assumed to be handled by another actor.
+	ExecutionDepthOnly bool
+
+	// IgnoreValidRootClaims disables defending root claims that match the local trace, such as proposals made by our
+	// own proposer. By default every claim that disagrees with them is countered. Invalid root claims are always attacked.
+	IgnoreValidRootClaims bool
+
+	// IncidentMode freezes offensive moves that challenge root claims posted by other actors on startup.
+	// Defensive moves and resolutions continue. It can be toggled at runtime via the admin RPC.
+	IncidentMode bool
+
+	// DryRun runs the full monitor and solver pipeline but logs the transactions that would be sent, including their
+	// calldata, instead of sending them. No signing key is required.
+	DryRun bool
+
+	// MonitorOnly tracks and forecasts the result of games but never sends transactions, even if a signing key is
+	// configured. It is handled as any other read-only challenger, see ReadOnly.
+	MonitorOnly bool
+
+	// GracefulUpgrade restarts the challenger as a new process, such as after its binary is replaced, when SIGUSR2 is
+	// received. The listeners are passed to the new process, which waits for the current process to stop before using
+	// the datadir.
+	GracefulUpgrade bool
+
+	// SelfTest plays a synthetic game in memory on startup, refusing to start if the challenger fails to win it.
+	SelfTest bool
+
+	// AdditionalPrivateKeys are the private keys of accounts used in addition to the configured signer.
+	// Games are distributed across all the accounts so that transactions for different games can be sent concurrently.
+	AdditionalPrivateKeys []string
+
+	// RPCJWTSecretPath is the file containing the hex-encoded 32 byte secret used to authenticate RPC requests.
+	// RPC requests are not authenticated if empty.
+	RPCJWTSecretPath string
+
+	// ArchiveURL is the base URL of the S3 or GCS compatible object storage that resolved games are archived to before
+	// their local state is removed. Games are not archived if empty.
+	ArchiveURL string
+	// ArchiveAuthToken is the bearer token used to authenticate uploads to ArchiveURL, if required.
+	ArchiveAuthToken string
+
+	// NotifyWebhookURL is the URL each game lifecycle event is posted to as JSON. Not sent if empty.
+	NotifyWebhookURL string
+	// NotifySlackWebhookURL is the Slack incoming webhook URL each game lifecycle event is posted to as a message.
+	// Not sent if empty.
+	NotifySlackWebhookURL string
+
+	// MaxDiskUsage is the budget in bytes for the disk space used by the data of all games. The data of the oldest
+	// in-progress games is evicted, to be regenerated when needed, to stay within it. Zero means unlimited.
+	MaxDiskUsage uint64
+
+	// TraceCacheSize is the number of claim values cached by each trace provider so they aren't recomputed each time
+	// the solver requests them. Zero disables caching.
+	TraceCacheSize uint
+
+	// GameDiscoveryInterval is the minimum time between loading the list of games from the factory.
+	// Zero loads the games on every new L1 head.
+	GameDiscoveryInterval time.Duration
+	// ClaimRefreshInterval is the minimum time between loading the claims of each in-progress game and responding to
+	// them. Games with a move that must be made before the interval elapses are still progressed immediately.
+	// Zero progresses every game on every new L1 head.
+	ClaimRefreshInterval time.Duration
+	// ResolutionCheckInterval is the minimum time between checking whether each in-progress game can be resolved.
+	// Zero checks every time the game is progressed.
+	ResolutionCheckInterval time.Duration
 
 	TraceTypes []TraceType // Type of traces supported
 
@@ -127,10 +219,13 @@ type Config struct {
 	CannonL2               string // L2 RPC Url
 	CannonSnapshotFreq     uint   // Frequency of snapshots to create when executing cannon (in VM instructions)
 	CannonInfoFreq         uint   // Frequency of cannon progress log messages (in VM instructions)
+	CannonMemoryLimit      uint64 // Maximum resident memory (in bytes) of each cannon execution, including op-program. 0 for no limit.
 
 	TxMgrConfig   txmgr.CLIConfig
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
+	TracingConfig optracing.CLIConfig
+	RPCConfig     oprpc.CLIConfig
 }
 
 func NewConfig(
@@ -144,17 +239,21 @@ func NewConfig(
 		GameFactoryAddress: gameFactoryAddress,
 		MaxConcurrency:     uint(runtime.NumCPU()),
 		PollInterval:       DefaultPollInterval,
+		L1BatchSize:        DefaultL1BatchSize,
 
 		TraceTypes: supportedTraceTypes,
 
 		TxMgrConfig:   txmgr.NewCLIConfig(l1EthRpc, txmgr.DefaultChallengerFlagValues),
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
+		TracingConfig: optracing.DefaultCLIConfig(),
+		RPCConfig:     oprpc.DefaultCLIConfig(),
 
 		Datadir: datadir,
 
 		CannonSnapshotFreq: DefaultCannonSnapshotFreq,
 		CannonInfoFreq:     DefaultCannonInfoFreq,
+		TraceCacheSize:     DefaultTraceCacheSize,
 		GameWindow:         DefaultGameWindow,
 	}
 }
@@ -163,6 +262,31 @@ func (c Config) TraceTypeEnabled(t TraceType) bool {
 	return slices.Contains(c.TraceTypes, t)
 }
 
+// ReadOnly returns true if no signing key is configured or monitor-only mode is enabled.
+// A read-only challenger monitors and evaluates games but never sends transactions.
+func (c Config) ReadOnly() bool {
+	return c.MonitorOnly || !c.hasSigner()
+}
+
+func (c Config) hasSigner() bool {
+	return c.TxMgrConfig.PrivateKey != "" || c.TxMgrConfig.Mnemonic != "" || c.TxMgrConfig.SignerCLIConfig.Enabled()
+}
+
+// AdditionalTxMgrConfigs returns the transaction manager config for each of the additional signing accounts.
+// They are identical to TxMgrConfig except for the signing key.
+func (c Config) AdditionalTxMgrConfigs() []txmgr.CLIConfig {
+	configs := make([]txmgr.CLIConfig, len(c.AdditionalPrivateKeys))
+	for i, key := range c.AdditionalPrivateKeys {
+		cfg := c.TxMgrConfig
+		cfg.PrivateKey = key
+		cfg.Mnemonic = ""
+		cfg.HDPath = ""
+		cfg.SignerCLIConfig = opsigner.NewCLIConfig()
+		configs[i] = cfg
+	}
+	return configs
+}
+
 func (c Config) Check() error {
 	if c.L1EthRpc == "" {
 		return ErrMissingL1EthRPC
@@ -179,6 +303,17 @@ func (c Config) Check() error {
 	if c.MaxConcurrency == 0 {
 		return ErrMaxConcurrencyZero
 	}
+	if c.L1BatchSize == 0 {
+		return ErrL1BatchSizeZero
+	}
+	if c.GameDiscoveryInterval < 0 || c.ClaimRefreshInterval < 0 || c.ResolutionCheckInterval < 0 {
+		return ErrNegativeTaskInterval
+	}
+	if c.Network != "" {
+		if _, ok := DefaultsForNetwork(c.Network); !ok {
+			return fmt.Errorf("%w: %v", ErrNetworkDefaultsUnknown, c.Network)
+		}
+	}
 	if c.TraceTypeEnabled(TraceTypeOutputCannon) || c.TraceTypeEnabled(TraceTypeOutputAlphabet) {
 		if c.RollupRpc == "" {
 			return ErrMissingRollupRpc
@@ -222,17 +357,59 @@ func (c Config) Check() error {
 			return ErrMissingCannonInfoFreq
 		}
 	}
+	if c.ExecutionDepthOnly && !c.TraceTypeEnabled(TraceTypeOutputCannon) && !c.TraceTypeEnabled(TraceTypeOutputAlphabet) {
+		return ErrExecutionDepthOnlyNoSplit
+	}
 	if c.TraceTypeEnabled(TraceTypeAlphabet) && c.AlphabetTrace == "" {
 		return ErrMissingAlphabetTrace
 	}
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
+	if len(c.AdditionalPrivateKeys) > 0 && !c.hasSigner() {
+		return ErrAdditionalKeysWithoutSigner
+	}
+	if c.L1EthWs != "" {
+		wsURL, err := url.Parse(c.L1EthWs)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidL1EthWs, err)
+		}
+		if wsURL.Scheme != "ws" && wsURL.Scheme != "wss" {
+			return fmt.Errorf("%w: unsupported scheme %q", ErrInvalidL1EthWs, wsURL.Scheme)
+		}
+	}
+	if c.ArchiveURL != "" {
+		archiveURL, err := url.Parse(c.ArchiveURL)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidArchiveURL, err)
+		}
+		if archiveURL.Scheme != "http" && archiveURL.Scheme != "https" {
+			return fmt.Errorf("%w: unsupported scheme %q", ErrInvalidArchiveURL, archiveURL.Scheme)
+		}
+	}
+	for _, notifyURL := range []string{c.NotifyWebhookURL, c.NotifySlackWebhookURL} {
+		if notifyURL == "" {
+			continue
+		}
+		parsed, err := url.Parse(notifyURL)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidNotifyURL, err)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return fmt.Errorf("%w: unsupported scheme %q", ErrInvalidNotifyURL, parsed.Scheme)
+		}
+	}
 	if err := c.MetricsConfig.Check(); err != nil {
 		return err
 	}
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.TracingConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.RPCConfig.Check(); err != nil {
+		return err
+	}
 	return nil
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
@@ -60,6 +61,109 @@ func TestTxMgrConfig(t *testing.T) {
 	})
 }
 
+func TestReadOnly(t *testing.T) {
+	t.Run("NoSigningKey", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		require.True(t, config.ReadOnly())
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("PrivateKey", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.TxMgrConfig.PrivateKey = "0x1234"
+		require.False(t, config.ReadOnly())
+	})
+
+	t.Run("Mnemonic", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.TxMgrConfig.Mnemonic = "test test test"
+		require.False(t, config.ReadOnly())
+	})
+
+	t.Run("RemoteSigner", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.TxMgrConfig.SignerCLIConfig.Endpoint = "http://localhost:9000"
+		config.TxMgrConfig.SignerCLIConfig.Address = "0x1234"
+		require.False(t, config.ReadOnly())
+	})
+
+	t.Run("MonitorOnly", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.TxMgrConfig.PrivateKey = "0x1234"
+		config.AdditionalPrivateKeys = []string{"0x5678"}
+		config.MonitorOnly = true
+		require.True(t, config.ReadOnly())
+		require.NoError(t, config.Check())
+	})
+}
+
+func TestAdditionalPrivateKeys(t *testing.T) {
+	t.Run("RequireSigner", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.AdditionalPrivateKeys = []string{"0x5678"}
+		require.ErrorIs(t, config.Check(), ErrAdditionalKeysWithoutSigner)
+	})
+
+	t.Run("TxMgrConfigs", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.TxMgrConfig.Mnemonic = "test test test"
+		config.TxMgrConfig.HDPath = "m/44'/60'/0'/0/0"
+		config.AdditionalPrivateKeys = []string{"0x5678", "0x9abc"}
+		require.NoError(t, config.Check())
+
+		configs := config.AdditionalTxMgrConfigs()
+		require.Len(t, configs, 2)
+		for i, cfg := range configs {
+			require.Equal(t, config.AdditionalPrivateKeys[i], cfg.PrivateKey)
+			require.Empty(t, cfg.Mnemonic)
+			require.Empty(t, cfg.HDPath)
+			require.Equal(t, config.TxMgrConfig.L1RPCURL, cfg.L1RPCURL)
+			require.Equal(t, config.TxMgrConfig.NumConfirmations, cfg.NumConfirmations)
+		}
+	})
+}
+
+func TestArchiveURL(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.ArchiveURL = "https://storage.googleapis.com/bucket/games"
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("UnsupportedScheme", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.ArchiveURL = "s3://bucket/games"
+		require.ErrorIs(t, config.Check(), ErrInvalidArchiveURL)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.ArchiveURL = "http://[::1"
+		require.ErrorIs(t, config.Check(), ErrInvalidArchiveURL)
+	})
+}
+
+func TestNotifyURLs(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.NotifyWebhookURL = "https://example.com/challenger"
+		config.NotifySlackWebhookURL = "https://hooks.slack.com/services/T000/B000/XXXX"
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("UnsupportedScheme", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.NotifyWebhookURL = "ftp://example.com/challenger"
+		require.ErrorIs(t, config.Check(), ErrInvalidNotifyURL)
+	})
+
+	t.Run("InvalidSlack", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.NotifySlackWebhookURL = "http://[::1"
+		require.ErrorIs(t, config.Check(), ErrInvalidNotifyURL)
+	})
+}
+
 func TestL1EthRpcRequired(t *testing.T) {
 	config := validConfig(TraceTypeCannon)
 	config.L1EthRpc = ""
@@ -127,6 +231,46 @@ func TestMaxConcurrency(t *testing.T) {
 	})
 }
 
+func TestL1BatchSize(t *testing.T) {
+	t.Run("Required", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		config.L1BatchSize = 0
+		require.ErrorIs(t, config.Check(), ErrL1BatchSizeZero)
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		require.Equal(t, DefaultL1BatchSize, config.L1BatchSize)
+	})
+}
+
+func TestTaskIntervals(t *testing.T) {
+	t.Run("DefaultToZero", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		require.Zero(t, config.GameDiscoveryInterval)
+		require.Zero(t, config.ClaimRefreshInterval)
+		require.Zero(t, config.ResolutionCheckInterval)
+	})
+
+	for name, setInterval := range map[string]func(cfg *Config){
+		"GameDiscovery":   func(cfg *Config) { cfg.GameDiscoveryInterval = -time.Second },
+		"ClaimRefresh":    func(cfg *Config) { cfg.ClaimRefreshInterval = -time.Second },
+		"ResolutionCheck": func(cfg *Config) { cfg.ResolutionCheckInterval = -time.Second },
+	} {
+		setInterval := setInterval
+		t.Run(name+"MustNotBeNegative", func(t *testing.T) {
+			config := validConfig(TraceTypeAlphabet)
+			setInterval(&config)
+			require.ErrorIs(t, config.Check(), ErrNegativeTaskInterval)
+		})
+	}
+}
+
+func TestTraceCacheSizeDefault(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	require.Equal(t, DefaultTraceCacheSize, config.TraceCacheSize)
+}
+
 func TestHttpPollInterval(t *testing.T) {
 	t.Run("Default", func(t *testing.T) {
 		config := validConfig(TraceTypeAlphabet)
@@ -146,6 +290,23 @@ func TestRollupRpcRequired_OutputAlphabet(t *testing.T) {
 	require.ErrorIs(t, config.Check(), ErrMissingRollupRpc)
 }
 
+func TestExecutionDepthOnly(t *testing.T) {
+	t.Run("RequiresOutputBisection", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.ExecutionDepthOnly = true
+		require.ErrorIs(t, config.Check(), ErrExecutionDepthOnlyNoSplit)
+	})
+
+	for _, traceType := range []TraceType{TraceTypeOutputCannon, TraceTypeOutputAlphabet} {
+		traceType := traceType
+		t.Run(traceType.String(), func(t *testing.T) {
+			config := validConfig(traceType)
+			config.ExecutionDepthOnly = true
+			require.NoError(t, config.Check())
+		})
+	}
+}
+
 func TestCannonL2Required(t *testing.T) {
 	config := validConfig(TraceTypeCannon)
 	config.CannonL2 = ""
@@ -206,6 +367,14 @@ func TestNetworkMustBeValid(t *testing.T) {
 	require.ErrorIs(t, cfg.Check(), ErrCannonNetworkUnknown)
 }
 
+func TestTracingConfigMustBeValid(t *testing.T) {
+	cfg := validConfig(TraceTypeAlphabet)
+	cfg.TracingConfig.Enabled = true
+	require.NoError(t, cfg.Check())
+	cfg.TracingConfig.SampleRatio = 1.5
+	require.ErrorContains(t, cfg.Check(), "tracing sample ratio")
+}
+
 func TestRequireConfigForMultipleTraceTypes(t *testing.T) {
 	cfg := validConfig(TraceTypeCannon)
 	cfg.TraceTypes = []TraceType{TraceTypeCannon, TraceTypeAlphabet, TraceTypeOutputCannon}
@@ -228,3 +397,23 @@ func TestRequireConfigForMultipleTraceTypes(t *testing.T) {
 	cfg.RollupRpc = ""
 	require.ErrorIs(t, cfg.Check(), ErrMissingRollupRpc)
 }
+
+func TestL1EthWs(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.L1EthWs = "wss://example.com/ws"
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("UnsupportedScheme", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.L1EthWs = "http://example.com"
+		require.ErrorIs(t, config.Check(), ErrInvalidL1EthWs)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.L1EthWs = "ws://[::1"
+		require.ErrorIs(t, config.Check(), ErrInvalidL1EthWs)
+	})
+}
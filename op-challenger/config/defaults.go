@@ -0,0 +1,99 @@
+package config
+
+import (
+	"slices"
+	"time"
+
+	"golang.org/x/exp/maps"
+)
+
+// NetworkDefaults is a bundle of recommended configuration values for a known network.
+// The safety-critical values are also used as thresholds: configuring a less safe value produces an UnsafeOverride.
+type NetworkDefaults struct {
+	PollInterval       time.Duration
+	MaxConcurrency     uint
+	GameWindow         time.Duration
+	CannonSnapshotFreq uint
+	FeeLimitMultiplier uint64
+}
+
+// UnsafeOverride describes a configured value that is less safe than the recommended value for the network.
+type UnsafeOverride struct {
+	Option      string
+	Value       any
+	Recommended any
+	Reason      string
+}
+
+var networkDefaults = map[string]NetworkDefaults{
+	"op-mainnet": {
+		PollInterval:       12 * time.Second,
+		MaxConcurrency:     4,
+		GameWindow:         DefaultGameWindow,
+		CannonSnapshotFreq: DefaultCannonSnapshotFreq,
+		FeeLimitMultiplier: 5,
+	},
+	"op-sepolia": {
+		PollInterval:       12 * time.Second,
+		MaxConcurrency:     4,
+		GameWindow:         DefaultGameWindow,
+		CannonSnapshotFreq: DefaultCannonSnapshotFreq,
+		FeeLimitMultiplier: 5,
+	},
+}
+
+// DefaultsNetworks returns the names of the networks that have recommended defaults.
+func DefaultsNetworks() []string {
+	networks := maps.Keys(networkDefaults)
+	slices.Sort(networks)
+	return networks
+}
+
+// DefaultsForNetwork returns the recommended defaults for the named network.
+func DefaultsForNetwork(network string) (NetworkDefaults, bool) {
+	defaults, ok := networkDefaults[network]
+	return defaults, ok
+}
+
+// UnsafeOverrides returns the safety-critical values in c that are less safe than the recommended defaults for
+// c.Network. No overrides are returned if no network is configured.
+func (c Config) UnsafeOverrides() []UnsafeOverride {
+	defaults, ok := DefaultsForNetwork(c.Network)
+	if !ok {
+		return nil
+	}
+	var overrides []UnsafeOverride
+	if c.GameWindow < defaults.GameWindow {
+		overrides = append(overrides, UnsafeOverride{
+			Option:      "game-window",
+			Value:       c.GameWindow,
+			Recommended: defaults.GameWindow,
+			Reason:      "games created before the window will not be progressed",
+		})
+	}
+	if c.PollInterval > defaults.PollInterval {
+		overrides = append(overrides, UnsafeOverride{
+			Option:      "http-poll-interval",
+			Value:       c.PollInterval,
+			Recommended: defaults.PollInterval,
+			Reason:      "new claims will take longer to be detected",
+		})
+	}
+	if (c.TraceTypeEnabled(TraceTypeCannon) || c.TraceTypeEnabled(TraceTypeOutputCannon)) && c.CannonSnapshotFreq > defaults.CannonSnapshotFreq {
+		overrides = append(overrides, UnsafeOverride{
+			Option:      "cannon-snapshot-freq",
+			Value:       c.CannonSnapshotFreq,
+			Recommended: defaults.CannonSnapshotFreq,
+			Reason:      "generating proofs will take longer",
+		})
+	}
+	if !c.ReadOnly() && c.TxMgrConfig.FeeLimitMultiplier < defaults.FeeLimitMultiplier {
+		overrides = append(overrides, UnsafeOverride{
+			Option:      "fee-limit-multiplier",
+			Value:       c.TxMgrConfig.FeeLimitMultiplier,
+			Recommended: defaults.FeeLimitMultiplier,
+			Reason:      "responses may not be included in time when fees rise",
+		})
+	}
+	return overrides
+}
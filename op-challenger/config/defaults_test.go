@@ -0,0 +1,83 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkDefaults(t *testing.T) {
+	t.Run("UnknownNetwork", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		config.Network = "unknown"
+		require.ErrorIs(t, config.Check(), ErrNetworkDefaultsUnknown)
+	})
+
+	for _, network := range DefaultsNetworks() {
+		network := network
+		t.Run(network, func(t *testing.T) {
+			config := validConfig(TraceTypeCannon)
+			config.Network = network
+			require.NoError(t, config.Check())
+			require.Empty(t, config.UnsafeOverrides(), "default config should not be less safe than recommended")
+		})
+	}
+}
+
+func TestUnsafeOverrides(t *testing.T) {
+	defaults, ok := DefaultsForNetwork("op-mainnet")
+	require.True(t, ok)
+	unsafeConfig := func(traceType TraceType) Config {
+		config := validConfig(traceType)
+		config.Network = "op-mainnet"
+		config.TxMgrConfig.PrivateKey = "0x1234"
+		config.GameWindow = defaults.GameWindow - time.Hour
+		config.PollInterval = defaults.PollInterval + time.Second
+		config.CannonSnapshotFreq = defaults.CannonSnapshotFreq + 1
+		config.TxMgrConfig.FeeLimitMultiplier = defaults.FeeLimitMultiplier - 1
+		return config
+	}
+	options := func(overrides []UnsafeOverride) []string {
+		var names []string
+		for _, override := range overrides {
+			names = append(names, override.Option)
+		}
+		return names
+	}
+
+	t.Run("NoNetwork", func(t *testing.T) {
+		config := unsafeConfig(TraceTypeCannon)
+		config.Network = ""
+		require.Empty(t, config.UnsafeOverrides())
+	})
+
+	t.Run("AllUnsafe", func(t *testing.T) {
+		config := unsafeConfig(TraceTypeCannon)
+		require.Equal(t,
+			[]string{"game-window", "http-poll-interval", "cannon-snapshot-freq", "fee-limit-multiplier"},
+			options(config.UnsafeOverrides()))
+	})
+
+	t.Run("SaferValuesAllowed", func(t *testing.T) {
+		config := validConfig(TraceTypeCannon)
+		config.Network = "op-mainnet"
+		config.TxMgrConfig.PrivateKey = "0x1234"
+		config.GameWindow = defaults.GameWindow + time.Hour
+		config.PollInterval = defaults.PollInterval - time.Second
+		config.CannonSnapshotFreq = defaults.CannonSnapshotFreq - 1
+		config.TxMgrConfig.FeeLimitMultiplier = defaults.FeeLimitMultiplier + 1
+		require.Empty(t, config.UnsafeOverrides())
+	})
+
+	t.Run("IgnoreSnapshotFreqWithoutCannon", func(t *testing.T) {
+		config := unsafeConfig(TraceTypeAlphabet)
+		require.NotContains(t, options(config.UnsafeOverrides()), "cannon-snapshot-freq")
+	})
+
+	t.Run("IgnoreFeeLimitWhenReadOnly", func(t *testing.T) {
+		config := unsafeConfig(TraceTypeCannon)
+		config.TxMgrConfig.PrivateKey = ""
+		require.NotContains(t, options(config.UnsafeOverrides()), "fee-limit-multiplier")
+	})
+}
@@ -16,15 +16,17 @@ import (
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
 const (
-	envVarPrefix = "OP_CHALLENGER"
+	EnvVarPrefix = "OP_CHALLENGER"
 )
 
 func prefixEnvVars(name string) []string {
-	return opservice.PrefixEnvVar(envVarPrefix, name)
+	return opservice.PrefixEnvVar(EnvVarPrefix, name)
 }
 
 var (
@@ -34,6 +36,11 @@ var (
 		Usage:   "HTTP provider URL for L1.",
 		EnvVars: prefixEnvVars("L1_ETH_RPC"),
 	}
+	L1EthWsFlag = &cli.StringFlag{
+		Name:    "l1-eth-ws",
+		Usage:   "Websocket provider URL for L1, used to subscribe to move events on tracked games so they are progressed as soon as a claim is posted. Games are still polled on each new L1 head.",
+		EnvVars: prefixEnvVars("L1_ETH_WS"),
+	}
 	FactoryAddressFlag = &cli.StringFlag{
 		Name:    "game-factory-address",
 		Usage:   "Address of the fault game factory contract.",
@@ -45,6 +52,18 @@ var (
 			"If empty, the challenger will play all games.",
 		EnvVars: prefixEnvVars("GAME_ALLOWLIST"),
 	}
+	MulticallAddressFlag = &cli.StringFlag{
+		Name: "multicall-address",
+		Usage: "Address of a Multicall3 contract used to resolve multiple claims in a single transaction. " +
+			"If not set, a transaction is sent for each claim.",
+		EnvVars: prefixEnvVars("MULTICALL_ADDRESS"),
+	}
+	GameFactoryStartBlockFlag = &cli.Uint64Flag{
+		Name: "game-factory-start-block",
+		Usage: "L1 block to start discovering games from using the factory's DisputeGameCreated events. " +
+			"If not set, games are discovered by reading the factory's list of games.",
+		EnvVars: prefixEnvVars("GAME_FACTORY_START_BLOCK"),
+	}
 	TraceTypeFlag = &cli.StringSliceFlag{
 		Name:    "trace-type",
 		Usage:   "The trace types to support. Valid options: " + openum.EnumString(config.TraceTypes),
@@ -56,12 +75,27 @@ var (
 		EnvVars: prefixEnvVars("DATADIR"),
 	}
 	// Optional Flags
+	NetworkFlag = &cli.StringFlag{
+		Name: "network",
+		Usage: fmt.Sprintf(
+			"Network to apply the recommended defaults for. Explicitly set options take precedence. Available networks: %s",
+			strings.Join(config.DefaultsNetworks(), ", "),
+		),
+		EnvVars: prefixEnvVars("NETWORK"),
+	}
 	MaxConcurrencyFlag = &cli.UintFlag{
 		Name:    "max-concurrency",
 		Usage:   "Maximum number of threads to use when progressing games",
 		EnvVars: prefixEnvVars("MAX_CONCURRENCY"),
 		Value:   uint(runtime.NumCPU()),
 	}
+	L1BatchSizeFlag = &cli.UintFlag{
+		Name: "l1-batch-size",
+		Usage: "Maximum number of contract calls, such as loading the claims of a game, sent to the L1 node in a " +
+			"single batch request.",
+		EnvVars: prefixEnvVars("L1_BATCH_SIZE"),
+		Value:   config.DefaultL1BatchSize,
+	}
 	HTTPPollInterval = &cli.DurationFlag{
 		Name:    "http-poll-interval",
 		Usage:   "Polling interval for latest-block subscription when using an HTTP RPC provider.",
@@ -128,12 +162,126 @@ var (
 		EnvVars: prefixEnvVars("CANNON_INFO_FREQ"),
 		Value:   config.DefaultCannonInfoFreq,
 	}
+	TraceCacheSizeFlag = &cli.UintFlag{
+		Name: "trace-cache-size",
+		Usage: "Number of claim values cached by each trace provider so they aren't recomputed each time they are " +
+			"needed. 0 disables caching.",
+		EnvVars: prefixEnvVars("TRACE_CACHE_SIZE"),
+		Value:   config.DefaultTraceCacheSize,
+	}
+	CannonMemoryLimitFlag = &cli.Uint64Flag{
+		Name: "cannon-memory-limit",
+		Usage: "Maximum resident memory in MiB of each cannon execution, including op-program. Executions exceeding the " +
+			"limit are killed and retried with a larger snapshot frequency. 0 disables the limit. Only enforced on Linux (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_MEMORY_LIMIT"),
+	}
 	GameWindowFlag = &cli.DurationFlag{
 		Name:    "game-window",
 		Usage:   "The time window which the challenger will look for games to progress.",
 		EnvVars: prefixEnvVars("GAME_WINDOW"),
 		Value:   config.DefaultGameWindow,
 	}
+	GameDiscoveryIntervalFlag = &cli.DurationFlag{
+		Name:    "game-discovery-interval",
+		Usage:   "Minimum time between loading the list of games from the factory. 0 loads games on every new L1 head.",
+		EnvVars: prefixEnvVars("GAME_DISCOVERY_INTERVAL"),
+	}
+	ClaimRefreshIntervalFlag = &cli.DurationFlag{
+		Name: "claim-refresh-interval",
+		Usage: "Minimum time between loading and responding to the claims of each in-progress game. Games with a move " +
+			"due before the interval elapses are still progressed. Should be well below the game clock duration. " +
+			"0 progresses every game on every new L1 head.",
+		EnvVars: prefixEnvVars("CLAIM_REFRESH_INTERVAL"),
+	}
+	ResolutionCheckIntervalFlag = &cli.DurationFlag{
+		Name:    "resolution-check-interval",
+		Usage:   "Minimum time between checking whether each in-progress game can be resolved. 0 checks every time a game is progressed.",
+		EnvVars: prefixEnvVars("RESOLUTION_CHECK_INTERVAL"),
+	}
+	ExecutionDepthOnlyFlag = &cli.BoolFlag{
+		Name: "execution-depth-only",
+		Usage: "Only respond to claims in the execution (bottom) half of output bisection games. " +
+			"Output bisection claims must be handled by another challenger.",
+		EnvVars: prefixEnvVars("EXECUTION_DEPTH_ONLY"),
+	}
+	IgnoreValidRootClaimsFlag = &cli.BoolFlag{
+		Name: "ignore-valid-root-claims",
+		Usage: "Do not defend root claims that match the local trace, such as proposals made by our own proposer. " +
+			"By default every claim that disagrees with them is countered. Invalid root claims are always attacked.",
+		EnvVars: prefixEnvVars("IGNORE_VALID_ROOT_CLAIMS"),
+	}
+	GracefulUpgradeFlag = &cli.BoolFlag{
+		Name: "graceful-upgrade",
+		Usage: "Restart as a new process, such as after replacing the binary, when SIGUSR2 is received. The RPC, metrics " +
+			"and pprof listeners are handed to the new process and the current process stops once it is ready. " +
+			"The process supervisor must not treat the exit of the original process as the service stopping.",
+		EnvVars: prefixEnvVars("GRACEFUL_UPGRADE"),
+	}
+	DryRunFlag = &cli.BoolFlag{
+		Name: "dry-run",
+		Usage: "Evaluate games and log the moves, steps and resolutions that would be made, including calldata, " +
+			"instead of sending transactions. Does not require a signing key.",
+		EnvVars: prefixEnvVars("DRY_RUN"),
+	}
+	MonitorOnlyFlag = &cli.BoolFlag{
+		Name: "monitor-only",
+		Usage: "Track every game and report games heading toward an incorrect result without ever sending " +
+			"transactions, even if a signing key is configured.",
+		EnvVars: prefixEnvVars("MONITOR_ONLY"),
+	}
+	SelfTestFlag = &cli.BoolFlag{
+		Name: "self-test",
+		Usage: "Play a synthetic game in memory on startup and refuse to start if the challenger fails to win it. " +
+			"Detects broken installations before a real dispute.",
+		EnvVars: prefixEnvVars("SELF_TEST"),
+	}
+	IncidentModeFlag = &cli.BoolFlag{
+		Name: "incident-mode",
+		Usage: "Start with offensive moves that challenge root claims posted by other actors frozen. " +
+			"Defensive moves and resolutions continue. Can be toggled at runtime with the admin RPC.",
+		EnvVars: prefixEnvVars("INCIDENT_MODE"),
+	}
+	AdditionalPrivateKeysFlag = &cli.StringSliceFlag{
+		Name: "additional-private-keys",
+		Usage: "Private keys of additional funded accounts to send transactions from. Games are distributed across " +
+			"these accounts and the configured signer so that many games can be acted on in the same block.",
+		EnvVars: prefixEnvVars("ADDITIONAL_PRIVATE_KEYS"),
+	}
+	RPCJWTSecretFlag = &cli.StringFlag{
+		Name: "rpc.jwt-secret",
+		Usage: "Path to a file containing the hex-encoded 32 byte secret used to authenticate RPC requests with JWT. " +
+			"Strongly recommended when the admin API is enabled.",
+		EnvVars:   prefixEnvVars("RPC_JWT_SECRET"),
+		TakesFile: true,
+	}
+	ArchiveURLFlag = &cli.StringFlag{
+		Name: "archive.url",
+		Usage: "Base URL of S3 or GCS compatible object storage to archive resolved games to, " +
+			"e.g. https://storage.googleapis.com/<bucket>/games. Local game state is only removed once archived.",
+		EnvVars: prefixEnvVars("ARCHIVE_URL"),
+	}
+	ArchiveAuthTokenFlag = &cli.StringFlag{
+		Name:    "archive.auth-token",
+		Usage:   "Bearer token used to authenticate uploads to the archive URL",
+		EnvVars: prefixEnvVars("ARCHIVE_AUTH_TOKEN"),
+	}
+	NotifyWebhookURLFlag = &cli.StringFlag{
+		Name: "notify.webhook-url",
+		Usage: "URL to post game lifecycle events to as JSON: game created, move submitted, step executed, " +
+			"game resolved and game resolved with an incorrect result",
+		EnvVars: prefixEnvVars("NOTIFY_WEBHOOK_URL"),
+	}
+	NotifySlackWebhookURLFlag = &cli.StringFlag{
+		Name:    "notify.slack-webhook-url",
+		Usage:   "Slack incoming webhook URL to post game lifecycle events to",
+		EnvVars: prefixEnvVars("NOTIFY_SLACK_WEBHOOK_URL"),
+	}
+	MaxDiskUsageFlag = &cli.Uint64Flag{
+		Name: "max-disk-usage",
+		Usage: "Maximum disk space in MiB used by game data in the datadir. The data of the oldest in-progress games " +
+			"is evicted and regenerated when needed to stay within it. 0 means unlimited.",
+		EnvVars: prefixEnvVars("MAX_DISK_USAGE"),
+	}
 )
 
 // requiredFlags are checked by [CheckRequired]
@@ -146,11 +294,16 @@ var requiredFlags = []cli.Flag{
 
 // optionalFlags is a list of unchecked cli flags
 var optionalFlags = []cli.Flag{
+	NetworkFlag,
+	L1EthWsFlag,
 	MaxConcurrencyFlag,
+	L1BatchSizeFlag,
 	HTTPPollInterval,
 	RollupRpcFlag,
 	AlphabetFlag,
 	GameAllowlistFlag,
+	MulticallAddressFlag,
+	GameFactoryStartBlockFlag,
 	CannonNetworkFlag,
 	CannonRollupConfigFlag,
 	CannonL2GenesisFlag,
@@ -160,14 +313,35 @@ var optionalFlags = []cli.Flag{
 	CannonL2Flag,
 	CannonSnapshotFreqFlag,
 	CannonInfoFreqFlag,
+	CannonMemoryLimitFlag,
 	GameWindowFlag,
+	ExecutionDepthOnlyFlag,
+	IgnoreValidRootClaimsFlag,
+	IncidentModeFlag,
+	DryRunFlag,
+	GracefulUpgradeFlag,
+	MonitorOnlyFlag,
+	SelfTestFlag,
+	AdditionalPrivateKeysFlag,
+	RPCJWTSecretFlag,
+	ArchiveURLFlag,
+	ArchiveAuthTokenFlag,
+	NotifyWebhookURLFlag,
+	NotifySlackWebhookURLFlag,
+	MaxDiskUsageFlag,
+	TraceCacheSizeFlag,
+	GameDiscoveryIntervalFlag,
+	ClaimRefreshIntervalFlag,
+	ResolutionCheckIntervalFlag,
 }
 
 func init() {
-	optionalFlags = append(optionalFlags, oplog.CLIFlags(envVarPrefix)...)
-	optionalFlags = append(optionalFlags, txmgr.CLIFlagsWithDefaults(envVarPrefix, txmgr.DefaultChallengerFlagValues)...)
-	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(envVarPrefix)...)
-	optionalFlags = append(optionalFlags, oppprof.CLIFlags(envVarPrefix)...)
+	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, txmgr.CLIFlagsWithDefaults(EnvVarPrefix, txmgr.DefaultChallengerFlagValues)...)
+	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, optracing.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oprpc.CLIFlags(EnvVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
 }
@@ -273,37 +447,105 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		}
 	}
 
+	var multicallAddress common.Address
+	if ctx.IsSet(MulticallAddressFlag.Name) {
+		multicallAddress, err = opservice.ParseAddress(ctx.String(MulticallAddressFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	txMgrConfig := txmgr.ReadCLIConfig(ctx)
 	metricsConfig := opmetrics.ReadCLIConfig(ctx)
 	pprofConfig := oppprof.ReadCLIConfig(ctx)
+	tracingConfig := optracing.ReadCLIConfig(ctx)
+	rpcConfig := oprpc.ReadCLIConfig(ctx)
 
 	maxConcurrency := ctx.Uint(MaxConcurrencyFlag.Name)
 	if maxConcurrency == 0 {
 		return nil, fmt.Errorf("%v must not be 0", MaxConcurrencyFlag.Name)
 	}
-	return &config.Config{
+	cfg := &config.Config{
 		// Required Flags
-		L1EthRpc:               ctx.String(L1EthRpcFlag.Name),
-		TraceTypes:             traceTypes,
-		GameFactoryAddress:     gameFactoryAddress,
-		GameAllowlist:          allowedGames,
-		GameWindow:             ctx.Duration(GameWindowFlag.Name),
-		MaxConcurrency:         maxConcurrency,
-		PollInterval:           ctx.Duration(HTTPPollInterval.Name),
-		RollupRpc:              ctx.String(RollupRpcFlag.Name),
-		AlphabetTrace:          ctx.String(AlphabetFlag.Name),
-		CannonNetwork:          ctx.String(CannonNetworkFlag.Name),
-		CannonRollupConfigPath: ctx.String(CannonRollupConfigFlag.Name),
-		CannonL2GenesisPath:    ctx.String(CannonL2GenesisFlag.Name),
-		CannonBin:              ctx.String(CannonBinFlag.Name),
-		CannonServer:           ctx.String(CannonServerFlag.Name),
-		CannonAbsolutePreState: ctx.String(CannonPreStateFlag.Name),
-		Datadir:                ctx.String(DatadirFlag.Name),
-		CannonL2:               ctx.String(CannonL2Flag.Name),
-		CannonSnapshotFreq:     ctx.Uint(CannonSnapshotFreqFlag.Name),
-		CannonInfoFreq:         ctx.Uint(CannonInfoFreqFlag.Name),
-		TxMgrConfig:            txMgrConfig,
-		MetricsConfig:          metricsConfig,
-		PprofConfig:            pprofConfig,
-	}, nil
+		L1EthRpc:                ctx.String(L1EthRpcFlag.Name),
+		L1EthWs:                 ctx.String(L1EthWsFlag.Name),
+		TraceTypes:              traceTypes,
+		GameFactoryAddress:      gameFactoryAddress,
+		GameAllowlist:           allowedGames,
+		MulticallAddress:        multicallAddress,
+		GameFactoryStartBlock:   ctx.Uint64(GameFactoryStartBlockFlag.Name),
+		GameWindow:              ctx.Duration(GameWindowFlag.Name),
+		MaxConcurrency:          maxConcurrency,
+		L1BatchSize:             ctx.Uint(L1BatchSizeFlag.Name),
+		PollInterval:            ctx.Duration(HTTPPollInterval.Name),
+		ExecutionDepthOnly:      ctx.Bool(ExecutionDepthOnlyFlag.Name),
+		IgnoreValidRootClaims:   ctx.Bool(IgnoreValidRootClaimsFlag.Name),
+		IncidentMode:            ctx.Bool(IncidentModeFlag.Name),
+		DryRun:                  ctx.Bool(DryRunFlag.Name),
+		GracefulUpgrade:         ctx.Bool(GracefulUpgradeFlag.Name),
+		MonitorOnly:             ctx.Bool(MonitorOnlyFlag.Name),
+		SelfTest:                ctx.Bool(SelfTestFlag.Name),
+		AdditionalPrivateKeys:   ctx.StringSlice(AdditionalPrivateKeysFlag.Name),
+		RPCJWTSecretPath:        ctx.String(RPCJWTSecretFlag.Name),
+		ArchiveURL:              ctx.String(ArchiveURLFlag.Name),
+		ArchiveAuthToken:        ctx.String(ArchiveAuthTokenFlag.Name),
+		NotifyWebhookURL:        ctx.String(NotifyWebhookURLFlag.Name),
+		NotifySlackWebhookURL:   ctx.String(NotifySlackWebhookURLFlag.Name),
+		MaxDiskUsage:            ctx.Uint64(MaxDiskUsageFlag.Name) * 1024 * 1024,
+		TraceCacheSize:          ctx.Uint(TraceCacheSizeFlag.Name),
+		GameDiscoveryInterval:   ctx.Duration(GameDiscoveryIntervalFlag.Name),
+		ClaimRefreshInterval:    ctx.Duration(ClaimRefreshIntervalFlag.Name),
+		ResolutionCheckInterval: ctx.Duration(ResolutionCheckIntervalFlag.Name),
+		RollupRpc:               ctx.String(RollupRpcFlag.Name),
+		AlphabetTrace:           ctx.String(AlphabetFlag.Name),
+		CannonNetwork:           ctx.String(CannonNetworkFlag.Name),
+		CannonRollupConfigPath:  ctx.String(CannonRollupConfigFlag.Name),
+		CannonL2GenesisPath:     ctx.String(CannonL2GenesisFlag.Name),
+		CannonBin:               ctx.String(CannonBinFlag.Name),
+		CannonServer:            ctx.String(CannonServerFlag.Name),
+		CannonAbsolutePreState:  ctx.String(CannonPreStateFlag.Name),
+		Network:                 ctx.String(NetworkFlag.Name),
+		Datadir:                 ctx.String(DatadirFlag.Name),
+		CannonL2:                ctx.String(CannonL2Flag.Name),
+		CannonSnapshotFreq:      ctx.Uint(CannonSnapshotFreqFlag.Name),
+		CannonInfoFreq:          ctx.Uint(CannonInfoFreqFlag.Name),
+		CannonMemoryLimit:       ctx.Uint64(CannonMemoryLimitFlag.Name) * 1024 * 1024,
+		TxMgrConfig:             txMgrConfig,
+		MetricsConfig:           metricsConfig,
+		PprofConfig:             pprofConfig,
+		TracingConfig:           tracingConfig,
+		RPCConfig:               rpcConfig,
+	}
+	if err := applyNetworkDefaults(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// applyNetworkDefaults replaces the values of options that were not explicitly set with the recommended defaults
+// for the configured network.
+func applyNetworkDefaults(ctx *cli.Context, cfg *config.Config) error {
+	if cfg.Network == "" {
+		return nil
+	}
+	defaults, ok := config.DefaultsForNetwork(cfg.Network)
+	if !ok {
+		return fmt.Errorf("%w: %v", config.ErrNetworkDefaultsUnknown, cfg.Network)
+	}
+	if !ctx.IsSet(HTTPPollInterval.Name) {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if !ctx.IsSet(MaxConcurrencyFlag.Name) {
+		cfg.MaxConcurrency = defaults.MaxConcurrency
+	}
+	if !ctx.IsSet(GameWindowFlag.Name) {
+		cfg.GameWindow = defaults.GameWindow
+	}
+	if !ctx.IsSet(CannonSnapshotFreqFlag.Name) {
+		cfg.CannonSnapshotFreq = defaults.CannonSnapshotFreq
+	}
+	if !ctx.IsSet(txmgr.FeeLimitMultiplierFlagName) {
+		cfg.TxMgrConfig.FeeLimitMultiplier = defaults.FeeLimitMultiplier
+	}
+	return nil
 }
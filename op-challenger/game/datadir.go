@@ -0,0 +1,157 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	datadirVersionFile = "datadir-version"
+	datadirBackupsDir  = "backups"
+)
+
+// ErrUnsupportedDatadirVersion is returned when the datadir was written by a newer version of op-challenger.
+var ErrUnsupportedDatadirVersion = errors.New("unsupported datadir version")
+
+// datadirMigration upgrades the datadir from one version to the next.
+type datadirMigration struct {
+	description string
+	// backup lists the paths, relative to the datadir, that are copied to the backups directory before apply is run.
+	backup []string
+	apply  func(logger log.Logger, dir string) error
+}
+
+// datadirMigrations contains the migrations required to upgrade the datadir to the current version.
+// The migration at index i upgrades the datadir from version i to version i+1.
+// Datadirs without a version marker are version 0.
+var datadirMigrations = []datadirMigration{
+	{
+		// The layout is unchanged from unversioned datadirs, only the version marker is added.
+		description: "add datadir version marker",
+		apply:       func(_ log.Logger, _ string) error { return nil },
+	},
+}
+
+// prepareDatadir ensures dir is using the current datadir version, migrating it if required.
+func prepareDatadir(logger log.Logger, dir string) error {
+	return migrateDatadir(logger, dir, datadirMigrations)
+}
+
+func migrateDatadir(logger log.Logger, dir string, migrations []datadirMigration) error {
+	target := len(migrations)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create datadir %v: %w", dir, err)
+	}
+	version, err := readDatadirVersion(dir)
+	if err != nil {
+		return err
+	}
+	if version > target {
+		return fmt.Errorf("%w: datadir %v has version %v but the latest supported version is %v", ErrUnsupportedDatadirVersion, dir, version, target)
+	}
+	for ; version < target; version++ {
+		migration := migrations[version]
+		logger.Info("Migrating datadir", "dir", dir, "from", version, "to", version+1, "migration", migration.description)
+		if err := backupDatadirPaths(dir, version, migration.backup); err != nil {
+			return fmt.Errorf("failed to backup datadir before migrating from version %v: %w", version, err)
+		}
+		if err := migration.apply(logger, dir); err != nil {
+			return fmt.Errorf("failed to migrate datadir from version %v: %w", version, err)
+		}
+		// Record progress after each migration so a failure doesn't cause completed migrations to run again.
+		if err := writeDatadirVersion(dir, version+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readDatadirVersion reads the version marker from dir, returning version 0 if it does not exist.
+func readDatadirVersion(dir string) (int, error) {
+	path := filepath.Join(dir, datadirVersionFile)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to read datadir version from %v: %w", path, err)
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("invalid datadir version in %v: %q", path, data)
+	}
+	return version, nil
+}
+
+func writeDatadirVersion(dir string, version int) error {
+	path := filepath.Join(dir, datadirVersionFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.Itoa(version)+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write datadir version to %v: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write datadir version to %v: %w", path, err)
+	}
+	return nil
+}
+
+// backupDatadirPaths copies each of paths, relative to dir, into the backups directory for version.
+// Paths that don't exist are skipped.
+func backupDatadirPaths(dir string, version int, paths []string) error {
+	backupDir := filepath.Join(dir, datadirBackupsDir, fmt.Sprintf("v%d", version))
+	for _, path := range paths {
+		src := filepath.Join(dir, path)
+		if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err := copyPath(src, filepath.Join(backupDir, path)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyPath recursively copies the file or directory at src to dest.
+func copyPath(src string, dest string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %v: %w", src, err)
+	}
+	defer in.Close()
+	out, err := os.Create(dest)
+	if err != nil {
+		return fmt.Errorf("failed to create %v: %w", dest, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("failed to copy %v to %v: %w", src, dest, err)
+	}
+	return out.Close()
+}
@@ -0,0 +1,113 @@
+package game
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestMigrateDatadir(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	var applied []int
+	migrations := []datadirMigration{
+		{
+			description: "first",
+			apply: func(_ log.Logger, _ string) error {
+				applied = append(applied, 0)
+				return nil
+			},
+		},
+		{
+			description: "second",
+			backup:      []string{"data.txt", "nested", "missing"},
+			apply: func(_ log.Logger, dir string) error {
+				applied = append(applied, 1)
+				return os.WriteFile(filepath.Join(dir, "data.txt"), []byte("migrated"), 0644)
+			},
+		},
+	}
+
+	t.Run("NewDatadir", func(t *testing.T) {
+		applied = nil
+		dir := filepath.Join(t.TempDir(), "datadir")
+		require.NoError(t, migrateDatadir(logger, dir, migrations))
+		requireDatadirVersion(t, dir, 2)
+		require.Equal(t, []int{0, 1}, applied)
+	})
+
+	t.Run("MigrateAndBackup", func(t *testing.T) {
+		applied = nil
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "data.txt"), []byte("original"), 0644))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "nested", "deep"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "deep", "file.txt"), []byte("nested"), 0644))
+		require.NoError(t, writeDatadirVersion(dir, 1))
+
+		require.NoError(t, migrateDatadir(logger, dir, migrations))
+		requireDatadirVersion(t, dir, 2)
+		require.Equal(t, []int{1}, applied)
+
+		requireFileContent(t, filepath.Join(dir, "data.txt"), "migrated")
+		requireFileContent(t, filepath.Join(dir, datadirBackupsDir, "v1", "data.txt"), "original")
+		requireFileContent(t, filepath.Join(dir, datadirBackupsDir, "v1", "nested", "deep", "file.txt"), "nested")
+		require.NoFileExists(t, filepath.Join(dir, datadirBackupsDir, "v1", "missing"))
+	})
+
+	t.Run("AlreadyCurrent", func(t *testing.T) {
+		applied = nil
+		dir := t.TempDir()
+		require.NoError(t, writeDatadirVersion(dir, 2))
+		require.NoError(t, migrateDatadir(logger, dir, migrations))
+		requireDatadirVersion(t, dir, 2)
+		require.Empty(t, applied)
+	})
+
+	t.Run("FutureVersion", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, writeDatadirVersion(dir, 3))
+		err := migrateDatadir(logger, dir, migrations)
+		require.ErrorIs(t, err, ErrUnsupportedDatadirVersion)
+		requireDatadirVersion(t, dir, 3)
+	})
+
+	t.Run("InvalidVersion", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, datadirVersionFile), []byte("abc"), 0644))
+		require.ErrorContains(t, migrateDatadir(logger, dir, migrations), "invalid datadir version")
+	})
+
+	t.Run("StopAtFailedMigration", func(t *testing.T) {
+		dir := t.TempDir()
+		migrationErr := errors.New("boom")
+		failing := []datadirMigration{
+			migrations[0],
+			{description: "failing", apply: func(_ log.Logger, _ string) error { return migrationErr }},
+		}
+		require.ErrorIs(t, migrateDatadir(logger, dir, failing), migrationErr)
+		requireDatadirVersion(t, dir, 1)
+	})
+}
+
+func TestPrepareDatadir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, prepareDatadir(testlog.Logger(t, log.LvlInfo), dir))
+	requireDatadirVersion(t, dir, len(datadirMigrations))
+}
+
+func requireDatadirVersion(t *testing.T, dir string, expected int) {
+	version, err := readDatadirVersion(dir)
+	require.NoError(t, err)
+	require.Equal(t, expected, version)
+}
+
+func requireFileContent(t *testing.T, path string, expected string) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(data))
+}
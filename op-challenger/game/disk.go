@@ -1,32 +1,182 @@
 package game
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
 	"golang.org/x/exp/slices"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 )
 
 const gameDirPrefix = "game-"
 
+// resolvedGamesFile is the file in the datadir that a record of each resolved game is appended to when its data is
+// removed, one JSON object per line.
+const resolvedGamesFile = "resolved-games.jsonl"
+
+// diskUsageInterval is the minimum time between measurements of the disk usage of each game.
+// Measuring walks every file of the tracked games so is too expensive to do after every game update.
+const diskUsageInterval = time.Minute
+
+type DiskMetricer interface {
+	RecordGameDiskUsage(usage map[common.Address]metrics.GameDiskUsage)
+}
+
+// ResolvedGameRecord is the compact record of a resolved game kept after its data is removed.
+type ResolvedGameRecord struct {
+	Game      common.Address `json:"game"`
+	GameType  uint8          `json:"gameType"`
+	Timestamp uint64         `json:"timestamp"`
+	Status    string         `json:"status"`
+	// Bytes, Snapshots and Proofs are the disk usage of the game's data when it was removed.
+	Bytes     int64 `json:"bytes"`
+	Snapshots int   `json:"snapshots"`
+	Proofs    int   `json:"proofs"`
+	// PrunedAt is the unix time the game's data was removed.
+	PrunedAt uint64 `json:"prunedAt"`
+}
+
 // diskManager coordinates the storage of game data on disk.
 type diskManager struct {
+	logger  log.Logger
+	metrics DiskMetricer
+	clock   clock.Clock
 	datadir string
+	// maxBytes is the disk usage budget for all game data. Zero means unlimited.
+	maxBytes int64
+
+	lastMeasured time.Time
+}
+
+func newDiskManager(logger log.Logger, m DiskMetricer, cl clock.Clock, dir string) *diskManager {
+	return &diskManager{
+		logger:  logger,
+		metrics: m,
+		clock:   cl,
+		datadir: dir,
+	}
 }
 
-func newDiskManager(dir string) *diskManager {
-	return &diskManager{datadir: dir}
+// WithMaxUsage sets the budget for the disk space used by all game data, in bytes. Zero means unlimited.
+func (d *diskManager) WithMaxUsage(maxBytes int64) *diskManager {
+	d.maxBytes = maxBytes
+	return d
 }
 
 func (d *diskManager) DirForGame(addr common.Address) string {
 	return filepath.Join(d.datadir, gameDirPrefix+addr.Hex())
 }
 
-func (d *diskManager) RemoveAllExcept(keep []common.Address) error {
+func (d *diskManager) Prune(games []scheduler.GameDisk) error {
+	var keep []common.Address
+	var errs []error
+	for _, game := range games {
+		if game.Status == types.GameStatusInProgress || game.InUse {
+			keep = append(keep, game.Game.Proxy)
+			continue
+		}
+		errs = append(errs, d.recordResolved(game))
+	}
+	errs = append(errs, d.removeAllExcept(keep))
+	if usage := d.measureDiskUsage(keep); usage != nil {
+		if d.maxBytes > 0 {
+			errs = append(errs, d.evictOldest(games, usage))
+		}
+		d.metrics.RecordGameDiskUsage(usage)
+	}
+	return errors.Join(errs...)
+}
+
+// recordResolved appends a record of the resolved game to the resolved games file if it has data to be removed.
+func (d *diskManager) recordResolved(game scheduler.GameDisk) error {
+	dir := d.DirForGame(game.Game.Proxy)
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		// No data to remove, either because it already has been or because the game was never played.
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to check data of game %v: %w", game.Game.Proxy, err)
+	}
+	usage, err := cannon.MeasureDiskUsage(dir)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(ResolvedGameRecord{
+		Game:      game.Game.Proxy,
+		GameType:  game.Game.GameType,
+		Timestamp: game.Game.Timestamp,
+		Status:    game.Status.String(),
+		Bytes:     usage.Bytes,
+		Snapshots: usage.Snapshots,
+		Proofs:    usage.Proofs,
+		PrunedAt:  uint64(d.clock.Now().Unix()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode record of game %v: %w", game.Game.Proxy, err)
+	}
+	f, err := os.OpenFile(filepath.Join(d.datadir, resolvedGamesFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open resolved games file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to record resolved game %v: %w", game.Game.Proxy, err)
+	}
+	return nil
+}
+
+// evictOldest removes the data of the oldest in-progress games that are not in use until the total disk usage is
+// within budget. The data is regenerated if it is needed again. Evicted games are removed from usage.
+func (d *diskManager) evictOldest(games []scheduler.GameDisk, usage map[common.Address]metrics.GameDiskUsage) error {
+	var total int64
+	for _, gameUsage := range usage {
+		total += gameUsage.Bytes
+	}
+	if total <= d.maxBytes {
+		return nil
+	}
+	var candidates []scheduler.GameDisk
+	for _, game := range games {
+		if game.Status == types.GameStatusInProgress && !game.InUse && usage[game.Game.Proxy].Bytes > 0 {
+			candidates = append(candidates, game)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Game.Timestamp < candidates[j].Game.Timestamp
+	})
+	var errs []error
+	for _, game := range candidates {
+		if total <= d.maxBytes {
+			break
+		}
+		addr := game.Game.Proxy
+		if err := os.RemoveAll(d.DirForGame(addr)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to evict data of game %v: %w", addr, err))
+			continue
+		}
+		d.logger.Warn("Evicted game data to stay within disk usage budget", "game", addr, "bytes", usage[addr].Bytes)
+		total -= usage[addr].Bytes
+		usage[addr] = metrics.GameDiskUsage{}
+	}
+	if total > d.maxBytes {
+		d.logger.Warn("Game data exceeds disk usage budget", "bytes", total, "budget", d.maxBytes)
+	}
+	return errors.Join(errs...)
+}
+
+func (d *diskManager) removeAllExcept(keep []common.Address) error {
 	entries, err := os.ReadDir(d.datadir)
 	if err != nil {
 		return fmt.Errorf("failed to list directory: %w", err)
@@ -53,3 +203,21 @@ func (d *diskManager) RemoveAllExcept(keep []common.Address) error {
 	}
 	return errors.Join(errs...)
 }
+
+// measureDiskUsage returns the disk usage of each game in keep, or nil if it has been measured recently.
+func (d *diskManager) measureDiskUsage(keep []common.Address) map[common.Address]metrics.GameDiskUsage {
+	now := d.clock.Now()
+	if now.Sub(d.lastMeasured) < diskUsageInterval {
+		return nil
+	}
+	d.lastMeasured = now
+	usage := make(map[common.Address]metrics.GameDiskUsage, len(keep))
+	for _, addr := range keep {
+		gameUsage, err := cannon.MeasureDiskUsage(d.DirForGame(addr))
+		if err != nil {
+			d.logger.Warn("Unable to measure game disk usage", "game", addr, "err", err)
+		}
+		usage[addr] = gameUsage
+	}
+	return usage
+}
@@ -1,27 +1,37 @@
 package game
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
 func TestDiskManager_DirForGame(t *testing.T) {
 	baseDir := t.TempDir()
 	addr := common.Address{0x53}
-	disk := newDiskManager(baseDir)
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), metrics.NoopMetrics, clock.SystemClock, baseDir)
 	result := disk.DirForGame(addr)
 	require.Equal(t, filepath.Join(baseDir, gameDirPrefix+addr.Hex()), result)
 }
 
-func TestDiskManager_RemoveAllExcept(t *testing.T) {
+func TestDiskManager_Prune(t *testing.T) {
 	baseDir := t.TempDir()
 	keep := common.Address{0x53}
 	delete := common.Address{0xaa}
-	disk := newDiskManager(baseDir)
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), metrics.NoopMetrics, clock.SystemClock, baseDir)
 	keepDir := disk.DirForGame(keep)
 	deleteDir := disk.DirForGame(delete)
 
@@ -46,7 +56,7 @@ func TestDiskManager_RemoveAllExcept(t *testing.T) {
 	keepFiles := populateDir(keepDir)
 	populateDir(deleteDir)
 
-	require.NoError(t, disk.RemoveAllExcept([]common.Address{keep}))
+	require.NoError(t, disk.Prune(inProgress(keep)))
 	require.NoDirExists(t, deleteDir, "should have deleted directory")
 	for _, file := range keepFiles {
 		require.FileExists(t, file, "should have kept file for active game")
@@ -55,3 +65,144 @@ func TestDiskManager_RemoveAllExcept(t *testing.T) {
 	require.DirExists(t, unexpectedDir, "should not delete unexpected dir")
 	require.DirExists(t, invalidHexDir, "should not delete dir with invalid address")
 }
+
+func TestDiskManager_RecordDiskUsage(t *testing.T) {
+	baseDir := t.TempDir()
+	game1 := common.Address{0x53}
+	game2 := common.Address{0xaa}
+	m := &diskUsageMetrics{}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), m, cl, baseDir)
+	writeFile := func(path string, size int) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0777))
+		require.NoError(t, os.WriteFile(path, make([]byte, size), 0644))
+	}
+	writeFile(filepath.Join(disk.DirForGame(game1), "snapshots", "100.json.gz"), 10)
+	writeFile(filepath.Join(disk.DirForGame(game1), "proofs", "5.json.gz"), 20)
+
+	require.NoError(t, disk.Prune(inProgress(game1, game2)))
+	require.Equal(t, map[common.Address]metrics.GameDiskUsage{
+		game1: {Bytes: 30, Snapshots: 1, Proofs: 1},
+		game2: {},
+	}, m.usage)
+	require.Equal(t, 1, m.calls)
+
+	// Usage is not measured again until the interval has passed
+	require.NoError(t, disk.Prune(inProgress(game1)))
+	require.Equal(t, 1, m.calls)
+
+	cl.AdvanceTime(diskUsageInterval)
+	require.NoError(t, disk.Prune(inProgress(game1)))
+	require.Equal(t, 2, m.calls)
+	require.Equal(t, map[common.Address]metrics.GameDiskUsage{
+		game1: {Bytes: 30, Snapshots: 1, Proofs: 1},
+	}, m.usage)
+}
+
+func TestDiskManager_RecordResolvedGames(t *testing.T) {
+	baseDir := t.TempDir()
+	resolved := types.GameMetadata{GameType: 1, Timestamp: 500, Proxy: common.Address{0x53}}
+	neverPlayed := types.GameMetadata{Proxy: common.Address{0x54}}
+	active := common.Address{0xaa}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), metrics.NoopMetrics, cl, baseDir)
+	writeTestFile(t, filepath.Join(disk.DirForGame(resolved.Proxy), "snapshots", "100.json.gz"), 10)
+	writeTestFile(t, filepath.Join(disk.DirForGame(resolved.Proxy), "proofs", "5.json.gz"), 20)
+	writeTestFile(t, filepath.Join(disk.DirForGame(active), "proofs", "5.json.gz"), 20)
+
+	games := append(inProgress(active),
+		scheduler.GameDisk{Game: resolved, Status: types.GameStatusChallengerWon},
+		scheduler.GameDisk{Game: neverPlayed, Status: types.GameStatusDefenderWon})
+	require.NoError(t, disk.Prune(games))
+	require.NoDirExists(t, disk.DirForGame(resolved.Proxy))
+	require.DirExists(t, disk.DirForGame(active))
+
+	// Pruning again should not record the game again as its data has already been removed.
+	require.NoError(t, disk.Prune(games))
+
+	data, err := os.ReadFile(filepath.Join(baseDir, resolvedGamesFile))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1)
+	var record ResolvedGameRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	require.Equal(t, ResolvedGameRecord{
+		Game:      resolved.Proxy,
+		GameType:  1,
+		Timestamp: 500,
+		Status:    types.GameStatusChallengerWon.String(),
+		Bytes:     30,
+		Snapshots: 1,
+		Proofs:    1,
+		PrunedAt:  1000,
+	}, record)
+}
+
+func TestDiskManager_KeepResolvedGameInUse(t *testing.T) {
+	baseDir := t.TempDir()
+	game := types.GameMetadata{Proxy: common.Address{0x53}}
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), metrics.NoopMetrics, clock.SystemClock, baseDir)
+	writeTestFile(t, filepath.Join(disk.DirForGame(game.Proxy), "proofs", "5.json.gz"), 20)
+
+	require.NoError(t, disk.Prune([]scheduler.GameDisk{{Game: game, Status: types.GameStatusDefenderWon, InUse: true}}))
+	require.DirExists(t, disk.DirForGame(game.Proxy))
+	require.NoFileExists(t, filepath.Join(baseDir, resolvedGamesFile))
+}
+
+func TestDiskManager_EvictOldestGames(t *testing.T) {
+	baseDir := t.TempDir()
+	newest := types.GameMetadata{Timestamp: 100, Proxy: common.Address{0x01}}
+	oldest := types.GameMetadata{Timestamp: 50, Proxy: common.Address{0x02}}
+	inUse := types.GameMetadata{Timestamp: 10, Proxy: common.Address{0x03}}
+	m := &diskUsageMetrics{}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	disk := newDiskManager(testlog.Logger(t, log.LvlInfo), m, cl, baseDir).WithMaxUsage(75)
+	writeTestFile(t, filepath.Join(disk.DirForGame(newest.Proxy), "snapshots", "100.json.gz"), 30)
+	writeTestFile(t, filepath.Join(disk.DirForGame(oldest.Proxy), "snapshots", "100.json.gz"), 20)
+	writeTestFile(t, filepath.Join(disk.DirForGame(inUse.Proxy), "snapshots", "100.json.gz"), 40)
+	games := []scheduler.GameDisk{
+		{Game: newest, Status: types.GameStatusInProgress},
+		{Game: oldest, Status: types.GameStatusInProgress},
+		{Game: inUse, Status: types.GameStatusInProgress, InUse: true},
+	}
+
+	require.NoError(t, disk.Prune(games))
+	require.NoDirExists(t, disk.DirForGame(oldest.Proxy), "should evict oldest game")
+	require.DirExists(t, disk.DirForGame(newest.Proxy), "should stop evicting once within budget")
+	require.DirExists(t, disk.DirForGame(inUse.Proxy), "should not evict game in use")
+	require.Equal(t, map[common.Address]metrics.GameDiskUsage{
+		newest.Proxy: {Bytes: 30, Snapshots: 1},
+		oldest.Proxy: {},
+		inUse.Proxy:  {Bytes: 40, Snapshots: 1},
+	}, m.usage)
+
+	// Games in use are never evicted, even if the budget is still exceeded.
+	cl.AdvanceTime(diskUsageInterval)
+	disk.WithMaxUsage(10)
+	require.NoError(t, disk.Prune(games))
+	require.NoDirExists(t, disk.DirForGame(newest.Proxy))
+	require.DirExists(t, disk.DirForGame(inUse.Proxy))
+}
+
+func inProgress(addrs ...common.Address) []scheduler.GameDisk {
+	games := make([]scheduler.GameDisk, len(addrs))
+	for i, addr := range addrs {
+		games[i] = scheduler.GameDisk{Game: types.GameMetadata{Proxy: addr}, Status: types.GameStatusInProgress}
+	}
+	return games
+}
+
+func writeTestFile(t *testing.T, path string, size int) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0777))
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0644))
+}
+
+type diskUsageMetrics struct {
+	calls int
+	usage map[common.Address]metrics.GameDiskUsage
+}
+
+func (d *diskUsageMetrics) RecordGameDiskUsage(usage map[common.Address]metrics.GameDiskUsage) {
+	d.calls++
+	d.usage = usage
+}
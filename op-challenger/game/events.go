@@ -0,0 +1,95 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// moveEventsResubscribeBackoff is the maximum time to wait before resubscribing after the move event subscription
+// fails. Games continue to be progressed on each new L1 head while the subscription is down.
+const moveEventsResubscribeBackoff = 10 * time.Second
+
+// LogSubscriber subscribes to logs matching a filter query, typically via a websocket connection to an L1 node.
+type LogSubscriber interface {
+	SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- ethTypes.Log) (ethereum.Subscription, error)
+}
+
+// WithMoveEvents enables progressing tracked games as soon as a Move log matching query is received from source,
+// rather than waiting for the next L1 head to be polled.
+func (m *gameMonitor) WithMoveEvents(source LogSubscriber, query ethereum.FilterQuery) *gameMonitor {
+	m.moveSource = source
+	m.moveQuery = query
+	return m
+}
+
+// trackGames records the games currently being played so move events for other games can be ignored.
+func (m *gameMonitor) trackGames(games []common.Address) {
+	m.trackedLock.Lock()
+	defer m.trackedLock.Unlock()
+	m.trackedGames = make(map[common.Address]bool, len(games))
+	for _, game := range games {
+		m.trackedGames[game] = true
+	}
+}
+
+func (m *gameMonitor) isTracked(game common.Address) bool {
+	m.trackedLock.Lock()
+	defer m.trackedLock.Unlock()
+	return m.trackedGames[game]
+}
+
+// onMoveEvent progresses games when a move is made in a tracked game. Only the first move in each block triggers an
+// update as all games are progressed together.
+func (m *gameMonitor) onMoveEvent(ctx context.Context, moveLog ethTypes.Log) {
+	if moveLog.Removed || !m.isTracked(moveLog.Address) || moveLog.BlockHash == m.lastMoveBlock {
+		return
+	}
+	m.lastMoveBlock = moveLog.BlockHash
+	m.logger.Debug("Progressing games after move event", "game", moveLog.Address, "block", moveLog.BlockNumber)
+	if err := m.progressGames(ctx, moveLog.BlockHash); err != nil {
+		m.logger.Error("Failed to progress games after move event", "err", err)
+	}
+}
+
+func (m *gameMonitor) resubscribeMoveEvents() event.ResubscribeErrFunc {
+	return func(ctx context.Context, err error) (event.Subscription, error) {
+		if err != nil {
+			m.logger.Warn("Move event subscription failed, relying on polling until resubscribed", "err", err)
+		}
+		logs := make(chan ethTypes.Log, 10)
+		sub, err := m.moveSource.SubscribeFilterLogs(ctx, m.moveQuery, logs)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			eventsCtx, eventsCancel := context.WithCancel(context.Background())
+			defer sub.Unsubscribe()
+			defer eventsCancel()
+
+			// Cancel any in-progress update as soon as the subscription is stopped.
+			go func() {
+				select {
+				case <-quit:
+					eventsCancel()
+				case <-eventsCtx.Done():
+				}
+			}()
+
+			for {
+				select {
+				case moveLog := <-logs:
+					m.onMoveEvent(eventsCtx, moveLog)
+				case err := <-sub.Err():
+					return err
+				case <-eventsCtx.Done():
+					return nil
+				}
+			}
+		}), nil
+	}
+}
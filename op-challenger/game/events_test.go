@@ -0,0 +1,116 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorOnMoveEvent(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	setup := func(t *testing.T) (*gameMonitor, *stubScheduler) {
+		monitor, source, sched, _ := setupMonitorTest(t, []common.Address{addr1})
+		monitor.WithMoveEvents(&mockLogSubscriber{}, ethereum.FilterQuery{})
+		source.games = []types.GameMetadata{newFDG(addr1, 9999), newFDG(addr2, 9999)}
+		require.NoError(t, monitor.progressGames(context.Background(), common.Hash{0x01}))
+		require.Len(t, sched.Scheduled(), 1)
+		return monitor, sched
+	}
+
+	t.Run("ProgressTrackedGame", func(t *testing.T) {
+		monitor, sched := setup(t)
+		monitor.onMoveEvent(context.Background(), ethtypes.Log{Address: addr1, BlockHash: common.Hash{0x02}})
+		require.Len(t, sched.Scheduled(), 2)
+		require.Equal(t, []common.Address{addr1}, sched.Scheduled()[1])
+	})
+
+	t.Run("IgnoreUntrackedGame", func(t *testing.T) {
+		monitor, sched := setup(t)
+		monitor.onMoveEvent(context.Background(), ethtypes.Log{Address: addr2, BlockHash: common.Hash{0x02}})
+		require.Len(t, sched.Scheduled(), 1)
+	})
+
+	t.Run("IgnoreRemovedLog", func(t *testing.T) {
+		monitor, sched := setup(t)
+		monitor.onMoveEvent(context.Background(), ethtypes.Log{Address: addr1, BlockHash: common.Hash{0x02}, Removed: true})
+		require.Len(t, sched.Scheduled(), 1)
+	})
+
+	t.Run("ProgressOncePerBlock", func(t *testing.T) {
+		monitor, sched := setup(t)
+		monitor.onMoveEvent(context.Background(), ethtypes.Log{Address: addr1, BlockHash: common.Hash{0x02}, Index: 0})
+		monitor.onMoveEvent(context.Background(), ethtypes.Log{Address: addr1, BlockHash: common.Hash{0x02}, Index: 1})
+		require.Len(t, sched.Scheduled(), 2)
+		monitor.onMoveEvent(context.Background(), ethtypes.Log{Address: addr1, BlockHash: common.Hash{0x03}})
+		require.Len(t, sched.Scheduled(), 3)
+	})
+}
+
+func TestMonitorMoveEventSubscription(t *testing.T) {
+	addr := common.Address{0xaa}
+	monitor, source, sched, _ := setupMonitorTest(t, []common.Address{})
+	logSource := &mockLogSubscriber{}
+	monitor.WithMoveEvents(logSource, ethereum.FilterQuery{})
+	source.games = []types.GameMetadata{newFDG(addr, 9999)}
+	monitor.trackGames([]common.Address{addr})
+
+	monitor.StartMonitoring()
+	defer monitor.StopMonitoring()
+
+	sub := waitForLogSub(t, logSource, nil)
+	sub.logs <- ethtypes.Log{Address: addr, BlockHash: common.Hash{0x01}}
+	require.Eventually(t, func() bool { return len(sched.Scheduled()) == 1 }, 10*time.Second, 10*time.Millisecond)
+
+	// Resubscribes after the subscription drops
+	sub.errChan <- errors.New("dropped")
+	sub = waitForLogSub(t, logSource, sub)
+	sub.logs <- ethtypes.Log{Address: addr, BlockHash: common.Hash{0x02}}
+	require.Eventually(t, func() bool { return len(sched.Scheduled()) == 2 }, 10*time.Second, 10*time.Millisecond)
+}
+
+func waitForLogSub(t *testing.T, source *mockLogSubscriber, prev *mockLogSubscription) *mockLogSubscription {
+	var sub *mockLogSubscription
+	require.Eventually(t, func() bool {
+		sub = source.Sub()
+		return sub != nil && sub != prev
+	}, 30*time.Second, 10*time.Millisecond)
+	return sub
+}
+
+type mockLogSubscriber struct {
+	sync.Mutex
+	sub *mockLogSubscription
+}
+
+func (m *mockLogSubscriber) Sub() *mockLogSubscription {
+	m.Lock()
+	defer m.Unlock()
+	return m.sub
+}
+
+func (m *mockLogSubscriber) SubscribeFilterLogs(_ context.Context, _ ethereum.FilterQuery, ch chan<- ethtypes.Log) (ethereum.Subscription, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.sub = &mockLogSubscription{errChan: make(chan error, 1), logs: ch}
+	return m.sub, nil
+}
+
+type mockLogSubscription struct {
+	errChan chan error
+	logs    chan<- ethtypes.Log
+}
+
+func (m *mockLogSubscription) Unsubscribe() {}
+
+func (m *mockLogSubscription) Err() <-chan error {
+	return m.errChan
+}
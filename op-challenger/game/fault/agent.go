@@ -5,22 +5,36 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/ethereum-optimism/optimism/op-challenger/game/fault")
+
+// maxPipelinedActions is the maximum number of actions for a game that may be waiting for their transactions to be
+// included at once.
+const maxPipelinedActions = 16
+
 // Responder takes a response action & executes.
 // For full op-challenger this means executing the transaction on chain.
 type Responder interface {
 	CallResolve(ctx context.Context) (gameTypes.GameStatus, error)
 	Resolve(ctx context.Context) error
 	CallResolveClaim(ctx context.Context, claimIdx uint64) error
-	ResolveClaim(ctx context.Context, claimIdx uint64) error
+	// ResolveClaims resolves the claims, returning the claims that were successfully resolved.
+	ResolveClaims(ctx context.Context, claimIdxs ...uint64) ([]uint64, error)
 	PerformAction(ctx context.Context, action types.Action) error
 }
 
@@ -28,50 +42,128 @@ type ClaimLoader interface {
 	GetAllClaims(ctx context.Context) ([]types.Claim, error)
 }
 
+type AssessmentRecorder interface {
+	SetAssessments(assessments []types.Assessment) error
+}
+
 type Agent struct {
-	metrics   metrics.Metricer
-	solver    *solver.GameSolver
-	loader    ClaimLoader
-	responder Responder
-	maxDepth  int
-	log       log.Logger
+	metrics       metrics.Metricer
+	solver        *solver.GameSolver
+	loader        ClaimLoader
+	responder     Responder
+	syncValidator SyncValidator
+	incidentMode  *IncidentMode
+	l1Head        eth.BlockID
+	maxDepth      int
+	log           log.Logger
+	// assessments records the solver's assessment of the game's claims. It is nil if assessments are not recorded.
+	assessments AssessmentRecorder
+
+	// diagnostic is true once the agent has detected it is losing the game at max depth. See checkMaxDepth.
+	diagnostic     bool
+	diagnosticsDir string
+	failedSteps    []FailedStep
+
+	// deadlineLock guards deadline, which is read from a different thread to the one acting on the game.
+	deadlineLock sync.Mutex
+	// deadline is the earliest chess clock deadline of the actions that remained unperformed after the last Act call.
+	// It is the zero time if there are no such actions or the deadline is unknown.
+	deadline time.Time
+
+	// forecastLock guards forecast, which is read from a different thread to the one acting on the game.
+	forecastLock sync.Mutex
+	// forecast is the forecast from the last time the game was evaluated. It is nil until the game is evaluated.
+	forecast *gameTypes.Forecast
+
+	// resolutionCheckInterval is the minimum time between checking whether the game can be resolved. Zero checks every
+	// time the agent acts. Only accessed from the thread acting on the game.
+	resolutionCheckInterval time.Duration
+	clock                   clock.Clock
+	lastResolutionCheck     time.Time
 }
 
-func NewAgent(m metrics.Metricer, loader ClaimLoader, maxDepth int, trace types.TraceAccessor, responder Responder, log log.Logger) *Agent {
+func NewAgent(m metrics.Metricer, loader ClaimLoader, maxDepth int, solver *solver.GameSolver, responder Responder, syncValidator SyncValidator, incidentMode *IncidentMode, l1Head eth.BlockID, log log.Logger) *Agent {
 	return &Agent{
-		metrics:   m,
-		solver:    solver.NewGameSolver(maxDepth, trace),
-		loader:    loader,
-		responder: responder,
-		maxDepth:  maxDepth,
-		log:       log,
+		metrics:       m,
+		solver:        solver,
+		loader:        loader,
+		responder:     responder,
+		syncValidator: syncValidator,
+		incidentMode:  incidentMode,
+		l1Head:        l1Head,
+		maxDepth:      maxDepth,
+		log:           log,
 	}
 }
 
+// WithAssessmentRecorder records the solver's assessment of each claim in the game every time the agent acts.
+func (a *Agent) WithAssessmentRecorder(recorder AssessmentRecorder) *Agent {
+	a.assessments = recorder
+	return a
+}
+
+// WithResolutionCheckInterval limits checking whether the game can be resolved to at most once per interval, rather
+// than every time the agent acts, to reduce the number of calls made to the L1 node.
+func (a *Agent) WithResolutionCheckInterval(cl clock.Clock, interval time.Duration) *Agent {
+	a.clock = cl
+	a.resolutionCheckInterval = interval
+	return a
+}
+
 // Act iterates the game & performs all of the next actions.
-func (a *Agent) Act(ctx context.Context) error {
-	if a.tryResolve(ctx) {
+func (a *Agent) Act(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "Agent.Act")
+	defer func() { tracing.EndSpan(span, err) }()
+	if a.resolutionCheckDue() && a.tryResolve(ctx) {
 		return nil
 	}
 	game, err := a.newGameFromContracts(ctx)
 	if err != nil {
 		return fmt.Errorf("create game from contracts: %w", err)
 	}
+	a.recordAssessments(ctx, game)
+	a.recordForecast(ctx, game)
 
 	// Calculate the actions to take
+	solveStart := time.Now()
 	actions, err := a.solver.CalculateNextActions(ctx, game)
+	a.metrics.RecordSolveTime(time.Since(solveStart).Seconds())
 	if err != nil {
 		log.Error("Failed to calculate all required moves", "err", err)
 	}
 
-	// Perform the actions
+	// Track the deadline of any actions that aren't performed so the game can be prioritised next time.
+	var unperformed []types.Action
+	defer func() { a.recordDeadline(game, unperformed) }()
+
+	// The actions were calculated from the local node's view of the chain. If it has fallen out of sync since,
+	// the actions may be based on invalid data. Drop them so they are recalculated once it recovers.
+	if len(actions) == 0 {
+		a.checkMaxDepth(ctx, game)
+		return nil
+	}
+	if err := a.syncValidator.ValidateNodeSynced(ctx, a.l1Head); err != nil {
+		unperformed = append(unperformed, actions...)
+		return fmt.Errorf("dropping %v unsent actions: %w", len(actions), err)
+	}
+
+	// Perform the actions concurrently so that each action's transaction is sent without waiting for the previous
+	// one to be included. The number in flight at once is limited to maxPipelinedActions.
+	var wg sync.WaitGroup
+	var resultsLock sync.Mutex
+	inFlight := make(chan struct{}, maxPipelinedActions)
 	for _, action := range actions {
+		action := action
 		log := a.log.New("action", action.Type, "is_attack", action.IsAttack, "parent", action.ParentIdx)
 		if action.Type == types.ActionTypeStep {
 			log = log.New("prestate", common.Bytes2Hex(action.PreState), "proof", common.Bytes2Hex(action.ProofData))
 		} else {
 			log = log.New("value", action.Value)
 		}
+		if a.incidentMode.Enabled() && isOffensive(action) {
+			log.Warn("Incident mode enabled, not performing offensive action")
+			continue
+		}
 
 		switch action.Type {
 		case types.ActionTypeMove:
@@ -80,16 +172,120 @@ func (a *Agent) Act(ctx context.Context) error {
 			a.metrics.RecordGameStep()
 		}
 		log.Info("Performing action")
-		err := a.responder.PerformAction(ctx, action)
-		if err != nil {
-			log.Error("Action failed", "err", err)
-		}
+		inFlight <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-inFlight }()
+			actionCtx, span := tracer.Start(ctx, "Agent.PerformAction", trace.WithAttributes(
+				attribute.String("action", action.Type.String()),
+				attribute.Bool("is_attack", action.IsAttack),
+				attribute.Int("parent", action.ParentIdx)))
+			err := a.responder.PerformAction(actionCtx, action)
+			tracing.EndSpan(span, err)
+			resultsLock.Lock()
+			defer resultsLock.Unlock()
+			a.metrics.RecordGameActionResult(action.Type.String(), err == nil)
+			if err != nil {
+				log.Error("Action failed", "err", err)
+				unperformed = append(unperformed, action)
+				if action.Type == types.ActionTypeStep {
+					a.recordFailedStep(action, err)
+				}
+			}
+		}()
 	}
+	wg.Wait()
+	a.checkMaxDepth(ctx, game)
 	return nil
 }
 
+// recordAssessments records the solver's assessment of each claim, if enabled.
+// Assessments are informational only so failures are logged rather than preventing the agent acting.
+func (a *Agent) recordAssessments(ctx context.Context, game types.Game) {
+	if a.assessments == nil {
+		return
+	}
+	assessments, err := a.solver.AssessClaims(ctx, game)
+	if err != nil {
+		a.log.Warn("Failed to assess claims", "err", err)
+		return
+	}
+	if err := a.assessments.SetAssessments(assessments); err != nil {
+		a.log.Warn("Failed to record claim assessments", "err", err)
+	}
+}
+
+// recordForecast records the status the game should resolve with and the status it would resolve with if no further
+// claims were posted. A warning is logged when the game starts heading toward an incorrect result.
+func (a *Agent) recordForecast(ctx context.Context, game types.Game) {
+	agreeWithRootClaim, err := a.solver.AgreeWithRootClaim(ctx, game)
+	if err != nil {
+		a.log.Warn("Failed to forecast game result", "err", err)
+		return
+	}
+	forecast := gameTypes.Forecast{
+		Expected:  expectedStatus(agreeWithRootClaim),
+		Projected: projectedStatus(game.Claims(), a.maxDepth),
+	}
+	a.forecastLock.Lock()
+	defer a.forecastLock.Unlock()
+	if forecast.Incorrect() && (a.forecast == nil || !a.forecast.Incorrect()) {
+		a.log.Warn("Game heading toward incorrect result", "expected", forecast.Expected, "projected", forecast.Projected)
+	} else if !forecast.Incorrect() && a.forecast != nil && a.forecast.Incorrect() {
+		a.log.Info("Game no longer heading toward incorrect result", "expected", forecast.Expected)
+	}
+	a.forecast = &forecast
+}
+
+// Forecast returns the forecast of the game's result from the last time it was evaluated.
+// Returns false if the game has not been evaluated.
+func (a *Agent) Forecast() (gameTypes.Forecast, bool) {
+	a.forecastLock.Lock()
+	defer a.forecastLock.Unlock()
+	if a.forecast == nil {
+		return gameTypes.Forecast{}, false
+	}
+	return *a.forecast, true
+}
+
+// ClockDeadline returns the earliest chess clock deadline of the actions that could not be performed by the last
+// call to Act. Returns false if all actions were performed or the deadline is unknown.
+func (a *Agent) ClockDeadline() (time.Time, bool) {
+	a.deadlineLock.Lock()
+	defer a.deadlineLock.Unlock()
+	return a.deadline, !a.deadline.IsZero()
+}
+
+func (a *Agent) recordDeadline(game types.Game, unperformed []types.Action) {
+	var earliest time.Time
+	for _, action := range unperformed {
+		deadline, ok := a.solver.ActionDeadline(game, action)
+		if ok && (earliest.IsZero() || deadline.Before(earliest)) {
+			earliest = deadline
+		}
+	}
+	a.deadlineLock.Lock()
+	defer a.deadlineLock.Unlock()
+	a.deadline = earliest
+}
+
 // tryResolve resolves the game if it is in a winning state
 // Returns true if the game is resolvable (regardless of whether it was actually resolved)
+// resolutionCheckDue returns true if the resolution check interval has elapsed since the game was last checked,
+// recording that it is being checked now.
+func (a *Agent) resolutionCheckDue() bool {
+	if a.resolutionCheckInterval == 0 {
+		return true
+	}
+	now := a.clock.Now()
+	if !a.lastResolutionCheck.IsZero() && now.Before(a.lastResolutionCheck.Add(a.resolutionCheckInterval)) {
+		return false
+	}
+	a.lastResolutionCheck = now
+	return true
+}
+
 func (a *Agent) tryResolve(ctx context.Context) bool {
 	if err := a.resolveClaims(ctx); err != nil {
 		a.log.Error("Failed to resolve claims", "err", err)
@@ -117,12 +313,12 @@ func (a *Agent) tryResolveClaims(ctx context.Context) error {
 		return errNoResolvableClaims
 	}
 
-	var resolvableClaims []int64
+	var resolvableClaims []uint64
 	for _, claim := range claims {
 		a.log.Debug("checking if claim is resolvable", "claimIdx", claim.ContractIndex)
 		if err := a.responder.CallResolveClaim(ctx, uint64(claim.ContractIndex)); err == nil {
 			a.log.Info("Resolving claim", "claimIdx", claim.ContractIndex)
-			resolvableClaims = append(resolvableClaims, int64(claim.ContractIndex))
+			resolvableClaims = append(resolvableClaims, uint64(claim.ContractIndex))
 		}
 	}
 	if len(resolvableClaims) == 0 {
@@ -130,19 +326,17 @@ func (a *Agent) tryResolveClaims(ctx context.Context) error {
 	}
 	a.log.Info("Resolving claims", "numClaims", len(resolvableClaims))
 
-	var wg sync.WaitGroup
-	wg.Add(len(resolvableClaims))
-	for _, claimIdx := range resolvableClaims {
-		claimIdx := claimIdx
-		go func() {
-			defer wg.Done()
-			err := a.responder.ResolveClaim(ctx, uint64(claimIdx))
-			if err != nil {
-				a.log.Error("Failed to resolve claim", "err", err)
-			}
-		}()
+	resolved, err := a.responder.ResolveClaims(ctx, resolvableClaims...)
+	for range resolved {
+		a.metrics.RecordClaimResolved()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to resolve claims: %w", err)
+	}
+	if len(resolved) == 0 {
+		// Stop rather than retrying claims that keep failing to resolve. They are retried on the next update.
+		return fmt.Errorf("failed to resolve any of %v claims", len(resolvableClaims))
 	}
-	wg.Wait()
 	return nil
 }
 
@@ -161,14 +355,20 @@ func (a *Agent) resolveClaims(ctx context.Context) error {
 }
 
 // newGameFromContracts initializes a new game state from the state in the contract
-func (a *Agent) newGameFromContracts(ctx context.Context) (types.Game, error) {
+func (a *Agent) newGameFromContracts(ctx context.Context) (game types.Game, err error) {
+	ctx, span := tracer.Start(ctx, "Agent.LoadGame")
+	defer func() { tracing.EndSpan(span, err) }()
 	claims, err := a.loader.GetAllClaims(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch claims: %w", err)
 	}
+	span.SetAttributes(attribute.Int("claims", len(claims)))
 	if len(claims) == 0 {
 		return nil, errors.New("no claims")
 	}
-	game := types.NewGameState(claims, uint64(a.maxDepth))
+	game, err = types.NewValidatedGameState(claims, uint64(a.maxDepth))
+	if err != nil {
+		return nil, fmt.Errorf("invalid game state: %w", err)
+	}
 	return game, nil
 }
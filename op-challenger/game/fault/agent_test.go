@@ -2,19 +2,28 @@ package fault
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
@@ -70,16 +79,349 @@ func TestLoadClaimsWhenGameNotResolvable(t *testing.T) {
 	require.Zero(t, responder.resolveClaimCount, "should not send resolveClaim")
 }
 
+func TestResolutionCheckInterval(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	agent.WithResolutionCheckInterval(cl, time.Minute)
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcdefg", uint64(depth)))
+	claimLoader.claims = []types.Claim{
+		claimBuilder.CreateRootClaim(true),
+	}
+
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, 1, responder.callResolveCount, "should check resolution on first act")
+
+	cl.AdvanceTime(59 * time.Second)
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, 1, responder.callResolveCount, "should not check resolution before interval elapses")
+	require.EqualValues(t, 3, claimLoader.callCount, "should still load claims to respond to")
+
+	cl.AdvanceTime(time.Second)
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, 2, responder.callResolveCount, "should check resolution once interval elapses")
+}
+
+func TestResolveClaimsTogether(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	responder.callResolveErr = errors.New("game is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	root := claimBuilder.CreateRootClaim(true)
+	attack := claimBuilder.AttackClaim(root, false)
+	attack.ContractIndex = 1
+	claimLoader.claims = []types.Claim{root, attack}
+
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, [][]uint64{{0, 1}}, responder.resolvedClaims, "should resolve all resolvable claims at once")
+}
+
+func TestRecordOnlySuccessfulClaimResolutions(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	m := &stubActionMetrics{}
+	agent.metrics = m
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.unresolvableClaims = map[uint64]bool{1: true}
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	root := claimBuilder.CreateRootClaim(true)
+	attack := claimBuilder.AttackClaim(root, false)
+	attack.ContractIndex = 1
+	claimLoader.claims = []types.Claim{root, attack}
+
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, [][]uint64{{0, 1}}, responder.resolvedClaims)
+	require.Equal(t, 1, m.claimsResolved)
+}
+
+func TestPerformActionsWithoutWaiting(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	root := claimBuilder.CreateRootClaim(false)
+	attack1 := claimBuilder.AttackClaim(root, true)
+	attack1.ContractIndex = 1
+	attack2 := claimBuilder.DefendClaim(attack1, false)
+	attack2.ContractIndex = 2
+	attack2.ParentContractIndex = 1
+	attack3 := claimBuilder.AttackClaim(attack1, false)
+	attack3.ContractIndex = 3
+	attack3.ParentContractIndex = 1
+	claimLoader.claims = []types.Claim{root, attack1, attack2, attack3}
+
+	// Each action blocks until all actions have been started, so only completes if they are performed concurrently.
+	var started sync.WaitGroup
+	started.Add(2)
+	responder.performActionWait = func() {
+		started.Done()
+		started.Wait()
+	}
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, 2, responder.performActionCount)
+}
+
+func TestDropUnsentActionsWhenNodeOutOfSync(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	claimLoader.claims = []types.Claim{
+		claimBuilder.CreateRootClaim(false),
+	}
+
+	agent.syncValidator = &stubSyncValidator{result: ErrNotInSync}
+	require.ErrorIs(t, agent.Act(context.Background()), ErrNotInSync)
+	require.Zero(t, responder.performActionCount, "should not send actions when node is out of sync")
+
+	agent.syncValidator = &stubSyncValidator{}
+	require.NoError(t, agent.Act(context.Background()))
+	require.Equal(t, 1, responder.performActionCount, "should counter root claim")
+}
+
+func TestRecordActionResults(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	m := &stubActionMetrics{}
+	agent.metrics = m
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	claimLoader.claims = []types.Claim{
+		claimBuilder.CreateRootClaim(false),
+	}
+
+	require.NoError(t, agent.Act(context.Background()))
+	responder.performActionErr = errors.New("boom")
+	require.NoError(t, agent.Act(context.Background()))
+
+	require.Equal(t, []string{"move:true", "move:false"}, m.actionResults)
+	require.Equal(t, 2, m.solves)
+}
+
+func TestIncidentModeFreezesOffensiveActions(t *testing.T) {
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	root := claimBuilder.CreateRootClaim(false)
+	ourClaim := claimBuilder.AttackClaim(root, true)
+	ourClaim.ContractIndex = 1
+	opponentClaim := claimBuilder.AttackClaim(ourClaim, false)
+	opponentClaim.ContractIndex = 2
+
+	t.Run("OffensiveActionSkipped", func(t *testing.T) {
+		agent, claimLoader, responder := setupTestAgent(t)
+		responder.callResolveErr = errors.New("game is not resolvable")
+		responder.callResolveClaimErr = errors.New("claim is not resolvable")
+		claimLoader.claims = []types.Claim{root}
+		agent.incidentMode.SetEnabled(true)
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.Zero(t, responder.performActionCount, "should not challenge root claim")
+
+		agent.incidentMode.SetEnabled(false)
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, responder.performActionCount, "should challenge root claim once incident mode is disabled")
+	})
+
+	t.Run("DefensiveActionPerformed", func(t *testing.T) {
+		agent, claimLoader, responder := setupTestAgent(t)
+		responder.callResolveErr = errors.New("game is not resolvable")
+		responder.callResolveClaimErr = errors.New("claim is not resolvable")
+		claimLoader.claims = []types.Claim{root, ourClaim, opponentClaim}
+		agent.incidentMode.SetEnabled(true)
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, responder.performActionCount, "should counter claim disputing our claim")
+	})
+}
+
+func TestRecordAssessments(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	root := claimBuilder.CreateRootClaim(false)
+	ourClaim := claimBuilder.AttackClaim(root, true)
+	ourClaim.ContractIndex = 1
+	claimLoader.claims = []types.Claim{root, ourClaim}
+
+	// Assessments are not recorded unless enabled
+	require.NoError(t, agent.Act(context.Background()))
+
+	recorder := &stubAssessmentRecorder{}
+	agent.WithAssessmentRecorder(recorder)
+	require.NoError(t, agent.Act(context.Background()))
+	// The root claim is countered by our uncountered claim.
+	require.Equal(t, []types.Assessment{types.AssessmentCountered, types.AssessmentAgree}, recorder.assessments)
+}
+
+func TestRecordForecast(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t)
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	root := claimBuilder.CreateRootClaim(false)
+
+	_, ok := agent.Forecast()
+	require.False(t, ok, "no forecast before game is evaluated")
+
+	// Invalid root claim is unchallenged so the defender would win
+	claimLoader.claims = []types.Claim{root}
+	require.NoError(t, agent.Act(context.Background()))
+	forecast, ok := agent.Forecast()
+	require.True(t, ok)
+	require.Equal(t, gameTypes.Forecast{Expected: gameTypes.GameStatusChallengerWon, Projected: gameTypes.GameStatusDefenderWon}, forecast)
+	require.True(t, forecast.Incorrect())
+
+	// Once the root claim is countered the challenger would win
+	ourClaim := claimBuilder.AttackClaim(root, true)
+	ourClaim.ContractIndex = 1
+	claimLoader.claims = []types.Claim{root, ourClaim}
+	require.NoError(t, agent.Act(context.Background()))
+	forecast, ok = agent.Forecast()
+	require.True(t, ok)
+	require.Equal(t, gameTypes.Forecast{Expected: gameTypes.GameStatusChallengerWon, Projected: gameTypes.GameStatusChallengerWon}, forecast)
+	require.False(t, forecast.Incorrect())
+}
+
+func TestDiagnosticModeWhenLosingAtMaxDepth(t *testing.T) {
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+
+	t.Run("HonestLeafCountered", func(t *testing.T) {
+		agent, claimLoader, responder := setupTestAgent(t)
+		responder.callResolveErr = errors.New("game is not resolvable")
+		responder.callResolveClaimErr = errors.New("claim is not resolvable")
+		m := &stubActionMetrics{}
+		agent.metrics = m
+		dir := t.TempDir()
+		agent.WithDiagnosticsDir(dir)
+		claimLoader.claims = honestPathToMaxDepth(claimBuilder, true)
+		claimLoader.claims[4].Countered = true
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.True(t, agent.diagnostic)
+		require.Equal(t, 1, m.losingGames)
+		diagnostics := readDiagnostics(t, dir)
+		require.Equal(t, []int{4}, diagnostics.HonestLeaves)
+		require.Equal(t, []int{4}, diagnostics.CounteredLeaves)
+		require.Len(t, diagnostics.Claims, 5)
+		require.Len(t, diagnostics.Assessments, 5)
+
+		// Diagnostics are refreshed on each subsequent action but the event is only emitted once
+		require.NoError(t, os.Remove(filepath.Join(dir, diagnosticsFile)))
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, m.losingGames)
+		readDiagnostics(t, dir)
+	})
+
+	t.Run("StepFailed", func(t *testing.T) {
+		agent, claimLoader, responder := setupTestAgent(t)
+		responder.callResolveErr = errors.New("game is not resolvable")
+		responder.callResolveClaimErr = errors.New("claim is not resolvable")
+		responder.performActionErr = errors.New("step reverted")
+		dir := t.TempDir()
+		agent.WithDiagnosticsDir(dir)
+		claimLoader.claims = honestPathToMaxDepth(claimBuilder, false)
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.True(t, agent.diagnostic)
+		diagnostics := readDiagnostics(t, dir)
+		require.Empty(t, diagnostics.HonestLeaves)
+		require.Len(t, diagnostics.FailedSteps, 1)
+		require.Equal(t, 4, diagnostics.FailedSteps[0].ParentIdx)
+		require.Equal(t, "step reverted", diagnostics.FailedSteps[0].Error)
+
+		// Repeated failures of the same step replace the earlier failure
+		require.NoError(t, agent.Act(context.Background()))
+		require.Len(t, readDiagnostics(t, dir).FailedSteps, 1)
+	})
+
+	t.Run("HonestLeafStands", func(t *testing.T) {
+		agent, claimLoader, responder := setupTestAgent(t)
+		responder.callResolveErr = errors.New("game is not resolvable")
+		responder.callResolveClaimErr = errors.New("claim is not resolvable")
+		dir := t.TempDir()
+		agent.WithDiagnosticsDir(dir)
+		claimLoader.claims = honestPathToMaxDepth(claimBuilder, true)
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.False(t, agent.diagnostic)
+		require.NoFileExists(t, filepath.Join(dir, diagnosticsFile))
+	})
+}
+
+// honestPathToMaxDepth builds a game where every claim on the solver's side is correct and every opposing claim is
+// incorrect, down to max depth. If rootCorrect is true, the final claim at max depth is the solver's.
+func honestPathToMaxDepth(claimBuilder *test.ClaimBuilder, rootCorrect bool) []types.Claim {
+	claims := []types.Claim{claimBuilder.CreateRootClaim(rootCorrect)}
+	for i := 1; i <= 4; i++ {
+		ours := (i%2 == 0) == rootCorrect
+		claim := claimBuilder.AttackClaim(claims[i-1], ours)
+		claim.ContractIndex = i
+		claims = append(claims, claim)
+	}
+	return claims
+}
+
+func readDiagnostics(t *testing.T, dir string) Diagnostics {
+	data, err := os.ReadFile(filepath.Join(dir, diagnosticsFile))
+	require.NoError(t, err)
+	var diagnostics Diagnostics
+	require.NoError(t, json.Unmarshal(data, &diagnostics))
+	return diagnostics
+}
+
 func setupTestAgent(t *testing.T) (*Agent, *stubClaimLoader, *stubResponder) {
 	logger := testlog.Logger(t, log.LvlInfo)
 	claimLoader := &stubClaimLoader{}
 	depth := 4
 	provider := alphabet.NewTraceProvider("abcd", uint64(depth))
 	responder := &stubResponder{}
-	agent := NewAgent(metrics.NoopMetrics, claimLoader, depth, trace.NewSimpleTraceAccessor(provider), responder, logger)
+	gameSolver := solver.NewGameSolver(logger, depth, trace.NewSimpleTraceAccessor(provider))
+	agent := NewAgent(metrics.NoopMetrics, claimLoader, depth, gameSolver, responder, noopSyncValidator{}, NewIncidentMode(false), eth.BlockID{}, logger)
 	return agent, claimLoader, responder
 }
 
+type stubActionMetrics struct {
+	metrics.NoopMetricsImpl
+	actionResults  []string
+	solves         int
+	losingGames    int
+	claimsResolved int
+}
+
+func (s *stubActionMetrics) RecordClaimResolved() {
+	s.claimsResolved++
+}
+
+func (s *stubActionMetrics) RecordGameLosingAtMaxDepth() {
+	s.losingGames++
+}
+
+func (s *stubActionMetrics) RecordGameActionResult(action string, landed bool) {
+	s.actionResults = append(s.actionResults, fmt.Sprintf("%v:%v", action, landed))
+}
+
+func (s *stubActionMetrics) RecordSolveTime(t float64) {
+	s.solves++
+}
+
+type stubAssessmentRecorder struct {
+	assessments []types.Assessment
+}
+
+func (s *stubAssessmentRecorder) SetAssessments(assessments []types.Assessment) error {
+	s.assessments = assessments
+	return nil
+}
+
 type stubClaimLoader struct {
 	callCount int
 	claims    []types.Claim
@@ -101,6 +443,18 @@ type stubResponder struct {
 	callResolveClaimCount int
 	callResolveClaimErr   error
 	resolveClaimCount     int
+	resolvedClaims        [][]uint64
+	// unresolvableClaims are claims that fail to resolve when sent.
+	unresolvableClaims map[uint64]bool
+
+	// performActionLock guards performActionCount as actions are performed concurrently.
+	performActionLock sync.Mutex
+	// performActionWait, if set, is called by each PerformAction call before it returns.
+	performActionWait func()
+
+	performActionCount int
+	performActionErr   error
+	actions            []types.Action
 }
 
 func (s *stubResponder) CallResolve(ctx context.Context) (gameTypes.GameStatus, error) {
@@ -118,11 +472,27 @@ func (s *stubResponder) CallResolveClaim(ctx context.Context, clainIdx uint64) e
 	return s.callResolveClaimErr
 }
 
-func (s *stubResponder) ResolveClaim(ctx context.Context, clainIdx uint64) error {
-	s.resolveClaimCount++
-	return nil
+func (s *stubResponder) ResolveClaims(ctx context.Context, claimIdxs ...uint64) ([]uint64, error) {
+	s.resolveClaimCount += len(claimIdxs)
+	s.resolvedClaims = append(s.resolvedClaims, claimIdxs)
+	// Claims are no longer resolvable once resolved.
+	s.callResolveClaimErr = errors.New("claim already resolved")
+	var resolved []uint64
+	for _, claimIdx := range claimIdxs {
+		if !s.unresolvableClaims[claimIdx] {
+			resolved = append(resolved, claimIdx)
+		}
+	}
+	return resolved, nil
 }
 
 func (s *stubResponder) PerformAction(ctx context.Context, response types.Action) error {
-	return nil
+	s.performActionLock.Lock()
+	s.performActionCount++
+	s.actions = append(s.actions, response)
+	s.performActionLock.Unlock()
+	if s.performActionWait != nil {
+		s.performActionWait()
+	}
+	return s.performActionErr
 }
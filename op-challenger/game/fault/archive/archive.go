@@ -0,0 +1,133 @@
+// Package archive uploads a record of each resolved game to object storage so that an audit trail is retained after
+// the challenger removes the game's local state.
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/transcript"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+)
+
+// Version is the version of the record schema produced by the Archiver.
+// It must be incremented whenever a change is made to the schema that is not backwards compatible.
+const Version = 1
+
+// Record is the archived record of a resolved game.
+type Record struct {
+	Version    int                    `json:"version"`
+	Transcript *transcript.Transcript `json:"transcript"`
+	// Assessments are the solver's last assessment of each claim, indexed by claim index.
+	Assessments []types.Assessment `json:"assessments,omitempty"`
+	// Moves are the moves the challenger made in the game.
+	Moves   []store.Move `json:"moves,omitempty"`
+	Summary Summary      `json:"summary"`
+}
+
+// Summary is a set of statistics about a resolved game.
+type Summary struct {
+	Resolution string `json:"resolution"`
+	Claims     int    `json:"claims"`
+	Countered  int    `json:"countered"`
+	// Agreed and Disagreed are the number of claims the solver last assessed as agreeing and disagreeing with the
+	// honest trace.
+	Agreed    int `json:"agreed"`
+	Disagreed int `json:"disagreed"`
+	// Moves and FailedMoves are the number of moves the challenger made, and of those the number that failed.
+	Moves       int `json:"moves"`
+	FailedMoves int `json:"failedMoves"`
+}
+
+// Archiver archives resolved games to an ObjectStore.
+type Archiver struct {
+	logger log.Logger
+	store  ObjectStore
+}
+
+func NewArchiver(logger log.Logger, store ObjectStore) *Archiver {
+	return &Archiver{
+		logger: logger,
+		store:  store,
+	}
+}
+
+// Archive uploads the record of the game at addr, loading its transcript from source and the challenger's
+// assessments and moves from game. The game may be nil if game state is not persisted.
+func (a *Archiver) Archive(ctx context.Context, addr common.Address, source transcript.GameSource, game *store.Game) error {
+	record, err := NewRecord(ctx, addr, source, game)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode archive record: %w", err)
+	}
+	key := Key(addr)
+	if err := a.store.Put(ctx, key, data); err != nil {
+		return err
+	}
+	a.logger.Info("Archived game", "game", addr, "key", key, "resolution", record.Summary.Resolution)
+	return nil
+}
+
+// Key returns the object key the record of the game at addr is archived to.
+func Key(addr common.Address) string {
+	return addr.Hex() + ".json"
+}
+
+// NewRecord creates the archive record of the game at addr.
+func NewRecord(ctx context.Context, addr common.Address, source transcript.GameSource, game *store.Game) (*Record, error) {
+	t, err := transcript.Export(ctx, addr, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export transcript: %w", err)
+	}
+	record := &Record{
+		Version:    Version,
+		Transcript: t,
+	}
+	if game != nil {
+		record.Assessments, err = game.Assessments()
+		if err != nil {
+			return nil, err
+		}
+		record.Moves, err = game.Moves()
+		if err != nil {
+			return nil, err
+		}
+	}
+	record.Summary = summarise(record)
+	return record, nil
+}
+
+func summarise(record *Record) Summary {
+	summary := Summary{
+		Resolution: record.Transcript.Resolution,
+		Claims:     len(record.Transcript.Claims),
+		Moves:      len(record.Moves),
+	}
+	for _, claim := range record.Transcript.Claims {
+		if claim.Countered {
+			summary.Countered++
+		}
+	}
+	for _, assessment := range record.Assessments {
+		switch assessment {
+		case types.AssessmentAgree:
+			summary.Agreed++
+		case types.AssessmentDisagree:
+			summary.Disagreed++
+		}
+	}
+	for _, move := range record.Moves {
+		if move.Status == store.MoveStatusFailed {
+			summary.FailedMoves++
+		}
+	}
+	return summary
+}
@@ -0,0 +1,155 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/kvstore"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+var gameAddr = common.Address{0xaa}
+
+func TestArchive(t *testing.T) {
+	source := newStubSource()
+	game := newTestGame(t)
+	require.NoError(t, game.SetAssessments([]types.Assessment{types.AssessmentDisagree, types.AssessmentAgree, types.AssessmentCountered}))
+	require.NoError(t, game.RecordMove(store.Move{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true, Value: common.Hash{0x02}, Status: store.MoveStatusConfirmed}))
+	require.NoError(t, game.RecordMove(store.Move{Type: types.ActionTypeStep, ParentIdx: 1, IsAttack: true, Status: store.MoveStatusFailed}))
+	objects := &stubObjectStore{}
+	archiver := NewArchiver(testlog.Logger(t, log.LvlInfo), objects)
+
+	require.NoError(t, archiver.Archive(context.Background(), gameAddr, source, game))
+
+	data, ok := objects.objects[Key(gameAddr)]
+	require.True(t, ok, "should upload record")
+	var record Record
+	require.NoError(t, json.Unmarshal(data, &record))
+	require.Equal(t, Version, record.Version)
+	require.Equal(t, gameAddr, record.Transcript.Game)
+	require.Len(t, record.Transcript.Claims, 3)
+	require.Equal(t, []types.Assessment{types.AssessmentDisagree, types.AssessmentAgree, types.AssessmentCountered}, record.Assessments)
+	require.Len(t, record.Moves, 2)
+	require.Equal(t, Summary{
+		Resolution:  gameTypes.GameStatusChallengerWon.String(),
+		Claims:      3,
+		Countered:   1,
+		Agreed:      1,
+		Disagreed:   1,
+		Moves:       2,
+		FailedMoves: 1,
+	}, record.Summary)
+}
+
+func TestArchiveWithoutStoredState(t *testing.T) {
+	objects := &stubObjectStore{}
+	archiver := NewArchiver(testlog.Logger(t, log.LvlInfo), objects)
+
+	require.NoError(t, archiver.Archive(context.Background(), gameAddr, newStubSource(), nil))
+
+	var record Record
+	require.NoError(t, json.Unmarshal(objects.objects[Key(gameAddr)], &record))
+	require.Nil(t, record.Assessments)
+	require.Nil(t, record.Moves)
+	require.Equal(t, 3, record.Summary.Claims)
+}
+
+func TestArchiveErrors(t *testing.T) {
+	t.Run("ExportFails", func(t *testing.T) {
+		source := newStubSource()
+		source.err = errors.New("boom")
+		objects := &stubObjectStore{}
+		archiver := NewArchiver(testlog.Logger(t, log.LvlInfo), objects)
+		require.ErrorIs(t, archiver.Archive(context.Background(), gameAddr, source, nil), source.err)
+		require.Empty(t, objects.objects)
+	})
+
+	t.Run("UploadFails", func(t *testing.T) {
+		objects := &stubObjectStore{err: errors.New("boom")}
+		archiver := NewArchiver(testlog.Logger(t, log.LvlInfo), objects)
+		require.ErrorIs(t, archiver.Archive(context.Background(), gameAddr, newStubSource(), nil), objects.err)
+	})
+}
+
+func newTestGame(t *testing.T) *store.Game {
+	kv, err := kvstore.OpenInMemory(testlog.Logger(t, log.LvlInfo), kvstore.NoopMetrics)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, kv.Close())
+	})
+	s, err := store.NewStore(kv)
+	require.NoError(t, err)
+	return s.Game(gameAddr)
+}
+
+type stubObjectStore struct {
+	err     error
+	objects map[string][]byte
+}
+
+func (s *stubObjectStore) Put(_ context.Context, key string, data []byte) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.objects == nil {
+		s.objects = make(map[string][]byte)
+	}
+	s.objects[key] = data
+	return nil
+}
+
+type stubSource struct {
+	err    error
+	claims []types.Claim
+}
+
+func newStubSource() *stubSource {
+	root := types.Claim{
+		ClaimData: types.ClaimData{Value: common.Hash{0x01}, Position: types.NewPositionFromGIndex(big.NewInt(1))},
+		Countered: true,
+	}
+	attack := types.Claim{
+		ClaimData:     types.ClaimData{Value: common.Hash{0x02}, Position: root.Position.Attack()},
+		ContractIndex: 1,
+	}
+	counter := types.Claim{
+		ClaimData:           types.ClaimData{Value: common.Hash{0x03}, Position: attack.Position.Attack()},
+		ContractIndex:       2,
+		ParentContractIndex: 1,
+	}
+	return &stubSource{claims: []types.Claim{root, attack, counter}}
+}
+
+func (s *stubSource) GetGameType(_ context.Context) (uint8, error) {
+	return 0, s.err
+}
+
+func (s *stubSource) GetStatus(_ context.Context) (gameTypes.GameStatus, error) {
+	return gameTypes.GameStatusChallengerWon, s.err
+}
+
+func (s *stubSource) GetMaxGameDepth(_ context.Context) (uint64, error) {
+	return 4, s.err
+}
+
+func (s *stubSource) GetAbsolutePrestateHash(_ context.Context) (common.Hash, error) {
+	return common.Hash{0xcc}, s.err
+}
+
+func (s *stubSource) GetL1Head(_ context.Context) (common.Hash, error) {
+	return common.Hash{0xdd}, s.err
+}
+
+func (s *stubSource) GetAllClaims(_ context.Context) ([]types.Claim, error) {
+	return s.claims, s.err
+}
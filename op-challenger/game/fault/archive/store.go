@@ -0,0 +1,57 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// uploadTimeout is the maximum time allowed for a single object upload.
+const uploadTimeout = time.Minute
+
+// ObjectStore stores archived objects by key.
+type ObjectStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// HTTPObjectStore uploads objects with HTTP PUT requests to a base URL, as supported by S3 and GCS compatible object
+// storage. Requests are authenticated with a bearer token if one is set, otherwise the base URL must allow writes
+// without authentication, for example via a pre-authorised gateway.
+type HTTPObjectStore struct {
+	client    *http.Client
+	baseURL   string
+	authToken string
+}
+
+func NewHTTPObjectStore(baseURL string, authToken string) *HTTPObjectStore {
+	return &HTTPObjectStore{
+		client:    &http.Client{Timeout: uploadTimeout},
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		authToken: authToken,
+	}
+}
+
+func (s *HTTPObjectStore) Put(ctx context.Context, key string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.baseURL+"/"+key, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.authToken)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload %v: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to upload %v: %v: %s", key, resp.Status, body)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package archive
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPObjectStore(t *testing.T) {
+	t.Run("Put", func(t *testing.T) {
+		var method, path, auth, contentType string
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			path = r.URL.Path
+			auth = r.Header.Get("Authorization")
+			contentType = r.Header.Get("Content-Type")
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer server.Close()
+
+		s := NewHTTPObjectStore(server.URL+"/bucket/games/", "secret")
+		require.NoError(t, s.Put(context.Background(), "game.json", []byte(`{"a":1}`)))
+		require.Equal(t, http.MethodPut, method)
+		require.Equal(t, "/bucket/games/game.json", path)
+		require.Equal(t, "Bearer secret", auth)
+		require.Equal(t, "application/json", contentType)
+		require.Equal(t, []byte(`{"a":1}`), body)
+	})
+
+	t.Run("NoAuthToken", func(t *testing.T) {
+		var auth []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Values("Authorization")
+		}))
+		defer server.Close()
+
+		s := NewHTTPObjectStore(server.URL, "")
+		require.NoError(t, s.Put(context.Background(), "game.json", nil))
+		require.Empty(t, auth)
+	})
+
+	t.Run("ErrorStatus", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "access denied", http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		s := NewHTTPObjectStore(server.URL, "")
+		err := s.Put(context.Background(), "game.json", nil)
+		require.ErrorContains(t, err, "403 Forbidden")
+		require.ErrorContains(t, err, "access denied")
+	})
+}
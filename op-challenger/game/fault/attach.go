@@ -0,0 +1,161 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+)
+
+// errMoveEventsMismatch is returned when the Move events emitted by a game don't match its claims, for example because
+// the L1 node serving the events is behind the node serving the claims.
+var errMoveEventsMismatch = errors.New("move events do not match claims")
+
+// maxMoveLogRange is the maximum number of blocks to request Move events for in a single request.
+const maxMoveLogRange = 5000
+
+// MoveLogSource fetches the Move events emitted by games, typically from an L1 node.
+type MoveLogSource interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]ethTypes.Log, error)
+}
+
+// attachToGame reconstructs the state of a game that may already be many moves deep when the player is created, so
+// play resumes from the game's current state rather than relying on the challenger having followed it from the start.
+//
+// The claims are loaded from the contract, including the claims they counter and their clocks, and checked to form a
+// valid game. The claims posted by any of the ours addresses are then identified from the game's Move events, emitted
+// from fromBlock onward, and recorded as confirmed moves in record if it is not nil. Returns the contract indices of
+// the claims posted by ours.
+//
+// The solver decides which claims to support from the trace alone, so the game is still played honestly if the
+// claimants can't be identified.
+func attachToGame(
+	ctx context.Context,
+	logger log.Logger,
+	loader ClaimLoader,
+	moveLogs MoveLogSource,
+	addr common.Address,
+	fromBlock uint64,
+	maxDepth uint64,
+	ours []common.Address,
+	record *store.Game,
+) ([]int, error) {
+	claims, err := loader.GetAllClaims(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load claims: %w", err)
+	}
+	game, err := types.NewValidatedGameState(claims, maxDepth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game state: %w", err)
+	}
+	if len(claims) <= 1 {
+		// Only the root claim has been posted so there is nothing to reconstruct.
+		return nil, nil
+	}
+	countered := 0
+	deepest := 0
+	for _, claim := range claims {
+		if claim.Countered {
+			countered++
+		}
+		if claim.Depth() > deepest {
+			deepest = claim.Depth()
+		}
+	}
+	logger = logger.New("claims", len(claims), "countered", countered, "depth", deepest)
+	if moveLogs == nil || len(ours) == 0 {
+		logger.Info("Attached to game in progress")
+		return nil, nil
+	}
+
+	claimants, err := loadClaimants(ctx, moveLogs, addr, fromBlock, claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to identify claimants: %w", err)
+	}
+	isOurs := make(map[common.Address]bool, len(ours))
+	for _, addr := range ours {
+		isOurs[addr] = true
+	}
+	var ourClaims []int
+	for _, claim := range claims {
+		if claim.IsRoot() || !isOurs[claimants[claim.ContractIndex]] {
+			continue
+		}
+		ourClaims = append(ourClaims, claim.ContractIndex)
+		if record == nil {
+			continue
+		}
+		move := store.Move{
+			Type:      types.ActionTypeMove,
+			ParentIdx: claim.ParentContractIndex,
+			IsAttack:  !game.DefendsParent(claim),
+			Value:     claim.Value,
+			Status:    store.MoveStatusConfirmed,
+		}
+		if err := record.RecordMove(move); err != nil {
+			logger.Warn("Failed to record move", "parent", move.ParentIdx, "err", err)
+		}
+	}
+	logger.Info("Attached to game in progress", "ours", len(ourClaims))
+	return ourClaims, nil
+}
+
+// loadClaimants returns the address that posted each of claims, indexed by contract index, from the Move events
+// emitted by the game at addr from fromBlock onward. The root claim is posted when the game is created rather than by a
+// move, so its claimant is left as the zero address.
+// Events are requested at most maxMoveLogRange blocks at a time, stopping once there is an event for every claim.
+func loadClaimants(ctx context.Context, source MoveLogSource, addr common.Address, fromBlock uint64, claims []types.Claim) ([]common.Address, error) {
+	query, err := contracts.MoveEventQuery()
+	if err != nil {
+		return nil, err
+	}
+	query.Addresses = []common.Address{addr}
+	// The claims were loaded before the head, so their events are all at or before it.
+	head, err := source.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch head block number: %w", err)
+	}
+	var moveLogs []ethTypes.Log
+	for start := fromBlock; start <= head && len(moveLogs) < len(claims)-1; start += maxMoveLogRange {
+		end := min(start+maxMoveLogRange-1, head)
+		query.FromBlock = new(big.Int).SetUint64(start)
+		query.ToBlock = new(big.Int).SetUint64(end)
+		logs, err := source.FilterLogs(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load move events from block %v to %v: %w", start, end, err)
+		}
+		for _, log := range logs {
+			if !log.Removed {
+				moveLogs = append(moveLogs, log)
+			}
+		}
+	}
+	if len(moveLogs) < len(claims)-1 {
+		return nil, fmt.Errorf("%w: found %v move events for %v claims", errMoveEventsMismatch, len(moveLogs), len(claims)-1)
+	}
+	// Every claim after the root is posted by a move, which emits its Move event as the claim is added, so the events
+	// are in the same order as the claims. Any further events are for claims posted since the claims were loaded.
+	claimants := make([]common.Address, len(claims))
+	for i, moveLog := range moveLogs[:len(claims)-1] {
+		claim := claims[i+1]
+		event, err := contracts.DecodeMoveEvent(moveLog)
+		if err != nil {
+			return nil, err
+		}
+		if event.ParentIndex != uint64(claim.ParentContractIndex) || event.Claim != claim.Value {
+			return nil, fmt.Errorf("%w: move event %v does not match claim %v", errMoveEventsMismatch, i, claim.ContractIndex)
+		}
+		claimants[claim.ContractIndex] = event.Claimant
+	}
+	return claimants, nil
+}
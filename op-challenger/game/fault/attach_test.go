@@ -0,0 +1,270 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+const attachHeadBlock = 100
+
+var (
+	attachGameAddr = common.Address{0xaa}
+	ourSigner      = common.Address{0x01}
+	ourOtherSigner = common.Address{0x02}
+	opponent       = common.Address{0xff}
+)
+
+func TestAttachToGameInProgress(t *testing.T) {
+	maxDepth := 4
+	claimBuilder := test.NewAlphabetClaimBuilder(t, maxDepth)
+
+	tests := []struct {
+		name      string
+		setupGame func(builder *test.GameBuilder)
+		// claimants maps the contract index of each claim not posted by the opponent to its claimant.
+		claimants     map[int]common.Address
+		expectedOurs  []int
+		expectedMoves []store.Move
+	}{
+		{
+			name: "RootClaimOnly",
+			setupGame: func(builder *test.GameBuilder) {
+				builder.Seq().ExpectAttack()
+			},
+		},
+		{
+			name: "AfterOpponentCounters",
+			setupGame: func(builder *test.GameBuilder) {
+				builder.Seq().AttackCorrect().Attack(common.Hash{0xaa}).ExpectAttack()
+			},
+			claimants:    map[int]common.Address{1: ourSigner},
+			expectedOurs: []int{1},
+			expectedMoves: []store.Move{
+				{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true, Value: claimBuilder.CorrectClaimAtPosition(types.NewPosition(1, big.NewInt(0))), Status: store.MoveStatusConfirmed},
+			},
+		},
+		{
+			name: "AlreadyResponded",
+			setupGame: func(builder *test.GameBuilder) {
+				builder.Seq().AttackCorrect()
+			},
+			claimants:    map[int]common.Address{1: ourOtherSigner},
+			expectedOurs: []int{1},
+			expectedMoves: []store.Move{
+				{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true, Value: claimBuilder.CorrectClaimAtPosition(types.NewPosition(1, big.NewInt(0))), Status: store.MoveStatusConfirmed},
+			},
+		},
+		{
+			name: "AtMaxDepth",
+			setupGame: func(builder *test.GameBuilder) {
+				lastHonestClaim := builder.Seq().
+					AttackCorrect().
+					AttackCorrect().
+					DefendCorrect()
+				lastHonestClaim.Attack(common.Hash{0xdd}).ExpectStepAttack()
+			},
+			claimants:    map[int]common.Address{1: ourSigner, 3: ourOtherSigner},
+			expectedOurs: []int{1, 3},
+			expectedMoves: []store.Move{
+				{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true, Value: claimBuilder.CorrectClaimAtPosition(types.NewPosition(1, big.NewInt(0))), Status: store.MoveStatusConfirmed},
+				{Type: types.ActionTypeMove, ParentIdx: 2, IsAttack: false, Value: claimBuilder.CorrectClaimAtPosition(types.NewPosition(2, big.NewInt(0)).Defend()), Status: store.MoveStatusConfirmed},
+			},
+		},
+		{
+			name: "NoOwnClaims",
+			setupGame: func(builder *test.GameBuilder) {
+				// The opponent's claims don't support an honest path so only the root claim is countered.
+				root := builder.Seq()
+				root.Attack(common.Hash{0xaa}).Attack(common.Hash{0xbb})
+				root.ExpectAttack()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			builder := claimBuilder.GameBuilder(false)
+			test.setupGame(builder)
+			claims := builder.Game.Claims()
+			claimants := make([]common.Address, len(claims))
+			for i := 1; i < len(claims); i++ {
+				claimants[i] = opponent
+				if claimant, ok := test.claimants[i]; ok {
+					claimants[i] = claimant
+				}
+			}
+			logs := &stubMoveLogSource{head: attachHeadBlock, logs: moveLogs(t, claims, claimants)}
+			record := newTestGameStore(t).Game(attachGameAddr)
+			logger := testlog.Logger(t, log.LvlInfo)
+
+			ours, err := attachToGame(context.Background(), logger, &stubClaimLoader{claims: claims}, logs, attachGameAddr, 10, uint64(maxDepth), []common.Address{ourSigner, ourOtherSigner}, record)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedOurs, ours)
+			moves, err := record.Moves()
+			require.NoError(t, err)
+			require.ElementsMatch(t, test.expectedMoves, moves)
+
+			// Honest play resumes from the reconstructed state.
+			responder := &stubResponder{
+				callResolveErr:      errors.New("game is not resolvable"),
+				callResolveClaimErr: errors.New("claim is not resolvable"),
+			}
+			gameSolver := solver.NewGameSolver(logger, maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
+			agent := NewAgent(metrics.NoopMetrics, &stubClaimLoader{claims: claims}, maxDepth, gameSolver, responder, noopSyncValidator{}, NewIncidentMode(false), eth.BlockID{}, logger)
+			require.NoError(t, agent.Act(context.Background()))
+			require.ElementsMatch(t, builder.ExpectedActions, responder.actions)
+		})
+	}
+}
+
+func TestAttachToGameWithoutClaimants(t *testing.T) {
+	claimBuilder := test.NewAlphabetClaimBuilder(t, 4)
+	builder := claimBuilder.GameBuilder(false)
+	builder.Seq().AttackCorrect()
+	claims := builder.Game.Claims()
+	logger := testlog.Logger(t, log.LvlInfo)
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		logs := &stubMoveLogSource{head: attachHeadBlock}
+		ours, err := attachToGame(context.Background(), logger, &stubClaimLoader{claims: claims}, logs, attachGameAddr, 10, 4, nil, nil)
+		require.NoError(t, err)
+		require.Empty(t, ours)
+		require.Zero(t, logs.calls, "should not load move events")
+	})
+
+	t.Run("EventsUnavailable", func(t *testing.T) {
+		logs := &stubMoveLogSource{head: attachHeadBlock, err: errors.New("boom")}
+		_, err := attachToGame(context.Background(), logger, &stubClaimLoader{claims: claims}, logs, attachGameAddr, 10, 4, []common.Address{ourSigner}, nil)
+		require.ErrorIs(t, err, logs.err)
+	})
+
+	t.Run("InvalidClaims", func(t *testing.T) {
+		invalid := append([]types.Claim(nil), claims...)
+		invalid[1].Position = types.NewPosition(5, big.NewInt(0))
+		_, err := attachToGame(context.Background(), logger, &stubClaimLoader{claims: invalid}, &stubMoveLogSource{head: attachHeadBlock}, attachGameAddr, 10, 4, []common.Address{ourSigner}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestLoadClaimants(t *testing.T) {
+	claimBuilder := test.NewAlphabetClaimBuilder(t, 4)
+	builder := claimBuilder.GameBuilder(false)
+	builder.Seq().AttackCorrect().Attack(common.Hash{0xaa})
+	claims := builder.Game.Claims()
+	claimants := []common.Address{{}, ourSigner, opponent}
+
+	t.Run("FiltersGameEvents", func(t *testing.T) {
+		logs := &stubMoveLogSource{head: attachHeadBlock, logs: moveLogs(t, claims, claimants)}
+		actual, err := loadClaimants(context.Background(), logs, attachGameAddr, 10, claims)
+		require.NoError(t, err)
+		require.Equal(t, claimants, actual)
+		require.Equal(t, []common.Address{attachGameAddr}, logs.query.Addresses)
+		require.Equal(t, big.NewInt(10), logs.query.FromBlock)
+		require.Equal(t, new(big.Int).SetUint64(attachHeadBlock), logs.query.ToBlock)
+		require.Len(t, logs.query.Topics, 1)
+	})
+
+	t.Run("PagesBlockRange", func(t *testing.T) {
+		logs := &stubMoveLogSource{head: 10 + 3*maxMoveLogRange, logs: moveLogs(t, claims, claimants)}
+		logs.logs[1].BlockNumber = 10 + maxMoveLogRange + 1
+		actual, err := loadClaimants(context.Background(), logs, attachGameAddr, 10, claims)
+		require.NoError(t, err)
+		require.Equal(t, claimants, actual)
+		require.Equal(t, 2, logs.calls, "should stop once every claim has an event")
+		require.Equal(t, big.NewInt(10+maxMoveLogRange), logs.query.FromBlock)
+		require.Equal(t, big.NewInt(10+2*maxMoveLogRange-1), logs.query.ToBlock)
+	})
+
+	t.Run("IgnoreRemovedEvents", func(t *testing.T) {
+		logs := &stubMoveLogSource{head: attachHeadBlock, logs: moveLogs(t, claims, claimants)}
+		logs.logs[1].Removed = true
+		_, err := loadClaimants(context.Background(), logs, attachGameAddr, 10, claims)
+		require.ErrorIs(t, err, errMoveEventsMismatch)
+	})
+
+	t.Run("IgnoreEventsForNewClaims", func(t *testing.T) {
+		logs := &stubMoveLogSource{head: attachHeadBlock, logs: moveLogs(t, claims, claimants)}
+		actual, err := loadClaimants(context.Background(), logs, attachGameAddr, 10, claims[:2])
+		require.NoError(t, err)
+		require.Equal(t, claimants[:2], actual)
+	})
+
+	t.Run("MissingEvents", func(t *testing.T) {
+		logs := &stubMoveLogSource{head: attachHeadBlock, logs: moveLogs(t, claims, claimants)[:1]}
+		_, err := loadClaimants(context.Background(), logs, attachGameAddr, 10, claims)
+		require.ErrorIs(t, err, errMoveEventsMismatch)
+	})
+
+	t.Run("MismatchedEvents", func(t *testing.T) {
+		logs := &stubMoveLogSource{head: attachHeadBlock, logs: moveLogs(t, claims, claimants)}
+		logs.logs[1].Topics[2] = common.Hash{0xbb}
+		_, err := loadClaimants(context.Background(), logs, attachGameAddr, 10, claims)
+		require.ErrorIs(t, err, errMoveEventsMismatch)
+	})
+}
+
+// moveLogs returns the Move events emitted when each claim after the root was posted by its claimant.
+func moveLogs(t *testing.T, claims []types.Claim, claimants []common.Address) []ethTypes.Log {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	require.NoError(t, err)
+	logs := make([]ethTypes.Log, 0, len(claims)-1)
+	for _, claim := range claims[1:] {
+		logs = append(logs, ethTypes.Log{
+			Address:     attachGameAddr,
+			BlockNumber: 10,
+			Topics: []common.Hash{
+				fdgAbi.Events["Move"].ID,
+				common.BigToHash(big.NewInt(int64(claim.ParentContractIndex))),
+				claim.Value,
+				common.BytesToHash(claimants[claim.ContractIndex].Bytes()),
+			},
+		})
+	}
+	return logs
+}
+
+type stubMoveLogSource struct {
+	head  uint64
+	calls int
+	query ethereum.FilterQuery
+	logs  []ethTypes.Log
+	err   error
+}
+
+func (s *stubMoveLogSource) BlockNumber(_ context.Context) (uint64, error) {
+	return s.head, nil
+}
+
+func (s *stubMoveLogSource) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]ethTypes.Log, error) {
+	s.calls++
+	s.query = q
+	if s.err != nil {
+		return nil, s.err
+	}
+	var logs []ethTypes.Log
+	for _, log := range s.logs {
+		if log.BlockNumber >= q.FromBlock.Uint64() && log.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/bigint"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -23,6 +24,7 @@ const (
 	methodClaimCount         = "claimDataLen"
 	methodClaim              = "claimData"
 	methodL1Head             = "l1Head"
+	methodGameType           = "gameType"
 	methodResolve            = "resolve"
 	methodResolveClaim       = "resolveClaim"
 	methodAttack             = "attack"
@@ -90,7 +92,11 @@ func (f *disputeGameContract) GetMaxGameDepth(ctx context.Context) (uint64, erro
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch max game depth: %w", err)
 	}
-	return result.GetBigInt(0).Uint64(), nil
+	maxDepth, err := bigint.ToUint64(result.GetBigInt(0))
+	if err != nil {
+		return 0, fmt.Errorf("invalid max game depth: %w", err)
+	}
+	return maxDepth, nil
 }
 
 func (f *disputeGameContract) GetAbsolutePrestateHash(ctx context.Context) (common.Hash, error) {
@@ -116,6 +122,14 @@ func (f *disputeGameContract) GetL1Head(ctx context.Context) (common.Hash, error
 	return result.GetHash(0), nil
 }
 
+func (f *disputeGameContract) GetGameType(ctx context.Context) (uint8, error) {
+	result, err := f.multiCaller.SingleCall(ctx, batching.BlockLatest, f.contract.Call(methodGameType))
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch game type: %w", err)
+	}
+	return result.GetUint8(0), nil
+}
+
 func (f *disputeGameContract) GetStatus(ctx context.Context) (gameTypes.GameStatus, error) {
 	result, err := f.multiCaller.SingleCall(ctx, batching.BlockLatest, f.contract.Call(methodStatus))
 	if err != nil {
@@ -129,7 +143,11 @@ func (f *disputeGameContract) GetClaimCount(ctx context.Context) (uint64, error)
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch claim count: %w", err)
 	}
-	return result.GetBigInt(0).Uint64(), nil
+	count, err := bigint.ToUint64(result.GetBigInt(0))
+	if err != nil {
+		return 0, fmt.Errorf("invalid claim count: %w", err)
+	}
+	return count, nil
 }
 
 func (f *disputeGameContract) GetClaim(ctx context.Context, idx uint64) (types.Claim, error) {
@@ -137,7 +155,7 @@ func (f *disputeGameContract) GetClaim(ctx context.Context, idx uint64) (types.C
 	if err != nil {
 		return types.Claim{}, fmt.Errorf("failed to fetch claim %v: %w", idx, err)
 	}
-	return f.decodeClaim(result, int(idx)), nil
+	return f.decodeClaim(result, int(idx))
 }
 
 func (f *disputeGameContract) GetAllClaims(ctx context.Context) ([]types.Claim, error) {
@@ -146,9 +164,18 @@ func (f *disputeGameContract) GetAllClaims(ctx context.Context) ([]types.Claim,
 		return nil, fmt.Errorf("failed to load claim count: %w", err)
 	}
 
-	calls := make([]*batching.ContractCall, count)
+	indices := make([]uint64, count)
 	for i := uint64(0); i < count; i++ {
-		calls[i] = f.contract.Call(methodClaim, new(big.Int).SetUint64(i))
+		indices[i] = i
+	}
+	return f.GetClaims(ctx, indices...)
+}
+
+// GetClaims loads the claims at the specified indices, in the order given.
+func (f *disputeGameContract) GetClaims(ctx context.Context, indices ...uint64) ([]types.Claim, error) {
+	calls := make([]*batching.ContractCall, len(indices))
+	for i, idx := range indices {
+		calls[i] = f.contract.Call(methodClaim, new(big.Int).SetUint64(idx))
 	}
 
 	results, err := f.multiCaller.Call(ctx, batching.BlockLatest, calls...)
@@ -157,8 +184,12 @@ func (f *disputeGameContract) GetAllClaims(ctx context.Context) ([]types.Claim,
 	}
 
 	var claims []types.Claim
-	for idx, result := range results {
-		claims = append(claims, f.decodeClaim(result, idx))
+	for i, result := range results {
+		claim, err := f.decodeClaim(result, int(indices[i]))
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, claim)
 	}
 	return claims, nil
 }
@@ -179,6 +210,24 @@ func (f *disputeGameContract) vm(ctx context.Context) (*VMContract, error) {
 	return NewVMContract(vmAddr, f.multiCaller)
 }
 
+// GetOracle returns the preimage oracle used by the game's VM.
+func (f *disputeGameContract) GetOracle(ctx context.Context) (*PreimageOracleContract, error) {
+	vm, err := f.vm(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return vm.Oracle(ctx)
+}
+
+// GlobalDataExists returns true if the global preimage data has already been loaded into the game's preimage oracle.
+func (f *disputeGameContract) GlobalDataExists(ctx context.Context, data *types.PreimageOracleData) (bool, error) {
+	oracle, err := f.GetOracle(ctx)
+	if err != nil {
+		return false, err
+	}
+	return oracle.GlobalDataExists(ctx, data)
+}
+
 func (f *disputeGameContract) AttackTx(parentContractIndex uint64, pivot common.Hash) (txmgr.TxCandidate, error) {
 	call := f.contract.Call(methodAttack, new(big.Int).SetUint64(parentContractIndex), pivot)
 	return call.ToTxCandidate()
@@ -189,11 +238,26 @@ func (f *disputeGameContract) DefendTx(parentContractIndex uint64, pivot common.
 	return call.ToTxCandidate()
 }
 
+// CallStep determines if the step would succeed, returning a [RevertError] with the decoded revert reason if the
+// contract would reject it.
+func (f *disputeGameContract) CallStep(ctx context.Context, claimIdx uint64, isAttack bool, stateData []byte, proof []byte) error {
+	call := f.stepCall(claimIdx, isAttack, stateData, proof)
+	_, err := f.multiCaller.SingleCall(ctx, batching.BlockLatest, call)
+	if err != nil {
+		return fmt.Errorf("failed to call step: %w", decodeRevert(call.Abi, err))
+	}
+	return nil
+}
+
 func (f *disputeGameContract) StepTx(claimIdx uint64, isAttack bool, stateData []byte, proof []byte) (txmgr.TxCandidate, error) {
-	call := f.contract.Call(methodStep, new(big.Int).SetUint64(claimIdx), isAttack, stateData, proof)
+	call := f.stepCall(claimIdx, isAttack, stateData, proof)
 	return call.ToTxCandidate()
 }
 
+func (f *disputeGameContract) stepCall(claimIdx uint64, isAttack bool, stateData []byte, proof []byte) *batching.ContractCall {
+	return f.contract.Call(methodStep, new(big.Int).SetUint64(claimIdx), isAttack, stateData, proof)
+}
+
 func (f *disputeGameContract) CallResolveClaim(ctx context.Context, claimIdx uint64) error {
 	call := f.resolveClaimCall(claimIdx)
 	_, err := f.multiCaller.SingleCall(ctx, batching.BlockLatest, call)
@@ -230,20 +294,28 @@ func (f *disputeGameContract) resolveCall() *batching.ContractCall {
 	return f.contract.Call(methodResolve)
 }
 
-func (f *disputeGameContract) decodeClaim(result *batching.CallResult, contractIndex int) types.Claim {
+func (f *disputeGameContract) decodeClaim(result *batching.CallResult, contractIndex int) (types.Claim, error) {
 	parentIndex := result.GetUint32(0)
 	countered := result.GetBool(1)
 	claim := result.GetHash(2)
 	position := result.GetBigInt(3)
-	clock := result.GetBigInt(4)
+	if err := bigint.CheckUint128(position); err != nil || position.Sign() == 0 {
+		return types.Claim{}, fmt.Errorf("invalid position %v for claim %v", position, contractIndex)
+	}
+	// The clock packs the duration into the upper 64 bits and the timestamp into the lower 64 bits.
+	clockDuration, clockTimestamp, err := bigint.UnpackUint64Pair(result.GetBigInt(4))
+	if err != nil {
+		return types.Claim{}, fmt.Errorf("invalid clock for claim %v: %w", contractIndex, err)
+	}
 	return types.Claim{
 		ClaimData: types.ClaimData{
 			Value:    claim,
 			Position: types.NewPositionFromGIndex(position),
 		},
 		Countered:           countered,
-		Clock:               clock.Uint64(),
+		Clock:               clockTimestamp,
+		ClockDuration:       clockDuration,
 		ContractIndex:       contractIndex,
 		ParentContractIndex: int(parentIndex),
-	}
+	}, nil
 }
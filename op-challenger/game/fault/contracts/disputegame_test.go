@@ -8,6 +8,7 @@ import (
 
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/bigint"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
 	"github.com/ethereum/go-ethereum/common"
@@ -30,11 +31,13 @@ func runCommonDisputeGameTests(t *testing.T, setup disputeGameSetupFunc) {
 		{"SimpleGetters", runSimpleGettersTest},
 		{"GetClaim", runGetClaimTest},
 		{"GetAllClaims", runGetAllClaimsTest},
+		{"GetClaims", runGetClaimsTest},
 		{"CallResolveClaim", runCallResolveClaimTest},
 		{"ResolveClaimTx", runResolveClaimTxTest},
 		{"ResolveTx", runResolveTxTest},
 		{"AttackTx", runAttackTxTest},
 		{"DefendTx", runDefendTxTest},
+		{"CallStep", runCallStepTest},
 		{"StepTx", runStepTxTest},
 	}
 
@@ -123,6 +126,14 @@ func runSimpleGettersTest(t *testing.T, setup disputeGameSetupFunc) {
 				return game.GetL1Head(context.Background())
 			},
 		},
+		{
+			methodAlias: "gameType",
+			method:      func(game *disputeGameContract) string { return methodGameType },
+			result:      uint8(254),
+			call: func(game *disputeGameContract) (any, error) {
+				return game.GetGameType(context.Background())
+			},
+		},
 		{
 			methodAlias: "resolve",
 			method:      func(game *disputeGameContract) string { return methodResolve },
@@ -155,7 +166,8 @@ func runGetClaimTest(t *testing.T, setup disputeGameSetupFunc) {
 	countered := true
 	value := common.Hash{0xab}
 	position := big.NewInt(2)
-	clock := big.NewInt(1234)
+	// Duration of 56 seconds packed into the upper 64 bits, timestamp of 1234 in the lower 64 bits.
+	clock := bigint.PackUint64Pair(56, 1234)
 	stubRpc.SetResponse(fdgAddr, methodClaim, batching.BlockLatest, []interface{}{idx}, []interface{}{parentIndex, countered, value, position, clock})
 	status, err := game.GetClaim(context.Background(), idx.Uint64())
 	require.NoError(t, err)
@@ -166,9 +178,15 @@ func runGetClaimTest(t *testing.T, setup disputeGameSetupFunc) {
 		},
 		Countered:           true,
 		Clock:               1234,
+		ClockDuration:       56,
 		ContractIndex:       int(idx.Uint64()),
 		ParentContractIndex: 1,
 	}, status)
+
+	// Position zero is not a valid generalized index
+	stubRpc.SetResponse(fdgAddr, methodClaim, batching.BlockLatest, []interface{}{big.NewInt(3)}, []interface{}{parentIndex, countered, value, big.NewInt(0), clock})
+	_, err = game.GetClaim(context.Background(), 3)
+	require.ErrorContains(t, err, "invalid position")
 }
 
 func runGetAllClaimsTest(t *testing.T, setup disputeGameSetupFunc) {
@@ -190,6 +208,7 @@ func runGetAllClaimsTest(t *testing.T, setup disputeGameSetupFunc) {
 		},
 		Countered:           true,
 		Clock:               4455,
+		ClockDuration:       30,
 		ContractIndex:       1,
 		ParentContractIndex: 0,
 	}
@@ -213,6 +232,34 @@ func runGetAllClaimsTest(t *testing.T, setup disputeGameSetupFunc) {
 	require.Equal(t, expectedClaims, claims)
 }
 
+func runGetClaimsTest(t *testing.T, setup disputeGameSetupFunc) {
+	stubRpc, game := setup(t)
+	claim1 := faultTypes.Claim{
+		ClaimData: faultTypes.ClaimData{
+			Value:    common.Hash{0xab},
+			Position: faultTypes.NewPositionFromGIndex(big.NewInt(2)),
+		},
+		Clock:               4455,
+		ContractIndex:       1,
+		ParentContractIndex: 0,
+	}
+	claim3 := faultTypes.Claim{
+		ClaimData: faultTypes.ClaimData{
+			Value:    common.Hash{0xbb},
+			Position: faultTypes.NewPositionFromGIndex(big.NewInt(5)),
+		},
+		Countered:           true,
+		Clock:               7777,
+		ContractIndex:       3,
+		ParentContractIndex: 2,
+	}
+	expectGetClaim(stubRpc, claim1)
+	expectGetClaim(stubRpc, claim3)
+	claims, err := game.GetClaims(context.Background(), 3, 1)
+	require.NoError(t, err)
+	require.Equal(t, []faultTypes.Claim{claim3, claim1}, claims)
+}
+
 func runCallResolveClaimTest(t *testing.T, setup disputeGameSetupFunc) {
 	stubRpc, game := setup(t)
 	stubRpc.SetResponse(fdgAddr, methodResolveClaim, batching.BlockLatest, []interface{}{big.NewInt(123)}, nil)
@@ -254,6 +301,15 @@ func runDefendTxTest(t *testing.T, setup disputeGameSetupFunc) {
 	stubRpc.VerifyTxCandidate(tx)
 }
 
+func runCallStepTest(t *testing.T, setup disputeGameSetupFunc) {
+	stubRpc, game := setup(t)
+	stateData := []byte{1, 2, 3}
+	proofData := []byte{4, 5, 6, 7, 8, 9}
+	stubRpc.SetResponse(fdgAddr, methodStep, batching.BlockLatest, []interface{}{big.NewInt(111), true, stateData, proofData}, nil)
+	err := game.CallStep(context.Background(), 111, true, stateData, proofData)
+	require.NoError(t, err)
+}
+
 func runStepTxTest(t *testing.T, setup disputeGameSetupFunc) {
 	stubRpc, game := setup(t)
 	stateData := []byte{1, 2, 3}
@@ -275,6 +331,6 @@ func expectGetClaim(stubRpc *batchingTest.AbiBasedRpc, claim faultTypes.Claim) {
 			claim.Countered,
 			claim.Value,
 			claim.Position.ToGIndex(),
-			big.NewInt(int64(claim.Clock)),
+			bigint.PackUint64Pair(claim.ClockDuration, claim.Clock),
 		})
 }
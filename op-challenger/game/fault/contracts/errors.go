@@ -0,0 +1,70 @@
+package contracts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RevertError is returned when a contract call reverts. The revert reason is decoded using the contract's custom
+// errors where possible so that, for example, a rejected step reports ValidStep() rather than opaque revert data.
+type RevertError struct {
+	Reason string
+	err    error
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("execution reverted: %v", e.Reason)
+}
+
+func (e *RevertError) Unwrap() error {
+	return e.err
+}
+
+// decodeRevert converts err to a [RevertError] if it includes revert data from the RPC node.
+// Errors without revert data are returned unchanged.
+func decodeRevert(contractAbi *abi.ABI, err error) error {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil {
+		return err
+	}
+	return &RevertError{Reason: revertReason(contractAbi, data), err: err}
+}
+
+// revertReason decodes the revert data as either a standard Error(string) or Panic(uint256) revert, or one of the
+// custom errors defined in contractAbi. Unknown revert data is returned as hex.
+func revertReason(contractAbi *abi.ABI, data []byte) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if len(data) >= 4 {
+		for name, abiErr := range contractAbi.Errors {
+			if !bytes.Equal(abiErr.ID[:4], data[:4]) {
+				continue
+			}
+			args, err := abiErr.Inputs.Unpack(data[4:])
+			if err != nil {
+				break
+			}
+			formatted := make([]string, len(args))
+			for i, arg := range args {
+				formatted[i] = fmt.Sprintf("%v", arg)
+			}
+			return fmt.Sprintf("%v(%v)", name, strings.Join(formatted, ", "))
+		}
+	}
+	return hexutil.Encode(data)
+}
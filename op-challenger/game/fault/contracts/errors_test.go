@@ -0,0 +1,63 @@
+package contracts
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/stretchr/testify/require"
+)
+
+type stubDataError struct {
+	data interface{}
+}
+
+func (e *stubDataError) Error() string {
+	return "execution reverted"
+}
+
+func (e *stubDataError) ErrorData() interface{} {
+	return e.data
+}
+
+func TestDecodeRevert(t *testing.T) {
+	contractAbi, err := bindings.OutputBisectionGameMetaData.GetAbi()
+	require.NoError(t, err)
+
+	t.Run("CustomError", func(t *testing.T) {
+		// ValidStep()
+		cause := &stubDataError{data: "0xfb4e40dd"}
+		err := decodeRevert(contractAbi, cause)
+		var revertErr *RevertError
+		require.ErrorAs(t, err, &revertErr)
+		require.Equal(t, "ValidStep()", revertErr.Reason)
+		require.ErrorIs(t, err, cause)
+	})
+
+	t.Run("ErrorString", func(t *testing.T) {
+		// Error("boom")
+		data := "0x08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000004" +
+			"626f6f6d00000000000000000000000000000000000000000000000000000000"
+		err := decodeRevert(contractAbi, &stubDataError{data: data})
+		var revertErr *RevertError
+		require.ErrorAs(t, err, &revertErr)
+		require.Equal(t, "boom", revertErr.Reason)
+	})
+
+	t.Run("UnknownError", func(t *testing.T) {
+		err := decodeRevert(contractAbi, &stubDataError{data: "0xdeadbeef"})
+		var revertErr *RevertError
+		require.ErrorAs(t, err, &revertErr)
+		require.Equal(t, "0xdeadbeef", revertErr.Reason)
+	})
+
+	t.Run("NoRevertData", func(t *testing.T) {
+		cause := errors.New("connection refused")
+		require.Same(t, cause, decodeRevert(contractAbi, cause))
+
+		dataErr := &stubDataError{data: 5}
+		require.Same(t, dataErr, decodeRevert(contractAbi, dataErr))
+	})
+}
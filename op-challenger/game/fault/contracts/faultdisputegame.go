@@ -9,11 +9,15 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 const (
 	methodProposals = "proposals"
+
+	eventMove = "Move"
 )
 
 type FaultDisputeGameContract struct {
@@ -35,6 +39,42 @@ func NewFaultDisputeGameContract(addr common.Address, caller *batching.MultiCall
 	}, nil
 }
 
+// MoveEventQuery returns a filter query matching the Move events emitted by any dispute game when a claim is attacked
+// or defended. The query is not restricted to specific games so callers must filter the logs by address.
+func MoveEventQuery() (ethereum.FilterQuery, error) {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	if err != nil {
+		return ethereum.FilterQuery{}, fmt.Errorf("failed to load fault dispute game ABI: %w", err)
+	}
+	return ethereum.FilterQuery{
+		Topics: [][]common.Hash{{fdgAbi.Events[eventMove].ID}},
+	}, nil
+}
+
+// MoveEvent is a decoded Move event, emitted when a claim is posted by attacking or defending its parent.
+type MoveEvent struct {
+	ParentIndex uint64
+	Claim       common.Hash
+	Claimant    common.Address
+}
+
+// DecodeMoveEvent decodes a log matched by MoveEventQuery. All the fields of the event are indexed so are read from
+// the log's topics.
+func DecodeMoveEvent(moveLog ethTypes.Log) (MoveEvent, error) {
+	if len(moveLog.Topics) != 4 {
+		return MoveEvent{}, fmt.Errorf("invalid move event: expected 4 topics but got %v", len(moveLog.Topics))
+	}
+	parentIndex := moveLog.Topics[1].Big()
+	if !parentIndex.IsUint64() {
+		return MoveEvent{}, fmt.Errorf("invalid move event: parent index %v out of range", parentIndex)
+	}
+	return MoveEvent{
+		ParentIndex: parentIndex.Uint64(),
+		Claim:       moveLog.Topics[2],
+		Claimant:    common.BytesToAddress(moveLog.Topics[3].Bytes()),
+	}, nil
+}
+
 // GetProposals returns the agreed and disputed proposals
 func (f *FaultDisputeGameContract) GetProposals(ctx context.Context) (Proposal, Proposal, error) {
 	result, err := f.multiCaller.SingleCall(ctx, batching.BlockLatest, f.contract.Call(methodProposals))
@@ -66,11 +106,7 @@ func (f *FaultDisputeGameContract) addLocalDataTx(data *types.PreimageOracleData
 }
 
 func (f *FaultDisputeGameContract) addGlobalDataTx(ctx context.Context, data *types.PreimageOracleData) (txmgr.TxCandidate, error) {
-	vm, err := f.vm(ctx)
-	if err != nil {
-		return txmgr.TxCandidate{}, err
-	}
-	oracle, err := vm.Oracle(ctx)
+	oracle, err := f.GetOracle(ctx)
 	if err != nil {
 		return txmgr.TxCandidate{}, err
 	}
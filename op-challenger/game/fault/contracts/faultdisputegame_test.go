@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
 	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,6 +21,42 @@ func TestFaultDisputeGameContract_CommonTests(t *testing.T) {
 	})
 }
 
+func TestMoveEventQuery(t *testing.T) {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	require.NoError(t, err)
+	query, err := MoveEventQuery()
+	require.NoError(t, err)
+	require.Empty(t, query.Addresses)
+	require.Nil(t, query.FromBlock)
+	require.Equal(t, [][]common.Hash{{fdgAbi.Events[eventMove].ID}}, query.Topics)
+}
+
+func TestDecodeMoveEvent(t *testing.T) {
+	moveID := common.Hash{0xee}
+	claimant := common.Address{0xab, 0xcd}
+
+	t.Run("Valid", func(t *testing.T) {
+		event, err := DecodeMoveEvent(ethTypes.Log{
+			Topics: []common.Hash{moveID, common.BigToHash(big.NewInt(3)), {0xcc}, common.BytesToHash(claimant.Bytes())},
+		})
+		require.NoError(t, err)
+		require.Equal(t, MoveEvent{ParentIndex: 3, Claim: common.Hash{0xcc}, Claimant: claimant}, event)
+	})
+
+	t.Run("MissingTopics", func(t *testing.T) {
+		_, err := DecodeMoveEvent(ethTypes.Log{Topics: []common.Hash{moveID, common.BigToHash(big.NewInt(3))}})
+		require.Error(t, err)
+	})
+
+	t.Run("ParentIndexOutOfRange", func(t *testing.T) {
+		parentIndex := new(big.Int).Lsh(big.NewInt(1), 64)
+		_, err := DecodeMoveEvent(ethTypes.Log{
+			Topics: []common.Hash{moveID, common.BigToHash(parentIndex), {0xcc}, common.BytesToHash(claimant.Bytes())},
+		})
+		require.Error(t, err)
+	})
+}
+
 func TestGetProposals(t *testing.T) {
 	stubRpc, game := setupFaultDisputeGameTest(t)
 	agreedIndex := big.NewInt(5)
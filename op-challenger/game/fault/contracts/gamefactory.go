@@ -2,23 +2,38 @@ package contracts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/bigint"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 const (
 	methodGameCount   = "gameCount"
 	methodGameAtIndex = "gameAtIndex"
+	methodGameImpls   = "gameImpls"
+	methodGames       = "games"
+	methodCreate      = "create"
+
+	eventDisputeGameCreated = "DisputeGameCreated"
 )
 
+var ErrNotDisputeGameCreatedLog = errors.New("not a DisputeGameCreated log")
+
 type DisputeGameFactoryContract struct {
 	multiCaller *batching.MultiCaller
 	contract    *batching.BoundContract
+	abi         *abi.ABI
+	addr        common.Address
 }
 
 func NewDisputeGameFactoryContract(addr common.Address, caller *batching.MultiCaller) (*DisputeGameFactoryContract, error) {
@@ -29,6 +44,8 @@ func NewDisputeGameFactoryContract(addr common.Address, caller *batching.MultiCa
 	return &DisputeGameFactoryContract{
 		multiCaller: caller,
 		contract:    batching.NewBoundContract(factoryAbi, addr),
+		abi:         factoryAbi,
+		addr:        addr,
 	}, nil
 }
 
@@ -37,7 +54,11 @@ func (f *DisputeGameFactoryContract) GetGameCount(ctx context.Context, blockHash
 	if err != nil {
 		return 0, fmt.Errorf("failed to load game count: %w", err)
 	}
-	return result.GetBigInt(0).Uint64(), nil
+	count, err := bigint.ToUint64(result.GetBigInt(0))
+	if err != nil {
+		return 0, fmt.Errorf("invalid game count: %w", err)
+	}
+	return count, nil
 }
 
 func (f *DisputeGameFactoryContract) GetGame(ctx context.Context, idx uint64, blockHash common.Hash) (types.GameMetadata, error) {
@@ -48,6 +69,31 @@ func (f *DisputeGameFactoryContract) GetGame(ctx context.Context, idx uint64, bl
 	return f.decodeGame(result), nil
 }
 
+// GetGameImpl returns the address of the implementation contract used to create new games of the specified type.
+// The zero address is returned if the game type is not registered with the factory.
+func (f *DisputeGameFactoryContract) GetGameImpl(ctx context.Context, gameType uint8) (common.Address, error) {
+	result, err := f.multiCaller.SingleCall(ctx, batching.BlockLatest, f.contract.Call(methodGameImpls, gameType))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to load game impl for type %v: %w", gameType, err)
+	}
+	return result.GetAddress(0), nil
+}
+
+// GetGameAddress returns the address of the game created with the specified game type, root claim and extra data.
+// The zero address is returned if no such game has been created.
+func (f *DisputeGameFactoryContract) GetGameAddress(ctx context.Context, gameType uint8, rootClaim common.Hash, extraData []byte) (common.Address, error) {
+	result, err := f.multiCaller.SingleCall(ctx, batching.BlockLatest, f.contract.Call(methodGames, gameType, rootClaim, extraData))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to load game address: %w", err)
+	}
+	return result.GetAddress(0), nil
+}
+
+// CreateTx returns a transaction to create a new game with the specified game type, root claim and extra data.
+func (f *DisputeGameFactoryContract) CreateTx(gameType uint8, rootClaim common.Hash, extraData []byte) (txmgr.TxCandidate, error) {
+	return f.contract.Call(methodCreate, gameType, rootClaim, extraData).ToTxCandidate()
+}
+
 func (f *DisputeGameFactoryContract) decodeGame(result *batching.CallResult) types.GameMetadata {
 	gameType := result.GetUint8(0)
 	timestamp := result.GetUint64(1)
@@ -58,3 +104,32 @@ func (f *DisputeGameFactoryContract) decodeGame(result *batching.CallResult) typ
 		Proxy:     proxy,
 	}
 }
+
+// DisputeGameCreatedQuery returns a filter query matching the DisputeGameCreated events emitted by the factory
+// between the from and to blocks, inclusive.
+func (f *DisputeGameFactoryContract) DisputeGameCreatedQuery(from uint64, to uint64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{f.addr},
+		Topics:    [][]common.Hash{{f.abi.Events[eventDisputeGameCreated].ID}},
+	}
+}
+
+// DecodeDisputeGameCreated decodes the game from a DisputeGameCreated log.
+// The event does not include the game creation time so the timestamp of the block that included the log must be
+// provided.
+func (f *DisputeGameFactoryContract) DecodeDisputeGameCreated(log *ethtypes.Log, timestamp uint64) (types.GameMetadata, error) {
+	if log.Address != f.addr || len(log.Topics) != 4 || log.Topics[0] != f.abi.Events[eventDisputeGameCreated].ID {
+		return types.GameMetadata{}, ErrNotDisputeGameCreatedLog
+	}
+	gameType := log.Topics[2].Big()
+	if !gameType.IsUint64() || gameType.Uint64() > 255 {
+		return types.GameMetadata{}, fmt.Errorf("%w: invalid game type %v", ErrNotDisputeGameCreatedLog, gameType)
+	}
+	return types.GameMetadata{
+		GameType:  uint8(gameType.Uint64()),
+		Timestamp: timestamp,
+		Proxy:     common.BytesToAddress(log.Topics[1].Bytes()),
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,6 +52,36 @@ func TestDisputeGameFactorySimpleGetters(t *testing.T) {
 	}
 }
 
+func TestGetGameImpl(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	gameImpl := common.Address{0xaa}
+	stubRpc.SetResponse(factoryAddr, methodGameImpls, batching.BlockLatest, []interface{}{uint8(1)}, []interface{}{gameImpl})
+	actual, err := factory.GetGameImpl(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, gameImpl, actual)
+}
+
+func TestGetGameAddress(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	rootClaim := common.Hash{0xdd}
+	extraData := []byte{0x01, 0x02}
+	game := common.Address{0xaa}
+	stubRpc.SetResponse(factoryAddr, methodGames, batching.BlockLatest, []interface{}{uint8(1), rootClaim, extraData}, []interface{}{game, uint64(1234)})
+	actual, err := factory.GetGameAddress(context.Background(), 1, rootClaim, extraData)
+	require.NoError(t, err)
+	require.Equal(t, game, actual)
+}
+
+func TestCreateTx(t *testing.T) {
+	stubRpc, factory := setupDisputeGameFactoryTest(t)
+	rootClaim := common.Hash{0xdd}
+	extraData := []byte{0x01, 0x02}
+	stubRpc.SetResponse(factoryAddr, methodCreate, batching.BlockLatest, []interface{}{uint8(1), rootClaim, extraData}, nil)
+	tx, err := factory.CreateTx(1, rootClaim, extraData)
+	require.NoError(t, err)
+	stubRpc.VerifyTxCandidate(tx)
+}
+
 func TestLoadGame(t *testing.T) {
 	blockHash := common.Hash{0xbb, 0xce}
 	stubRpc, factory := setupDisputeGameFactoryTest(t)
@@ -78,6 +109,60 @@ func TestLoadGame(t *testing.T) {
 	}
 }
 
+func TestDisputeGameCreatedLogs(t *testing.T) {
+	_, factory := setupDisputeGameFactoryTest(t)
+	fdgAbi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	eventID := fdgAbi.Events[eventDisputeGameCreated].ID
+
+	t.Run("Query", func(t *testing.T) {
+		query := factory.DisputeGameCreatedQuery(10, 20)
+		require.Equal(t, big.NewInt(10), query.FromBlock)
+		require.Equal(t, big.NewInt(20), query.ToBlock)
+		require.Equal(t, []common.Address{factoryAddr}, query.Addresses)
+		require.Equal(t, [][]common.Hash{{eventID}}, query.Topics)
+	})
+
+	validLog := func() *ethtypes.Log {
+		return &ethtypes.Log{
+			Address: factoryAddr,
+			Topics: []common.Hash{
+				eventID,
+				common.BytesToHash(common.Address{0xaa}.Bytes()),
+				common.BigToHash(big.NewInt(3)),
+				{0xcc},
+			},
+		}
+	}
+
+	t.Run("Decode", func(t *testing.T) {
+		game, err := factory.DecodeDisputeGameCreated(validLog(), 1234)
+		require.NoError(t, err)
+		require.Equal(t, types.GameMetadata{GameType: 3, Timestamp: 1234, Proxy: common.Address{0xaa}}, game)
+	})
+
+	t.Run("WrongAddress", func(t *testing.T) {
+		log := validLog()
+		log.Address = common.Address{0xdd}
+		_, err := factory.DecodeDisputeGameCreated(log, 1234)
+		require.ErrorIs(t, err, ErrNotDisputeGameCreatedLog)
+	})
+
+	t.Run("WrongEvent", func(t *testing.T) {
+		log := validLog()
+		log.Topics[0] = common.Hash{0xee}
+		_, err := factory.DecodeDisputeGameCreated(log, 1234)
+		require.ErrorIs(t, err, ErrNotDisputeGameCreatedLog)
+	})
+
+	t.Run("InvalidGameType", func(t *testing.T) {
+		log := validLog()
+		log.Topics[2] = common.BigToHash(big.NewInt(256))
+		_, err := factory.DecodeDisputeGameCreated(log, 1234)
+		require.ErrorIs(t, err, ErrNotDisputeGameCreatedLog)
+	})
+}
+
 func expectGetGame(stubRpc *batchingTest.AbiBasedRpc, idx int, blockHash common.Hash, game types.GameMetadata) {
 	stubRpc.SetResponse(
 		factoryAddr,
@@ -0,0 +1,52 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const methodGetL2OutputAfter = "getL2OutputAfter"
+
+// L2OutputOracleContract reads output root proposals from the L2OutputOracle used before fault proofs.
+type L2OutputOracleContract struct {
+	multiCaller *batching.MultiCaller
+	contract    *batching.BoundContract
+}
+
+// oracleProposal matches the Types.OutputProposal struct returned by the L2OutputOracle.
+type oracleProposal struct {
+	OutputRoot    common.Hash
+	Timestamp     *big.Int
+	L2BlockNumber *big.Int
+}
+
+func NewL2OutputOracleContract(addr common.Address, caller *batching.MultiCaller) (*L2OutputOracleContract, error) {
+	oracleAbi, err := bindings.L2OutputOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load L2 output oracle ABI: %w", err)
+	}
+	return &L2OutputOracleContract{
+		multiCaller: caller,
+		contract:    batching.NewBoundContract(oracleAbi, addr),
+	}, nil
+}
+
+// GetL2OutputAfter returns the first proposal for an L2 block at or after l2BlockNumber.
+// The call fails if no output has been proposed for a block that late yet.
+func (o *L2OutputOracleContract) GetL2OutputAfter(ctx context.Context, l2BlockNumber uint64) (Proposal, error) {
+	result, err := o.multiCaller.SingleCall(ctx, batching.BlockLatest, o.contract.Call(methodGetL2OutputAfter, new(big.Int).SetUint64(l2BlockNumber)))
+	if err != nil {
+		return Proposal{}, fmt.Errorf("failed to fetch output after block %v: %w", l2BlockNumber, err)
+	}
+	var proposal oracleProposal
+	result.GetStruct(0, &proposal)
+	return Proposal{
+		L2BlockNumber: proposal.L2BlockNumber,
+		OutputRoot:    proposal.OutputRoot,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package contracts
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var l2ooAddr = common.HexToAddress("0x55552842371dFC380576ebb09Ae16Cb6B6ca5555")
+
+func TestGetL2OutputAfter(t *testing.T) {
+	oracleAbi, err := bindings.L2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+	stubRpc := batchingTest.NewAbiBasedRpc(t, l2ooAddr, oracleAbi)
+	oracle, err := NewL2OutputOracleContract(l2ooAddr, batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize))
+	require.NoError(t, err)
+
+	outputRoot := common.Hash{0xaa}
+	stubRpc.SetResponse(l2ooAddr, methodGetL2OutputAfter, batching.BlockLatest, []interface{}{big.NewInt(100)}, []interface{}{
+		bindings.TypesOutputProposal{OutputRoot: outputRoot, Timestamp: big.NewInt(1234), L2BlockNumber: big.NewInt(120)},
+	})
+	proposal, err := oracle.GetL2OutputAfter(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, Proposal{L2BlockNumber: big.NewInt(120), OutputRoot: outputRoot}, proposal)
+}
@@ -0,0 +1,99 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	methodAggregate3 = "aggregate3"
+)
+
+var ErrInvalidMulticallCall = errors.New("invalid multicall call")
+
+// MulticallContract is a binding for the Multicall3 contract, used to combine multiple transactions into one.
+// The calls are made by the multicall contract so only permissionless calls, where the sender is not recorded, may
+// be combined.
+type MulticallContract struct {
+	multiCaller *batching.MultiCaller
+	contract    *batching.BoundContract
+	addr        common.Address
+}
+
+func NewMulticallContract(addr common.Address, caller *batching.MultiCaller) (*MulticallContract, error) {
+	multicallAbi, err := bindings.MultiCall3MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load multicall ABI: %w", err)
+	}
+	return &MulticallContract{
+		multiCaller: caller,
+		contract:    batching.NewBoundContract(multicallAbi, addr),
+		addr:        addr,
+	}, nil
+}
+
+func (m *MulticallContract) Addr() common.Address {
+	return m.addr
+}
+
+// Aggregate3Tx returns a transaction that makes each of the candidate transactions in a single transaction.
+// Each call is allowed to fail without reverting the others.
+// Candidates must be calls to a contract and must not send value.
+func (m *MulticallContract) Aggregate3Tx(candidates []txmgr.TxCandidate) (txmgr.TxCandidate, error) {
+	call, err := m.aggregate3Call(candidates)
+	if err != nil {
+		return txmgr.TxCandidate{}, err
+	}
+	return call.ToTxCandidate()
+}
+
+// Aggregate3Results returns whether each of the calls in an Aggregate3Tx transaction for candidates succeeded, given
+// the number of the block that included it. Receipts do not include the data returned by a transaction, so the
+// results are decoded from replaying the calls against the state of the previous block. If includedIn is nil, the
+// calls are replayed against the latest block.
+func (m *MulticallContract) Aggregate3Results(ctx context.Context, candidates []txmgr.TxCandidate, includedIn *big.Int) ([]bool, error) {
+	call, err := m.aggregate3Call(candidates)
+	if err != nil {
+		return nil, err
+	}
+	block := batching.BlockLatest
+	if includedIn != nil && includedIn.Sign() > 0 {
+		block = batching.BlockByNumber(includedIn.Uint64() - 1)
+	}
+	result, err := m.multiCaller.SingleCall(ctx, block, call)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay %v: %w", methodAggregate3, err)
+	}
+	var results []bindings.Multicall3Result
+	result.GetStruct(0, &results)
+	if len(results) != len(candidates) {
+		return nil, fmt.Errorf("expected %v results but got %v", len(candidates), len(results))
+	}
+	successes := make([]bool, len(results))
+	for i, r := range results {
+		successes[i] = r.Success
+	}
+	return successes, nil
+}
+
+func (m *MulticallContract) aggregate3Call(candidates []txmgr.TxCandidate) (*batching.ContractCall, error) {
+	calls := make([]bindings.Multicall3Call3, 0, len(candidates))
+	for i, candidate := range candidates {
+		if candidate.To == nil || (candidate.Value != nil && candidate.Value.Sign() != 0) {
+			return nil, fmt.Errorf("%w: candidate %v", ErrInvalidMulticallCall, i)
+		}
+		calls = append(calls, bindings.Multicall3Call3{
+			Target:       *candidate.To,
+			AllowFailure: true,
+			CallData:     candidate.TxData,
+		})
+	}
+	return m.contract.Call(methodAggregate3, calls), nil
+}
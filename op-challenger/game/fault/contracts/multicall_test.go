@@ -0,0 +1,99 @@
+package contracts
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMulticallAggregate3Tx(t *testing.T) {
+	multicallAddr := common.Address{0xca, 0x11}
+	game1 := common.Address{0xaa}
+	game2 := common.Address{0xbb}
+	multicall, err := NewMulticallContract(multicallAddr, nil)
+	require.NoError(t, err)
+	require.Equal(t, multicallAddr, multicall.Addr())
+
+	t.Run("Valid", func(t *testing.T) {
+		tx, err := multicall.Aggregate3Tx([]txmgr.TxCandidate{
+			{To: &game1, TxData: []byte{0x01, 0x02}},
+			{To: &game2, TxData: []byte{0x03}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &multicallAddr, tx.To)
+
+		multicallAbi, err := bindings.MultiCall3MetaData.GetAbi()
+		require.NoError(t, err)
+		method, err := multicallAbi.MethodById(tx.TxData[:4])
+		require.NoError(t, err)
+		require.Equal(t, methodAggregate3, method.Name)
+		args, err := method.Inputs.Unpack(tx.TxData[4:])
+		require.NoError(t, err)
+		var calls []bindings.Multicall3Call3
+		require.NoError(t, method.Inputs.Copy(&calls, args))
+		require.Equal(t, []bindings.Multicall3Call3{
+			{Target: game1, AllowFailure: true, CallData: []byte{0x01, 0x02}},
+			{Target: game2, AllowFailure: true, CallData: []byte{0x03}},
+		}, calls)
+	})
+
+	t.Run("RejectContractCreation", func(t *testing.T) {
+		_, err := multicall.Aggregate3Tx([]txmgr.TxCandidate{{TxData: []byte{0x01}}})
+		require.ErrorIs(t, err, ErrInvalidMulticallCall)
+	})
+
+	t.Run("RejectValue", func(t *testing.T) {
+		_, err := multicall.Aggregate3Tx([]txmgr.TxCandidate{{To: &game1, Value: big.NewInt(1)}})
+		require.ErrorIs(t, err, ErrInvalidMulticallCall)
+	})
+}
+
+func TestMulticallAggregate3Results(t *testing.T) {
+	multicallAddr := common.Address{0xca, 0x11}
+	game := common.Address{0xaa}
+	multicallAbi, err := bindings.MultiCall3MetaData.GetAbi()
+	require.NoError(t, err)
+	stubRpc := batchingTest.NewAbiBasedRpc(t, multicallAddr, multicallAbi)
+	multicall, err := NewMulticallContract(multicallAddr, batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize))
+	require.NoError(t, err)
+
+	candidates := []txmgr.TxCandidate{
+		{To: &game, TxData: []byte{0x01}},
+		{To: &game, TxData: []byte{0x02}},
+	}
+	calls := []bindings.Multicall3Call3{
+		{Target: game, AllowFailure: true, CallData: []byte{0x01}},
+		{Target: game, AllowFailure: true, CallData: []byte{0x02}},
+	}
+	results := []bindings.Multicall3Result{
+		{Success: true, ReturnData: []byte{}},
+		{Success: false, ReturnData: []byte{0xde, 0xad}},
+	}
+
+	t.Run("ReplayedBeforeIncludingBlock", func(t *testing.T) {
+		stubRpc.SetResponse(multicallAddr, methodAggregate3, batching.BlockByNumber(41), []interface{}{calls}, []interface{}{results})
+		successes, err := multicall.Aggregate3Results(context.Background(), candidates, big.NewInt(42))
+		require.NoError(t, err)
+		require.Equal(t, []bool{true, false}, successes)
+	})
+
+	t.Run("ReplayedAtLatestWhenNotIncluded", func(t *testing.T) {
+		stubRpc.SetResponse(multicallAddr, methodAggregate3, batching.BlockLatest, []interface{}{calls}, []interface{}{results})
+		successes, err := multicall.Aggregate3Results(context.Background(), candidates, nil)
+		require.NoError(t, err)
+		require.Equal(t, []bool{true, false}, successes)
+	})
+
+	t.Run("WrongNumberOfResults", func(t *testing.T) {
+		stubRpc.SetResponse(multicallAddr, methodAggregate3, batching.BlockByNumber(9), []interface{}{calls}, []interface{}{results[:1]})
+		_, err := multicall.Aggregate3Results(context.Background(), candidates, big.NewInt(10))
+		require.ErrorContains(t, err, "expected 2 results but got 1")
+	})
+}
@@ -1,6 +1,7 @@
 package contracts
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -13,6 +14,7 @@ import (
 
 const (
 	methodLoadKeccak256PreimagePart = "loadKeccak256PreimagePart"
+	methodPreimagePartOk            = "preimagePartOk"
 )
 
 // PreimageOracleContract is a binding that works with contracts implementing the IPreimageOracle interface
@@ -37,3 +39,13 @@ func (c PreimageOracleContract) AddGlobalDataTx(data *types.PreimageOracleData)
 	call := c.contract.Call(methodLoadKeccak256PreimagePart, new(big.Int).SetUint64(uint64(data.OracleOffset)), data.GetPreimageWithoutSize())
 	return call.ToTxCandidate()
 }
+
+// GlobalDataExists returns true if the part of the preimage required by data has already been loaded into the oracle.
+func (c PreimageOracleContract) GlobalDataExists(ctx context.Context, data *types.PreimageOracleData) (bool, error) {
+	results, err := c.multiCaller.SingleCall(ctx, batching.BlockLatest,
+		c.contract.Call(methodPreimagePartOk, common.BytesToHash(data.OracleKey), new(big.Int).SetUint64(uint64(data.OracleOffset))))
+	if err != nil {
+		return false, fmt.Errorf("failed to get preimagePartOk: %w", err)
+	}
+	return results.GetBool(0), nil
+}
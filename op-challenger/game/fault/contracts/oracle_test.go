@@ -1,6 +1,7 @@
 package contracts
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -34,3 +35,26 @@ func TestPreimageOracleContract_LoadKeccak256(t *testing.T) {
 	require.NoError(t, err)
 	stubRpc.VerifyTxCandidate(tx)
 }
+
+func TestPreimageOracleContract_GlobalDataExists(t *testing.T) {
+	oracleAbi, err := bindings.PreimageOracleMetaData.GetAbi()
+	require.NoError(t, err)
+
+	stubRpc := batchingTest.NewAbiBasedRpc(t, oracleAddr, oracleAbi)
+	oracleContract, err := NewPreimageOracleContract(oracleAddr, batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize))
+	require.NoError(t, err)
+
+	data := &types.PreimageOracleData{
+		OracleKey:    common.Hash{0xcc}.Bytes(),
+		OracleData:   make([]byte, 20),
+		OracleOffset: 545,
+	}
+	stubRpc.SetResponse(oracleAddr, methodPreimagePartOk, batching.BlockLatest, []interface{}{
+		common.Hash{0xcc},
+		new(big.Int).SetUint64(uint64(data.OracleOffset)),
+	}, []interface{}{true})
+
+	exists, err := oracleContract.GlobalDataExists(context.Background(), data)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
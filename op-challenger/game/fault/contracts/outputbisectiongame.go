@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/bigint"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -52,11 +53,33 @@ func (c *OutputBisectionGameContract) GetBlockRange(ctx context.Context) (presta
 		retErr = fmt.Errorf("expected 2 results but got %v", len(results))
 		return
 	}
-	prestateBlock = results[0].GetBigInt(0).Uint64()
-	poststateBlock = results[1].GetBigInt(0).Uint64()
+	prestateBlock, retErr = bigint.ToUint64(results[0].GetBigInt(0))
+	if retErr != nil {
+		retErr = fmt.Errorf("invalid genesis block number: %w", retErr)
+		return
+	}
+	poststateBlock, retErr = bigint.ToUint64(results[1].GetBigInt(0))
+	if retErr != nil {
+		retErr = fmt.Errorf("invalid l2 block number: %w", retErr)
+		return
+	}
 	return
 }
 
+// GetGenesisBlockNumber returns the block number of the absolute pre-state block.
+// Unlike GetBlockRange, it can be called on the game implementation registered with the factory.
+func (c *OutputBisectionGameContract) GetGenesisBlockNumber(ctx context.Context) (uint64, error) {
+	result, err := c.multiCaller.SingleCall(ctx, batching.BlockLatest, c.contract.Call(methodGenesisBlockNumber))
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve genesis block number: %w", err)
+	}
+	block, err := bigint.ToUint64(result.GetBigInt(0))
+	if err != nil {
+		return 0, fmt.Errorf("invalid genesis block number: %w", err)
+	}
+	return block, nil
+}
+
 func (c *OutputBisectionGameContract) GetGenesisOutputRoot(ctx context.Context) (common.Hash, error) {
 	genesisOutputRoot, err := c.multiCaller.SingleCall(ctx, batching.BlockLatest, c.contract.Call(methodGenesisOutputRoot))
 	if err != nil {
@@ -70,7 +93,11 @@ func (c *OutputBisectionGameContract) GetSplitDepth(ctx context.Context) (uint64
 	if err != nil {
 		return 0, fmt.Errorf("failed to retrieve split depth: %w", err)
 	}
-	return splitDepth.GetBigInt(0).Uint64(), nil
+	depth, err := bigint.ToUint64(splitDepth.GetBigInt(0))
+	if err != nil {
+		return 0, fmt.Errorf("invalid split depth: %w", err)
+	}
+	return depth, nil
 }
 
 func (f *OutputBisectionGameContract) UpdateOracleTx(ctx context.Context, claimIdx uint64, data *types.PreimageOracleData) (txmgr.TxCandidate, error) {
@@ -91,11 +118,7 @@ func (f *OutputBisectionGameContract) addLocalDataTx(claimIdx uint64, data *type
 }
 
 func (f *OutputBisectionGameContract) addGlobalDataTx(ctx context.Context, data *types.PreimageOracleData) (txmgr.TxCandidate, error) {
-	vm, err := f.vm(ctx)
-	if err != nil {
-		return txmgr.TxCandidate{}, err
-	}
-	oracle, err := vm.Oracle(ctx)
+	oracle, err := f.GetOracle(ctx)
 	if err != nil {
 		return txmgr.TxCandidate{}, err
 	}
@@ -32,6 +32,15 @@ func TestGetBlockRange(t *testing.T) {
 	require.Equal(t, expectedEnd, end)
 }
 
+func TestGetGenesisBlockNumber(t *testing.T) {
+	stubRpc, contract := setupOutputBisectionGameTest(t)
+	expectedBlock := uint64(65)
+	stubRpc.SetResponse(fdgAddr, methodGenesisBlockNumber, batching.BlockLatest, nil, []interface{}{new(big.Int).SetUint64(expectedBlock)})
+	block, err := contract.GetGenesisBlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expectedBlock, block)
+}
+
 func TestGetSplitDepth(t *testing.T) {
 	stubRpc, contract := setupOutputBisectionGameTest(t)
 	expectedSplitDepth := uint64(15)
@@ -0,0 +1,140 @@
+package fault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// diagnosticsFile is the file in the game's data directory that diagnostics are written to.
+const diagnosticsFile = "diagnostics.json"
+
+// Diagnostics is the state of a game the challenger appears to be losing at max depth. It is dumped for human
+// intervention, as an honest claim at max depth can only be countered, or an honest step fail, if the trace or the
+// VM disagree with the on-chain contracts.
+type Diagnostics struct {
+	Time        time.Time          `json:"time"`
+	L1Head      eth.BlockID        `json:"l1Head"`
+	MaxDepth    int                `json:"maxDepth"`
+	Claims      []types.Claim      `json:"claims"`
+	Assessments []types.Assessment `json:"assessments,omitempty"`
+	// HonestLeaves and CounteredLeaves are the contract indices of the honest claims at max depth, and the subset of
+	// those that have been countered.
+	HonestLeaves    []int        `json:"honestLeaves"`
+	CounteredLeaves []int        `json:"counteredLeaves"`
+	FailedSteps     []FailedStep `json:"failedSteps"`
+}
+
+// FailedStep is a step the challenger attempted that failed.
+type FailedStep struct {
+	ParentIdx int           `json:"parentIdx"`
+	IsAttack  bool          `json:"isAttack"`
+	PreState  hexutil.Bytes `json:"preState"`
+	ProofData hexutil.Bytes `json:"proofData"`
+	Error     string        `json:"error"`
+}
+
+func newFailedStep(action types.Action, err error) FailedStep {
+	return FailedStep{
+		ParentIdx: action.ParentIdx,
+		IsAttack:  action.IsAttack,
+		PreState:  action.PreState,
+		ProofData: action.ProofData,
+		Error:     err.Error(),
+	}
+}
+
+// WithDiagnosticsDir sets the directory diagnostics are written to if the agent enters diagnostic mode.
+// If not set, diagnostics are only logged.
+func (a *Agent) WithDiagnosticsDir(dir string) *Agent {
+	a.diagnosticsDir = dir
+	return a
+}
+
+// recordFailedStep records a failed step for diagnostics, replacing any earlier failure of the same step.
+func (a *Agent) recordFailedStep(action types.Action, err error) {
+	failed := newFailedStep(action, err)
+	for i, prev := range a.failedSteps {
+		if prev.ParentIdx == failed.ParentIdx && prev.IsAttack == failed.IsAttack {
+			a.failedSteps[i] = failed
+			return
+		}
+	}
+	a.failedSteps = append(a.failedSteps, failed)
+}
+
+// checkMaxDepth switches the agent into diagnostic mode if all of its honest claims at max depth have been countered
+// or its steps have failed, meaning it has no path left to win the game. Once in diagnostic mode, the full game state
+// is dumped every time the agent acts so the latest state is available for human intervention.
+func (a *Agent) checkMaxDepth(ctx context.Context, game types.Game) {
+	leaves, err := a.solver.HonestLeafClaims(ctx, game)
+	if err != nil {
+		a.log.Warn("Failed to load honest leaf claims", "err", err)
+		return
+	}
+	honest := make([]int, 0, len(leaves))
+	countered := make([]int, 0, len(leaves))
+	for _, leaf := range leaves {
+		honest = append(honest, leaf.ContractIndex)
+		if leaf.Countered {
+			countered = append(countered, leaf.ContractIndex)
+		}
+	}
+	losing := len(countered) == len(honest) && (len(countered) > 0 || len(a.failedSteps) > 0)
+	if !losing && !a.diagnostic {
+		return
+	}
+	if !a.diagnostic {
+		a.diagnostic = true
+		a.metrics.RecordGameLosingAtMaxDepth()
+		a.log.Error("CRITICAL: Losing game at max depth, entering diagnostic mode. Manual intervention required",
+			"honestLeaves", honest, "counteredLeaves", countered, "failedSteps", len(a.failedSteps), "l1Head", a.l1Head)
+	}
+	diagnostics := Diagnostics{
+		Time:            time.Now(),
+		L1Head:          a.l1Head,
+		MaxDepth:        a.maxDepth,
+		Claims:          game.Claims(),
+		HonestLeaves:    honest,
+		CounteredLeaves: countered,
+		FailedSteps:     a.failedSteps,
+	}
+	if assessments, err := a.solver.AssessClaims(ctx, game); err != nil {
+		a.log.Warn("Failed to assess claims for diagnostics", "err", err)
+	} else {
+		diagnostics.Assessments = assessments
+	}
+	a.dumpDiagnostics(diagnostics)
+}
+
+func (a *Agent) dumpDiagnostics(diagnostics Diagnostics) {
+	data, err := json.MarshalIndent(diagnostics, "", "  ")
+	if err != nil {
+		a.log.Error("Failed to encode diagnostics", "err", err)
+		return
+	}
+	if a.diagnosticsDir == "" {
+		a.log.Error("Game diagnostics", "diagnostics", string(data))
+		return
+	}
+	path := filepath.Join(a.diagnosticsDir, diagnosticsFile)
+	if err := writeDiagnostics(path, data); err != nil {
+		a.log.Error("Failed to write diagnostics, logging instead", "err", err, "diagnostics", string(data))
+		return
+	}
+	a.log.Warn("Wrote game diagnostics", "path", path)
+}
+
+func writeDiagnostics(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create diagnostics dir: %w", err)
+	}
+	return os.WriteFile(path, data, 0o644)
+}
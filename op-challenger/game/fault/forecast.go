@@ -0,0 +1,24 @@
+package fault
+
+import (
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+)
+
+// expectedStatus returns the status a game should resolve with, given whether the root claim is correct.
+func expectedStatus(agreeWithRootClaim bool) gameTypes.GameStatus {
+	if agreeWithRootClaim {
+		return gameTypes.GameStatusDefenderWon
+	}
+	return gameTypes.GameStatusChallengerWon
+}
+
+// projectedStatus returns the status a game with the specified claims would resolve with if no further claims were
+// posted. See [types.CounteredClaims] for how claims are resolved.
+func projectedStatus(claims []types.Claim, maxDepth int) gameTypes.GameStatus {
+	countered := types.CounteredClaims(claims, maxDepth)
+	if len(claims) > 0 && countered[0] {
+		return gameTypes.GameStatusChallengerWon
+	}
+	return gameTypes.GameStatusDefenderWon
+}
@@ -0,0 +1,111 @@
+package fault
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedStatus(t *testing.T) {
+	require.Equal(t, gameTypes.GameStatusDefenderWon, expectedStatus(true))
+	require.Equal(t, gameTypes.GameStatusChallengerWon, expectedStatus(false))
+}
+
+func TestProjectedStatus(t *testing.T) {
+	maxDepth := 2
+	root := types.NewPositionFromGIndex(big.NewInt(1))
+	// Positions of claims at each depth down to the max depth.
+	level1 := root.Attack()
+	level2 := level1.Attack()
+	// claim returns a claim as reported by the contract, which marks a claim countered when it is moved against and,
+	// at max depth, when it is stepped on.
+	claim := func(idx int, parentIdx int, pos types.Position, countered bool) types.Claim {
+		return types.Claim{
+			ClaimData:           types.ClaimData{Value: common.Hash{byte(idx)}, Position: pos},
+			ContractIndex:       idx,
+			ParentContractIndex: parentIdx,
+			Countered:           countered,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		claims   []types.Claim
+		expected gameTypes.GameStatus
+	}{
+		{
+			name:     "NoClaims",
+			expected: gameTypes.GameStatusDefenderWon,
+		},
+		{
+			name:     "UnchallengedRoot",
+			claims:   []types.Claim{claim(0, 0, root, false)},
+			expected: gameTypes.GameStatusDefenderWon,
+		},
+		{
+			name:     "ChallengedRoot",
+			claims:   []types.Claim{claim(0, 0, root, true), claim(1, 0, level1, false)},
+			expected: gameTypes.GameStatusChallengerWon,
+		},
+		{
+			name: "ChallengeCountered",
+			claims: []types.Claim{
+				claim(0, 0, root, true),
+				claim(1, 0, level1, true),
+				claim(2, 1, level2, false),
+			},
+			expected: gameTypes.GameStatusDefenderWon,
+		},
+		{
+			name: "CounterSteppedOn",
+			claims: []types.Claim{
+				claim(0, 0, root, true),
+				claim(1, 0, level1, true),
+				claim(2, 1, level2, true),
+			},
+			expected: gameTypes.GameStatusChallengerWon,
+		},
+		{
+			name: "OneOfTwoChallengesCountered",
+			claims: []types.Claim{
+				claim(0, 0, root, true),
+				claim(1, 0, level1, true),
+				claim(2, 0, level1, false),
+				claim(3, 1, level2, false),
+			},
+			expected: gameTypes.GameStatusChallengerWon,
+		},
+		{
+			name: "BothChallengesCountered",
+			claims: []types.Claim{
+				claim(0, 0, root, true),
+				claim(1, 0, level1, true),
+				claim(2, 0, level1, true),
+				claim(3, 1, level2, false),
+				claim(4, 2, level2, false),
+			},
+			expected: gameTypes.GameStatusDefenderWon,
+		},
+		{
+			name: "OneOfTwoCountersSteppedOn",
+			claims: []types.Claim{
+				claim(0, 0, root, true),
+				claim(1, 0, level1, true),
+				claim(2, 1, level2, true),
+				claim(3, 1, level2, false),
+			},
+			expected: gameTypes.GameStatusDefenderWon,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, projectedStatus(test.claims, maxDepth))
+		})
+	}
+}
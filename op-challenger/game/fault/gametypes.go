@@ -0,0 +1,217 @@
+package fault
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	cannonGameType         = uint8(0)
+	outputCannonGameType   = uint8(1)
+	outputAlphabetGameType = uint8(254)
+	alphabetGameType       = uint8(255)
+)
+
+// GameTypeDependencies are the clients and configuration shared by all games, used to create the resources to play
+// each game.
+type GameTypeDependencies struct {
+	Logger  log.Logger
+	Metrics metrics.Metricer
+	Config  *config.Config
+	Caller  *batching.MultiCaller
+	// RollupClient is only available if a trace type based on output roots is enabled.
+	RollupClient RollupClient
+	// L2Client is only available if an enabled game type requires it.
+	L2Client cannon.L2HeaderSource
+}
+
+// GameResources are the game type specific components used to play a single game.
+type GameResources struct {
+	Contract GameContract
+	// Validators check the game was created with the same prestates as the local trace before any moves are made.
+	Validators    []Validator
+	SyncValidator SyncValidator
+	TraceAccessor TraceAccessorCreator
+	// NewSolver creates the solver that determines how to respond to claims in the game.
+	NewSolver SolverCreator
+}
+
+// GameTypeDefinition describes how the challenger plays a type of dispute game.
+type GameTypeDefinition struct {
+	GameType uint8
+	// TraceType is the trace type that must be enabled for games of this type to be played.
+	TraceType config.TraceType
+	// OutputRootBisection is true if the game bisects output roots in the top half of the game.
+	OutputRootBisection bool
+	// RequiresL2Client is true if GameTypeDependencies.L2Client is used to create the game resources.
+	RequiresL2Client bool
+	// NewResources loads the game contract at addr and creates the resources used to play it.
+	NewResources func(ctx context.Context, deps *GameTypeDependencies, addr common.Address) (*GameResources, error)
+}
+
+var (
+	gameTypeDefinitionsLock sync.RWMutex
+	gameTypeDefinitions     = map[uint8]GameTypeDefinition{
+		cannonGameType: {
+			GameType:         cannonGameType,
+			TraceType:        config.TraceTypeCannon,
+			RequiresL2Client: true,
+			NewResources:     cannonGameResources,
+		},
+		outputCannonGameType: {
+			GameType:            outputCannonGameType,
+			TraceType:           config.TraceTypeOutputCannon,
+			OutputRootBisection: true,
+			RequiresL2Client:    true,
+			NewResources:        outputCannonGameResources,
+		},
+		outputAlphabetGameType: {
+			GameType:            outputAlphabetGameType,
+			TraceType:           config.TraceTypeOutputAlphabet,
+			OutputRootBisection: true,
+			NewResources:        outputAlphabetGameResources,
+		},
+		alphabetGameType: {
+			GameType:     alphabetGameType,
+			TraceType:    config.TraceTypeAlphabet,
+			NewResources: alphabetGameResources,
+		},
+	}
+)
+
+// RegisterGameTypeDefinition adds support for playing an additional game type.
+// It must be called before the challenger starts, typically from an init function.
+// Panics if the game type is already defined, since this indicates a significant programmer error.
+func RegisterGameTypeDefinition(def GameTypeDefinition) {
+	gameTypeDefinitionsLock.Lock()
+	defer gameTypeDefinitionsLock.Unlock()
+	if _, ok := gameTypeDefinitions[def.GameType]; ok {
+		panic(fmt.Errorf("duplicate definition for game type: %v", def.GameType))
+	}
+	gameTypeDefinitions[def.GameType] = def
+}
+
+// GameTypeDefinitionFor returns the definition of gameType, or false if the game type is not supported.
+func GameTypeDefinitionFor(gameType uint8) (GameTypeDefinition, bool) {
+	gameTypeDefinitionsLock.RLock()
+	defer gameTypeDefinitionsLock.RUnlock()
+	def, ok := gameTypeDefinitions[gameType]
+	return def, ok
+}
+
+// IsOutputGameType returns true if the game type uses output root bisection in the top half of the game.
+func IsOutputGameType(gameType uint8) bool {
+	def, ok := GameTypeDefinitionFor(gameType)
+	return ok && def.OutputRootBisection
+}
+
+// RequiresL2Client returns true if any game type enabled in cfg requires an L2 client.
+func RequiresL2Client(cfg *config.Config) bool {
+	return slices.ContainsFunc(enabledGameTypes(cfg), func(def GameTypeDefinition) bool {
+		return def.RequiresL2Client
+	})
+}
+
+// enabledGameTypes returns the definitions of the game types whose trace type is enabled in cfg, in game type order.
+func enabledGameTypes(cfg *config.Config) []GameTypeDefinition {
+	gameTypeDefinitionsLock.RLock()
+	defer gameTypeDefinitionsLock.RUnlock()
+	var defs []GameTypeDefinition
+	for _, def := range gameTypeDefinitions {
+		if cfg.TraceTypeEnabled(def.TraceType) {
+			defs = append(defs, def)
+		}
+	}
+	slices.SortFunc(defs, func(a, b GameTypeDefinition) int {
+		return int(a.GameType) - int(b.GameType)
+	})
+	return defs
+}
+
+func outputAlphabetGameResources(ctx context.Context, deps *GameTypeDependencies, addr common.Address) (*GameResources, error) {
+	contract, err := contracts.NewOutputBisectionGameContract(addr, deps.Caller)
+	if err != nil {
+		return nil, err
+	}
+	prestateProvider, creator, err := outputAlphabetResources(ctx, deps.Logger, deps.Metrics, deps.RollupClient, contract)
+	if err != nil {
+		return nil, err
+	}
+	return outputGameResources(ctx, deps, contract, prestateProvider, creator)
+}
+
+func outputCannonGameResources(ctx context.Context, deps *GameTypeDependencies, addr common.Address) (*GameResources, error) {
+	contract, err := contracts.NewOutputBisectionGameContract(addr, deps.Caller)
+	if err != nil {
+		return nil, err
+	}
+	prestateProvider, creator, err := outputCannonResources(ctx, deps.Logger, metrics.ForGame(deps.Metrics, addr), deps.Config, deps.RollupClient, deps.L2Client, contract)
+	if err != nil {
+		return nil, err
+	}
+	return outputGameResources(ctx, deps, contract, prestateProvider, creator)
+}
+
+// outputGameResources returns the resources common to all output bisection games.
+func outputGameResources(
+	ctx context.Context,
+	deps *GameTypeDependencies,
+	contract *contracts.OutputBisectionGameContract,
+	prestateProvider faultTypes.PrestateProvider,
+	creator TraceAccessorCreator) (*GameResources, error) {
+	newSolver, err := outputSolverCreator(ctx, deps.Config, contract)
+	if err != nil {
+		return nil, err
+	}
+	return &GameResources{
+		Contract: contract,
+		Validators: []Validator{
+			NewPrestateValidator(contract.GetAbsolutePrestateHash, prestateProvider),
+			NewPrestateValidator(contract.GetGenesisOutputRoot, prestateProvider),
+		},
+		SyncValidator: newSyncStatusValidator(deps.RollupClient),
+		TraceAccessor: creator,
+		NewSolver:     newSolver,
+	}, nil
+}
+
+func cannonGameResources(_ context.Context, deps *GameTypeDependencies, addr common.Address) (*GameResources, error) {
+	contract, err := contracts.NewFaultDisputeGameContract(addr, deps.Caller)
+	if err != nil {
+		return nil, err
+	}
+	prestateProvider, creator := cannonResources(metrics.ForGame(deps.Metrics, addr), deps.Config, deps.L2Client, contract)
+	return &GameResources{
+		Contract:      contract,
+		Validators:    []Validator{NewPrestateValidator(contract.GetAbsolutePrestateHash, prestateProvider)},
+		SyncValidator: noopSyncValidator{},
+		TraceAccessor: creator,
+		NewSolver:     newGameSolver,
+	}, nil
+}
+
+func alphabetGameResources(_ context.Context, deps *GameTypeDependencies, addr common.Address) (*GameResources, error) {
+	contract, err := contracts.NewFaultDisputeGameContract(addr, deps.Caller)
+	if err != nil {
+		return nil, err
+	}
+	prestateProvider, creator := alphabetResources(deps.Config.AlphabetTrace)
+	return &GameResources{
+		Contract:      contract,
+		Validators:    []Validator{NewPrestateValidator(contract.GetAbsolutePrestateHash, prestateProvider)},
+		SyncValidator: noopSyncValidator{},
+		TraceAccessor: creator,
+		NewSolver:     newGameSolver,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package fault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestIsOutputGameType(t *testing.T) {
+	require.True(t, IsOutputGameType(outputCannonGameType))
+	require.True(t, IsOutputGameType(outputAlphabetGameType))
+	require.False(t, IsOutputGameType(cannonGameType))
+	require.False(t, IsOutputGameType(alphabetGameType))
+	require.False(t, IsOutputGameType(100))
+}
+
+func TestRequiresL2Client(t *testing.T) {
+	require.True(t, RequiresL2Client(&config.Config{TraceTypes: []config.TraceType{config.TraceTypeCannon}}))
+	require.True(t, RequiresL2Client(&config.Config{TraceTypes: []config.TraceType{config.TraceTypeAlphabet, config.TraceTypeOutputCannon}}))
+	require.False(t, RequiresL2Client(&config.Config{TraceTypes: []config.TraceType{config.TraceTypeAlphabet, config.TraceTypeOutputAlphabet}}))
+}
+
+func TestRegisterGameTypes(t *testing.T) {
+	t.Run("OnlyEnabledTraceTypes", func(t *testing.T) {
+		cfg := &config.Config{TraceTypes: []config.TraceType{config.TraceTypeAlphabet, config.TraceTypeOutputAlphabet}}
+		registry := &stubRegistry{}
+		closer, err := RegisterGameTypes(registry, context.Background(), testlog.Logger(t, log.LvlError), metrics.NoopMetrics, cfg, nil, nil, nil, nil, nil, nil, NewIncidentMode(false), nil, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, closer, "should not dial L2 client")
+		require.Equal(t, []uint8{outputAlphabetGameType, alphabetGameType}, registry.gameTypes)
+	})
+
+	t.Run("CustomGameType", func(t *testing.T) {
+		customGameType := uint8(100)
+		RegisterGameTypeDefinition(GameTypeDefinition{
+			GameType:  customGameType,
+			TraceType: config.TraceTypeAlphabet,
+			NewResources: func(ctx context.Context, deps *GameTypeDependencies, addr common.Address) (*GameResources, error) {
+				return alphabetGameResources(ctx, deps, addr)
+			},
+		})
+		t.Cleanup(func() {
+			gameTypeDefinitionsLock.Lock()
+			defer gameTypeDefinitionsLock.Unlock()
+			delete(gameTypeDefinitions, customGameType)
+		})
+		def, ok := GameTypeDefinitionFor(customGameType)
+		require.True(t, ok)
+		require.Equal(t, customGameType, def.GameType)
+
+		cfg := &config.Config{TraceTypes: []config.TraceType{config.TraceTypeAlphabet}}
+		registry := &stubRegistry{}
+		_, err := RegisterGameTypes(registry, context.Background(), testlog.Logger(t, log.LvlError), metrics.NoopMetrics, cfg, nil, nil, nil, nil, nil, nil, NewIncidentMode(false), nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []uint8{customGameType, alphabetGameType}, registry.gameTypes)
+	})
+
+	t.Run("DuplicateDefinition", func(t *testing.T) {
+		require.Panics(t, func() {
+			RegisterGameTypeDefinition(GameTypeDefinition{GameType: alphabetGameType, TraceType: config.TraceTypeAlphabet})
+		})
+	})
+}
+
+type stubRegistry struct {
+	gameTypes []uint8
+}
+
+func (s *stubRegistry) RegisterGameType(gameType uint8, _ scheduler.PlayerCreator) {
+	s.gameTypes = append(s.gameTypes, gameType)
+}
@@ -0,0 +1,36 @@
+package fault
+
+import (
+	"sync/atomic"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+)
+
+// IncidentMode controls whether offensive moves are frozen.
+// Offensive moves challenge a root claim posted by another actor. All other actions counter claims that dispute
+// one of our own claims or a root claim we agree with, so are defensive and continue while incident mode is enabled.
+// Resolutions are never affected.
+// It is safe to use from multiple goroutines so can be toggled at runtime while games are being progressed.
+type IncidentMode struct {
+	enabled atomic.Bool
+}
+
+func NewIncidentMode(enabled bool) *IncidentMode {
+	m := &IncidentMode{}
+	m.enabled.Store(enabled)
+	return m
+}
+
+func (m *IncidentMode) Enabled() bool {
+	return m.enabled.Load()
+}
+
+func (m *IncidentMode) SetEnabled(enabled bool) {
+	m.enabled.Store(enabled)
+}
+
+// isOffensive returns true if action challenges the root claim.
+// The solver only counters the root claim when it disagrees with it, so every action against it is offensive.
+func isOffensive(action types.Action) bool {
+	return action.ParentIdx == 0
+}
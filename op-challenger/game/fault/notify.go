@@ -0,0 +1,53 @@
+package fault
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
+)
+
+// notifyingResponder sends a notification for each action that is successfully performed.
+type notifyingResponder struct {
+	Responder
+	addr     common.Address
+	notifier notify.Notifier
+}
+
+func (r *notifyingResponder) PerformAction(ctx context.Context, action types.Action) error {
+	if err := r.Responder.PerformAction(ctx, action); err != nil {
+		return err
+	}
+	_ = r.notifier.Notify(ctx, actionEvent(r.addr, action))
+	return nil
+}
+
+func actionEvent(addr common.Address, action types.Action) notify.Event {
+	moveType := "Defended"
+	if action.IsAttack {
+		moveType = "Attacked"
+	}
+	details := map[string]string{
+		"parent": strconv.Itoa(action.ParentIdx),
+		"attack": strconv.FormatBool(action.IsAttack),
+	}
+	if action.Type == types.ActionTypeStep {
+		return notify.Event{
+			Type:    notify.EventStepExecuted,
+			Game:    addr,
+			Message: fmt.Sprintf("%v claim %v with step", moveType, action.ParentIdx),
+			Details: details,
+		}
+	}
+	details["value"] = action.Value.Hex()
+	return notify.Event{
+		Type:    notify.EventMoveSubmitted,
+		Game:    addr,
+		Message: fmt.Sprintf("%v claim %v", moveType, action.ParentIdx),
+		Details: details,
+	}
+}
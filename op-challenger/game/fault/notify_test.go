@@ -0,0 +1,50 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
+)
+
+func TestNotifyingResponder(t *testing.T) {
+	addr := common.Address{0xaa}
+
+	t.Run("Move", func(t *testing.T) {
+		notifier := &stubNotifier{}
+		r := &notifyingResponder{Responder: &stubResponder{}, addr: addr, notifier: notifier}
+		action := types.Action{Type: types.ActionTypeMove, ParentIdx: 3, IsAttack: true, Value: common.Hash{0x01}}
+		require.NoError(t, r.PerformAction(context.Background(), action))
+		require.Equal(t, []notify.Event{{
+			Type:    notify.EventMoveSubmitted,
+			Game:    addr,
+			Message: "Attacked claim 3",
+			Details: map[string]string{"parent": "3", "attack": "true", "value": common.Hash{0x01}.Hex()},
+		}}, notifier.events)
+	})
+
+	t.Run("Step", func(t *testing.T) {
+		notifier := &stubNotifier{}
+		r := &notifyingResponder{Responder: &stubResponder{}, addr: addr, notifier: notifier}
+		action := types.Action{Type: types.ActionTypeStep, ParentIdx: 5, IsAttack: false}
+		require.NoError(t, r.PerformAction(context.Background(), action))
+		require.Equal(t, []notify.Event{{
+			Type:    notify.EventStepExecuted,
+			Game:    addr,
+			Message: "Defended claim 5 with step",
+			Details: map[string]string{"parent": "5", "attack": "false"},
+		}}, notifier.events)
+	})
+
+	t.Run("NotNotifiedWhenActionFails", func(t *testing.T) {
+		notifier := &stubNotifier{}
+		r := &notifyingResponder{Responder: &stubResponder{performActionErr: errors.New("boom")}, addr: addr, notifier: notifier}
+		require.Error(t, r.PerformAction(context.Background(), types.Action{Type: types.ActionTypeMove}))
+		require.Empty(t, notifier.events)
+	})
+}
@@ -2,41 +2,102 @@ package fault
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/responder"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/transcript"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type actor func(ctx context.Context) error
 
+type deadlineReporter func() (time.Time, bool)
+
+type forecastReporter func() (gameTypes.Forecast, bool)
+
 type GameInfo interface {
 	GetStatus(context.Context) (gameTypes.GameStatus, error)
 	GetClaimCount(context.Context) (uint64, error)
 }
 
 type GamePlayer struct {
+	addr               common.Address
 	act                actor
+	clockDeadline      deadlineReporter
+	forecast           forecastReporter
 	loader             GameInfo
 	logger             log.Logger
 	prestateValidators []Validator
 	status             gameTypes.GameStatus
+
+	syncValidator SyncValidator
+	gameL1Head    eth.BlockID
+	// store records the state of the game. It is nil if game state is not persisted.
+	store *store.Game
+	// outOfSync is true if the game was last skipped because the local node was not in sync.
+	outOfSync bool
+	// archive archives the game once it is resolved. It is nil if games are not archived.
+	archive actor
+	// unarchived is the status the game resolved with if archiving it failed.
+	unarchived *gameTypes.GameStatus
+	notifier   notify.Notifier
 }
 
 type GameContract interface {
 	responder.GameContract
+	transcript.GameSource
 	GameInfo
 	ClaimLoader
+	ClaimFetcher
 	GetStatus(ctx context.Context) (gameTypes.GameStatus, error)
 	GetMaxGameDepth(ctx context.Context) (uint64, error)
+	GetGameDuration(ctx context.Context) (uint64, error)
+	GetL1Head(ctx context.Context) (common.Hash, error)
+}
+
+// GameArchiver archives resolved games before their local state is removed.
+type GameArchiver interface {
+	Archive(ctx context.Context, addr common.Address, source transcript.GameSource, game *store.Game) error
 }
 
-type resourceCreator func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (types.TraceAccessor, error)
+type L1HeaderSource interface {
+	HeaderByHash(context.Context, common.Hash) (*ethtypes.Header, error)
+}
+
+// TraceAccessorCreator creates the honest trace accessor for a game with the specified max depth.
+// The dir is used to persist any trace data.
+type TraceAccessorCreator func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (types.TraceAccessor, error)
+
+// SolverCreator creates the solver that determines how to respond to claims in a game.
+type SolverCreator func(logger log.Logger, gameDepth uint64, accessor types.TraceAccessor) *solver.GameSolver
+
+// newGameSolver creates a solver that responds to claims at any depth.
+func newGameSolver(logger log.Logger, gameDepth uint64, accessor types.TraceAccessor) *solver.GameSolver {
+	return solver.NewGameSolver(logger, int(gameDepth), accessor)
+}
+
+// newExecutionOnlySolver returns a SolverCreator for solvers that only respond to claims in the execution (bottom)
+// half of split games.
+func newExecutionOnlySolver(splitDepth uint64) SolverCreator {
+	return func(logger log.Logger, gameDepth uint64, accessor types.TraceAccessor) *solver.GameSolver {
+		return solver.NewExecutionOnlyGameSolver(logger, int(gameDepth), int(splitDepth), accessor)
+	}
+}
 
 func NewGamePlayer(
 	ctx context.Context,
@@ -47,53 +108,181 @@ func NewGamePlayer(
 	txMgr txmgr.TxManager,
 	loader GameContract,
 	validators []Validator,
-	creator resourceCreator,
+	syncValidator SyncValidator,
+	incidentMode *IncidentMode,
+	gameStore *store.Store,
+	archiver GameArchiver,
+	creator TraceAccessorCreator,
+	newSolver SolverCreator,
+	l1HeaderSource L1HeaderSource,
+	moveLogs MoveLogSource,
+	ours []common.Address,
+	multicall responder.Multicall,
+	gasEstimator responder.GasEstimator,
+	resolutionCheckInterval time.Duration,
+	notifier notify.Notifier,
 ) (*GamePlayer, error) {
 	logger = logger.New("game", addr)
+	if notifier == nil {
+		notifier = notify.NoopNotifier{}
+	}
 
-	status, err := loader.GetStatus(ctx)
+	var record *store.Game
+	if gameStore != nil {
+		record = gameStore.Game(addr)
+	}
+	var archive actor
+	if archiver != nil {
+		archive = func(ctx context.Context) error {
+			return archiver.Archive(ctx, addr, loader, record)
+		}
+	}
+	status, resolvedSinceEnrolled, err := loadStatus(ctx, logger, loader, record)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch game status: %w", err)
+		return nil, err
 	}
 	if status != gameTypes.GameStatusInProgress {
 		logger.Info("Game already resolved", "status", status)
 		// Game is already complete so skip creating the trace provider, loading game inputs etc.
-		return &GamePlayer{
-			logger:             logger,
-			loader:             loader,
-			prestateValidators: validators,
-			status:             status,
+		// The prestate isn't validated as the game won't be acted on, and it may predate a change to the local prestate.
+		player := &GamePlayer{
+			addr:          addr,
+			logger:        logger,
+			loader:        loader,
+			status:        status,
+			syncValidator: syncValidator,
+			store:         record,
+			archive:       archive,
+			notifier:      notifier,
 			// Act function does nothing because the game is already complete
 			act: func(ctx context.Context) error {
 				return nil
 			},
-		}, nil
+		}
+		if resolvedSinceEnrolled {
+			// The game resolved while the challenger was stopped, so its resolution hasn't been recorded yet.
+			player.status = gameTypes.GameStatusInProgress
+			player.complete(ctx, status)
+		}
+		return player, nil
 	}
 
+	l1HeadHash, err := loader.GetL1Head(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load game L1 head: %w", err)
+	}
+	l1Header, err := l1HeaderSource.HeaderByHash(ctx, l1HeadHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load L1 header %v: %w", l1HeadHash, err)
+	}
+	l1Head := eth.BlockID{Hash: l1Header.Hash(), Number: l1Header.Number.Uint64()}
+
 	gameDepth, err := loader.GetMaxGameDepth(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch the game depth: %w", err)
 	}
+	if err := types.ValidateGameDepth(gameDepth); err != nil {
+		return nil, err
+	}
+
+	gameDuration, err := loader.GetGameDuration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the game duration: %w", err)
+	}
+	// Each team's chess clock has half the game duration available.
+	maxClockDuration := time.Duration(gameDuration/2) * time.Second
 
 	accessor, err := creator(ctx, logger, gameDepth, dir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create trace accessor: %w", err)
 	}
 
-	responder, err := responder.NewFaultResponder(logger, txMgr, loader)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create the responder: %w", err)
+	// Claims are fetched incrementally, only reloading claims that are new or may have changed since the last update.
+	var claimStore ClaimStore = &memoryClaimStore{}
+	if record != nil {
+		markInterruptedMoves(logger, record)
+		claimStore = record
+	}
+	claimLoader := newStoredClaimLoader(logger, loader, claimStore, int(gameDepth))
+	// The claims loaded by the agent are shared with the responder to check for duplicate moves.
+	claims := newClaimSnapshot(claimLoader, clock.SystemClock)
+
+	var gameResponder Responder
+	if txMgr == nil {
+		// No signing key was configured so the game is monitored but never responded to.
+		gameResponder = responder.NewReadOnlyResponder(logger, loader)
+	} else {
+		faultResponder, err := responder.NewFaultResponder(logger, m, txMgr, loader, claims)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the responder: %w", err)
+		}
+		if multicall != nil {
+			faultResponder.WithMulticall(multicall, gasEstimator)
+		}
+		gameResponder = &notifyingResponder{Responder: faultResponder, addr: addr, notifier: notifier}
+	}
+	if record != nil {
+		gameResponder = &recordingResponder{Responder: gameResponder, logger: logger, store: record}
+	}
+	// The game was created after its L1 head so the search for its Move events starts there.
+	if _, err := attachToGame(ctx, logger, claims, moveLogs, addr, l1Head.Number, gameDepth, ours, record); err != nil {
+		logger.Warn("Failed to reconstruct game state", "err", err)
 	}
 
-	agent := NewAgent(m, loader, int(gameDepth), accessor, responder, logger)
+	gameSolver := newSolver(logger, gameDepth, accessor).WithChessClock(clock.SystemClock, maxClockDuration)
+	agent := NewAgent(m, claims, int(gameDepth), gameSolver, gameResponder, syncValidator, incidentMode, l1Head, logger).
+		WithDiagnosticsDir(dir).
+		WithResolutionCheckInterval(clock.SystemClock, resolutionCheckInterval)
+	if record != nil {
+		agent.WithAssessmentRecorder(record)
+	}
 	return &GamePlayer{
-		act:    agent.Act,
-		loader: loader,
-		logger: logger,
-		status: status,
+		addr:               addr,
+		act:                agent.Act,
+		clockDeadline:      agent.ClockDeadline,
+		forecast:           agent.Forecast,
+		loader:             loader,
+		logger:             logger,
+		prestateValidators: validators,
+		status:             status,
+		syncValidator:      syncValidator,
+		gameL1Head:         l1Head,
+		store:              record,
+		archive:            archive,
+		notifier:           notifier,
 	}, nil
 }
 
+// loadStatus returns the status of the game, using the stored status if the game is already known to be resolved.
+// The status is stored when a game is first seen, enrolling it in the store.
+// Returns true if the game was enrolled while in progress but has since resolved. The resolved status is not stored
+// in that case, as storing it removes the local game state which must be archived first.
+func loadStatus(ctx context.Context, logger log.Logger, loader GameInfo, record *store.Game) (gameTypes.GameStatus, bool, error) {
+	enrolled := false
+	if record != nil {
+		status, ok, err := record.Status()
+		if err != nil {
+			logger.Warn("Failed to load stored game status", "err", err)
+		} else if ok && status != gameTypes.GameStatusInProgress {
+			return status, false, nil
+		}
+		enrolled = ok && err == nil
+	}
+	status, err := loader.GetStatus(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to fetch game status: %w", err)
+	}
+	if enrolled {
+		return status, status != gameTypes.GameStatusInProgress, nil
+	}
+	if record != nil {
+		if err := record.SetStatus(status); err != nil {
+			logger.Warn("Failed to store game status", "err", err)
+		}
+	}
+	return status, false, nil
+}
+
 func (g *GamePlayer) ValidatePrestate(ctx context.Context) error {
 	for _, validator := range g.prestateValidators {
 		if err := validator.Validate(ctx); err != nil {
@@ -107,14 +296,57 @@ func (g *GamePlayer) Status() gameTypes.GameStatus {
 	return g.status
 }
 
+// ClockDeadline returns the earliest chess clock deadline of any actions the player still needs to perform.
+// Returns false if there are no such actions.
+func (g *GamePlayer) ClockDeadline() (time.Time, bool) {
+	if g.clockDeadline == nil {
+		return time.Time{}, false
+	}
+	return g.clockDeadline()
+}
+
+// Forecast returns the forecast of the game's result from the last time the player evaluated the game.
+// Returns false if the game has not been evaluated, which is always the case for games that were already resolved
+// when the player was created.
+func (g *GamePlayer) Forecast() (gameTypes.Forecast, bool) {
+	if g.forecast == nil {
+		return gameTypes.Forecast{}, false
+	}
+	return g.forecast()
+}
+
 func (g *GamePlayer) ProgressGame(ctx context.Context) gameTypes.GameStatus {
 	if g.status != gameTypes.GameStatusInProgress {
 		// Game is already complete so don't try to perform further actions.
 		g.logger.Trace("Skipping completed game")
 		return g.status
 	}
+	if g.unarchived != nil {
+		// The game is resolved but archiving it failed, so retry without acting on it.
+		return g.complete(ctx, *g.unarchived)
+	}
+	ctx, span := tracer.Start(ctx, "GamePlayer.ProgressGame", trace.WithAttributes(attribute.String("game", g.addr.Hex())))
+	defer span.End()
+	if err := g.syncValidator.ValidateNodeSynced(ctx, g.gameL1Head); errors.Is(err, ErrNotInSync) {
+		if !g.outOfSync {
+			g.logger.Warn("Local node not sufficiently up to date, deferring game evaluation", "err", err)
+		}
+		g.outOfSync = true
+		return g.status
+	} else if err != nil {
+		g.logger.Error("Unable to check local node sync status", "err", err)
+		return g.status
+	}
+	if g.outOfSync {
+		// Nothing was evaluated while the node was out of sync, so acting now re-evaluates the game from scratch.
+		g.logger.Info("Local node back in sync, re-evaluating game")
+		g.outOfSync = false
+	}
 	g.logger.Trace("Checking if actions are required")
-	if err := g.act(ctx); err != nil {
+	if err := g.act(ctx); errors.Is(err, ErrNotInSync) {
+		g.logger.Warn("Local node fell out of sync while acting on game, unsent actions dropped", "err", err)
+		g.outOfSync = true
+	} else if err != nil {
 		g.logger.Error("Error when acting on game", "err", err)
 	}
 	status, err := g.loader.GetStatus(ctx)
@@ -122,11 +354,61 @@ func (g *GamePlayer) ProgressGame(ctx context.Context) gameTypes.GameStatus {
 		g.logger.Warn("Unable to retrieve game status", "err", err)
 		return gameTypes.GameStatusInProgress
 	}
+	span.SetAttributes(attribute.String("status", status.String()))
 	g.logGameStatus(ctx, status)
+	if status != gameTypes.GameStatusInProgress {
+		return g.complete(ctx, status)
+	}
+	return status
+}
+
+// complete records that the game resolved with status, archiving it first if archiving is enabled.
+// If archiving fails the game is reported as still in progress so that its local state is kept, and archiving is
+// retried the next time the game is progressed.
+func (g *GamePlayer) complete(ctx context.Context, status gameTypes.GameStatus) gameTypes.GameStatus {
+	if g.archive != nil {
+		if err := g.archive(ctx); err != nil {
+			g.logger.Error("Failed to archive resolved game, retaining local state", "status", status, "err", err)
+			g.unarchived = &status
+			return gameTypes.GameStatusInProgress
+		}
+	}
+	g.unarchived = nil
+	g.notifyResolved(ctx, status)
+	if g.store != nil {
+		if err := g.store.SetStatus(status); err != nil {
+			g.logger.Warn("Failed to store game status", "err", err)
+		}
+	}
 	g.status = status
 	return status
 }
 
+// notifyResolved sends a notification that the game resolved with status, and an additional notification if the
+// local trace expected a different result.
+func (g *GamePlayer) notifyResolved(ctx context.Context, status gameTypes.GameStatus) {
+	details := map[string]string{"status": status.String()}
+	forecast, ok := g.Forecast()
+	if ok {
+		details["expected"] = forecast.Expected.String()
+	}
+	_ = g.notifier.Notify(ctx, notify.Event{
+		Type:    notify.EventGameResolved,
+		Game:    g.addr,
+		Message: fmt.Sprintf("Game resolved: %v", status),
+		Details: details,
+	})
+	if ok && status != forecast.Expected {
+		g.logger.Error("Game resolved with incorrect result", "status", status, "expected", forecast.Expected)
+		_ = g.notifier.Notify(ctx, notify.Event{
+			Type:    notify.EventIncorrectResolution,
+			Game:    g.addr,
+			Message: fmt.Sprintf("Game resolved as %v but the local trace expected %v", status, forecast.Expected),
+			Details: details,
+		})
+	}
+}
+
 func (g *GamePlayer) logGameStatus(ctx context.Context, status gameTypes.GameStatus) {
 	if status == gameTypes.GameStatusInProgress {
 		claimCount, err := g.loader.GetClaimCount(ctx)
@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -145,6 +148,41 @@ func (m *mockValidator) Validate(ctx context.Context) error {
 	return nil
 }
 
+func TestProgressGame_NodeNotInSync(t *testing.T) {
+	handler, game, gameState := setupProgressGameTest(t)
+	validator := game.syncValidator.(*stubSyncValidator)
+	validator.result = ErrNotInSync
+
+	status := game.ProgressGame(context.Background())
+	require.Equal(t, types.GameStatusInProgress, status)
+	require.Zero(t, gameState.callCount, "should not act when node is not in sync")
+	require.NotNil(t, handler.FindLog(log.LvlWarn, "Local node not sufficiently up to date, deferring game evaluation"))
+
+	validator.result = nil
+	game.ProgressGame(context.Background())
+	require.Equal(t, 1, gameState.callCount, "should re-evaluate game once node is in sync")
+	require.NotNil(t, handler.FindLog(log.LvlInfo, "Local node back in sync, re-evaluating game"))
+}
+
+func TestProgressGame_SyncCheckFails(t *testing.T) {
+	handler, game, gameState := setupProgressGameTest(t)
+	game.syncValidator.(*stubSyncValidator).result = errors.New("boom")
+
+	status := game.ProgressGame(context.Background())
+	require.Equal(t, types.GameStatusInProgress, status)
+	require.Zero(t, gameState.callCount, "should not act when sync status is unknown")
+	require.NotNil(t, handler.FindLog(log.LvlError, "Unable to check local node sync status"))
+}
+
+func TestProgressGame_NodeFellOutOfSyncWhileActing(t *testing.T) {
+	handler, game, gameState := setupProgressGameTest(t)
+	gameState.actErr = fmt.Errorf("dropping 1 unsent actions: %w", ErrNotInSync)
+
+	game.ProgressGame(context.Background())
+	require.NotNil(t, handler.FindLog(log.LvlWarn, "Local node fell out of sync while acting on game, unsent actions dropped"))
+	require.True(t, game.outOfSync)
+}
+
 func setupProgressGameTest(t *testing.T) (*testlog.CapturingHandler, *GamePlayer, *stubGameState) {
 	logger := testlog.Logger(t, log.LvlDebug)
 	handler := &testlog.CapturingHandler{
@@ -153,13 +191,23 @@ func setupProgressGameTest(t *testing.T) (*testlog.CapturingHandler, *GamePlayer
 	logger.SetHandler(handler)
 	gameState := &stubGameState{claimCount: 1}
 	game := &GamePlayer{
-		act:    gameState.Act,
-		loader: gameState,
-		logger: logger,
+		act:           gameState.Act,
+		loader:        gameState,
+		logger:        logger,
+		syncValidator: &stubSyncValidator{},
+		notifier:      notify.NoopNotifier{},
 	}
 	return handler, game, gameState
 }
 
+type stubSyncValidator struct {
+	result error
+}
+
+func (s *stubSyncValidator) ValidateNodeSynced(_ context.Context, _ eth.BlockID) error {
+	return s.result
+}
+
 type stubGameState struct {
 	status     types.GameStatus
 	claimCount uint64
@@ -184,3 +232,177 @@ func (s *stubGameState) GetClaimCount(ctx context.Context) (uint64, error) {
 func (s *stubGameState) GetAbsolutePrestateHash(ctx context.Context) (common.Hash, error) {
 	return common.Hash{}, s.Err
 }
+
+func TestProgressGame_StoresStatus(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t)
+	game.store = newTestGameStore(t).Game(common.Address{0xaa})
+	gameState.status = types.GameStatusDefenderWon
+	game.ProgressGame(context.Background())
+
+	status, ok, err := game.store.Status()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, types.GameStatusDefenderWon, status)
+}
+
+func TestProgressGame_NotifiesResolution(t *testing.T) {
+	t.Run("Resolved", func(t *testing.T) {
+		_, game, gameState := setupProgressGameTest(t)
+		notifier := &stubNotifier{}
+		game.notifier = notifier
+		game.forecast = func() (types.Forecast, bool) {
+			return types.Forecast{Expected: types.GameStatusDefenderWon, Projected: types.GameStatusDefenderWon}, true
+		}
+		gameState.status = types.GameStatusDefenderWon
+		game.ProgressGame(context.Background())
+
+		require.Len(t, notifier.events, 1)
+		require.Equal(t, notify.EventGameResolved, notifier.events[0].Type)
+		require.Equal(t, map[string]string{"status": "Defender Won", "expected": "Defender Won"}, notifier.events[0].Details)
+	})
+
+	t.Run("IncorrectResolution", func(t *testing.T) {
+		_, game, gameState := setupProgressGameTest(t)
+		notifier := &stubNotifier{}
+		game.notifier = notifier
+		game.forecast = func() (types.Forecast, bool) {
+			return types.Forecast{Expected: types.GameStatusChallengerWon, Projected: types.GameStatusDefenderWon}, true
+		}
+		gameState.status = types.GameStatusDefenderWon
+		game.ProgressGame(context.Background())
+
+		require.Len(t, notifier.events, 2)
+		require.Equal(t, notify.EventGameResolved, notifier.events[0].Type)
+		require.Equal(t, notify.EventIncorrectResolution, notifier.events[1].Type)
+	})
+
+	t.Run("NotNotifiedWhileInProgress", func(t *testing.T) {
+		_, game, _ := setupProgressGameTest(t)
+		notifier := &stubNotifier{}
+		game.notifier = notifier
+		game.ProgressGame(context.Background())
+		require.Empty(t, notifier.events)
+	})
+}
+
+type stubNotifier struct {
+	events []notify.Event
+}
+
+func (s *stubNotifier) Notify(_ context.Context, event notify.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestLoadStatus(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+
+	t.Run("EnrollsNewGame", func(t *testing.T) {
+		record := newTestGameStore(t).Game(common.Address{0xaa})
+		status, resolvedSinceEnrolled, err := loadStatus(context.Background(), logger, &stubGameState{status: types.GameStatusInProgress}, record)
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusInProgress, status)
+		require.False(t, resolvedSinceEnrolled)
+		_, ok, err := record.Status()
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("EnrollsNewResolvedGame", func(t *testing.T) {
+		record := newTestGameStore(t).Game(common.Address{0xaa})
+		status, resolvedSinceEnrolled, err := loadStatus(context.Background(), logger, &stubGameState{status: types.GameStatusDefenderWon}, record)
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusDefenderWon, status)
+		require.False(t, resolvedSinceEnrolled, "no local state to archive for games never played")
+		stored, ok, err := record.Status()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, types.GameStatusDefenderWon, stored)
+	})
+
+	t.Run("UsesStoredResolvedStatus", func(t *testing.T) {
+		record := newTestGameStore(t).Game(common.Address{0xaa})
+		require.NoError(t, record.SetStatus(types.GameStatusChallengerWon))
+		status, resolvedSinceEnrolled, err := loadStatus(context.Background(), logger, &stubGameState{status: types.GameStatusInProgress}, record)
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusChallengerWon, status)
+		require.False(t, resolvedSinceEnrolled)
+	})
+
+	t.Run("RefreshesInProgressStatus", func(t *testing.T) {
+		record := newTestGameStore(t).Game(common.Address{0xaa})
+		require.NoError(t, record.SetStatus(types.GameStatusInProgress))
+		status, resolvedSinceEnrolled, err := loadStatus(context.Background(), logger, &stubGameState{status: types.GameStatusDefenderWon}, record)
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusDefenderWon, status)
+		require.True(t, resolvedSinceEnrolled)
+
+		stored, _, err := record.Status()
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusInProgress, stored, "should not discard local state before it is archived")
+	})
+}
+
+func TestProgressGame_Archive(t *testing.T) {
+	t.Run("ArchivesBeforeStoringStatus", func(t *testing.T) {
+		_, game, gameState := setupProgressGameTest(t)
+		game.store = newTestGameStore(t).Game(common.Address{0xaa})
+		require.NoError(t, game.store.SetStatus(types.GameStatusInProgress))
+		archiver := &stubArchiver{store: game.store}
+		game.archive = archiver.Archive
+		gameState.status = types.GameStatusDefenderWon
+
+		status := game.ProgressGame(context.Background())
+		require.Equal(t, types.GameStatusDefenderWon, status)
+		require.Equal(t, 1, archiver.calls)
+		require.Equal(t, types.GameStatusInProgress, archiver.storedStatus, "should archive before discarding local state")
+		stored, _, err := game.store.Status()
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusDefenderWon, stored)
+
+		game.ProgressGame(context.Background())
+		require.Equal(t, 1, archiver.calls, "should not archive again")
+	})
+
+	t.Run("RetriesFailedArchive", func(t *testing.T) {
+		handler, game, gameState := setupProgressGameTest(t)
+		game.store = newTestGameStore(t).Game(common.Address{0xaa})
+		require.NoError(t, game.store.SetStatus(types.GameStatusInProgress))
+		archiver := &stubArchiver{store: game.store, err: errors.New("boom")}
+		game.archive = archiver.Archive
+		gameState.status = types.GameStatusChallengerWon
+
+		status := game.ProgressGame(context.Background())
+		require.Equal(t, types.GameStatusInProgress, status, "should keep local state until archived")
+		require.NotNil(t, handler.FindLog(log.LvlError, "Failed to archive resolved game, retaining local state"))
+		stored, _, err := game.store.Status()
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusInProgress, stored)
+
+		archiver.err = nil
+		status = game.ProgressGame(context.Background())
+		require.Equal(t, types.GameStatusChallengerWon, status)
+		require.Equal(t, 2, archiver.calls)
+		require.Equal(t, 1, gameState.callCount, "should not act on the game while retrying the archive")
+		stored, _, err = game.store.Status()
+		require.NoError(t, err)
+		require.Equal(t, types.GameStatusChallengerWon, stored)
+	})
+}
+
+type stubArchiver struct {
+	store        *store.Game
+	err          error
+	calls        int
+	storedStatus types.GameStatus
+}
+
+func (s *stubArchiver) Archive(_ context.Context) error {
+	s.calls++
+	status, _, err := s.store.Status()
+	if err != nil {
+		return err
+	}
+	s.storedStatus = status
+	return s.err
+}
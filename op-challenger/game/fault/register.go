@@ -2,195 +2,258 @@ package fault
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/responder"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 )
 
-var (
-	cannonGameType         = uint8(0)
-	outputCannonGameType   = uint8(1)
-	outputAlphabetGameType = uint8(254)
-	alphabetGameType       = uint8(255)
-)
+// ErrUnsupportedGameType is returned when no trace type is enabled that can provide the trace for a game type.
+var ErrUnsupportedGameType = errors.New("unsupported game type")
 
 type CloseFunc func()
 
+// RollupClient is the rollup node API used by output based game types.
+type RollupClient interface {
+	outputs.OutputRollupClient
+	SyncStatusProvider
+}
+
 type Registry interface {
 	RegisterGameType(gameType uint8, creator scheduler.PlayerCreator)
 }
 
+// RegisterGameTypes registers the players for each game type with an enabled trace type.
+// Each game sends transactions from the signer assigned to it by signers.
+// If signers is nil, players are read-only and never send transactions.
+// Offensive moves are skipped by all players while incidentMode is enabled.
+// If gameStore is not nil, players persist the state of their game to it so it survives restarts.
+// If a multicall address is configured, claims are resolved in batches sized using gasEstimator.
+// Games already in progress are attached to by identifying the claims posted by signers from the Move events in
+// moveLogs.
 func RegisterGameTypes(
 	registry Registry,
 	ctx context.Context,
 	logger log.Logger,
 	m metrics.Metricer,
 	cfg *config.Config,
-	rollupClient outputs.OutputRollupClient,
-	txMgr txmgr.TxManager,
+	rollupClient RollupClient,
+	signers *responder.SignerPool,
 	caller *batching.MultiCaller,
+	l1HeaderSource L1HeaderSource,
+	moveLogs MoveLogSource,
+	gasEstimator responder.GasEstimator,
+	incidentMode *IncidentMode,
+	gameStore *store.Store,
+	archiver GameArchiver,
+	notifier notify.Notifier,
 ) (CloseFunc, error) {
+	// Avoid passing a typed nil so the responders only batch resolutions when a multicall contract is configured.
+	var multicall responder.Multicall
+	if cfg.MulticallAddress != (common.Address{}) {
+		contract, err := contracts.NewMulticallContract(cfg.MulticallAddress, caller)
+		if err != nil {
+			return nil, err
+		}
+		multicall = contract
+	}
 	var closer CloseFunc
-	var l2Client *ethclient.Client
-	if cfg.TraceTypeEnabled(config.TraceTypeCannon) || cfg.TraceTypeEnabled(config.TraceTypeOutputCannon) {
+	deps := &GameTypeDependencies{
+		Logger:       logger,
+		Metrics:      m,
+		Config:       cfg,
+		Caller:       caller,
+		RollupClient: rollupClient,
+	}
+	if RequiresL2Client(cfg) {
 		l2, err := ethclient.DialContext(ctx, cfg.CannonL2)
 		if err != nil {
 			return nil, fmt.Errorf("dial l2 client %v: %w", cfg.CannonL2, err)
 		}
-		l2Client = l2
-		closer = l2Client.Close
+		deps.L2Client = l2
+		closer = l2.Close
 	}
-	if cfg.TraceTypeEnabled(config.TraceTypeOutputCannon) {
-		registerOutputCannon(registry, ctx, logger, m, cfg, rollupClient, txMgr, caller, l2Client)
+	for _, def := range enabledGameTypes(cfg) {
+		def := def
+		playerCreator := func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
+			res, err := def.NewResources(ctx, deps, game.Proxy)
+			if err != nil {
+				return nil, err
+			}
+			// Avoid passing a typed nil so the game players can detect read-only mode.
+			var txMgr txmgr.TxManager
+			var ours []common.Address
+			if signers != nil {
+				txMgr = signers.ForGame(game.Proxy)
+				// Claims from any signer in the pool are ours, as games may have been assigned differently before a restart.
+				ours = signers.Addresses()
+			}
+			return NewGamePlayer(ctx, logger, m, dir, game.Proxy, txMgr, res.Contract, res.Validators, res.SyncValidator, incidentMode, gameStore, archiver, res.TraceAccessor, configureSolver(cfg, res.NewSolver), l1HeaderSource, moveLogs, ours, multicall, gasEstimator, cfg.ResolutionCheckInterval, notifier)
+		}
+		registry.RegisterGameType(def.GameType, playerCreator)
 	}
-	if cfg.TraceTypeEnabled(config.TraceTypeOutputAlphabet) {
-		registerOutputAlphabet(registry, ctx, logger, m, rollupClient, txMgr, caller)
+	return closer, nil
+}
+
+// configureSolver applies the solver options from cfg to the solvers created by newSolver.
+func configureSolver(cfg *config.Config, newSolver SolverCreator) SolverCreator {
+	return func(logger log.Logger, gameDepth uint64, accessor faultTypes.TraceAccessor) *solver.GameSolver {
+		return newSolver(logger, gameDepth, accessor).WithDefense(!cfg.IgnoreValidRootClaims)
 	}
-	if cfg.TraceTypeEnabled(config.TraceTypeCannon) {
-		registerCannon(registry, ctx, logger, m, cfg, txMgr, caller, l2Client)
+}
+
+// outputSolverCreator returns the SolverCreator for an output bisection game, restricting responses to the
+// execution half of the game if configured.
+func outputSolverCreator(ctx context.Context, cfg *config.Config, contract *contracts.OutputBisectionGameContract) (SolverCreator, error) {
+	if !cfg.ExecutionDepthOnly {
+		return newGameSolver, nil
 	}
-	if cfg.TraceTypeEnabled(config.TraceTypeAlphabet) {
-		registerAlphabet(registry, ctx, logger, m, cfg.AlphabetTrace, txMgr, caller)
+	splitDepth, err := contract.GetSplitDepth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load split depth: %w", err)
 	}
-	return closer, nil
+	return newExecutionOnlySolver(splitDepth), nil
 }
 
-func registerOutputAlphabet(
-	registry Registry,
+func outputAlphabetResources(
 	ctx context.Context,
 	logger log.Logger,
 	m metrics.Metricer,
 	rollupClient outputs.OutputRollupClient,
-	txMgr txmgr.TxManager,
-	caller *batching.MultiCaller) {
-	playerCreator := func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
-		contract, err := contracts.NewOutputBisectionGameContract(game.Proxy, caller)
-		if err != nil {
-			return nil, err
-		}
-		prestateBlock, poststateBlock, err := contract.GetBlockRange(ctx)
-		if err != nil {
+	contract *contracts.OutputBisectionGameContract) (faultTypes.PrestateProvider, TraceAccessorCreator, error) {
+	prestateBlock, poststateBlock, err := contract.GetBlockRange(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	prestateProvider := outputs.NewPrestateProvider(ctx, logger, rollupClient, prestateBlock)
+	splitDepth, err := contract.GetSplitDepth(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	creator := func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (faultTypes.TraceAccessor, error) {
+		if err := faultTypes.ValidateSplitDepth(gameDepth, splitDepth); err != nil {
 			return nil, err
 		}
-		prestateProvider := outputs.NewPrestateProvider(ctx, logger, rollupClient, prestateBlock)
-		splitDepth, err := contract.GetSplitDepth(ctx)
+		accessor, err := outputs.NewOutputAlphabetTraceAccessor(logger, m, prestateProvider, rollupClient, splitDepth, prestateBlock, poststateBlock)
 		if err != nil {
 			return nil, err
 		}
-		creator := func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (faultTypes.TraceAccessor, error) {
-			accessor, err := outputs.NewOutputAlphabetTraceAccessor(logger, m, prestateProvider, rollupClient, splitDepth, prestateBlock, poststateBlock)
-			if err != nil {
-				return nil, err
-			}
-			return accessor, nil
-		}
-		prestateValidator := NewPrestateValidator(contract.GetAbsolutePrestateHash, prestateProvider)
-		genesisValidator := NewPrestateValidator(contract.GetGenesisOutputRoot, prestateProvider)
-		return NewGamePlayer(ctx, logger, m, dir, game.Proxy, txMgr, contract, []Validator{prestateValidator, genesisValidator}, creator)
+		return accessor, nil
 	}
-	registry.RegisterGameType(outputAlphabetGameType, playerCreator)
+	return prestateProvider, creator, nil
 }
 
-func registerOutputCannon(
-	registry Registry,
+func outputCannonResources(
 	ctx context.Context,
 	logger log.Logger,
-	m metrics.Metricer,
+	m metrics.GameMetricer,
 	cfg *config.Config,
 	rollupClient outputs.OutputRollupClient,
-	txMgr txmgr.TxManager,
-	caller *batching.MultiCaller,
-	l2Client cannon.L2HeaderSource) {
-	playerCreator := func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
-		contract, err := contracts.NewOutputBisectionGameContract(game.Proxy, caller)
+	l2Client cannon.L2HeaderSource,
+	contract *contracts.OutputBisectionGameContract) (faultTypes.PrestateProvider, TraceAccessorCreator, error) {
+	prestateBlock, poststateBlock, err := contract.GetBlockRange(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	prestateProvider := outputs.NewPrestateProvider(ctx, logger, rollupClient, prestateBlock)
+	creator := func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (faultTypes.TraceAccessor, error) {
+		splitDepth, err := contract.GetSplitDepth(ctx)
 		if err != nil {
+			return nil, fmt.Errorf("failed to load split depth: %w", err)
+		}
+		if err := faultTypes.ValidateSplitDepth(gameDepth, splitDepth); err != nil {
 			return nil, err
 		}
-		prestateBlock, poststateBlock, err := contract.GetBlockRange(ctx)
+		accessor, err := outputs.NewOutputCannonTraceAccessor(logger, m, cfg, l2Client, contract, prestateProvider, rollupClient, dir, splitDepth, prestateBlock, poststateBlock)
 		if err != nil {
 			return nil, err
 		}
-		prestateProvider := outputs.NewPrestateProvider(ctx, logger, rollupClient, prestateBlock)
-		creator := func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (faultTypes.TraceAccessor, error) {
-			splitDepth, err := contract.GetSplitDepth(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to load split depth: %w", err)
-			}
-			accessor, err := outputs.NewOutputCannonTraceAccessor(logger, m, cfg, l2Client, contract, prestateProvider, rollupClient, dir, splitDepth, prestateBlock, poststateBlock)
-			if err != nil {
-				return nil, err
-			}
-			return accessor, nil
-		}
-		prestateValidator := NewPrestateValidator(contract.GetAbsolutePrestateHash, prestateProvider)
-		genesisValidator := NewPrestateValidator(contract.GetGenesisOutputRoot, prestateProvider)
-		return NewGamePlayer(ctx, logger, m, dir, game.Proxy, txMgr, contract, []Validator{prestateValidator, genesisValidator}, creator)
+		return accessor, nil
 	}
-	registry.RegisterGameType(outputCannonGameType, playerCreator)
+	return prestateProvider, creator, nil
 }
 
-func registerCannon(
-	registry Registry,
-	ctx context.Context,
-	logger log.Logger,
-	m metrics.Metricer,
+func cannonResources(
+	m metrics.GameMetricer,
 	cfg *config.Config,
-	txMgr txmgr.TxManager,
-	caller *batching.MultiCaller,
-	l2Client cannon.L2HeaderSource) {
-	playerCreator := func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
-		contract, err := contracts.NewFaultDisputeGameContract(game.Proxy, caller)
+	l2Client cannon.L2HeaderSource,
+	contract *contracts.FaultDisputeGameContract) (faultTypes.PrestateProvider, TraceAccessorCreator) {
+	prestateProvider := cannon.NewPrestateProvider(cfg.CannonAbsolutePreState)
+	creator := func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (faultTypes.TraceAccessor, error) {
+		localInputs, err := cannon.FetchLocalInputs(ctx, contract, l2Client)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to fetch cannon local inputs: %w", err)
 		}
-		prestateProvider := cannon.NewPrestateProvider(cfg.CannonAbsolutePreState)
-		creator := func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (faultTypes.TraceAccessor, error) {
-			localInputs, err := cannon.FetchLocalInputs(ctx, contract, l2Client)
-			if err != nil {
-				return nil, fmt.Errorf("failed to fetch cannon local inputs: %w", err)
-			}
-			provider := cannon.NewTraceProvider(logger, m, cfg, faultTypes.NoLocalContext, localInputs, dir, gameDepth)
-			return trace.NewSimpleTraceAccessor(provider), nil
-		}
-		validator := NewPrestateValidator(contract.GetAbsolutePrestateHash, prestateProvider)
-		return NewGamePlayer(ctx, logger, m, dir, game.Proxy, txMgr, contract, []Validator{validator}, creator)
+		provider := cannon.NewTraceProvider(logger, m, cfg, faultTypes.NoLocalContext, localInputs, dir, gameDepth)
+		return trace.NewSimpleTraceAccessor(trace.NewCachingTraceProvider(m, "cannon_trace", cfg.TraceCacheSize, provider)), nil
 	}
-	registry.RegisterGameType(cannonGameType, playerCreator)
+	return prestateProvider, creator
 }
 
-func registerAlphabet(
-	registry Registry,
+func alphabetResources(alphabetTrace string) (faultTypes.PrestateProvider, TraceAccessorCreator) {
+	prestateProvider := &alphabet.AlphabetPrestateProvider{}
+	creator := func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (faultTypes.TraceAccessor, error) {
+		traceProvider := alphabet.NewTraceProvider(alphabetTrace, gameDepth)
+		return trace.NewSimpleTraceAccessor(traceProvider), nil
+	}
+	return prestateProvider, creator
+}
+
+// NewTraceAccessor creates the honest TraceAccessor for the game at addr, using the same trace the challenger
+// would use when playing a game of the specified type. Only the trace types enabled in cfg are supported.
+// The rollupClient is only required for output based game types and l2Client only for game types that require it.
+func NewTraceAccessor(
 	ctx context.Context,
 	logger log.Logger,
 	m metrics.Metricer,
-	alphabetTrace string,
-	txMgr txmgr.TxManager,
-	caller *batching.MultiCaller) {
-	playerCreator := func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
-		contract, err := contracts.NewFaultDisputeGameContract(game.Proxy, caller)
-		if err != nil {
-			return nil, err
-		}
-		prestateProvider := &alphabet.AlphabetPrestateProvider{}
-		creator := func(ctx context.Context, logger log.Logger, gameDepth uint64, dir string) (faultTypes.TraceAccessor, error) {
-			traceProvider := alphabet.NewTraceProvider(alphabetTrace, gameDepth)
-			return trace.NewSimpleTraceAccessor(traceProvider), nil
-		}
-		validator := NewPrestateValidator(contract.GetAbsolutePrestateHash, prestateProvider)
-		return NewGamePlayer(ctx, logger, m, dir, game.Proxy, txMgr, contract, []Validator{validator}, creator)
+	cfg *config.Config,
+	caller *batching.MultiCaller,
+	rollupClient RollupClient,
+	l2Client cannon.L2HeaderSource,
+	gameType uint8,
+	addr common.Address,
+	dir string,
+) (faultTypes.TraceAccessor, error) {
+	def, ok := GameTypeDefinitionFor(gameType)
+	if !ok || !cfg.TraceTypeEnabled(def.TraceType) {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedGameType, gameType)
+	}
+	deps := &GameTypeDependencies{
+		Logger:       logger,
+		Metrics:      m,
+		Config:       cfg,
+		Caller:       caller,
+		RollupClient: rollupClient,
+		L2Client:     l2Client,
+	}
+	res, err := def.NewResources(ctx, deps, addr)
+	if err != nil {
+		return nil, err
+	}
+	gameDepth, err := res.Contract.GetMaxGameDepth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the game depth: %w", err)
+	}
+	if err := faultTypes.ValidateGameDepth(gameDepth); err != nil {
+		return nil, err
 	}
-	registry.RegisterGameType(alphabetGameType, playerCreator)
+	return res.TraceAccessor(ctx, logger, gameDepth, dir)
 }
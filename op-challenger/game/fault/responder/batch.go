@@ -0,0 +1,179 @@
+package responder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// maxBatchGas is the gas limit a batch of calls combined into one multicall transaction is kept under.
+	// It is well below the L1 block gas limit so the transaction can be included promptly.
+	maxBatchGas = 10_000_000
+	// multicallCallGas is the additional gas used by the multicall contract for each call it makes.
+	multicallCallGas = 10_000
+)
+
+// GasEstimator estimates the gas required for a call.
+type GasEstimator interface {
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+}
+
+// Multicall combines multiple transactions into a single transaction.
+type Multicall interface {
+	Aggregate3Tx(candidates []txmgr.TxCandidate) (txmgr.TxCandidate, error)
+	// Aggregate3Results returns whether each call in the Aggregate3Tx transaction for candidates, included in block
+	// includedIn, succeeded.
+	Aggregate3Results(ctx context.Context, candidates []txmgr.TxCandidate, includedIn *big.Int) ([]bool, error)
+}
+
+// WithMulticall enables combining claim resolutions into multicall transactions, split so that each is under
+// maxBatchGas as estimated by estimator.
+// Moves are never combined as the multicall contract, rather than the challenger, would be recorded as the claimant.
+func (r *FaultResponder) WithMulticall(multicall Multicall, estimator GasEstimator) *FaultResponder {
+	r.multicall = multicall
+	r.estimator = estimator
+	return r
+}
+
+// ResolveClaims resolves each of the claims. If a multicall contract is configured, the claims are combined into as
+// few transactions as possible. Otherwise a transaction is sent for each claim.
+// All transactions are sent without waiting for the others to be included.
+// Returns the claims that were successfully resolved. Calls in a multicall transaction are allowed to fail without
+// reverting the others, so claims whose resolution failed are logged and omitted even if the transaction succeeded.
+func (r *FaultResponder) ResolveClaims(ctx context.Context, claimIdxs ...uint64) ([]uint64, error) {
+	candidates := make([]txmgr.TxCandidate, 0, len(claimIdxs))
+	for _, claimIdx := range claimIdxs {
+		candidate, err := r.contract.ResolveClaimTx(claimIdx)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+	if r.multicall == nil || len(candidates) < 2 {
+		return r.resolveBatches(ctx, claimIdxs, splitSingles(candidates))
+	}
+	batches, err := r.splitBatches(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+	r.log.Info("Resolving claims in batches", "claims", len(candidates), "txs", len(batches))
+	return r.resolveBatches(ctx, claimIdxs, batches)
+}
+
+// resolveBatches sends a transaction for each batch of claim resolutions, combining batches of more than one claim
+// into a multicall transaction, and waits for them all to be included. claimIdxs are the claims resolved by the
+// candidates in batches, in order. Returns the claims that were successfully resolved.
+func (r *FaultResponder) resolveBatches(ctx context.Context, claimIdxs []uint64, batches [][]txmgr.TxCandidate) ([]uint64, error) {
+	txs := make([]txmgr.TxCandidate, 0, len(batches))
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			txs = append(txs, batch[0])
+			continue
+		}
+		tx, err := r.multicall.Aggregate3Tx(batch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create multicall tx: %w", err)
+		}
+		txs = append(txs, tx)
+	}
+	receipts, err := r.sendAllAndWait(ctx, actionResolveClaim, txs)
+	var resolved []uint64
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	offset := 0
+	for i, batch := range batches {
+		batchClaims := claimIdxs[offset : offset+len(batch)]
+		offset += len(batch)
+		receipt := receipts[i]
+		if receipt == nil {
+			continue
+		}
+		if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+			r.log.Warn("Failed to resolve claims, tx reverted", "claims", batchClaims, "tx_hash", receipt.TxHash)
+			continue
+		}
+		if len(batch) == 1 {
+			resolved = append(resolved, batchClaims...)
+			continue
+		}
+		successes, err := r.multicall.Aggregate3Results(ctx, batch, receipt.BlockNumber)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to check multicall results for tx %v: %w", receipt.TxHash, err))
+			continue
+		}
+		for j, success := range successes {
+			if !success {
+				r.log.Warn("Failed to resolve claim in multicall tx", "claim", batchClaims[j], "tx_hash", receipt.TxHash)
+				continue
+			}
+			resolved = append(resolved, batchClaims[j])
+		}
+	}
+	return resolved, errors.Join(errs...)
+}
+
+// splitSingles places each candidate in a batch of its own.
+func splitSingles(candidates []txmgr.TxCandidate) [][]txmgr.TxCandidate {
+	batches := make([][]txmgr.TxCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		batches = append(batches, []txmgr.TxCandidate{candidate})
+	}
+	return batches
+}
+
+// splitBatches groups candidates, in order, into batches that each use less than maxBatchGas when combined into a
+// multicall. A candidate that uses more than maxBatchGas by itself is placed in a batch of its own.
+func (r *FaultResponder) splitBatches(ctx context.Context, candidates []txmgr.TxCandidate) ([][]txmgr.TxCandidate, error) {
+	var batches [][]txmgr.TxCandidate
+	var batch []txmgr.TxCandidate
+	var batchGas uint64
+	for i, candidate := range candidates {
+		gas, err := r.estimator.EstimateGas(ctx, ethereum.CallMsg{
+			From: r.txMgr.From(),
+			To:   candidate.To,
+			Data: candidate.TxData,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas for call %v: %w", i, err)
+		}
+		gas += multicallCallGas
+		if len(batch) > 0 && batchGas+gas > maxBatchGas {
+			batches = append(batches, batch)
+			batch = nil
+			batchGas = 0
+		}
+		batch = append(batch, candidate)
+		batchGas += gas
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
+
+// sendAllAndWait sends all candidates at once, without waiting for each to be included before sending the next,
+// then waits for all of them to be included. Returns the receipt of each candidate, which is nil if it failed to send.
+func (r *FaultResponder) sendAllAndWait(ctx context.Context, label string, candidates []txmgr.TxCandidate) ([]*ethtypes.Receipt, error) {
+	receipts := make([]*ethtypes.Receipt, len(candidates))
+	errs := make([]error, len(candidates))
+	var wg sync.WaitGroup
+	for i, candidate := range candidates {
+		i, candidate := i, candidate
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			receipts[i], errs[i] = sendTxAndWaitForReceipt(ctx, r.log, r.txMgr, label, candidate)
+		}()
+	}
+	wg.Wait()
+	return receipts, errors.Join(errs...)
+}
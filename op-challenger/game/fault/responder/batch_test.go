@@ -0,0 +1,152 @@
+package responder
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveClaims(t *testing.T) {
+	multicallAddr := common.Address{0xca, 0x11}
+
+	t.Run("SendEachClaimWithoutMulticall", func(t *testing.T) {
+		responder, mockTxMgr, _ := newTestFaultResponder(t)
+		resolved, err := responder.ResolveClaims(context.Background(), 1, 2, 3)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2, 3}, resolved)
+		require.Equal(t, 3, mockTxMgr.sends)
+		require.ElementsMatch(t, []string{"resolveClaim(1)", "resolveClaim(2)", "resolveClaim(3)"}, sentData(mockTxMgr.sent))
+	})
+
+	t.Run("SendFails", func(t *testing.T) {
+		responder, mockTxMgr, _ := newTestFaultResponder(t)
+		mockTxMgr.sendFails = true
+		resolved, err := responder.ResolveClaims(context.Background(), 1, 2)
+		require.ErrorIs(t, err, mockSendError)
+		require.Empty(t, resolved)
+	})
+
+	t.Run("TxReverts", func(t *testing.T) {
+		responder, mockTxMgr, _ := newTestFaultResponder(t)
+		mockTxMgr.sendReverts = true
+		resolved, err := responder.ResolveClaims(context.Background(), 1, 2)
+		require.NoError(t, err)
+		require.Empty(t, resolved)
+	})
+
+	t.Run("CombineClaims", func(t *testing.T) {
+		responder, mockTxMgr, _ := newTestFaultResponder(t)
+		multicall := &stubMulticall{addr: multicallAddr}
+		responder.WithMulticall(multicall, &stubGasEstimator{gas: 100_000})
+		resolved, err := responder.ResolveClaims(context.Background(), 1, 2, 3)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2, 3}, resolved)
+		require.Equal(t, 1, mockTxMgr.sends)
+		require.Equal(t, &multicallAddr, mockTxMgr.sent[0].To)
+		require.Equal(t, actionResolveClaim, mockTxMgr.sent[0].Label)
+		require.Equal(t, [][]string{{"resolveClaim(1)", "resolveClaim(2)", "resolveClaim(3)"}}, multicall.batches)
+	})
+
+	t.Run("OmitFailedCallsInMulticall", func(t *testing.T) {
+		responder, _, _ := newTestFaultResponder(t)
+		multicall := &stubMulticall{addr: multicallAddr, failing: map[string]bool{"resolveClaim(2)": true}}
+		responder.WithMulticall(multicall, &stubGasEstimator{gas: 100_000})
+		resolved, err := responder.ResolveClaims(context.Background(), 1, 2, 3)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 3}, resolved)
+	})
+
+	t.Run("MulticallResultsFail", func(t *testing.T) {
+		responder, _, _ := newTestFaultResponder(t)
+		resultsErr := errors.New("boom")
+		multicall := &stubMulticall{addr: multicallAddr, resultsErr: resultsErr}
+		responder.WithMulticall(multicall, &stubGasEstimator{gas: 100_000})
+		resolved, err := responder.ResolveClaims(context.Background(), 1, 2)
+		require.ErrorIs(t, err, resultsErr)
+		require.Empty(t, resolved)
+	})
+
+	t.Run("SplitUnderGasLimit", func(t *testing.T) {
+		responder, mockTxMgr, _ := newTestFaultResponder(t)
+		multicall := &stubMulticall{addr: multicallAddr}
+		responder.WithMulticall(multicall, &stubGasEstimator{gas: maxBatchGas / 3})
+		resolved, err := responder.ResolveClaims(context.Background(), 1, 2, 3, 4, 5)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2, 3, 4, 5}, resolved)
+		// Each call is a third of the limit plus the multicall overhead so only two fit in each batch.
+		// The last claim is sent by itself rather than through the multicall contract.
+		require.Equal(t, [][]string{
+			{"resolveClaim(1)", "resolveClaim(2)"},
+			{"resolveClaim(3)", "resolveClaim(4)"},
+		}, multicall.batches)
+		require.Equal(t, 3, mockTxMgr.sends)
+		require.Contains(t, sentData(mockTxMgr.sent), "resolveClaim(5)")
+	})
+
+	t.Run("SingleClaimNotCombined", func(t *testing.T) {
+		responder, mockTxMgr, _ := newTestFaultResponder(t)
+		multicall := &stubMulticall{addr: multicallAddr}
+		responder.WithMulticall(multicall, &stubGasEstimator{gas: 100_000})
+		resolved, err := responder.ResolveClaims(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1}, resolved)
+		require.Empty(t, multicall.batches)
+		require.Equal(t, []string{"resolveClaim(1)"}, sentData(mockTxMgr.sent))
+	})
+
+	t.Run("EstimateFails", func(t *testing.T) {
+		responder, mockTxMgr, _ := newTestFaultResponder(t)
+		estimateErr := errors.New("boom")
+		responder.WithMulticall(&stubMulticall{addr: multicallAddr}, &stubGasEstimator{err: estimateErr})
+		_, err := responder.ResolveClaims(context.Background(), 1, 2)
+		require.ErrorIs(t, err, estimateErr)
+		require.Zero(t, mockTxMgr.sends)
+	})
+}
+
+func sentData(candidates []txmgr.TxCandidate) []string {
+	data := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		data = append(data, string(candidate.TxData))
+	}
+	return data
+}
+
+type stubGasEstimator struct {
+	gas uint64
+	err error
+}
+
+func (s *stubGasEstimator) EstimateGas(_ context.Context, _ ethereum.CallMsg) (uint64, error) {
+	return s.gas, s.err
+}
+
+type stubMulticall struct {
+	addr    common.Address
+	batches [][]string
+	// failing are the calls that fail within the multicall.
+	failing    map[string]bool
+	resultsErr error
+}
+
+func (s *stubMulticall) Aggregate3Results(_ context.Context, candidates []txmgr.TxCandidate, _ *big.Int) ([]bool, error) {
+	if s.resultsErr != nil {
+		return nil, s.resultsErr
+	}
+	successes := make([]bool, len(candidates))
+	for i, data := range sentData(candidates) {
+		successes[i] = !s.failing[data]
+	}
+	return successes, nil
+}
+
+func (s *stubMulticall) Aggregate3Tx(candidates []txmgr.TxCandidate) (txmgr.TxCandidate, error) {
+	s.batches = append(s.batches, sentData(candidates))
+	return txmgr.TxCandidate{To: &s.addr, TxData: []byte("aggregate3")}, nil
+}
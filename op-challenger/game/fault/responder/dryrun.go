@@ -0,0 +1,57 @@
+package responder
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// BlockNumberSource provides the current L1 block number.
+type BlockNumberSource interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// DryRunTxManager implements [txmgr.TxManager] by logging the transactions it is asked to send instead of
+// sending them. Every transaction is reported as successful so the challenger behaves as it would if its
+// transactions had been included.
+type DryRunTxManager struct {
+	log    log.Logger
+	from   common.Address
+	blocks BlockNumberSource
+}
+
+var _ txmgr.TxManager = (*DryRunTxManager)(nil)
+
+// NewDryRunTxManager returns a new [DryRunTxManager] that reports transactions as being sent from from.
+func NewDryRunTxManager(logger log.Logger, from common.Address, blocks BlockNumberSource) *DryRunTxManager {
+	return &DryRunTxManager{
+		log:    logger,
+		from:   from,
+		blocks: blocks,
+	}
+}
+
+// Send logs the transaction candidate, including its calldata, and returns a successful receipt without sending it.
+func (d *DryRunTxManager) Send(_ context.Context, candidate txmgr.TxCandidate) (*ethtypes.Receipt, error) {
+	d.log.Info("Dry run, not sending transaction",
+		"label", candidate.Label,
+		"to", candidate.To,
+		"value", candidate.Value,
+		"gasLimit", candidate.GasLimit,
+		"calldata", hexutil.Bytes(candidate.TxData))
+	return &ethtypes.Receipt{Status: ethtypes.ReceiptStatusSuccessful}, nil
+}
+
+func (d *DryRunTxManager) From() common.Address {
+	return d.from
+}
+
+func (d *DryRunTxManager) BlockNumber(ctx context.Context) (uint64, error) {
+	return d.blocks.BlockNumber(ctx)
+}
+
+func (d *DryRunTxManager) Close() {}
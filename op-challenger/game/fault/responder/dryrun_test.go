@@ -0,0 +1,49 @@
+package responder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunTxManager(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	logs := testlog.Capture(logger)
+	from := common.Address{0xaa}
+	txMgr := NewDryRunTxManager(logger, from, &stubBlockNumberSource{number: 42})
+	require.Equal(t, from, txMgr.From())
+
+	block, err := txMgr.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), block)
+
+	to := common.Address{0xbb}
+	receipt, err := txMgr.Send(context.Background(), txmgr.TxCandidate{
+		TxData: []byte{0x12, 0x34},
+		To:     &to,
+		Value:  big.NewInt(5),
+		Label:  actionMove,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+	l := logs.FindLog(log.LvlInfo, "Dry run, not sending transaction")
+	require.NotNil(t, l)
+	require.Equal(t, actionMove, l.GetContextValue("label"))
+	require.Equal(t, "0x1234", l.GetContextValue("calldata").(fmt.Stringer).String())
+}
+
+type stubBlockNumberSource struct {
+	number uint64
+}
+
+func (s *stubBlockNumberSource) BlockNumber(_ context.Context) (uint64, error) {
+	return s.number, nil
+}
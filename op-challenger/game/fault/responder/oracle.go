@@ -0,0 +1,65 @@
+package responder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type OracleContract interface {
+	UpdateOracleTx(ctx context.Context, claimIdx uint64, data *types.PreimageOracleData) (txmgr.TxCandidate, error)
+	GlobalDataExists(ctx context.Context, data *types.PreimageOracleData) (bool, error)
+}
+
+type OracleMetricer interface {
+	RecordPreimageUploaded(local bool)
+}
+
+// OracleUpdater loads the preimage data required by a step into the on-chain preimage oracle.
+type OracleUpdater struct {
+	log      log.Logger
+	metrics  OracleMetricer
+	txMgr    txmgr.TxManager
+	contract OracleContract
+}
+
+// NewOracleUpdater returns a new [OracleUpdater] that sends transactions via txMgr.
+func NewOracleUpdater(logger log.Logger, m OracleMetricer, txMgr txmgr.TxManager, contract OracleContract) *OracleUpdater {
+	return &OracleUpdater{
+		log:      logger,
+		metrics:  m,
+		txMgr:    txMgr,
+		contract: contract,
+	}
+}
+
+// UpdateOracle ensures the preimage part in data is available in the preimage oracle for the step against claimIdx.
+// Global keccak256 preimages are shared between games so are only uploaded if they are not already present.
+// Local data is specific to the game and claim being stepped on so is always loaded.
+func (u *OracleUpdater) UpdateOracle(ctx context.Context, claimIdx uint64, data *types.PreimageOracleData) error {
+	if !data.IsLocal {
+		exists, err := u.contract.GlobalDataExists(ctx, data)
+		if err != nil {
+			return fmt.Errorf("failed to check if pre-image is in oracle: %w", err)
+		}
+		if exists {
+			u.log.Debug("Pre-image already in oracle", "key", data.OracleKey, "offset", data.OracleOffset)
+			return nil
+		}
+	}
+	u.log.Info("Updating oracle data", "key", data.OracleKey, "offset", data.OracleOffset, "local", data.IsLocal)
+	candidate, err := u.contract.UpdateOracleTx(ctx, claimIdx, data)
+	if err != nil {
+		return fmt.Errorf("failed to create pre-image oracle tx: %w", err)
+	}
+	// Uploading the preimage reveals the data required to step, so use the private relay if available.
+	candidate.Private = true
+	if err := sendTxAndWait(ctx, u.log, u.txMgr, actionOracle, candidate); err != nil {
+		return fmt.Errorf("failed to populate pre-image oracle: %w", err)
+	}
+	u.metrics.RecordPreimageUploaded(data.IsLocal)
+	return nil
+}
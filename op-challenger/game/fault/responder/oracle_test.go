@@ -0,0 +1,81 @@
+package responder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateOracle(t *testing.T) {
+	globalData := &types.PreimageOracleData{
+		OracleKey:    common.Hash{0x02, 0xaa}.Bytes(),
+		OracleData:   []byte{1, 2, 3},
+		OracleOffset: 4,
+	}
+	localData := &types.PreimageOracleData{
+		IsLocal:   true,
+		OracleKey: common.Hash{0x01, 0xbb}.Bytes(),
+	}
+
+	setup := func(t *testing.T) (*OracleUpdater, *mockTxManager, *mockContract) {
+		txMgr := &mockTxManager{}
+		contract := &mockContract{}
+		return NewOracleUpdater(testlog.Logger(t, log.LvlError), &stubOracleMetrics{}, txMgr, contract), txMgr, contract
+	}
+
+	t.Run("UploadMissingGlobalData", func(t *testing.T) {
+		updater, txMgr, contract := setup(t)
+		require.NoError(t, updater.UpdateOracle(context.Background(), 5, globalData))
+		require.Len(t, txMgr.sent, 1)
+		require.Equal(t, "oracle", txMgr.sent[0].Label)
+		require.Equal(t, globalData, contract.updateOracleArgs)
+		require.EqualValues(t, 5, contract.updateOracleClaimIdx)
+		require.Equal(t, []bool{false}, updater.metrics.(*stubOracleMetrics).uploads)
+	})
+
+	t.Run("SkipExistingGlobalData", func(t *testing.T) {
+		updater, txMgr, contract := setup(t)
+		contract.globalDataExists = true
+		require.NoError(t, updater.UpdateOracle(context.Background(), 5, globalData))
+		require.Empty(t, txMgr.sent)
+		require.Nil(t, contract.updateOracleArgs)
+		require.Empty(t, updater.metrics.(*stubOracleMetrics).uploads)
+	})
+
+	t.Run("CheckGlobalDataFails", func(t *testing.T) {
+		updater, txMgr, contract := setup(t)
+		contract.globalDataExistsErr = mockCallError
+		require.ErrorIs(t, updater.UpdateOracle(context.Background(), 5, globalData), mockCallError)
+		require.Empty(t, txMgr.sent)
+	})
+
+	t.Run("AlwaysUploadLocalData", func(t *testing.T) {
+		updater, txMgr, contract := setup(t)
+		// Local data is not checked as the key depends on the game and claim
+		contract.globalDataExists = true
+		require.NoError(t, updater.UpdateOracle(context.Background(), 5, localData))
+		require.Len(t, txMgr.sent, 1)
+		require.Equal(t, localData, contract.updateOracleArgs)
+		require.Equal(t, []bool{true}, updater.metrics.(*stubOracleMetrics).uploads)
+	})
+
+	t.Run("SendFails", func(t *testing.T) {
+		updater, txMgr, _ := setup(t)
+		txMgr.sendFails = true
+		require.ErrorIs(t, updater.UpdateOracle(context.Background(), 5, globalData), mockSendError)
+		require.Empty(t, updater.metrics.(*stubOracleMetrics).uploads)
+	})
+}
+
+type stubOracleMetrics struct {
+	uploads []bool
+}
+
+func (s *stubOracleMetrics) RecordPreimageUploaded(local bool) {
+	s.uploads = append(s.uploads, local)
+}
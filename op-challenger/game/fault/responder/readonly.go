@@ -0,0 +1,63 @@
+package responder
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ErrReadOnly is returned when a responder running without a signing key is asked to do something that requires a
+// transaction to be sent.
+var ErrReadOnly = errors.New("responder is read-only")
+
+// ReadOnlyResponder implements the [Responder] interface for challengers that are run without a signing key.
+// The game is still loaded, evaluated and monitored but no transactions are ever sent.
+type ReadOnlyResponder struct {
+	log      log.Logger
+	contract GameContract
+}
+
+// NewReadOnlyResponder returns a new [ReadOnlyResponder].
+func NewReadOnlyResponder(logger log.Logger, contract GameContract) *ReadOnlyResponder {
+	return &ReadOnlyResponder{
+		log:      logger,
+		contract: contract,
+	}
+}
+
+// CallResolve determines if the resolve function on the fault dispute game contract would succeed.
+// Calls do not require a transaction so are still forwarded to the contract.
+func (r *ReadOnlyResponder) CallResolve(ctx context.Context) (gameTypes.GameStatus, error) {
+	return r.contract.CallResolve(ctx)
+}
+
+// Resolve logs that the game could be resolved but does not send a transaction.
+func (r *ReadOnlyResponder) Resolve(_ context.Context) error {
+	r.log.Info("Read-only mode, not resolving game")
+	return nil
+}
+
+// CallResolveClaim always returns ErrReadOnly. Resolving claims requires sending transactions so reporting claims as
+// resolvable would cause the agent to keep retrying claims that will never be resolved.
+func (r *ReadOnlyResponder) CallResolveClaim(_ context.Context, _ uint64) error {
+	return ErrReadOnly
+}
+
+// ResolveClaim always returns ErrReadOnly.
+func (r *ReadOnlyResponder) ResolveClaim(_ context.Context, _ uint64) error {
+	return ErrReadOnly
+}
+
+// ResolveClaims always returns ErrReadOnly.
+func (r *ReadOnlyResponder) ResolveClaims(_ context.Context, _ ...uint64) ([]uint64, error) {
+	return nil, ErrReadOnly
+}
+
+// PerformAction logs the action that would have been taken but does not send a transaction.
+func (r *ReadOnlyResponder) PerformAction(_ context.Context, action types.Action) error {
+	r.log.Info("Read-only mode, not performing action", "action", action.Type, "is_attack", action.IsAttack, "parent", action.ParentIdx)
+	return nil
+}
@@ -0,0 +1,52 @@
+package responder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyResponder(t *testing.T) {
+	newResponder := func(t *testing.T) (*ReadOnlyResponder, *mockContract) {
+		contract := &mockContract{}
+		return NewReadOnlyResponder(testlog.Logger(t, log.LvlError), contract), contract
+	}
+
+	t.Run("CallResolveForwardedToContract", func(t *testing.T) {
+		responder, contract := newResponder(t)
+		_, err := responder.CallResolve(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, contract.calls)
+	})
+
+	t.Run("ResolveDoesNothing", func(t *testing.T) {
+		responder, _ := newResponder(t)
+		require.NoError(t, responder.Resolve(context.Background()))
+	})
+
+	t.Run("ClaimsNeverResolvable", func(t *testing.T) {
+		responder, contract := newResponder(t)
+		require.ErrorIs(t, responder.CallResolveClaim(context.Background(), 0), ErrReadOnly)
+		require.ErrorIs(t, responder.ResolveClaim(context.Background(), 0), ErrReadOnly)
+		_, err := responder.ResolveClaims(context.Background(), 0, 1)
+		require.ErrorIs(t, err, ErrReadOnly)
+		require.Zero(t, contract.calls)
+	})
+
+	t.Run("PerformActionDoesNotCreateTx", func(t *testing.T) {
+		responder, contract := newResponder(t)
+		action := types.Action{
+			Type:      types.ActionTypeMove,
+			ParentIdx: 123,
+			IsAttack:  true,
+			Value:     common.Hash{0xaa},
+		}
+		require.NoError(t, responder.PerformAction(context.Background(), action))
+		require.Nil(t, contract.attackArgs)
+	})
+}
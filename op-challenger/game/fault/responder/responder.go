@@ -2,17 +2,50 @@ package responder
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+var tracer = otel.Tracer("github.com/ethereum-optimism/optimism/op-challenger/game/fault/responder")
+
+// Labels used to attribute the gas used by responder transactions.
+const (
+	actionMove         = "move"
+	actionStep         = "step"
+	actionOracle       = "oracle"
+	actionResolve      = "resolve"
+	actionResolveClaim = "resolveClaim"
+)
+
+// moveWatchInterval is how often the game's claims are checked for the same claim being posted by another sender
+// while a move is pending. Claims loaded within the interval are reused rather than reloaded.
+const moveWatchInterval = 12 * time.Second
+
+type ResponderMetricer interface {
+	OracleMetricer
+	RecordMoveBeaten()
+}
+
+// ClaimSnapshot provides the claims of the game loaded by the agent, so checking moves for duplicates does not reload
+// the game for every move.
+type ClaimSnapshot interface {
+	// LatestClaims returns the most recently loaded claims, reloading them if they were loaded more than maxAge ago.
+	LatestClaims(ctx context.Context, maxAge time.Duration) ([]types.Claim, error)
+}
+
 type GameContract interface {
 	CallResolve(ctx context.Context) (gameTypes.GameStatus, error)
 	ResolveTx() (txmgr.TxCandidate, error)
@@ -20,24 +53,38 @@ type GameContract interface {
 	ResolveClaimTx(claimIdx uint64) (txmgr.TxCandidate, error)
 	AttackTx(parentContractIndex uint64, pivot common.Hash) (txmgr.TxCandidate, error)
 	DefendTx(parentContractIndex uint64, pivot common.Hash) (txmgr.TxCandidate, error)
+	CallStep(ctx context.Context, claimIdx uint64, isAttack bool, stateData []byte, proof []byte) error
 	StepTx(claimIdx uint64, isAttack bool, stateData []byte, proof []byte) (txmgr.TxCandidate, error)
-	UpdateOracleTx(ctx context.Context, claimIdx uint64, data *types.PreimageOracleData) (txmgr.TxCandidate, error)
+	OracleContract
 }
 
 // FaultResponder implements the [Responder] interface to send onchain transactions.
 type FaultResponder struct {
-	log log.Logger
+	log     log.Logger
+	metrics ResponderMetricer
 
 	txMgr    txmgr.TxManager
 	contract GameContract
+	claims   ClaimSnapshot
+	oracle   *OracleUpdater
+
+	moveWatchInterval time.Duration
+
+	// multicall and estimator are used to combine claim resolutions into fewer transactions. See WithMulticall.
+	multicall Multicall
+	estimator GasEstimator
 }
 
 // NewFaultResponder returns a new [FaultResponder].
-func NewFaultResponder(logger log.Logger, txMgr txmgr.TxManager, contract GameContract) (*FaultResponder, error) {
+func NewFaultResponder(logger log.Logger, m ResponderMetricer, txMgr txmgr.TxManager, contract GameContract, claims ClaimSnapshot) (*FaultResponder, error) {
 	return &FaultResponder{
-		log:      logger,
-		txMgr:    txMgr,
-		contract: contract,
+		log:               logger,
+		metrics:           m,
+		txMgr:             txMgr,
+		contract:          contract,
+		claims:            claims,
+		oracle:            NewOracleUpdater(logger, m, txMgr, contract),
+		moveWatchInterval: moveWatchInterval,
 	}, nil
 }
 
@@ -54,7 +101,7 @@ func (r *FaultResponder) Resolve(ctx context.Context) error {
 		return err
 	}
 
-	return r.sendTxAndWait(ctx, candidate)
+	return r.sendTxAndWait(ctx, actionResolve, candidate)
 }
 
 // CallResolveClaim determines if the resolveClaim function on the fault dispute game contract
@@ -69,49 +116,170 @@ func (r *FaultResponder) ResolveClaim(ctx context.Context, claimIdx uint64) erro
 	if err != nil {
 		return err
 	}
-	return r.sendTxAndWait(ctx, candidate)
+	return r.sendTxAndWait(ctx, actionResolveClaim, candidate)
 }
 
 func (r *FaultResponder) PerformAction(ctx context.Context, action types.Action) error {
 	if action.OracleData != nil {
-		r.log.Info("Updating oracle data", "key", action.OracleData.OracleKey)
-		candidate, err := r.contract.UpdateOracleTx(ctx, uint64(action.ParentIdx), action.OracleData)
+		// The step would fail if the oracle did not contain the preimage, so ensure it is loaded first.
+		if err := r.oracle.UpdateOracle(ctx, uint64(action.ParentIdx), action.OracleData); err != nil {
+			return err
+		}
+	}
+	if action.Type == types.ActionTypeMove {
+		exists, err := r.moveExists(ctx, action)
 		if err != nil {
-			return fmt.Errorf("failed to create pre-image oracle tx: %w", err)
+			return err
 		}
-		if err := r.sendTxAndWait(ctx, candidate); err != nil {
-			return fmt.Errorf("failed to populate pre-image oracle: %w", err)
+		if exists {
+			// Another actor has already posted the same claim since the game was loaded. Sending it again would
+			// revert with ClaimAlreadyExists and waste gas.
+			r.log.Info("Skipping move, claim already exists", "parent", action.ParentIdx, "attack", action.IsAttack, "value", action.Value)
+			return nil
 		}
 	}
 	var candidate txmgr.TxCandidate
 	var err error
+	var label string
 	switch action.Type {
 	case types.ActionTypeMove:
+		label = actionMove
 		if action.IsAttack {
 			candidate, err = r.contract.AttackTx(uint64(action.ParentIdx), action.Value)
 		} else {
 			candidate, err = r.contract.DefendTx(uint64(action.ParentIdx), action.Value)
 		}
 	case types.ActionTypeStep:
+		label = actionStep
+		// Verify the step against the VM contract before sending it so that a step the contract would reject,
+		// for example because the proof data is invalid, reports the revert reason rather than wasting gas.
+		if err := r.contract.CallStep(ctx, uint64(action.ParentIdx), action.IsAttack, action.PreState, action.ProofData); err != nil {
+			return fmt.Errorf("step against claim %v rejected: %w", action.ParentIdx, err)
+		}
 		candidate, err = r.contract.StepTx(uint64(action.ParentIdx), action.IsAttack, action.PreState, action.ProofData)
+		if err != nil {
+			return err
+		}
+		// Like pre-image uploads, steps reveal the proof data, which could be copied from the public mempool and sent
+		// first. There are no bonds to lose, but use the private relay if available so the step lands as sent.
+		candidate.Private = true
 	}
 	if err != nil {
 		return err
 	}
-	return r.sendTxAndWait(ctx, candidate)
+	if action.Type == types.ActionTypeMove {
+		return r.sendMoveAndWait(ctx, action, candidate)
+	}
+	return r.sendTxAndWait(ctx, label, candidate)
+}
+
+// sendMoveAndWait sends the move transaction, replacing it with a cancellation transaction if the same claim is
+// posted by another sender before the move is included, rather than letting it revert as a duplicate.
+// The cancellation is a 0 value transfer at the same nonce, so the nonce is consumed and later transactions are not
+// left waiting behind the abandoned move. If the move is mined before the cancellation, it is handled as normal.
+func (r *FaultResponder) sendMoveAndWait(ctx context.Context, action types.Action, candidate txmgr.TxCandidate) error {
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	defer stopWatching()
+	beaten := make(chan struct{})
+	candidate.Cancel = beaten
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if r.watchForMove(watchCtx, action) {
+			close(beaten)
+		}
+	}()
+	err := r.sendTxAndWait(ctx, actionMove, candidate)
+	stopWatching()
+	<-done
+	if errors.Is(err, txmgr.ErrTxCancelled) {
+		r.log.Warn("Beaten to move, replaced pending tx", "parent", action.ParentIdx, "attack", action.IsAttack, "value", action.Value)
+		r.metrics.RecordMoveBeaten()
+		return nil
+	}
+	return err
+}
+
+// watchForMove polls the game's claims until the move's claim is posted or ctx is done.
+// Returns true if the claim was posted.
+func (r *FaultResponder) watchForMove(ctx context.Context, action types.Action) bool {
+	ticker := time.NewTicker(r.moveWatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			exists, err := r.moveExists(ctx, action)
+			if err != nil {
+				r.log.Debug("Failed to check for existing move", "err", err)
+				continue
+			}
+			if exists {
+				return true
+			}
+		}
+	}
+}
+
+// moveExists checks the latest claims for a claim with the same parent, position and value as the move, from any
+// actor. The claims loaded by the agent are reused if they were loaded within the move watch interval.
+func (r *FaultResponder) moveExists(ctx context.Context, action types.Action) (bool, error) {
+	claims, err := r.claims.LatestClaims(ctx, r.moveWatchInterval)
+	if err != nil {
+		return false, fmt.Errorf("failed to load claims: %w", err)
+	}
+	if action.ParentIdx < 0 || action.ParentIdx >= len(claims) {
+		return false, fmt.Errorf("%w: parent claim %v", types.ErrClaimNotFound, action.ParentIdx)
+	}
+	position, err := claims[action.ParentIdx].Position.MoveTo(!action.IsAttack)
+	if err != nil {
+		return false, err
+	}
+	for _, claim := range claims {
+		if claim.ParentContractIndex == action.ParentIdx &&
+			claim.Value == action.Value &&
+			claim.Position.ToGIndex().Cmp(position.ToGIndex()) == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 // sendTxAndWait sends a transaction through the [txmgr] and waits for a receipt.
+// The label identifies the action for gas usage accounting.
+func (r *FaultResponder) sendTxAndWait(ctx context.Context, label string, candidate txmgr.TxCandidate) error {
+	return sendTxAndWait(ctx, r.log, r.txMgr, label, candidate)
+}
+
+// sendTxAndWait sends a transaction through txMgr and waits for a receipt.
 // This sets the tx GasLimit to 0, performing gas estimation online through the [txmgr].
-func (r *FaultResponder) sendTxAndWait(ctx context.Context, candidate txmgr.TxCandidate) error {
-	receipt, err := r.txMgr.Send(ctx, candidate)
+// The label identifies the action for gas usage accounting.
+func sendTxAndWait(ctx context.Context, logger log.Logger, txMgr txmgr.TxManager, label string, candidate txmgr.TxCandidate) error {
+	_, err := sendTxAndWaitForReceipt(ctx, logger, txMgr, label, candidate)
+	return err
+}
+
+// sendTxAndWaitForReceipt is sendTxAndWait but also returns the receipt of the transaction.
+func sendTxAndWaitForReceipt(ctx context.Context, logger log.Logger, txMgr txmgr.TxManager, label string, candidate txmgr.TxCandidate) (receipt *ethtypes.Receipt, err error) {
+	ctx, span := tracer.Start(ctx, "FaultResponder.SendTx", trace.WithAttributes(attribute.String("label", label)))
+	defer func() { tracing.EndSpan(span, err) }()
+	candidate.Label = label
+	receipt, err = txMgr.Send(ctx, candidate)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	span.SetAttributes(
+		attribute.String("tx_hash", receipt.TxHash.Hex()),
+		attribute.Int64("status", int64(receipt.Status)),
+		attribute.Int64("gas_used", int64(receipt.GasUsed)))
+	if receipt.BlockNumber != nil {
+		span.SetAttributes(attribute.Int64("block_number", receipt.BlockNumber.Int64()))
 	}
 	if receipt.Status == ethtypes.ReceiptStatusFailed {
-		r.log.Error("Responder tx successfully published but reverted", "tx_hash", receipt.TxHash)
+		logger.Error("Responder tx successfully published but reverted", "tx_hash", receipt.TxHash)
 	} else {
-		r.log.Debug("Responder tx successfully published", "tx_hash", receipt.TxHash)
+		logger.Debug("Responder tx successfully published", "tx_hash", receipt.TxHash)
 	}
-	return nil
+	return receipt, nil
 }
@@ -3,7 +3,11 @@ package responder
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/big"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
@@ -135,6 +139,8 @@ func TestPerformAction(t *testing.T) {
 		require.Len(t, mockTxMgr.sent, 1)
 		require.EqualValues(t, []interface{}{uint64(action.ParentIdx), action.Value}, contract.attackArgs)
 		require.Equal(t, ([]byte)("attack"), mockTxMgr.sent[0].TxData)
+		require.Equal(t, "move", mockTxMgr.sent[0].Label)
+		require.False(t, mockTxMgr.sent[0].Private)
 	})
 
 	t.Run("defend", func(t *testing.T) {
@@ -151,6 +157,117 @@ func TestPerformAction(t *testing.T) {
 		require.Len(t, mockTxMgr.sent, 1)
 		require.EqualValues(t, []interface{}{uint64(action.ParentIdx), action.Value}, contract.defendArgs)
 		require.Equal(t, ([]byte)("defend"), mockTxMgr.sent[0].TxData)
+		require.Equal(t, "move", mockTxMgr.sent[0].Label)
+	})
+
+	t.Run("skipDuplicateMove", func(t *testing.T) {
+		responder, mockTxMgr, contract := newTestFaultResponder(t)
+		contract.claims = append(contract.claims, types.Claim{
+			ClaimData:           types.ClaimData{Value: common.Hash{0xaa}, Position: contract.claims[123].Position.Attack()},
+			ContractIndex:       len(contract.claims),
+			ParentContractIndex: 123,
+		})
+		err := responder.PerformAction(context.Background(), types.Action{
+			Type:      types.ActionTypeMove,
+			ParentIdx: 123,
+			IsAttack:  true,
+			Value:     common.Hash{0xaa},
+		})
+		require.NoError(t, err)
+		require.Empty(t, mockTxMgr.sent)
+	})
+
+	t.Run("sendMoveWithDifferentPosition", func(t *testing.T) {
+		responder, mockTxMgr, contract := newTestFaultResponder(t)
+		contract.claims = append(contract.claims, types.Claim{
+			ClaimData:           types.ClaimData{Value: common.Hash{0xaa}, Position: contract.claims[123].Position.Attack()},
+			ContractIndex:       len(contract.claims),
+			ParentContractIndex: 123,
+		})
+		err := responder.PerformAction(context.Background(), types.Action{
+			Type:      types.ActionTypeMove,
+			ParentIdx: 123,
+			IsAttack:  false,
+			Value:     common.Hash{0xaa},
+		})
+		require.NoError(t, err)
+		require.Len(t, mockTxMgr.sent, 1)
+		require.Equal(t, ([]byte)("defend"), mockTxMgr.sent[0].TxData)
+	})
+
+	t.Run("sendMoveWithDifferentValue", func(t *testing.T) {
+		responder, mockTxMgr, contract := newTestFaultResponder(t)
+		contract.claims = append(contract.claims, types.Claim{
+			ClaimData:           types.ClaimData{Value: common.Hash{0xbb}, Position: contract.claims[123].Position.Attack()},
+			ContractIndex:       len(contract.claims),
+			ParentContractIndex: 123,
+		})
+		err := responder.PerformAction(context.Background(), types.Action{
+			Type:      types.ActionTypeMove,
+			ParentIdx: 123,
+			IsAttack:  true,
+			Value:     common.Hash{0xaa},
+		})
+		require.NoError(t, err)
+		require.Len(t, mockTxMgr.sent, 1)
+	})
+
+	t.Run("cancelMoveWhenBeaten", func(t *testing.T) {
+		responder, mockTxMgr, contract := newTestFaultResponder(t)
+		responder.moveWatchInterval = time.Millisecond
+		action := types.Action{
+			Type:      types.ActionTypeMove,
+			ParentIdx: 123,
+			IsAttack:  true,
+			Value:     common.Hash{0xaa},
+		}
+		mockTxMgr.pending = func() {
+			// Another sender posts the same claim while our tx is pending.
+			contract.addClaim(types.Claim{
+				ClaimData:           types.ClaimData{Value: action.Value, Position: contract.claims[123].Position.Attack()},
+				ParentContractIndex: 123,
+			})
+		}
+		err := responder.PerformAction(context.Background(), action)
+		require.NoError(t, err)
+		require.Len(t, mockTxMgr.sent, 1)
+		require.NotNil(t, mockTxMgr.sent[0].Cancel)
+		require.Equal(t, 1, responder.metrics.(*stubResponderMetrics).beaten)
+	})
+
+	t.Run("pendingMoveNotBeatenByDifferentClaim", func(t *testing.T) {
+		responder, mockTxMgr, contract := newTestFaultResponder(t)
+		responder.moveWatchInterval = time.Millisecond
+		action := types.Action{
+			Type:      types.ActionTypeMove,
+			ParentIdx: 123,
+			IsAttack:  true,
+			Value:     common.Hash{0xaa},
+		}
+		mockTxMgr.pending = func() {
+			contract.addClaim(types.Claim{
+				ClaimData:           types.ClaimData{Value: common.Hash{0xbb}, Position: contract.claims[123].Position.Attack()},
+				ParentContractIndex: 123,
+			})
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := responder.PerformAction(ctx, action)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Zero(t, responder.metrics.(*stubResponderMetrics).beaten)
+	})
+
+	t.Run("loadClaimsFails", func(t *testing.T) {
+		responder, mockTxMgr, contract := newTestFaultResponder(t)
+		contract.claimsErr = mockCallError
+		err := responder.PerformAction(context.Background(), types.Action{
+			Type:      types.ActionTypeMove,
+			ParentIdx: 123,
+			IsAttack:  true,
+			Value:     common.Hash{0xaa},
+		})
+		require.ErrorIs(t, err, mockCallError)
+		require.Empty(t, mockTxMgr.sent)
 	})
 
 	t.Run("step", func(t *testing.T) {
@@ -168,6 +285,23 @@ func TestPerformAction(t *testing.T) {
 		require.Len(t, mockTxMgr.sent, 1)
 		require.EqualValues(t, []interface{}{uint64(action.ParentIdx), action.IsAttack, action.PreState, action.ProofData}, contract.stepArgs)
 		require.Equal(t, ([]byte)("step"), mockTxMgr.sent[0].TxData)
+		require.Equal(t, "step", mockTxMgr.sent[0].Label)
+		require.True(t, mockTxMgr.sent[0].Private)
+	})
+
+	t.Run("stepRejected", func(t *testing.T) {
+		responder, mockTxMgr, contract := newTestFaultResponder(t)
+		contract.stepFails = true
+		action := types.Action{
+			Type:      types.ActionTypeStep,
+			ParentIdx: 123,
+			IsAttack:  true,
+			PreState:  []byte{1, 2, 3},
+			ProofData: []byte{4, 5, 6},
+		}
+		err := responder.PerformAction(context.Background(), action)
+		require.ErrorIs(t, err, mockCallError)
+		require.Empty(t, mockTxMgr.sent)
 	})
 
 	t.Run("stepWithOracleData", func(t *testing.T) {
@@ -191,6 +325,9 @@ func TestPerformAction(t *testing.T) {
 		require.EqualValues(t, []interface{}{uint64(action.ParentIdx), action.IsAttack, action.PreState, action.ProofData}, contract.stepArgs)
 		// Important that the oracle is updated first
 		require.Equal(t, ([]byte)("updateOracle"), mockTxMgr.sent[0].TxData)
+		require.Equal(t, "oracle", mockTxMgr.sent[0].Label)
+		require.True(t, mockTxMgr.sent[0].Private)
+		require.Equal(t, "step", mockTxMgr.sent[1].Label)
 		require.Equal(t, ([]byte)("step"), mockTxMgr.sent[1].TxData)
 	})
 }
@@ -198,28 +335,65 @@ func TestPerformAction(t *testing.T) {
 func newTestFaultResponder(t *testing.T) (*FaultResponder, *mockTxManager, *mockContract) {
 	log := testlog.Logger(t, log.LvlError)
 	mockTxMgr := &mockTxManager{}
-	contract := &mockContract{}
-	responder, err := NewFaultResponder(log, mockTxMgr, contract)
+	// Claim 123 is the parent of the moves in tests, so fill in claims up to it.
+	claims := make([]types.Claim, 124)
+	for i := range claims {
+		claims[i] = types.Claim{
+			ClaimData:     types.ClaimData{Value: common.Hash{byte(i)}, Position: types.NewPosition(1, big.NewInt(0))},
+			ContractIndex: i,
+		}
+	}
+	contract := &mockContract{claims: claims}
+	responder, err := NewFaultResponder(log, &stubResponderMetrics{}, mockTxMgr, contract, contract)
 	require.NoError(t, err)
 	return responder, mockTxMgr, contract
 }
 
+type stubResponderMetrics struct {
+	stubOracleMetrics
+	beaten int
+}
+
+func (s *stubResponderMetrics) RecordMoveBeaten() {
+	s.beaten++
+}
+
 type mockTxManager struct {
+	// sentLock guards sends and sent as transactions may be sent concurrently.
+	sentLock  sync.Mutex
 	from      common.Address
 	sends     int
 	sent      []txmgr.TxCandidate
 	sendFails bool
+	// sendReverts makes sent txs return a receipt with a failed status.
+	sendReverts bool
+	// pending, if set, is called when a tx is sent and the tx then never lands, blocking until the send is cancelled
+	// or the candidate's Cancel channel is closed.
+	pending func()
 }
 
-func (m *mockTxManager) Send(_ context.Context, candidate txmgr.TxCandidate) (*ethtypes.Receipt, error) {
+func (m *mockTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*ethtypes.Receipt, error) {
 	if m.sendFails {
 		return nil, mockSendError
 	}
+	m.sentLock.Lock()
+	if m.pending != nil {
+		m.sent = append(m.sent, candidate)
+		m.sentLock.Unlock()
+		m.pending()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-candidate.Cancel:
+			return ethtypes.NewReceipt([]byte{}, false, 0), txmgr.ErrTxCancelled
+		}
+	}
 	m.sends++
 	m.sent = append(m.sent, candidate)
+	m.sentLock.Unlock()
 	return ethtypes.NewReceipt(
 		[]byte{},
-		false,
+		m.sendReverts,
 		0,
 	), nil
 }
@@ -236,13 +410,32 @@ func (m *mockTxManager) Close() {
 }
 
 type mockContract struct {
+	claimsLock           sync.Mutex
+	claims               []types.Claim
+	claimsErr            error
 	calls                int
 	callFails            bool
 	attackArgs           []interface{}
 	defendArgs           []interface{}
 	stepArgs             []interface{}
+	stepFails            bool
 	updateOracleClaimIdx uint64
 	updateOracleArgs     *types.PreimageOracleData
+	globalDataExists     bool
+	globalDataExistsErr  error
+}
+
+func (m *mockContract) LatestClaims(_ context.Context, _ time.Duration) ([]types.Claim, error) {
+	m.claimsLock.Lock()
+	defer m.claimsLock.Unlock()
+	return m.claims, m.claimsErr
+}
+
+func (m *mockContract) addClaim(claim types.Claim) {
+	m.claimsLock.Lock()
+	defer m.claimsLock.Unlock()
+	claim.ContractIndex = len(m.claims)
+	m.claims = append(m.claims, claim)
 }
 
 func (m *mockContract) CallResolve(_ context.Context) (gameTypes.GameStatus, error) {
@@ -265,8 +458,8 @@ func (m *mockContract) CallResolveClaim(_ context.Context, _ uint64) error {
 	return nil
 }
 
-func (m *mockContract) ResolveClaimTx(_ uint64) (txmgr.TxCandidate, error) {
-	return txmgr.TxCandidate{}, nil
+func (m *mockContract) ResolveClaimTx(claimIdx uint64) (txmgr.TxCandidate, error) {
+	return txmgr.TxCandidate{To: &common.Address{0xaa}, TxData: []byte(fmt.Sprintf("resolveClaim(%v)", claimIdx))}, nil
 }
 
 func (m *mockContract) AttackTx(parentClaimId uint64, claim common.Hash) (txmgr.TxCandidate, error) {
@@ -279,6 +472,13 @@ func (m *mockContract) DefendTx(parentClaimId uint64, claim common.Hash) (txmgr.
 	return txmgr.TxCandidate{TxData: ([]byte)("defend")}, nil
 }
 
+func (m *mockContract) CallStep(_ context.Context, _ uint64, _ bool, _ []byte, _ []byte) error {
+	if m.stepFails {
+		return mockCallError
+	}
+	return nil
+}
+
 func (m *mockContract) StepTx(claimIdx uint64, isAttack bool, stateData []byte, proofData []byte) (txmgr.TxCandidate, error) {
 	m.stepArgs = []interface{}{claimIdx, isAttack, stateData, proofData}
 	return txmgr.TxCandidate{TxData: ([]byte)("step")}, nil
@@ -289,3 +489,7 @@ func (m *mockContract) UpdateOracleTx(_ context.Context, claimIdx uint64, data *
 	m.updateOracleArgs = data
 	return txmgr.TxCandidate{TxData: ([]byte)("updateOracle")}, nil
 }
+
+func (m *mockContract) GlobalDataExists(_ context.Context, _ *types.PreimageOracleData) (bool, error) {
+	return m.globalDataExists, m.globalDataExistsErr
+}
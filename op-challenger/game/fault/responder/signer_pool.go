@@ -0,0 +1,61 @@
+package responder
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SignerPool distributes games across a pool of transaction managers, each sending from a different account, so that
+// transactions for different games don't serialize behind a single account's nonce.
+type SignerPool struct {
+	txMgrs []txmgr.TxManager
+}
+
+// NewSignerPool returns a new [SignerPool] distributing games across txMgrs. At least one must be provided.
+func NewSignerPool(txMgrs ...txmgr.TxManager) *SignerPool {
+	if len(txMgrs) == 0 {
+		panic("signer pool requires at least one transaction manager")
+	}
+	return &SignerPool{txMgrs: txMgrs}
+}
+
+// ForGame returns the transaction manager to use for all transactions in the game at addr.
+// Games are assigned by address so the same account is used for a game across restarts, provided the pool is unchanged.
+func (p *SignerPool) ForGame(addr common.Address) txmgr.TxManager {
+	idx := binary.BigEndian.Uint64(addr[common.AddressLength-8:]) % uint64(len(p.txMgrs))
+	return p.txMgrs[idx]
+}
+
+// Primary returns the first transaction manager in the pool.
+func (p *SignerPool) Primary() txmgr.TxManager {
+	return p.txMgrs[0]
+}
+
+// Addresses returns the sending address of every transaction manager in the pool.
+func (p *SignerPool) Addresses() []common.Address {
+	addrs := make([]common.Address, len(p.txMgrs))
+	for i, txMgr := range p.txMgrs {
+		addrs[i] = txMgr.From()
+	}
+	return addrs
+}
+
+// GasUsage returns the gas used by transactions sent by every transaction manager in the pool, keyed by action label.
+func (p *SignerPool) GasUsage() map[string]txmgr.GasUsage {
+	sources := make([]txmgr.GasUsageSource, 0, len(p.txMgrs))
+	for _, txMgr := range p.txMgrs {
+		if source, ok := txMgr.(txmgr.GasUsageSource); ok {
+			sources = append(sources, source)
+		}
+	}
+	return txmgr.CombineGasUsage(sources...)
+}
+
+// Close closes all the transaction managers in the pool.
+func (p *SignerPool) Close() {
+	for _, txMgr := range p.txMgrs {
+		txMgr.Close()
+	}
+}
@@ -0,0 +1,69 @@
+package responder
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerPool(t *testing.T) {
+	txMgr1 := &mockTxManager{from: common.Address{0x01}}
+	txMgr2 := &mockTxManager{from: common.Address{0x02}}
+	txMgr3 := &mockTxManager{from: common.Address{0x03}}
+	pool := NewSignerPool(txMgr1, txMgr2, txMgr3)
+
+	require.Same(t, txMgr1, pool.Primary())
+	require.Equal(t, []common.Address{{0x01}, {0x02}, {0x03}}, pool.Addresses())
+
+	t.Run("DistributesGames", func(t *testing.T) {
+		require.Same(t, txMgr1, pool.ForGame(common.Address{19: 0x03}))
+		require.Same(t, txMgr2, pool.ForGame(common.Address{19: 0x04}))
+		require.Same(t, txMgr3, pool.ForGame(common.Address{19: 0x05}))
+		// The same game is always assigned to the same signer.
+		require.Same(t, pool.ForGame(common.Address{0xab, 19: 0x07}), pool.ForGame(common.Address{0xab, 19: 0x07}))
+	})
+
+	t.Run("SingleSigner", func(t *testing.T) {
+		pool := NewSignerPool(txMgr1)
+		require.Same(t, txMgr1, pool.ForGame(common.Address{19: 0x04}))
+		require.Same(t, txMgr1, pool.ForGame(common.Address{19: 0x05}))
+	})
+
+	t.Run("GasUsage", func(t *testing.T) {
+		withUsage := func(from common.Address, usage map[string]txmgr.GasUsage) txmgr.TxManager {
+			return &gasUsageTxManager{mockTxManager: &mockTxManager{from: from}, usage: usage}
+		}
+		pool := NewSignerPool(
+			withUsage(common.Address{0x01}, map[string]txmgr.GasUsage{
+				actionMove: {Txs: 1, GasUsed: 100, Cost: (*hexutil.Big)(big.NewInt(1000))},
+			}),
+			withUsage(common.Address{0x02}, map[string]txmgr.GasUsage{
+				actionMove: {Txs: 2, GasUsed: 200, Cost: (*hexutil.Big)(big.NewInt(2000))},
+				actionStep: {Txs: 1, GasUsed: 50, Cost: (*hexutil.Big)(big.NewInt(500))},
+			}),
+			// Transaction managers that don't track gas usage are skipped.
+			&mockTxManager{from: common.Address{0x03}},
+		)
+		require.Equal(t, map[string]txmgr.GasUsage{
+			actionMove: {Txs: 3, GasUsed: 300, Cost: (*hexutil.Big)(big.NewInt(3000))},
+			actionStep: {Txs: 1, GasUsed: 50, Cost: (*hexutil.Big)(big.NewInt(500))},
+		}, pool.GasUsage())
+	})
+
+	t.Run("RequiresSigner", func(t *testing.T) {
+		require.Panics(t, func() { NewSignerPool() })
+	})
+}
+
+type gasUsageTxManager struct {
+	*mockTxManager
+	usage map[string]txmgr.GasUsage
+}
+
+func (m *gasUsageTxManager) GasUsage() map[string]txmgr.GasUsage {
+	return m.usage
+}
@@ -0,0 +1,187 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	selfTestTrace = "abcdefghijklmnop"
+	selfTestDepth = 4
+	// selfTestMaxRounds limits the number of rounds played. Every claim is countered within a round, so a game is
+	// complete well before this unless the challenger misbehaves.
+	selfTestMaxRounds = 2 * selfTestDepth
+)
+
+var (
+	// ErrSelfTestFailed is returned when the challenger does not win the self-test game.
+	ErrSelfTestFailed = errors.New("self-test failed")
+
+	errSelfTestNotResolvable = errors.New("self-test game is never resolved")
+)
+
+// RunSelfTest plays a synthetic alphabet game in memory against an opponent that posts an invalid root claim and
+// counters every claim the challenger makes. It uses the same agent, solver and trace provider as real games but
+// with a responder that applies actions to the in memory game instead of sending transactions, so no network access
+// is required. Returns an error wrapping ErrSelfTestFailed if the challenger fails to counter the root claim.
+func RunSelfTest(ctx context.Context, logger log.Logger) error {
+	logger = logger.New("game", "self-test")
+	_, creator := alphabetResources(selfTestTrace)
+	accessor, err := creator(ctx, logger, selfTestDepth, "")
+	if err != nil {
+		return fmt.Errorf("failed to create trace accessor: %w", err)
+	}
+	game := newSelfTestGame(selfTestDepth)
+	gameSolver := newGameSolver(logger, selfTestDepth, accessor)
+	agent := NewAgent(metrics.NoopMetrics, game, selfTestDepth, gameSolver, game, noopSyncValidator{}, NewIncidentMode(false), eth.BlockID{}, logger)
+	for round := 0; round < selfTestMaxRounds; round++ {
+		progress := game.progress()
+		if err := agent.Act(ctx); err != nil {
+			return fmt.Errorf("%w: challenger failed to act: %w", ErrSelfTestFailed, err)
+		}
+		game.counterChallenger()
+		if game.progress() == progress {
+			break
+		}
+	}
+	if !game.rootCountered() {
+		return fmt.Errorf("%w: challenger did not counter the invalid root claim", ErrSelfTestFailed)
+	}
+	logger.Info("Self-test passed", "claims", len(game.claims))
+	return nil
+}
+
+// selfTestGame is an in memory dispute game used by RunSelfTest. It is both the ClaimLoader and the Responder of the
+// challenger's agent, recording the challenger's actions instead of sending transactions.
+type selfTestGame struct {
+	lock     sync.Mutex
+	maxDepth int
+	claims   []types.Claim
+	// challenger records the contract index of each claim posted by the challenger.
+	challenger map[int]bool
+	steps      int
+}
+
+func newSelfTestGame(maxDepth int) *selfTestGame {
+	root := types.NewPositionFromGIndex(big.NewInt(1))
+	return &selfTestGame{
+		maxDepth:   maxDepth,
+		claims:     []types.Claim{{ClaimData: types.ClaimData{Value: invalidSelfTestClaim(root), Position: root}}},
+		challenger: make(map[int]bool),
+	}
+}
+
+// invalidSelfTestClaim returns a claim value that never matches the alphabet trace, which always has a VM status byte.
+func invalidSelfTestClaim(pos types.Position) common.Hash {
+	return common.BigToHash(pos.ToGIndex())
+}
+
+func (g *selfTestGame) GetAllClaims(_ context.Context) ([]types.Claim, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return append([]types.Claim(nil), g.claims...), nil
+}
+
+func (g *selfTestGame) CallResolve(_ context.Context) (gameTypes.GameStatus, error) {
+	return gameTypes.GameStatusInProgress, nil
+}
+
+func (g *selfTestGame) Resolve(_ context.Context) error {
+	return errSelfTestNotResolvable
+}
+
+func (g *selfTestGame) CallResolveClaim(_ context.Context, _ uint64) error {
+	return errSelfTestNotResolvable
+}
+
+func (g *selfTestGame) ResolveClaims(_ context.Context, _ ...uint64) ([]uint64, error) {
+	return nil, errSelfTestNotResolvable
+}
+
+func (g *selfTestGame) PerformAction(_ context.Context, action types.Action) error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if action.ParentIdx < 0 || action.ParentIdx >= len(g.claims) {
+		return fmt.Errorf("parent claim %v does not exist", action.ParentIdx)
+	}
+	parent := &g.claims[action.ParentIdx]
+	if g.challenger[action.ParentIdx] {
+		return fmt.Errorf("challenger countered its own claim %v", action.ParentIdx)
+	}
+	switch action.Type {
+	case types.ActionTypeMove:
+		if parent.Depth() >= g.maxDepth {
+			return fmt.Errorf("cannot move against claim %v at max depth", action.ParentIdx)
+		}
+		pos := parent.Position.Attack()
+		if !action.IsAttack {
+			if parent.IsRootPosition() {
+				return errors.New("cannot defend the root claim")
+			}
+			pos = parent.Position.Defend()
+		}
+		g.addClaim(action.Value, pos, action.ParentIdx)
+		g.challenger[len(g.claims)-1] = true
+	case types.ActionTypeStep:
+		if parent.Depth() != g.maxDepth {
+			return fmt.Errorf("cannot step against claim %v above max depth", action.ParentIdx)
+		}
+		if parent.Countered {
+			return fmt.Errorf("claim %v already countered", action.ParentIdx)
+		}
+		parent.Countered = true
+		g.steps++
+	default:
+		return fmt.Errorf("unsupported action type %v", action.Type)
+	}
+	return nil
+}
+
+func (g *selfTestGame) addClaim(value common.Hash, pos types.Position, parentIdx int) {
+	g.claims = append(g.claims, types.Claim{
+		ClaimData:           types.ClaimData{Value: value, Position: pos},
+		ContractIndex:       len(g.claims),
+		ParentContractIndex: parentIdx,
+	})
+}
+
+// counterChallenger attacks each of the challenger's claims that has not been countered yet with an invalid claim.
+func (g *selfTestGame) counterChallenger() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	countered := make(map[int]bool)
+	for _, claim := range g.claims[1:] {
+		countered[claim.ParentContractIndex] = true
+	}
+	for i, claim := range g.claims {
+		if !g.challenger[i] || countered[i] || claim.Depth() >= g.maxDepth {
+			continue
+		}
+		pos := claim.Position.Attack()
+		g.addClaim(invalidSelfTestClaim(pos), pos, i)
+	}
+}
+
+// progress returns a value that changes every time a claim is added or countered.
+func (g *selfTestGame) progress() int {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return len(g.claims) + g.steps
+}
+
+// rootCountered returns true if the root claim would be countered when the game is resolved.
+func (g *selfTestGame) rootCountered() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return projectedStatus(g.claims, g.maxDepth) == gameTypes.GameStatusChallengerWon
+}
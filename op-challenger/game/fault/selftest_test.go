@@ -0,0 +1,49 @@
+package fault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunSelfTest(t *testing.T) {
+	require.NoError(t, RunSelfTest(context.Background(), testlog.Logger(t, log.LvlInfo)))
+}
+
+func TestSelfTestGame(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RootNotCounteredInitially", func(t *testing.T) {
+		game := newSelfTestGame(2)
+		require.False(t, game.rootCountered())
+	})
+
+	t.Run("UncounteredChildCountersRoot", func(t *testing.T) {
+		game := newSelfTestGame(2)
+		require.NoError(t, game.PerformAction(ctx, types.Action{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true, Value: common.Hash{0xaa}}))
+		require.True(t, game.rootCountered())
+
+		// The opponent counters the challenger's claim so the root is no longer countered
+		game.counterChallenger()
+		require.Len(t, game.claims, 3)
+		require.False(t, game.rootCountered())
+
+		// Stepping on the opponent's leaf claim counters it again
+		require.NoError(t, game.PerformAction(ctx, types.Action{Type: types.ActionTypeStep, ParentIdx: 2, IsAttack: true}))
+		require.True(t, game.rootCountered())
+	})
+
+	t.Run("RejectInvalidActions", func(t *testing.T) {
+		game := newSelfTestGame(2)
+		require.ErrorContains(t, game.PerformAction(ctx, types.Action{Type: types.ActionTypeMove, ParentIdx: 1, IsAttack: true}), "does not exist")
+		require.ErrorContains(t, game.PerformAction(ctx, types.Action{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: false}), "cannot defend the root")
+		require.ErrorContains(t, game.PerformAction(ctx, types.Action{Type: types.ActionTypeStep, ParentIdx: 0, IsAttack: true}), "above max depth")
+		require.NoError(t, game.PerformAction(ctx, types.Action{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true}))
+		require.ErrorContains(t, game.PerformAction(ctx, types.Action{Type: types.ActionTypeMove, ParentIdx: 1, IsAttack: true}), "its own claim")
+	})
+}
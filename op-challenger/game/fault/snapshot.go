@@ -0,0 +1,56 @@
+package fault
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+// claimSnapshot shares the claims loaded by the agent with the responder, so moves can be checked against the
+// latest claims without each pending move reloading the game.
+// Loads are serialised, so concurrent callers wait for an in progress load and then share its result.
+type claimSnapshot struct {
+	loader ClaimLoader
+	clock  clock.Clock
+
+	lock     sync.Mutex
+	claims   []types.Claim
+	loadedAt time.Time
+}
+
+func newClaimSnapshot(loader ClaimLoader, cl clock.Clock) *claimSnapshot {
+	return &claimSnapshot{
+		loader: loader,
+		clock:  cl,
+	}
+}
+
+// GetAllClaims loads the latest claims and records them as the snapshot.
+func (s *claimSnapshot) GetAllClaims(ctx context.Context) ([]types.Claim, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.load(ctx)
+}
+
+// LatestClaims returns the claims in the snapshot, reloading them if they were loaded more than maxAge ago.
+func (s *claimSnapshot) LatestClaims(ctx context.Context, maxAge time.Duration) ([]types.Claim, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.loadedAt.IsZero() && s.clock.Now().Sub(s.loadedAt) < maxAge {
+		return s.claims, nil
+	}
+	return s.load(ctx)
+}
+
+func (s *claimSnapshot) load(ctx context.Context) ([]types.Claim, error) {
+	claims, err := s.loader.GetAllClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.claims = claims
+	s.loadedAt = s.clock.Now()
+	return claims, nil
+}
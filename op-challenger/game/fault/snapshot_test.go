@@ -0,0 +1,85 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+func TestClaimSnapshot(t *testing.T) {
+	claims := []types.Claim{storedTestClaim(0, 0, 0, -1)}
+	setup := func() (*claimSnapshot, *countingClaimLoader, *clock.DeterministicClock) {
+		loader := &countingClaimLoader{claims: claims}
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		return newClaimSnapshot(loader, cl), loader, cl
+	}
+
+	t.Run("GetAllClaimsAlwaysLoads", func(t *testing.T) {
+		snapshot, loader, _ := setup()
+		for i := 0; i < 2; i++ {
+			actual, err := snapshot.GetAllClaims(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, claims, actual)
+		}
+		require.Equal(t, 2, loader.loads)
+	})
+
+	t.Run("LatestClaimsReusesRecentClaims", func(t *testing.T) {
+		snapshot, loader, cl := setup()
+		_, err := snapshot.GetAllClaims(context.Background())
+		require.NoError(t, err)
+
+		cl.AdvanceTime(9 * time.Second)
+		actual, err := snapshot.LatestClaims(context.Background(), 10*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, claims, actual)
+		require.Equal(t, 1, loader.loads)
+
+		cl.AdvanceTime(time.Second)
+		actual, err = snapshot.LatestClaims(context.Background(), 10*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, claims, actual)
+		require.Equal(t, 2, loader.loads)
+	})
+
+	t.Run("LatestClaimsLoadsWhenEmpty", func(t *testing.T) {
+		snapshot, loader, _ := setup()
+		actual, err := snapshot.LatestClaims(context.Background(), time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, claims, actual)
+		require.Equal(t, 1, loader.loads)
+	})
+
+	t.Run("ErrorsAreNotCached", func(t *testing.T) {
+		snapshot, loader, _ := setup()
+		loader.err = errors.New("boom")
+		_, err := snapshot.LatestClaims(context.Background(), time.Minute)
+		require.ErrorIs(t, err, loader.err)
+
+		loader.err = nil
+		actual, err := snapshot.LatestClaims(context.Background(), time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, claims, actual)
+		require.Equal(t, 2, loader.loads)
+	})
+}
+
+type countingClaimLoader struct {
+	claims []types.Claim
+	err    error
+	loads  int
+}
+
+func (l *countingClaimLoader) GetAllClaims(_ context.Context) ([]types.Claim, error) {
+	l.loads++
+	if l.err != nil {
+		return nil, l.err
+	}
+	return l.claims, nil
+}
@@ -4,60 +4,278 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
+	"github.com/ethereum/go-ethereum/log"
 )
 
+var tracer = otel.Tracer("github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver")
+
 type GameSolver struct {
+	log         log.Logger
 	claimSolver *claimSolver
+
+	// minClaimDepth is the shallowest depth of claims that will be responded to.
+	minClaimDepth int
+
+	// ignoreValidRootClaim disables countering claims that dispute a root claim the solver agrees with.
+	// If set, no actions are taken in games with a valid root claim.
+	ignoreValidRootClaim bool
+
+	// clock and maxClockDuration are used to track the chess clock of claims being responded to.
+	// If clock is nil, chess clocks are ignored.
+	clock            clock.Clock
+	maxClockDuration time.Duration
 }
 
-func NewGameSolver(gameDepth int, trace types.TraceAccessor) *GameSolver {
+// NewGameSolver creates a new [GameSolver].
+// The logger is optional and is used to trace the solver's decisions at debug level. If nil, nothing is logged.
+func NewGameSolver(logger log.Logger, gameDepth int, trace types.TraceAccessor) *GameSolver {
+	if logger == nil {
+		logger = log.New()
+		logger.SetHandler(log.DiscardHandler())
+	}
 	return &GameSolver{
-		claimSolver: newClaimSolver(gameDepth, trace),
+		log:         logger,
+		claimSolver: newClaimSolver(logger, gameDepth, trace),
+	}
+}
+
+// NewExecutionOnlyGameSolver creates a new [GameSolver] that only responds in the execution (bottom) half of a
+// split game. Claims above splitDepth are assumed to be produced and countered by another actor. They are still
+// evaluated when determining if claims in the execution half are on an honest path.
+func NewExecutionOnlyGameSolver(logger log.Logger, gameDepth int, splitDepth int, trace types.TraceAccessor) *GameSolver {
+	solver := NewGameSolver(logger, gameDepth, trace)
+	solver.minClaimDepth = splitDepth
+	return solver
+}
+
+// WithDefense sets whether the solver defends root claims that match its trace, such as proposals made by our own
+// proposer, by countering every claim that disagrees with them. Defense is enabled by default.
+func (s *GameSolver) WithDefense(defend bool) *GameSolver {
+	s.ignoreValidRootClaim = !defend
+	return s
+}
+
+// WithChessClock enables chess clock tracking. Moves against claims whose clock has expired are skipped as the
+// contract would reject them, and actions are ordered so that those closest to timing out are performed first.
+// maxClockDuration is the total time each team has available, which is half the game duration.
+func (s *GameSolver) WithChessClock(cl clock.Clock, maxClockDuration time.Duration) *GameSolver {
+	s.clock = cl
+	s.maxClockDuration = maxClockDuration
+	return s
+}
+
+// ActionDeadline returns the time at which the chess clock for responding to the claim countered by action expires.
+// Returns false if chess clocks are not being tracked or the deadline can't be determined.
+func (s *GameSolver) ActionDeadline(game types.Game, action types.Action) (time.Time, bool) {
+	if s.clock == nil {
+		return time.Time{}, false
 	}
+	claims := game.Claims()
+	if action.ParentIdx < 0 || action.ParentIdx >= len(claims) {
+		return time.Time{}, false
+	}
+	deadline, err := types.ChessClockDeadline(game, claims[action.ParentIdx], s.maxClockDuration)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return deadline, true
 }
 
 func (s *GameSolver) AgreeWithRootClaim(ctx context.Context, game types.Game) (bool, error) {
 	return s.claimSolver.agreeWithClaim(ctx, game, game.Claims()[0])
 }
 
-func (s *GameSolver) CalculateNextActions(ctx context.Context, game types.Game) ([]types.Action, error) {
+// AssessClaims returns the solver's assessment of each claim in the game, indexed by contract index.
+// Claims that would be countered if the game were resolved now are reported as countered, as determined by
+// [types.CounteredClaims]. Other claims are assessed by whether they are on the same side of the game as the solver,
+// rather than whether their value matches the trace, as that is what determines which claims the solver responds to.
+func (s *GameSolver) AssessClaims(ctx context.Context, game types.Game) ([]types.Assessment, error) {
 	agreeWithRootClaim, err := s.AgreeWithRootClaim(ctx, game)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine if root claim is correct: %w", err)
 	}
-	var errs []error
-	var actions []types.Action
+	claims := game.Claims()
+	countered := types.CounteredClaims(claims, int(game.MaxDepth()))
+	assessments := make([]types.Assessment, len(claims))
+	for i, claim := range claims {
+		switch {
+		case countered[i]:
+			assessments[i] = types.AssessmentCountered
+		case game.AgreeWithClaimLevel(claim, agreeWithRootClaim):
+			assessments[i] = types.AssessmentAgree
+		default:
+			assessments[i] = types.AssessmentDisagree
+		}
+	}
+	return assessments, nil
+}
+
+// HonestLeafClaims returns the claims at max depth that are on an honest path and match the solver's trace.
+// These claims can only be countered by a step if the solver's trace is wrong, so an honest leaf claim being
+// countered indicates the solver is losing the game.
+func (s *GameSolver) HonestLeafClaims(ctx context.Context, game types.Game) ([]types.Claim, error) {
+	agreeWithRootClaim, err := s.AgreeWithRootClaim(ctx, game)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine if root claim is correct: %w", err)
+	}
+	honest, errs := s.honestClaims(ctx, game, agreeWithRootClaim)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	var leaves []types.Claim
 	for _, claim := range game.Claims() {
+		if uint64(claim.Depth()) == game.MaxDepth() && honest[claim.ContractIndex] {
+			leaves = append(leaves, claim)
+		}
+	}
+	return leaves, nil
+}
+
+func (s *GameSolver) CalculateNextActions(ctx context.Context, game types.Game) (actions []types.Action, err error) {
+	ctx, span := tracer.Start(ctx, "GameSolver.CalculateNextActions", trace.WithAttributes(attribute.Int("claims", len(game.Claims()))))
+	defer func() {
+		span.SetAttributes(attribute.Int("actions", len(actions)))
+		tracing.EndSpan(span, err)
+	}()
+	agreeWithRootClaim, err := s.AgreeWithRootClaim(ctx, game)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine if root claim is correct: %w", err)
+	}
+	if agreeWithRootClaim && s.ignoreValidRootClaim {
+		s.log.Debug("Agree with root claim and defense is disabled, no response required")
+		return nil, nil
+	}
+	s.prefetch(ctx, game, agreeWithRootClaim)
+
+	honest, errs := s.honestClaims(ctx, game, agreeWithRootClaim)
+	deadlines := make(map[int]time.Time)
+	for _, claim := range game.ClaimsRequiringResponse(agreeWithRootClaim, honest) {
+		if !s.respondsTo(claim) {
+			continue
+		}
+		isStep := uint64(claim.Depth()) == game.MaxDepth()
+		if s.clock != nil {
+			deadline, err := types.ChessClockDeadline(game, claim, s.maxClockDuration)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to calculate clock deadline for claim index %v: %w", claim.ContractIndex, err))
+				continue
+			}
+			// Steps are not limited by the chess clock so are still attempted after it expires.
+			if !isStep && !s.clock.Now().Before(deadline) {
+				s.log.Debug("Clock expired, claim can no longer be countered", "claim", claim.ContractIndex, "deadline", deadline)
+				continue
+			}
+			deadlines[claim.ContractIndex] = deadline
+		}
 		var action *types.Action
 		var err error
-		if uint64(claim.Depth()) == game.MaxDepth() {
-			action, err = s.calculateStep(ctx, game, agreeWithRootClaim, claim)
+		if isStep {
+			action, err = s.calculateStep(ctx, game, claim)
 		} else {
-			action, err = s.calculateMove(ctx, game, agreeWithRootClaim, claim)
+			action, err = s.calculateMove(ctx, game, claim)
 		}
 		if err != nil {
 			errs = append(errs, err)
 			continue
 		}
 		if action == nil {
+			s.log.Debug("No response required", "claim", claim.ContractIndex, "depth", claim.Depth(), "index_at_depth", claim.IndexAtDepth())
 			continue
 		}
+		s.log.Debug("Calculated response", "claim", claim.ContractIndex, "action", action.Type, "is_attack", action.IsAttack)
 		actions = append(actions, *action)
 	}
+	if s.clock != nil {
+		// Perform the actions closest to timing out first.
+		sort.SliceStable(actions, func(i, j int) bool {
+			return deadlines[actions[i].ParentIdx].Before(deadlines[actions[j].ParentIdx])
+		})
+	}
 	return actions, errors.Join(errs...)
 }
 
-func (s *GameSolver) calculateStep(ctx context.Context, game types.Game, agreeWithRootClaim bool, claim types.Claim) (*types.Action, error) {
-	if claim.Countered {
-		return nil, nil
+// honestClaims returns the contract indices of claims at levels the solver agrees with that are on an honest path.
+// A claim is on an honest path if it matches the trace and every other claim on the path to the root does too.
+// Claims are visited in contract order so the result for the grandparent is always known. Claims the solver fails
+// to evaluate are treated as dishonest and the errors returned.
+func (s *GameSolver) honestClaims(ctx context.Context, game types.Game, agreeWithRootClaim bool) (map[int]bool, []error) {
+	var errs []error
+	honest := make(map[int]bool)
+	for _, claim := range game.Claims() {
+		if !game.AgreeWithClaimLevel(claim, agreeWithRootClaim) {
+			continue
+		}
+		if claim.IsRoot() {
+			// The root claim is only at an agreeing level if it has already been found to be correct.
+			honest[claim.ContractIndex] = true
+			continue
+		}
+		parent, err := game.GetParent(claim)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get parent of claim %v: %w", claim.ContractIndex, err))
+			continue
+		}
+		if !parent.IsRoot() && !honest[parent.ParentContractIndex] {
+			continue
+		}
+		agree, err := s.claimSolver.agreeWithClaim(ctx, game, claim)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to check claim index %v: %w", claim.ContractIndex, err))
+			continue
+		}
+		honest[claim.ContractIndex] = agree
 	}
-	if game.AgreeWithClaimLevel(claim, agreeWithRootClaim) {
-		return nil, nil
+	return honest, errs
+}
+
+// prefetch requests the trace values for all claims that may need a response in a single batch if the
+// trace supports it. This allows neighbouring claims to share one trace pass (e.g. one cannon execution)
+// rather than each claim triggering a separate execution.
+// Prefetching is only an optimisation so errors are ignored. They will recur when the value is actually used.
+func (s *GameSolver) prefetch(ctx context.Context, game types.Game, agreeWithRootClaim bool) {
+	prefetcher, ok := s.claimSolver.trace.(types.TracePrefetcher)
+	if !ok {
+		return
 	}
+	var requests []types.PrefetchRequest
+	for _, claim := range game.Claims() {
+		if !s.respondsTo(claim) || game.AgreeWithClaimLevel(claim, agreeWithRootClaim) {
+			continue
+		}
+		requests = append(requests, types.PrefetchRequest{Ref: claim, Pos: claim.Position})
+		if uint64(claim.Depth()) == game.MaxDepth() {
+			continue
+		}
+		requests = append(requests, types.PrefetchRequest{Ref: claim, Pos: claim.Attack()})
+		if !claim.IsRoot() {
+			requests = append(requests, types.PrefetchRequest{Ref: claim, Pos: claim.Defend()})
+		}
+	}
+	if len(requests) == 0 {
+		return
+	}
+	ctx, span := tracer.Start(ctx, "TracePrefetcher.Prefetch", trace.WithAttributes(attribute.Int("requests", len(requests))))
+	tracing.EndSpan(span, prefetcher.Prefetch(ctx, game, requests))
+}
+
+// respondsTo returns true if the solver is responsible for responding to claim.
+func (s *GameSolver) respondsTo(claim types.Claim) bool {
+	return claim.Depth() >= s.minClaimDepth
+}
+
+func (s *GameSolver) calculateStep(ctx context.Context, game types.Game, claim types.Claim) (*types.Action, error) {
 	step, err := s.claimSolver.AttemptStep(ctx, game, claim)
-	if errors.Is(err, ErrStepIgnoreInvalidPath) {
+	if errors.Is(err, types.ErrClaimAlreadyCountered) || errors.Is(err, ErrStepIgnoreInvalidPath) {
 		return nil, nil
 	}
 	if err != nil {
@@ -73,11 +291,11 @@ func (s *GameSolver) calculateStep(ctx context.Context, game types.Game, agreeWi
 	}, nil
 }
 
-func (s *GameSolver) calculateMove(ctx context.Context, game types.Game, agreeWithRootClaim bool, claim types.Claim) (*types.Action, error) {
-	if game.AgreeWithClaimLevel(claim, agreeWithRootClaim) {
+func (s *GameSolver) calculateMove(ctx context.Context, game types.Game, claim types.Claim) (*types.Action, error) {
+	move, err := s.claimSolver.NextMove(ctx, claim, game)
+	if errors.Is(err, types.ErrNoMovePossible) {
 		return nil, nil
 	}
-	move, err := s.claimSolver.NextMove(ctx, claim, game)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate next move for claim index %v: %w", claim.ContractIndex, err)
 	}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"encoding/hex"
 	"testing"
+	"time"
 
 	faulttest "github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,6 +57,18 @@ func TestCalculateNextActions(t *testing.T) {
 				honestClaim.Defend(common.Hash{0xdd}).ExpectAttack()
 			},
 		},
+		{
+			name: "DoNotRespondToClaimsCounteredByHonestMove",
+			setupGame: func(builder *faulttest.GameBuilder) {
+				honestClaim := builder.Seq().AttackCorrect()
+				// The honest defense differs from the attack we would make but still counters the claim.
+				honestClaim.Defend(common.Hash{0xcc}).DefendCorrect()
+				// A dishonest counter doesn't remove the need to respond.
+				dishonestClaim := honestClaim.Defend(common.Hash{0xdd})
+				dishonestClaim.ExpectAttack()
+				dishonestClaim.Defend(common.Hash{0xee})
+			},
+		},
 		{
 			name: "StepAtMaxDepth",
 			setupGame: func(builder *faulttest.GameBuilder) {
@@ -94,7 +111,7 @@ func TestCalculateNextActions(t *testing.T) {
 					i, claim.Position.ToGIndex(), claim.Position.TraceIndex(maxDepth), claim.ParentContractIndex, claim.Countered, claim.Value)
 			}
 
-			solver := NewGameSolver(maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
+			solver := NewGameSolver(testlog.Logger(t, log.LvlError), maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
 			actions, err := solver.CalculateNextActions(context.Background(), game)
 			require.NoError(t, err)
 			for i, action := range actions {
@@ -112,3 +129,227 @@ func TestCalculateNextActions(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateNextActions_ExecutionOnly(t *testing.T) {
+	maxDepth := 6
+	splitDepth := 3
+	claimBuilder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+	builder := claimBuilder.GameBuilder(false)
+	// Claims above the split depth are left for another actor to counter.
+	honestClaim := builder.Seq().AttackCorrect()
+	honestClaim.Attack(common.Hash{0xaa})
+	honestClaim.AttackCorrect().AttackCorrect().Attack(common.Hash{0xbb}).ExpectAttack()
+
+	solver := NewExecutionOnlyGameSolver(testlog.Logger(t, log.LvlError), maxDepth, splitDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
+	actions, err := solver.CalculateNextActions(context.Background(), builder.Game)
+	require.NoError(t, err)
+	require.ElementsMatch(t, builder.ExpectedActions, actions)
+}
+
+func TestCalculateNextActions_PrefetchesSiblingClaims(t *testing.T) {
+	maxDepth := 4
+	claimBuilder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+	builder := claimBuilder.GameBuilder(false)
+	honestClaim := builder.Seq().AttackCorrect()
+	honestClaim.Attack(common.Hash{0xaa})
+	honestClaim.Defend(common.Hash{0xbb})
+
+	provider := &prefetchingProvider{TraceProvider: claimBuilder.CorrectTraceProvider()}
+	solver := NewGameSolver(nil, maxDepth, trace.NewSimpleTraceAccessor(provider))
+	_, err := solver.CalculateNextActions(context.Background(), builder.Game)
+	require.NoError(t, err)
+	require.Len(t, provider.prefetched, 1, "should prefetch all positions in a single batch")
+
+	claims := builder.Game.Claims()
+	// Root claim, plus the two dishonest sibling claims need their own position, attack and defend values.
+	expected := []types.Position{claims[0].Position, claims[0].Attack()}
+	for _, claim := range claims[2:] {
+		expected = append(expected, claim.Position, claim.Attack(), claim.Defend())
+	}
+	require.Equal(t, expected, provider.prefetched[0])
+}
+
+func TestCalculateNextActions_Defense(t *testing.T) {
+	maxDepth := 4
+	claimBuilder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+
+	attackInvalidRoot := func(builder *faulttest.GameBuilder) {
+		builder.Seq().ExpectAttack()
+	}
+	defendValidRoot := func(builder *faulttest.GameBuilder) {
+		builder.Seq().Attack(common.Hash{0xaa}).ExpectAttack()
+		builder.Seq().Attack(common.Hash{0xbb}).AttackCorrect().Attack(common.Hash{0xcc}).ExpectAttack()
+	}
+
+	tests := []struct {
+		name             string
+		rootClaimCorrect bool
+		defend           bool
+		setupGame        func(builder *faulttest.GameBuilder)
+	}{
+		{name: "AttackInvalidRootClaim", defend: true, setupGame: attackInvalidRoot},
+		{name: "AttackInvalidRootClaimWithoutDefense", setupGame: attackInvalidRoot},
+		{name: "DefendValidRootClaim", rootClaimCorrect: true, defend: true, setupGame: defendValidRoot},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			builder := claimBuilder.GameBuilder(test.rootClaimCorrect)
+			test.setupGame(builder)
+			solver := NewGameSolver(nil, maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider())).
+				WithDefense(test.defend)
+			actions, err := solver.CalculateNextActions(context.Background(), builder.Game)
+			require.NoError(t, err)
+			for _, action := range actions {
+				require.NoError(t, checkRules(builder.Game, action), "Attempting to perform invalid action")
+			}
+			require.ElementsMatch(t, builder.ExpectedActions, actions)
+		})
+	}
+
+	t.Run("DefendValidRootClaimByDefault", func(t *testing.T) {
+		builder := claimBuilder.GameBuilder(true)
+		defendValidRoot(builder)
+		solver := NewGameSolver(nil, maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
+		actions, err := solver.CalculateNextActions(context.Background(), builder.Game)
+		require.NoError(t, err)
+		require.ElementsMatch(t, builder.ExpectedActions, actions)
+	})
+
+	t.Run("IgnoreValidRootClaimWithoutDefense", func(t *testing.T) {
+		builder := claimBuilder.GameBuilder(true)
+		defendValidRoot(builder)
+		solver := NewGameSolver(nil, maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider())).
+			WithDefense(false)
+		actions, err := solver.CalculateNextActions(context.Background(), builder.Game)
+		require.NoError(t, err)
+		require.Empty(t, actions)
+	})
+}
+
+func TestCalculateNextActions_ChessClock(t *testing.T) {
+	maxDepth := 4
+	claimBuilder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+	builder := claimBuilder.GameBuilder(false)
+	honestClaim := builder.Seq().AttackCorrect()
+	honestClaim.Attack(common.Hash{0xaa})
+	honestClaim.Defend(common.Hash{0xbb})
+
+	claims := builder.Game.Claims()
+	claims[0].Clock = 10
+	claims[1].Clock = 20
+	claims[1].ClockDuration = 10
+	claims[2].Clock = 100
+	claims[3].Clock = 50
+	game := types.NewGameState(claims, uint64(maxDepth))
+	maxClockDuration := 1000 * time.Second
+
+	t.Run("PrioritiseClosestToTimingOut", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(500, 0))
+		solver := NewGameSolver(nil, maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider())).
+			WithChessClock(cl, maxClockDuration)
+		actions, err := solver.CalculateNextActions(context.Background(), game)
+		require.NoError(t, err)
+		require.Len(t, actions, 2)
+		require.Equal(t, 3, actions[0].ParentIdx)
+		require.Equal(t, 2, actions[1].ParentIdx)
+
+		deadline, ok := solver.ActionDeadline(game, actions[0])
+		require.True(t, ok)
+		require.Equal(t, time.Unix(1040, 0), deadline)
+	})
+
+	t.Run("SkipExpiredClocks", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(1075, 0))
+		solver := NewGameSolver(nil, maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider())).
+			WithChessClock(cl, maxClockDuration)
+		actions, err := solver.CalculateNextActions(context.Background(), game)
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		require.Equal(t, 2, actions[0].ParentIdx)
+	})
+}
+
+type prefetchingProvider struct {
+	types.TraceProvider
+	prefetched [][]types.Position
+}
+
+func (p *prefetchingProvider) Prefetch(_ context.Context, positions []types.Position) error {
+	p.prefetched = append(p.prefetched, positions)
+	return nil
+}
+
+func TestAssessClaims(t *testing.T) {
+	maxDepth := 4
+	claimBuilder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+	solver := NewGameSolver(nil, maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
+
+	t.Run("InvalidRootClaim", func(t *testing.T) {
+		builder := claimBuilder.GameBuilder(false)
+		builder.Seq().AttackCorrect().Attack(common.Hash{0xaa})
+		claims := builder.Game.Claims()
+		// Above max depth the contract flags any claim that has been moved against, so the flag is not used.
+		claims[2].Countered = true
+		game := types.NewGameState(claims, uint64(maxDepth))
+		assessments, err := solver.AssessClaims(context.Background(), game)
+		require.NoError(t, err)
+		require.Equal(t, []types.Assessment{types.AssessmentDisagree, types.AssessmentCountered, types.AssessmentDisagree}, assessments)
+	})
+
+	t.Run("CounteredByStep", func(t *testing.T) {
+		builder := claimBuilder.GameBuilder(false)
+		builder.Seq().AttackCorrect().Attack(common.Hash{0xaa}).AttackCorrect().Attack(common.Hash{0xbb})
+		claims := builder.Game.Claims()
+		claims[4].Countered = true
+		game := types.NewGameState(claims, uint64(maxDepth))
+		assessments, err := solver.AssessClaims(context.Background(), game)
+		require.NoError(t, err)
+		require.Equal(t, []types.Assessment{
+			types.AssessmentCountered,
+			types.AssessmentAgree,
+			types.AssessmentCountered,
+			types.AssessmentAgree,
+			types.AssessmentCountered,
+		}, assessments)
+	})
+
+	t.Run("ValidRootClaim", func(t *testing.T) {
+		builder := claimBuilder.GameBuilder(true)
+		builder.Seq().Attack(common.Hash{0xaa}).AttackCorrect()
+		assessments, err := solver.AssessClaims(context.Background(), builder.Game)
+		require.NoError(t, err)
+		require.Equal(t, []types.Assessment{types.AssessmentAgree, types.AssessmentCountered, types.AssessmentAgree}, assessments)
+	})
+}
+
+func TestHonestLeafClaims(t *testing.T) {
+	maxDepth := 4
+	claimBuilder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+	solver := NewGameSolver(nil, maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
+
+	t.Run("HonestLeaf", func(t *testing.T) {
+		builder := claimBuilder.GameBuilder(true)
+		builder.Seq().Attack(common.Hash{0xaa}).AttackCorrect().Attack(common.Hash{0xbb}).AttackCorrect()
+		leaves, err := solver.HonestLeafClaims(context.Background(), builder.Game)
+		require.NoError(t, err)
+		require.Len(t, leaves, 1)
+		require.Equal(t, 4, leaves[0].ContractIndex)
+	})
+
+	t.Run("DishonestLeaf", func(t *testing.T) {
+		builder := claimBuilder.GameBuilder(true)
+		builder.Seq().Attack(common.Hash{0xaa}).AttackCorrect().Attack(common.Hash{0xbb}).Attack(common.Hash{0xcc})
+		leaves, err := solver.HonestLeafClaims(context.Background(), builder.Game)
+		require.NoError(t, err)
+		require.Empty(t, leaves)
+	})
+
+	t.Run("OpponentLeaf", func(t *testing.T) {
+		builder := claimBuilder.GameBuilder(false)
+		builder.Seq().AttackCorrect().Attack(common.Hash{0xaa}).AttackCorrect().Attack(common.Hash{0xbb})
+		leaves, err := solver.HonestLeafClaims(context.Background(), builder.Game)
+		require.NoError(t, err)
+		require.Empty(t, leaves)
+	})
+}
@@ -6,7 +6,13 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 )
 
 var (
@@ -17,22 +23,26 @@ var (
 
 // claimSolver uses a [TraceProvider] to determine the moves to make in a dispute game.
 type claimSolver struct {
+	log       log.Logger
 	trace     types.TraceAccessor
 	gameDepth int
 }
 
 // newClaimSolver creates a new [claimSolver] using the provided [TraceProvider].
-func newClaimSolver(gameDepth int, trace types.TraceAccessor) *claimSolver {
+func newClaimSolver(logger log.Logger, gameDepth int, trace types.TraceAccessor) *claimSolver {
 	return &claimSolver{
-		trace,
-		gameDepth,
+		log:       logger,
+		trace:     trace,
+		gameDepth: gameDepth,
 	}
 }
 
 // NextMove returns the next move to make given the current state of the game.
+// Returns types.ErrGameDepthExceeded if the claim is at the max depth and types.ErrNoMovePossible if no move
+// should be made against the claim.
 func (s *claimSolver) NextMove(ctx context.Context, claim types.Claim, game types.Game) (*types.Claim, error) {
 	if claim.Depth() == s.gameDepth {
-		return nil, types.ErrGameDepthReached
+		return nil, types.ErrGameDepthExceeded
 	}
 
 	// Before challenging this claim, first check that the move wasn't warranted.
@@ -48,7 +58,8 @@ func (s *claimSolver) NextMove(ctx context.Context, claim types.Claim, game type
 			return nil, err
 		}
 		if !agreeWithParent {
-			return nil, nil
+			s.log.Debug("Not responding to claim on dishonest path", "claim", claim.ContractIndex, "parent", parent.ContractIndex)
+			return nil, fmt.Errorf("%w: parent claim %v is on a dishonest path", types.ErrNoMovePossible, parent.ContractIndex)
 		}
 	}
 
@@ -56,11 +67,7 @@ func (s *claimSolver) NextMove(ctx context.Context, claim types.Claim, game type
 	if err != nil {
 		return nil, err
 	}
-	if agree {
-		return s.defend(ctx, game, claim)
-	} else {
-		return s.attack(ctx, game, claim)
-	}
+	return s.move(ctx, game, claim, agree)
 }
 
 type StepData struct {
@@ -73,11 +80,15 @@ type StepData struct {
 
 // AttemptStep determines what step should occur for a given leaf claim.
 // An error will be returned if the claim is not at the max depth.
-// Returns ErrStepIgnoreInvalidPath if the claim disputes an invalid path
+// Returns types.ErrClaimAlreadyCountered if the claim has already been countered and
+// ErrStepIgnoreInvalidPath if the claim disputes an invalid path.
 func (s *claimSolver) AttemptStep(ctx context.Context, game types.Game, claim types.Claim) (StepData, error) {
 	if claim.Depth() != s.gameDepth {
 		return StepData{}, ErrStepNonLeafNode
 	}
+	if claim.Countered {
+		return StepData{}, types.ErrClaimAlreadyCountered
+	}
 
 	// Step only on claims that dispute a valid path
 	parent, err := game.GetParent(claim)
@@ -89,6 +100,7 @@ func (s *claimSolver) AttemptStep(ctx context.Context, game types.Game, claim ty
 		return StepData{}, err
 	}
 	if !parentValid {
+		s.log.Debug("Not stepping on claim that disputes an invalid path", "claim", claim.ContractIndex, "parent", parent.ContractIndex)
 		return StepData{}, ErrStepIgnoreInvalidPath
 	}
 
@@ -107,7 +119,7 @@ func (s *claimSolver) AttemptStep(ctx context.Context, game types.Game, claim ty
 		position = claim.Position.MoveRight()
 	}
 
-	preState, proofData, oracleData, err := s.trace.GetStepData(ctx, game, claim, position)
+	preState, proofData, oracleData, err := s.getStepData(ctx, game, claim, position)
 	if err != nil {
 		return StepData{}, err
 	}
@@ -121,28 +133,15 @@ func (s *claimSolver) AttemptStep(ctx context.Context, game types.Game, claim ty
 	}, nil
 }
 
-// attack returns a response that attacks the claim.
-func (s *claimSolver) attack(ctx context.Context, game types.Game, claim types.Claim) (*types.Claim, error) {
-	position := claim.Attack()
-	value, err := s.trace.Get(ctx, game, claim, position)
+// move returns the honest response to the claim, defending it if agree is true and attacking it otherwise.
+func (s *claimSolver) move(ctx context.Context, game types.Game, claim types.Claim, agree bool) (*types.Claim, error) {
+	position, err := claim.Position.MoveTo(agree)
 	if err != nil {
-		return nil, fmt.Errorf("attack claim: %w", err)
-	}
-	return &types.Claim{
-		ClaimData:           types.ClaimData{Value: value, Position: position},
-		ParentContractIndex: claim.ContractIndex,
-	}, nil
-}
-
-// defend returns a response that defends the claim.
-func (s *claimSolver) defend(ctx context.Context, game types.Game, claim types.Claim) (*types.Claim, error) {
-	if claim.IsRoot() {
-		return nil, nil
+		return nil, err
 	}
-	position := claim.Defend()
-	value, err := s.trace.Get(ctx, game, claim, position)
+	value, err := s.get(ctx, game, claim, position)
 	if err != nil {
-		return nil, fmt.Errorf("defend claim: %w", err)
+		return nil, fmt.Errorf("move against claim: %w", err)
 	}
 	return &types.Claim{
 		ClaimData:           types.ClaimData{Value: value, Position: position},
@@ -152,7 +151,7 @@ func (s *claimSolver) defend(ctx context.Context, game types.Game, claim types.C
 
 // agreeWithClaim returns true if the claim is correct according to the internal [TraceProvider].
 func (s *claimSolver) agreeWithClaim(ctx context.Context, game types.Game, claim types.Claim) (bool, error) {
-	ourValue, err := s.trace.Get(ctx, game, claim, claim.Position)
+	ourValue, err := s.get(ctx, game, claim, claim.Position)
 	return bytes.Equal(ourValue[:], claim.Value[:]), err
 }
 
@@ -181,3 +180,30 @@ func (s *claimSolver) agreeWithClaimPath(ctx context.Context, game types.Game, c
 	}
 	return s.agreeWithClaimPath(ctx, game, grandParent)
 }
+
+// get loads the honest claim value at pos from the trace, recording the call in a span.
+func (s *claimSolver) get(ctx context.Context, game types.Game, ref types.Claim, pos types.Position) (common.Hash, error) {
+	ctx, span := tracer.Start(ctx, "TraceAccessor.Get", trace.WithAttributes(positionAttributes(pos)...))
+	value, err := s.trace.Get(ctx, game, ref, pos)
+	tracing.EndSpan(span, err)
+	if err == nil {
+		s.log.Debug("Loaded trace value", "ref", ref.ContractIndex, "depth", pos.Depth(), "index_at_depth", pos.IndexAtDepth(), "value", value)
+	}
+	return value, err
+}
+
+// getStepData loads the data required to step at pos from the trace, recording the call in a span.
+func (s *claimSolver) getStepData(ctx context.Context, game types.Game, ref types.Claim, pos types.Position) ([]byte, []byte, *types.PreimageOracleData, error) {
+	ctx, span := tracer.Start(ctx, "TraceAccessor.GetStepData", trace.WithAttributes(positionAttributes(pos)...))
+	preState, proofData, oracleData, err := s.trace.GetStepData(ctx, game, ref, pos)
+	span.SetAttributes(attribute.Bool("oracle_data", oracleData != nil))
+	tracing.EndSpan(span, err)
+	return preState, proofData, oracleData, err
+}
+
+func positionAttributes(pos types.Position) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("depth", pos.Depth()),
+		attribute.String("index_at_depth", pos.IndexAtDepth().String()),
+	}
+}
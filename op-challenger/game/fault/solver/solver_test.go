@@ -8,7 +8,9 @@ import (
 	faulttest "github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,6 +27,7 @@ func TestAttemptStep(t *testing.T) {
 	tests := []struct {
 		name                string
 		agreeWithOutputRoot bool
+		countered           bool
 		expectedErr         error
 		expectAttack        bool
 		expectPreState      []byte
@@ -118,6 +121,18 @@ func TestAttemptStep(t *testing.T) {
 			expectedErr:         ErrStepNonLeafNode,
 			agreeWithOutputRoot: true,
 		},
+		{
+			name: "CannotStepCounteredClaim",
+			setupGame: func(builder *faulttest.GameBuilder) {
+				builder.Seq().
+					AttackCorrect().
+					DefendCorrect().
+					Attack(common.Hash{0xaa})
+			},
+			countered:           true,
+			expectedErr:         types.ErrClaimAlreadyCountered,
+			agreeWithOutputRoot: true,
+		},
 		{
 			name: "CannotStepAgreedNode",
 			setupGame: func(builder *faulttest.GameBuilder) {
@@ -162,10 +177,11 @@ func TestAttemptStep(t *testing.T) {
 		t.Run(tableTest.name, func(t *testing.T) {
 			builder := claimBuilder.GameBuilder(!tableTest.agreeWithOutputRoot)
 			tableTest.setupGame(builder)
-			alphabetSolver := newClaimSolver(maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
+			alphabetSolver := newClaimSolver(testlog.Logger(t, log.LvlError), maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
 			game := builder.Game
 			claims := game.Claims()
 			lastClaim := claims[len(claims)-1]
+			lastClaim.Countered = tableTest.countered
 			step, err := alphabetSolver.AttemptStep(ctx, game, lastClaim)
 			if tableTest.expectedErr == nil {
 				require.NoError(t, err)
@@ -184,3 +200,55 @@ func TestAttemptStep(t *testing.T) {
 		})
 	}
 }
+
+func TestNextMove(t *testing.T) {
+	maxDepth := 3
+	claimBuilder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		rootCorrect bool
+		setupGame   func(builder *faulttest.GameBuilder)
+		expectedErr error
+	}{
+		{
+			name: "GameDepthExceeded",
+			setupGame: func(builder *faulttest.GameBuilder) {
+				builder.Seq().
+					AttackCorrect().
+					AttackCorrect().
+					Attack(common.Hash{0xaa})
+			},
+			expectedErr: types.ErrGameDepthExceeded,
+		},
+		{
+			name:        "CannotDefendRoot",
+			rootCorrect: true,
+			setupGame:   func(builder *faulttest.GameBuilder) {},
+			expectedErr: types.ErrNoMovePossible,
+		},
+		{
+			name: "ParentOnDishonestPath",
+			setupGame: func(builder *faulttest.GameBuilder) {
+				builder.Seq().
+					Attack(common.Hash{0xaa}).
+					Attack(common.Hash{0xbb})
+			},
+			expectedErr: types.ErrNoMovePossible,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			builder := claimBuilder.GameBuilder(test.rootCorrect)
+			test.setupGame(builder)
+			solver := newClaimSolver(testlog.Logger(t, log.LvlError), maxDepth, trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider()))
+			claims := builder.Game.Claims()
+			move, err := solver.NextMove(ctx, claims[len(claims)-1], builder.Game)
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Nil(t, move)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+// Package store persists the state of the fault dispute games the challenger is playing so that a restart resumes
+// from where it left off rather than reloading every game from scratch.
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/kvstore"
+)
+
+// Namespace is the kvstore namespace the game state is stored in.
+const Namespace = "fault-games"
+
+const (
+	statusPrefix     byte = 's'
+	claimPrefix      byte = 'c'
+	movePrefix       byte = 'm'
+	assessmentPrefix byte = 'a'
+)
+
+// MoveStatus is the status of a move the challenger has made.
+type MoveStatus string
+
+const (
+	MoveStatusPending   MoveStatus = "pending"
+	MoveStatusConfirmed MoveStatus = "confirmed"
+	MoveStatusFailed    MoveStatus = "failed"
+	// MoveStatusInterrupted marks moves that were pending when the challenger was stopped.
+	// The transaction may or may not have been included.
+	MoveStatusInterrupted MoveStatus = "interrupted"
+)
+
+// Move is an action the challenger performed on a game.
+type Move struct {
+	Type      types.ActionType `json:"type"`
+	ParentIdx int              `json:"parentIdx"`
+	IsAttack  bool             `json:"isAttack"`
+	// Value is the claim posted by a move. It is empty for steps.
+	Value  common.Hash `json:"value"`
+	Status MoveStatus  `json:"status"`
+}
+
+// MoveFromAction creates a Move with the given status from an action performed by the challenger.
+func MoveFromAction(action types.Action, status MoveStatus) Move {
+	return Move{
+		Type:      action.Type,
+		ParentIdx: action.ParentIdx,
+		IsAttack:  action.IsAttack,
+		Value:     action.Value,
+		Status:    status,
+	}
+}
+
+// Store records the state of every game the challenger is enrolled in.
+type Store struct {
+	ns *kvstore.Namespace
+}
+
+func NewStore(kv *kvstore.Store) (*Store, error) {
+	ns, err := kv.Namespace(Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open game store: %w", err)
+	}
+	return &Store{ns: ns}, nil
+}
+
+// Game returns the stored state of the game at addr.
+func (s *Store) Game(addr common.Address) *Game {
+	return &Game{ns: s.ns, addr: addr}
+}
+
+// Games returns the status of every enrolled game.
+func (s *Store) Games() (map[common.Address]gameTypes.GameStatus, error) {
+	games := make(map[common.Address]gameTypes.GameStatus)
+	err := s.ns.Iterate([]byte{statusPrefix}, func(key []byte, value []byte) error {
+		status, err := decodeStatus(value)
+		if err != nil {
+			return err
+		}
+		games[common.BytesToAddress(key[1:])] = status
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load enrolled games: %w", err)
+	}
+	return games, nil
+}
+
+// Game is the stored state of a single game.
+type Game struct {
+	ns   *kvstore.Namespace
+	addr common.Address
+}
+
+// Status returns the last recorded status of the game. Returns false if the game is not enrolled.
+func (g *Game) Status() (gameTypes.GameStatus, bool, error) {
+	value, err := g.ns.Get(g.key(statusPrefix))
+	if errors.Is(err, kvstore.ErrNotFound) {
+		return gameTypes.GameStatusInProgress, false, nil
+	} else if err != nil {
+		return gameTypes.GameStatusInProgress, false, err
+	}
+	status, err := decodeStatus(value)
+	if err != nil {
+		return gameTypes.GameStatusInProgress, false, err
+	}
+	return status, true, nil
+}
+
+// SetStatus enrolls the game, if it isn't already, and records its status.
+// Claims, moves and assessments are no longer required once the game is resolved so are removed.
+func (g *Game) SetStatus(status gameTypes.GameStatus) error {
+	batch := g.ns.NewBatch()
+	batch.Put(g.key(statusPrefix), []byte{byte(status)})
+	if status != gameTypes.GameStatusInProgress {
+		if err := g.deleteAll(batch, claimPrefix); err != nil {
+			return err
+		}
+		if err := g.deleteAll(batch, movePrefix); err != nil {
+			return err
+		}
+		if err := g.deleteAll(batch, assessmentPrefix); err != nil {
+			return err
+		}
+	}
+	return batch.Commit()
+}
+
+// Claims returns the stored claims of the game, ordered by contract index.
+func (g *Game) Claims() ([]types.Claim, error) {
+	var claims []types.Claim
+	err := g.ns.Iterate(g.key(claimPrefix), func(_ []byte, value []byte) error {
+		var claim types.Claim
+		if err := json.Unmarshal(value, &claim); err != nil {
+			return fmt.Errorf("invalid stored claim: %w", err)
+		}
+		claims = append(claims, claim)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load claims of game %v: %w", g.addr, err)
+	}
+	for i, claim := range claims {
+		if claim.ContractIndex != i {
+			return nil, fmt.Errorf("stored claims of game %v are not contiguous at index %v", g.addr, i)
+		}
+	}
+	return claims, nil
+}
+
+// UpdateClaims stores the updated claims and removes any stored claims with an index of count or above, so that the
+// game has count claims stored afterwards. Stored claims below count that are not updated are left unchanged.
+func (g *Game) UpdateClaims(updated []types.Claim, count int) error {
+	batch := g.ns.NewBatch()
+	err := g.ns.Iterate(g.key(claimPrefix), func(key []byte, _ []byte) error {
+		if idx := binary.BigEndian.Uint64(key[len(key)-8:]); idx >= uint64(count) {
+			batch.Delete(key)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load claims of game %v: %w", g.addr, err)
+	}
+	for _, claim := range updated {
+		value, err := json.Marshal(claim)
+		if err != nil {
+			return fmt.Errorf("failed to encode claim %v: %w", claim.ContractIndex, err)
+		}
+		batch.Put(g.claimKey(claim.ContractIndex), value)
+	}
+	return batch.Commit()
+}
+
+// Moves returns the moves the challenger has made in the game.
+func (g *Game) Moves() ([]Move, error) {
+	var moves []Move
+	err := g.ns.Iterate(g.key(movePrefix), func(_ []byte, value []byte) error {
+		var move Move
+		if err := json.Unmarshal(value, &move); err != nil {
+			return fmt.Errorf("invalid stored move: %w", err)
+		}
+		moves = append(moves, move)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load moves of game %v: %w", g.addr, err)
+	}
+	return moves, nil
+}
+
+// RecordMove stores move, replacing the status of any previous attempt to perform the same move.
+func (g *Game) RecordMove(move Move) error {
+	value, err := json.Marshal(move)
+	if err != nil {
+		return fmt.Errorf("failed to encode move: %w", err)
+	}
+	return g.ns.Put(g.moveKey(move), value)
+}
+
+// Assessments returns the solver's last assessment of each claim in the game, indexed by contract index.
+// Returns nil if the game has not been assessed.
+func (g *Game) Assessments() ([]types.Assessment, error) {
+	value, err := g.ns.Get(g.key(assessmentPrefix))
+	if errors.Is(err, kvstore.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load assessments of game %v: %w", g.addr, err)
+	}
+	var assessments []types.Assessment
+	if err := json.Unmarshal(value, &assessments); err != nil {
+		return nil, fmt.Errorf("invalid stored assessments of game %v: %w", g.addr, err)
+	}
+	return assessments, nil
+}
+
+// SetAssessments replaces the stored assessments of the game's claims.
+func (g *Game) SetAssessments(assessments []types.Assessment) error {
+	value, err := json.Marshal(assessments)
+	if err != nil {
+		return fmt.Errorf("failed to encode assessments: %w", err)
+	}
+	return g.ns.Put(g.key(assessmentPrefix), value)
+}
+
+func (g *Game) key(prefix byte) []byte {
+	key := make([]byte, 0, 1+common.AddressLength)
+	key = append(key, prefix)
+	return append(key, g.addr.Bytes()...)
+}
+
+func (g *Game) claimKey(idx int) []byte {
+	return binary.BigEndian.AppendUint64(g.key(claimPrefix), uint64(idx))
+}
+
+func (g *Game) moveKey(move Move) []byte {
+	key := g.key(movePrefix)
+	key = append(key, move.Type...)
+	key = binary.BigEndian.AppendUint64(key, uint64(move.ParentIdx))
+	if move.IsAttack {
+		key = append(key, 1)
+	} else {
+		key = append(key, 0)
+	}
+	return append(key, move.Value.Bytes()...)
+}
+
+func (g *Game) deleteAll(batch *kvstore.Batch, prefix byte) error {
+	return g.ns.Iterate(g.key(prefix), func(key []byte, _ []byte) error {
+		batch.Delete(key)
+		return nil
+	})
+}
+
+func decodeStatus(value []byte) (gameTypes.GameStatus, error) {
+	if len(value) != 1 {
+		return gameTypes.GameStatusInProgress, fmt.Errorf("invalid stored game status: %x", value)
+	}
+	return gameTypes.GameStatusFromUint8(value[0])
+}
@@ -0,0 +1,160 @@
+package store
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/kvstore"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestStatus(t *testing.T) {
+	s := newTestStore(t)
+	game1 := common.Address{0xaa}
+	game2 := common.Address{0xbb}
+
+	_, ok, err := s.Game(game1).Status()
+	require.NoError(t, err)
+	require.False(t, ok, "game not enrolled")
+
+	require.NoError(t, s.Game(game1).SetStatus(gameTypes.GameStatusInProgress))
+	require.NoError(t, s.Game(game2).SetStatus(gameTypes.GameStatusDefenderWon))
+
+	status, ok, err := s.Game(game2).Status()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, gameTypes.GameStatusDefenderWon, status)
+
+	games, err := s.Games()
+	require.NoError(t, err)
+	require.Equal(t, map[common.Address]gameTypes.GameStatus{
+		game1: gameTypes.GameStatusInProgress,
+		game2: gameTypes.GameStatusDefenderWon,
+	}, games)
+}
+
+func TestClaims(t *testing.T) {
+	s := newTestStore(t)
+	game := s.Game(common.Address{0xaa})
+	other := s.Game(common.Address{0xbb})
+	claims := []types.Claim{claim(0, 0, 0), claim(1, 1, 0), claim(2, 2, 1)}
+
+	require.NoError(t, game.UpdateClaims(claims, len(claims)))
+	require.NoError(t, other.UpdateClaims(claims[:1], 1))
+	actual, err := game.Claims()
+	require.NoError(t, err)
+	require.Equal(t, claims, actual)
+
+	t.Run("UpdateExisting", func(t *testing.T) {
+		updated := claims[1]
+		updated.Countered = true
+		require.NoError(t, game.UpdateClaims([]types.Claim{updated}, len(claims)))
+		actual, err := game.Claims()
+		require.NoError(t, err)
+		require.Equal(t, []types.Claim{claims[0], updated, claims[2]}, actual)
+	})
+
+	t.Run("RemoveAboveCount", func(t *testing.T) {
+		require.NoError(t, game.UpdateClaims(nil, 1))
+		actual, err := game.Claims()
+		require.NoError(t, err)
+		require.Equal(t, claims[:1], actual)
+	})
+
+	t.Run("NotContiguous", func(t *testing.T) {
+		require.NoError(t, other.UpdateClaims([]types.Claim{claims[2]}, 3))
+		_, err := other.Claims()
+		require.ErrorContains(t, err, "not contiguous")
+	})
+}
+
+func TestMoves(t *testing.T) {
+	s := newTestStore(t)
+	game := s.Game(common.Address{0xaa})
+	attack := types.Action{Type: types.ActionTypeMove, ParentIdx: 1, IsAttack: true, Value: common.Hash{0x01}}
+	step := types.Action{Type: types.ActionTypeStep, ParentIdx: 2, IsAttack: false, PreState: []byte{1}}
+
+	require.NoError(t, game.RecordMove(MoveFromAction(attack, MoveStatusPending)))
+	require.NoError(t, game.RecordMove(MoveFromAction(step, MoveStatusPending)))
+	require.NoError(t, game.RecordMove(MoveFromAction(attack, MoveStatusConfirmed)))
+
+	moves, err := game.Moves()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []Move{
+		MoveFromAction(attack, MoveStatusConfirmed),
+		MoveFromAction(step, MoveStatusPending),
+	}, moves)
+}
+
+func TestAssessments(t *testing.T) {
+	s := newTestStore(t)
+	game := s.Game(common.Address{0xaa})
+	assessments, err := game.Assessments()
+	require.NoError(t, err)
+	require.Nil(t, assessments)
+
+	expected := []types.Assessment{types.AssessmentDisagree, types.AssessmentAgree, types.AssessmentCountered}
+	require.NoError(t, game.SetAssessments(expected))
+	assessments, err = game.Assessments()
+	require.NoError(t, err)
+	require.Equal(t, expected, assessments)
+
+	// Assessments are replaced rather than merged
+	require.NoError(t, game.SetAssessments(expected[:1]))
+	assessments, err = game.Assessments()
+	require.NoError(t, err)
+	require.Equal(t, expected[:1], assessments)
+}
+
+func TestResolvedGameRemovesClaimsAndMoves(t *testing.T) {
+	s := newTestStore(t)
+	game := s.Game(common.Address{0xaa})
+	require.NoError(t, game.SetStatus(gameTypes.GameStatusInProgress))
+	require.NoError(t, game.UpdateClaims([]types.Claim{claim(0, 0, 0)}, 1))
+	require.NoError(t, game.RecordMove(Move{Type: types.ActionTypeMove, Status: MoveStatusConfirmed}))
+	require.NoError(t, game.SetAssessments([]types.Assessment{types.AssessmentDisagree}))
+
+	require.NoError(t, game.SetStatus(gameTypes.GameStatusChallengerWon))
+	claims, err := game.Claims()
+	require.NoError(t, err)
+	require.Empty(t, claims)
+	moves, err := game.Moves()
+	require.NoError(t, err)
+	require.Empty(t, moves)
+	assessments, err := game.Assessments()
+	require.NoError(t, err)
+	require.Empty(t, assessments)
+	status, ok, err := game.Status()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, gameTypes.GameStatusChallengerWon, status)
+}
+
+func claim(idx int, depth int, indexAtDepth int64) types.Claim {
+	return types.Claim{
+		ClaimData: types.ClaimData{
+			Value:    common.Hash{byte(idx + 1)},
+			Position: types.NewPosition(depth, big.NewInt(indexAtDepth)),
+		},
+		Clock:               uint64(1000 + idx),
+		ContractIndex:       idx,
+		ParentContractIndex: idx - 1,
+	}
+}
+
+func newTestStore(t *testing.T) *Store {
+	kv, err := kvstore.OpenInMemory(testlog.Logger(t, log.LvlInfo), kvstore.NoopMetrics)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, kv.Close())
+	})
+	s, err := NewStore(kv)
+	require.NoError(t, err)
+	return s
+}
@@ -0,0 +1,215 @@
+package fault
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+)
+
+type ClaimFetcher interface {
+	GetClaimCount(ctx context.Context) (uint64, error)
+	GetClaims(ctx context.Context, indices ...uint64) ([]types.Claim, error)
+}
+
+// ClaimStore records the claims loaded from a game. It is implemented by store.Game to persist claims across restarts.
+type ClaimStore interface {
+	Claims() ([]types.Claim, error)
+	UpdateClaims(updated []types.Claim, count int) error
+}
+
+// storedClaimLoader loads the claims of a game, reusing the claims recorded in the claim store so only claims that
+// are new or may have changed are fetched from the contract.
+//
+// Claims are immutable once posted except for the Countered flag. The contract sets it on the parent of every move,
+// on a max depth claim when it is stepped on and rewrites it on claims with children as their subgames are resolved.
+// So uncountered max depth claims, claims with children and the parents of new claims are always refetched. The last
+// stored claim is also refetched to detect claims being removed by an L1 reorg, in which case all claims are reloaded.
+type storedClaimLoader struct {
+	logger   log.Logger
+	contract ClaimFetcher
+	store    ClaimStore
+	maxDepth int
+}
+
+func newStoredClaimLoader(logger log.Logger, contract ClaimFetcher, store ClaimStore, maxDepth int) *storedClaimLoader {
+	return &storedClaimLoader{
+		logger:   logger,
+		contract: contract,
+		store:    store,
+		maxDepth: maxDepth,
+	}
+}
+
+func (l *storedClaimLoader) GetAllClaims(ctx context.Context) ([]types.Claim, error) {
+	stored, storeErr := l.store.Claims()
+	count, err := l.contract.GetClaimCount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load claim count: %w", err)
+	}
+	if storeErr != nil {
+		l.logger.Warn("Failed to load stored claims, reloading all claims", "err", storeErr)
+		return l.reloadAll(ctx, count)
+	}
+	if uint64(len(stored)) > count {
+		l.logger.Warn("Stored claims not found in game, reloading all claims", "stored", len(stored), "count", count)
+		return l.reloadAll(ctx, count)
+	}
+
+	refetch := make([]bool, len(stored))
+	for _, claim := range stored {
+		if claim.Depth() == l.maxDepth && !claim.Countered {
+			refetch[claim.ContractIndex] = true
+		}
+		if !claim.IsRoot() && claim.ParentContractIndex < len(stored) {
+			refetch[claim.ParentContractIndex] = true
+		}
+	}
+	if len(stored) > 0 {
+		refetch[len(stored)-1] = true
+	}
+	var indices []uint64
+	for i, ok := range refetch {
+		if ok {
+			indices = append(indices, uint64(i))
+		}
+	}
+	for i := uint64(len(stored)); i < count; i++ {
+		indices = append(indices, i)
+	}
+	updated, err := l.contract.GetClaims(ctx, indices...)
+	if err != nil {
+		return nil, err
+	}
+	// The parents of new claims were countered by the moves that posted them.
+	var parents []uint64
+	for _, claim := range updated {
+		parent := claim.ParentContractIndex
+		if claim.ContractIndex >= len(stored) && !claim.IsRoot() && parent < len(stored) && !refetch[parent] {
+			refetch[parent] = true
+			parents = append(parents, uint64(parent))
+		}
+	}
+	if len(parents) > 0 {
+		sort.Slice(parents, func(i, j int) bool { return parents[i] < parents[j] })
+		countered, err := l.contract.GetClaims(ctx, parents...)
+		if err != nil {
+			return nil, err
+		}
+		updated = append(updated, countered...)
+	}
+	if len(stored) > 0 {
+		last := stored[len(stored)-1]
+		for _, claim := range updated {
+			if claim.ContractIndex == last.ContractIndex && !sameClaim(claim, last) {
+				l.logger.Warn("Stored claim changed in game, reloading all claims", "claim", last.ContractIndex)
+				return l.reloadAll(ctx, count)
+			}
+		}
+	}
+
+	claims := append(stored[:0:0], stored...)
+	claims = append(claims, make([]types.Claim, int(count)-len(stored))...)
+	for _, claim := range updated {
+		claims[claim.ContractIndex] = claim
+	}
+	l.storeClaims(updated, count)
+	return claims, nil
+}
+
+func (l *storedClaimLoader) reloadAll(ctx context.Context, count uint64) ([]types.Claim, error) {
+	indices := make([]uint64, count)
+	for i := uint64(0); i < count; i++ {
+		indices[i] = i
+	}
+	claims, err := l.contract.GetClaims(ctx, indices...)
+	if err != nil {
+		return nil, err
+	}
+	l.storeClaims(claims, count)
+	return claims, nil
+}
+
+func (l *storedClaimLoader) storeClaims(updated []types.Claim, count uint64) {
+	if err := l.store.UpdateClaims(updated, int(count)); err != nil {
+		l.logger.Warn("Failed to store claims", "err", err)
+	}
+}
+
+// memoryClaimStore is a ClaimStore that only keeps claims in memory. It is used when game state isn't persisted so that
+// claims are still fetched incrementally while the game is played.
+type memoryClaimStore struct {
+	claims []types.Claim
+}
+
+func (m *memoryClaimStore) Claims() ([]types.Claim, error) {
+	return append([]types.Claim(nil), m.claims...), nil
+}
+
+func (m *memoryClaimStore) UpdateClaims(updated []types.Claim, count int) error {
+	if count < len(m.claims) {
+		m.claims = m.claims[:count]
+	} else {
+		m.claims = append(m.claims, make([]types.Claim, count-len(m.claims))...)
+	}
+	for _, claim := range updated {
+		m.claims[claim.ContractIndex] = claim
+	}
+	return nil
+}
+
+// sameClaim returns true if a and b are the same posted claim, ignoring the mutable Countered flag.
+func sameClaim(a types.Claim, b types.Claim) bool {
+	return a.Value == b.Value &&
+		a.Position.ToGIndex().Cmp(b.Position.ToGIndex()) == 0 &&
+		a.Clock == b.Clock &&
+		a.ClockDuration == b.ClockDuration &&
+		a.ParentContractIndex == b.ParentContractIndex
+}
+
+// recordingResponder records the moves performed by the wrapped Responder in the game store.
+type recordingResponder struct {
+	Responder
+	logger log.Logger
+	store  *store.Game
+}
+
+func (r *recordingResponder) PerformAction(ctx context.Context, action types.Action) error {
+	r.recordMove(action, store.MoveStatusPending)
+	if err := r.Responder.PerformAction(ctx, action); err != nil {
+		r.recordMove(action, store.MoveStatusFailed)
+		return err
+	}
+	r.recordMove(action, store.MoveStatusConfirmed)
+	return nil
+}
+
+func (r *recordingResponder) recordMove(action types.Action, status store.MoveStatus) {
+	if err := r.store.RecordMove(store.MoveFromAction(action, status)); err != nil {
+		r.logger.Warn("Failed to record move", "type", action.Type, "parent", action.ParentIdx, "status", status, "err", err)
+	}
+}
+
+// markInterruptedMoves records any moves that were still pending when the challenger last stopped as interrupted.
+// The solver re-evaluates the game so they are retried if still required.
+func markInterruptedMoves(logger log.Logger, game *store.Game) {
+	moves, err := game.Moves()
+	if err != nil {
+		logger.Warn("Failed to load recorded moves", "err", err)
+		return
+	}
+	for _, move := range moves {
+		if move.Status != store.MoveStatusPending {
+			continue
+		}
+		logger.Info("Move interrupted by restart", "type", move.Type, "parent", move.ParentIdx, "attack", move.IsAttack)
+		move.Status = store.MoveStatusInterrupted
+		if err := game.RecordMove(move); err != nil {
+			logger.Warn("Failed to record interrupted move", "err", err)
+		}
+	}
+}
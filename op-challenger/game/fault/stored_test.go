@@ -0,0 +1,231 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/kvstore"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+const storedTestMaxDepth = 2
+
+func TestStoredClaimLoader(t *testing.T) {
+	root := storedTestClaim(0, 0, 0, -1)
+	top := storedTestClaim(1, 1, 0, 0)
+	leaf := storedTestClaim(2, 2, 0, 1)
+	countered := storedTestClaim(3, 2, 1, 1)
+	countered.Countered = true
+
+	t.Run("FetchesAllClaimsInitially", func(t *testing.T) {
+		fetcher, loader := setupStoredClaimLoader(t, root, top)
+		claims, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []types.Claim{root, top}, claims)
+		require.Equal(t, [][]uint64{{0, 1}}, fetcher.requested)
+	})
+
+	t.Run("OnlyFetchesNewAndMutableClaims", func(t *testing.T) {
+		// Claims are countered when moved against, as they are by the contract.
+		root := storedTestClaim(0, 0, 0, -1)
+		root.Countered = true
+		top := storedTestClaim(1, 1, 0, 0)
+		top.Countered = true
+		unchallenged := storedTestClaim(2, 1, 0, 0)
+		leaf := storedTestClaim(3, 2, 0, 1)
+		stepped := storedTestClaim(4, 2, 1, 1)
+		stepped.Countered = true
+		last := storedTestClaim(5, 2, 0, 1)
+		fetcher, loader := setupStoredClaimLoader(t, root, top, unchallenged, leaf, stepped, last)
+		_, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+
+		fetcher.claims[3].Countered = true
+		fetcher.requested = nil
+		claims, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, fetcher.claims, claims)
+		// Refetches the claims with children, the uncountered leaf claims and the last stored claim
+		require.Equal(t, [][]uint64{{0, 1, 3, 5}}, fetcher.requested)
+	})
+
+	t.Run("RefetchesParentsOfNewClaims", func(t *testing.T) {
+		root := storedTestClaim(0, 0, 0, -1)
+		root.Countered = true
+		top := storedTestClaim(1, 1, 0, 0)
+		unchallenged := storedTestClaim(2, 1, 0, 0)
+		fetcher, loader := setupStoredClaimLoader(t, root, top, unchallenged)
+		_, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+
+		// A move against the cached top claim sets it as countered.
+		fetcher.claims = append(fetcher.claims, storedTestClaim(3, 2, 0, 1))
+		fetcher.claims[1].Countered = true
+		fetcher.requested = nil
+		claims, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, fetcher.claims, claims)
+		require.True(t, claims[1].Countered)
+		require.Equal(t, [][]uint64{{0, 2, 3}, {1}}, fetcher.requested)
+
+		// The countered parent is stored.
+		fetcher.requested = nil
+		restarted := newStoredClaimLoader(loader.logger, fetcher, loader.store, storedTestMaxDepth)
+		claims, err = restarted.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, fetcher.claims, claims)
+		require.Equal(t, [][]uint64{{0, 1, 3}}, fetcher.requested)
+	})
+
+	t.Run("OnlyFetchesNewClaimsWithMemoryStore", func(t *testing.T) {
+		fetcher := &stubClaimFetcher{claims: []types.Claim{root, top}}
+		loader := newStoredClaimLoader(testlog.Logger(t, log.LvlInfo), fetcher, &memoryClaimStore{}, storedTestMaxDepth)
+		_, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+
+		fetcher.claims = append(fetcher.claims, leaf)
+		fetcher.requested = nil
+		claims, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []types.Claim{root, top, leaf}, claims)
+		require.Equal(t, [][]uint64{{0, 1, 2}}, fetcher.requested)
+
+		// Claims removed by a reorg are dropped from the store
+		fetcher.claims = fetcher.claims[:1]
+		fetcher.requested = nil
+		claims, err = loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []types.Claim{root}, claims)
+		require.Equal(t, [][]uint64{{0}}, fetcher.requested)
+	})
+
+	t.Run("PersistsAcrossLoaders", func(t *testing.T) {
+		fetcher, loader := setupStoredClaimLoader(t, root, top)
+		_, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+
+		fetcher.requested = nil
+		restarted := newStoredClaimLoader(loader.logger, fetcher, loader.store, storedTestMaxDepth)
+		claims, err := restarted.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []types.Claim{root, top}, claims)
+		require.Equal(t, [][]uint64{{0, 1}}, fetcher.requested)
+	})
+
+	t.Run("ReloadWhenClaimsRemoved", func(t *testing.T) {
+		fetcher, loader := setupStoredClaimLoader(t, root, top, leaf)
+		_, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+
+		fetcher.claims = fetcher.claims[:2]
+		fetcher.requested = nil
+		claims, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []types.Claim{root, top}, claims)
+		require.Equal(t, [][]uint64{{0, 1}}, fetcher.requested)
+	})
+
+	t.Run("ReloadWhenLastClaimChanged", func(t *testing.T) {
+		fetcher, loader := setupStoredClaimLoader(t, root, top)
+		_, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+
+		replaced := storedTestClaim(1, 1, 0, 0)
+		replaced.Value = common.Hash{0xff}
+		fetcher.claims[1] = replaced
+		fetcher.requested = nil
+		claims, err := loader.GetAllClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []types.Claim{root, replaced}, claims)
+		require.Equal(t, [][]uint64{{0, 1}, {0, 1}}, fetcher.requested)
+	})
+}
+
+func TestRecordingResponder(t *testing.T) {
+	game := newTestGameStore(t).Game(common.Address{0xaa})
+	logger := testlog.Logger(t, log.LvlInfo)
+	success := types.Action{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true, Value: common.Hash{0x01}}
+	failure := types.Action{Type: types.ActionTypeMove, ParentIdx: 1, IsAttack: false, Value: common.Hash{0x02}}
+	stub := &stubResponder{}
+	responder := &recordingResponder{Responder: stub, logger: logger, store: game}
+
+	require.NoError(t, responder.PerformAction(context.Background(), success))
+	stub.performActionErr = errors.New("boom")
+	require.ErrorIs(t, responder.PerformAction(context.Background(), failure), stub.performActionErr)
+	moves, err := game.Moves()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []store.Move{
+		store.MoveFromAction(success, store.MoveStatusConfirmed),
+		store.MoveFromAction(failure, store.MoveStatusFailed),
+	}, moves)
+}
+
+func TestMarkInterruptedMoves(t *testing.T) {
+	game := newTestGameStore(t).Game(common.Address{0xaa})
+	pending := store.Move{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true, Status: store.MoveStatusPending}
+	confirmed := store.Move{Type: types.ActionTypeStep, ParentIdx: 3, Status: store.MoveStatusConfirmed}
+	require.NoError(t, game.RecordMove(pending))
+	require.NoError(t, game.RecordMove(confirmed))
+
+	markInterruptedMoves(testlog.Logger(t, log.LvlInfo), game)
+	moves, err := game.Moves()
+	require.NoError(t, err)
+	interrupted := pending
+	interrupted.Status = store.MoveStatusInterrupted
+	require.ElementsMatch(t, []store.Move{interrupted, confirmed}, moves)
+}
+
+func setupStoredClaimLoader(t *testing.T, claims ...types.Claim) (*stubClaimFetcher, *storedClaimLoader) {
+	fetcher := &stubClaimFetcher{claims: claims}
+	game := newTestGameStore(t).Game(common.Address{0xaa})
+	return fetcher, newStoredClaimLoader(testlog.Logger(t, log.LvlInfo), fetcher, game, storedTestMaxDepth)
+}
+
+func newTestGameStore(t *testing.T) *store.Store {
+	kv, err := kvstore.OpenInMemory(testlog.Logger(t, log.LvlInfo), kvstore.NoopMetrics)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, kv.Close())
+	})
+	s, err := store.NewStore(kv)
+	require.NoError(t, err)
+	return s
+}
+
+func storedTestClaim(idx int, depth int, indexAtDepth int64, parentIdx int) types.Claim {
+	return types.Claim{
+		ClaimData: types.ClaimData{
+			Value:    common.Hash{byte(idx + 1)},
+			Position: types.NewPosition(depth, big.NewInt(indexAtDepth)),
+		},
+		Clock:               uint64(1000 + idx),
+		ContractIndex:       idx,
+		ParentContractIndex: parentIdx,
+	}
+}
+
+type stubClaimFetcher struct {
+	claims    []types.Claim
+	requested [][]uint64
+}
+
+func (s *stubClaimFetcher) GetClaimCount(_ context.Context) (uint64, error) {
+	return uint64(len(s.claims)), nil
+}
+
+func (s *stubClaimFetcher) GetClaims(_ context.Context, indices ...uint64) ([]types.Claim, error) {
+	s.requested = append(s.requested, indices)
+	claims := make([]types.Claim, len(indices))
+	for i, idx := range indices {
+		claims[i] = s.claims[idx]
+	}
+	return claims, nil
+}
@@ -0,0 +1,54 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ErrNotInSync is returned when the local rollup node has not yet processed the L1 data required to evaluate a game.
+var ErrNotInSync = errors.New("local node too far behind")
+
+type SyncStatusProvider interface {
+	SyncStatus(context.Context) (*eth.SyncStatus, error)
+}
+
+// SyncValidator checks that the local node is sufficiently in sync to evaluate a game.
+type SyncValidator interface {
+	// ValidateNodeSynced returns ErrNotInSync if the local node has not processed the L1 chain up to and including gameL1Head.
+	ValidateNodeSynced(ctx context.Context, gameL1Head eth.BlockID) error
+}
+
+var _ SyncValidator = (*syncStatusValidator)(nil)
+
+type syncStatusValidator struct {
+	statusProvider SyncStatusProvider
+}
+
+func newSyncStatusValidator(statusProvider SyncStatusProvider) *syncStatusValidator {
+	return &syncStatusValidator{
+		statusProvider: statusProvider,
+	}
+}
+
+func (s *syncStatusValidator) ValidateNodeSynced(ctx context.Context, gameL1Head eth.BlockID) error {
+	syncStatus, err := s.statusProvider.SyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve local node sync status: %w", err)
+	}
+	// The derivation pipeline may not have finished processing the data in CurrentL1, so require it to have moved past
+	// the game's L1 head.
+	if syncStatus.CurrentL1.Number <= gameL1Head.Number {
+		return fmt.Errorf("%w: current L1 %v, game L1 head %v", ErrNotInSync, syncStatus.CurrentL1.Number, gameL1Head.Number)
+	}
+	return nil
+}
+
+// noopSyncValidator is used for game types that do not use the rollup node.
+type noopSyncValidator struct{}
+
+func (n noopSyncValidator) ValidateNodeSynced(_ context.Context, _ eth.BlockID) error {
+	return nil
+}
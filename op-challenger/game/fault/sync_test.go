@@ -0,0 +1,48 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func TestSyncStatusValidator(t *testing.T) {
+	l1Head := eth.BlockID{Number: 100}
+
+	t.Run("ErrorFetchingStatus", func(t *testing.T) {
+		err := errors.New("boom")
+		validator := newSyncStatusValidator(&stubSyncStatusProvider{err: err})
+		require.ErrorIs(t, validator.ValidateNodeSynced(context.Background(), l1Head), err)
+	})
+
+	t.Run("BehindL1Head", func(t *testing.T) {
+		validator := newSyncStatusValidator(&stubSyncStatusProvider{currentL1: l1Head.Number - 1})
+		require.ErrorIs(t, validator.ValidateNodeSynced(context.Background(), l1Head), ErrNotInSync)
+	})
+
+	t.Run("AtL1Head", func(t *testing.T) {
+		validator := newSyncStatusValidator(&stubSyncStatusProvider{currentL1: l1Head.Number})
+		require.ErrorIs(t, validator.ValidateNodeSynced(context.Background(), l1Head), ErrNotInSync)
+	})
+
+	t.Run("AfterL1Head", func(t *testing.T) {
+		validator := newSyncStatusValidator(&stubSyncStatusProvider{currentL1: l1Head.Number + 1})
+		require.NoError(t, validator.ValidateNodeSynced(context.Background(), l1Head))
+	})
+}
+
+type stubSyncStatusProvider struct {
+	currentL1 uint64
+	err       error
+}
+
+func (s *stubSyncStatusProvider) SyncStatus(_ context.Context) (*eth.SyncStatus, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &eth.SyncStatus{CurrentL1: eth.L1BlockRef{Number: s.currentL1}}, nil
+}
@@ -2,6 +2,7 @@ package trace
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -40,4 +41,33 @@ func (t *Accessor) GetStepData(ctx context.Context, game types.Game, ref types.C
 	return provider.GetStepData(ctx, pos)
 }
 
+// Prefetch groups the requested positions by the provider responsible for them and allows each provider that
+// supports it to compute all its values in a single pass.
+func (t *Accessor) Prefetch(ctx context.Context, game types.Game, requests []types.PrefetchRequest) error {
+	var providers []types.PrefetchingTraceProvider
+	positions := make(map[types.PrefetchingTraceProvider][]types.Position)
+	for _, req := range requests {
+		provider, err := t.selector(ctx, game, req.Ref, req.Pos)
+		if err != nil {
+			return err
+		}
+		prefetcher, ok := provider.(types.PrefetchingTraceProvider)
+		if !ok {
+			continue
+		}
+		if _, ok := positions[prefetcher]; !ok {
+			providers = append(providers, prefetcher)
+		}
+		positions[prefetcher] = append(positions[prefetcher], req.Pos)
+	}
+	var errs []error
+	for _, provider := range providers {
+		if err := provider.Prefetch(ctx, positions[provider]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 var _ types.TraceAccessor = (*Accessor)(nil)
+var _ types.TracePrefetcher = (*Accessor)(nil)
@@ -68,3 +68,47 @@ func TestAccessor_UsesSelector(t *testing.T) {
 		require.Equal(t, expectedPreimageData, actualPreimageData)
 	})
 }
+
+func TestAccessor_Prefetch(t *testing.T) {
+	ctx := context.Background()
+	depth := uint64(4)
+	provider1 := &stubPrefetchingProvider{TraceProvider: alphabet.NewTraceProvider("abcdef", depth)}
+	provider2 := &stubPrefetchingProvider{TraceProvider: alphabet.NewTraceProvider("qrstuv", depth)}
+	nonPrefetching := alphabet.NewTraceProvider("xyz", depth)
+	claim := types.Claim{}
+	game := types.NewGameState([]types.Claim{claim}, depth)
+	pos1 := types.NewPositionFromGIndex(big.NewInt(4))
+	pos2 := types.NewPositionFromGIndex(big.NewInt(5))
+	pos3 := types.NewPositionFromGIndex(big.NewInt(6))
+	pos4 := types.NewPositionFromGIndex(big.NewInt(7))
+
+	accessor := NewAccessor(func(ctx context.Context, game types.Game, ref types.Claim, pos types.Position) (types.TraceProvider, error) {
+		switch pos {
+		case pos1, pos3:
+			return provider1, nil
+		case pos2:
+			return provider2, nil
+		default:
+			return nonPrefetching, nil
+		}
+	})
+	err := accessor.Prefetch(ctx, game, []types.PrefetchRequest{
+		{Ref: claim, Pos: pos1},
+		{Ref: claim, Pos: pos2},
+		{Ref: claim, Pos: pos3},
+		{Ref: claim, Pos: pos4},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]types.Position{{pos1, pos3}}, provider1.prefetched, "should prefetch in a single batch")
+	require.Equal(t, [][]types.Position{{pos2}}, provider2.prefetched)
+}
+
+type stubPrefetchingProvider struct {
+	types.TraceProvider
+	prefetched [][]types.Position
+}
+
+func (s *stubPrefetchingProvider) Prefetch(_ context.Context, positions []types.Position) error {
+	s.prefetched = append(s.prefetched, positions)
+	return nil
+}
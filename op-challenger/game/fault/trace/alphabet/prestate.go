@@ -4,9 +4,9 @@ import (
 	"context"
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/codec"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var absolutePrestate = common.Hex2Bytes("0000000000000000000000000000000000000000000000000000000000000060")
@@ -18,7 +18,5 @@ var _ types.PrestateProvider = (*AlphabetPrestateProvider)(nil)
 type AlphabetPrestateProvider struct{}
 
 func (ap *AlphabetPrestateProvider) AbsolutePreStateCommitment(_ context.Context) (common.Hash, error) {
-	hash := common.BytesToHash(crypto.Keccak256(absolutePrestate))
-	hash[0] = mipsevm.VMStatusUnfinished
-	return hash, nil
+	return codec.KeccakWithStatus{Status: mipsevm.VMStatusUnfinished}.Commitment(absolutePrestate)
 }
@@ -10,10 +10,10 @@ import (
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/codec"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const (
@@ -24,6 +24,9 @@ var (
 	ErrIndexTooLarge = errors.New("index is larger than the maximum index")
 )
 
+// stateCodec commits to the states in the alphabet trace, which are always reported as invalid VM states.
+var stateCodec = codec.KeccakWithStatus{Status: mipsevm.VMStatusInvalid}
+
 // AlphabetTraceProvider is a [TraceProvider] that provides claims for specific
 // indices in the given trace.
 type AlphabetTraceProvider struct {
@@ -74,7 +77,7 @@ func (ap *AlphabetTraceProvider) Get(ctx context.Context, i types.Position) (com
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return alphabetStateHash(claimBytes), nil
+	return stateCodec.Commitment(claimBytes)
 }
 
 // BuildAlphabetPreimage constructs the claim bytes for the index and state item.
@@ -82,12 +85,6 @@ func BuildAlphabetPreimage(i *big.Int, letter string) []byte {
 	return append(i.FillBytes(make([]byte, 32)), LetterToBytes(letter)...)
 }
 
-func alphabetStateHash(state []byte) common.Hash {
-	h := crypto.Keccak256Hash(state)
-	h[0] = mipsevm.VMStatusInvalid
-	return h
-}
-
 // LetterToBytes converts a letter to a 32 byte array
 func LetterToBytes(letter string) []byte {
 	out := make([]byte, 32)
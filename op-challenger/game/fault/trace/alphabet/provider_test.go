@@ -14,7 +14,11 @@ import (
 )
 
 func alphabetClaim(index *big.Int, letter string) common.Hash {
-	return alphabetStateHash(BuildAlphabetPreimage(index, letter))
+	claim, err := stateCodec.Commitment(BuildAlphabetPreimage(index, letter))
+	if err != nil {
+		panic(err)
+	}
+	return claim
 }
 
 // TestAlphabetProvider_Get_ClaimsByTraceIndex tests the [fault.AlphabetProvider] Get function.
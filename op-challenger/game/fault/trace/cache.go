@@ -0,0 +1,81 @@
+package trace
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CachingTraceProvider wraps a provider, memoizing the claim values it returns in a bounded LRU cache keyed by
+// position. The solver repeatedly requests the values of the same positions, such as the ancestors of each claim,
+// so caching avoids recomputing them, which may require hashing, RPC requests or VM execution.
+// Step data is not cached as it is large and only requested when stepping.
+type CachingTraceProvider struct {
+	provider types.TraceProvider
+	cache    *caching.LRUCache[common.Hash, common.Hash]
+}
+
+// NewCachingTraceProvider returns provider wrapped with a cache of up to size claim values. Cache hits and misses are
+// recorded in m with the specified label. If size is 0, provider is returned without caching.
+func NewCachingTraceProvider(m caching.Metrics, label string, size uint, provider types.TraceProvider) types.TraceProvider {
+	if size == 0 {
+		return provider
+	}
+	return &CachingTraceProvider{
+		provider: provider,
+		cache:    caching.NewLRUCache[common.Hash, common.Hash](m, label, int(size)),
+	}
+}
+
+func (p *CachingTraceProvider) Original() types.TraceProvider {
+	return p.provider
+}
+
+func (p *CachingTraceProvider) Get(ctx context.Context, pos types.Position) (common.Hash, error) {
+	key := cacheKey(pos)
+	if value, ok := p.cache.Get(key); ok {
+		return value, nil
+	}
+	value, err := p.provider.Get(ctx, pos)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	p.cache.Add(key, value)
+	return value, nil
+}
+
+func (p *CachingTraceProvider) GetStepData(ctx context.Context, pos types.Position) (prestate []byte, proofData []byte, preimageData *types.PreimageOracleData, err error) {
+	return p.provider.GetStepData(ctx, pos)
+}
+
+// Prefetch passes the positions that are not already cached on to the underlying provider if it supports prefetching.
+func (p *CachingTraceProvider) Prefetch(ctx context.Context, positions []types.Position) error {
+	prefetcher, ok := p.provider.(types.PrefetchingTraceProvider)
+	if !ok {
+		return nil
+	}
+	uncached := make([]types.Position, 0, len(positions))
+	for _, pos := range positions {
+		if !p.cache.Contains(cacheKey(pos)) {
+			uncached = append(uncached, pos)
+		}
+	}
+	if len(uncached) == 0 {
+		return nil
+	}
+	return prefetcher.Prefetch(ctx, uncached)
+}
+
+func (p *CachingTraceProvider) AbsolutePreStateCommitment(ctx context.Context) (common.Hash, error) {
+	return p.provider.AbsolutePreStateCommitment(ctx)
+}
+
+// cacheKey identifies a position by its generalized index, which is unique for every position in the game tree.
+func cacheKey(pos types.Position) common.Hash {
+	return common.BigToHash(pos.ToGIndex())
+}
+
+var _ types.TraceProvider = (*CachingTraceProvider)(nil)
+var _ types.PrefetchingTraceProvider = (*CachingTraceProvider)(nil)
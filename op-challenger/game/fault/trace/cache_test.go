@@ -0,0 +1,120 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingTraceProvider_Get(t *testing.T) {
+	depth := 4
+	orig := &countingTraceProvider{shortTraceProvider: shortTraceProvider{depth: depth, length: 16}}
+	m := &stubCacheMetrics{}
+	provider := NewCachingTraceProvider(m, "test", 2, orig)
+
+	pos1 := types.NewPosition(depth, big.NewInt(3))
+	pos2 := types.NewPosition(2, big.NewInt(1))
+	pos3 := types.NewPosition(depth, big.NewInt(5))
+	for i := 0; i < 3; i++ {
+		value, err := provider.Get(context.Background(), pos1)
+		require.NoError(t, err)
+		require.Equal(t, indexHash(3), value)
+	}
+	require.Equal(t, 1, orig.gets, "should only load value once")
+	require.Equal(t, 2, m.hits)
+	require.Equal(t, 1, m.misses)
+
+	// Positions with the same trace index are cached separately
+	value, err := provider.Get(context.Background(), pos2)
+	require.NoError(t, err)
+	require.Equal(t, indexHash(pos2.TraceIndex(depth).Int64()), value)
+	require.Equal(t, 2, orig.gets)
+
+	// Least recently used value is evicted once the cache is full
+	_, err = provider.Get(context.Background(), pos3)
+	require.NoError(t, err)
+	_, err = provider.Get(context.Background(), pos1)
+	require.NoError(t, err)
+	require.Equal(t, 4, orig.gets)
+}
+
+func TestCachingTraceProvider_ErrorsNotCached(t *testing.T) {
+	depth := 4
+	orig := &countingTraceProvider{shortTraceProvider: shortTraceProvider{depth: depth, length: 16}, err: errors.New("boom")}
+	provider := NewCachingTraceProvider(nil, "test", 10, orig)
+	pos := types.NewPosition(depth, big.NewInt(3))
+	_, err := provider.Get(context.Background(), pos)
+	require.ErrorIs(t, err, orig.err)
+
+	orig.err = nil
+	value, err := provider.Get(context.Background(), pos)
+	require.NoError(t, err)
+	require.Equal(t, indexHash(3), value)
+	require.Equal(t, 2, orig.gets)
+}
+
+func TestCachingTraceProvider_Prefetch(t *testing.T) {
+	depth := 4
+	orig := &countingTraceProvider{shortTraceProvider: shortTraceProvider{depth: depth, length: 16}}
+	provider := NewCachingTraceProvider(nil, "test", 10, orig).(*CachingTraceProvider)
+	cached := types.NewPosition(depth, big.NewInt(3))
+	uncached := types.NewPosition(depth, big.NewInt(4))
+	_, err := provider.Get(context.Background(), cached)
+	require.NoError(t, err)
+
+	require.NoError(t, provider.Prefetch(context.Background(), []types.Position{cached, uncached}))
+	require.Equal(t, []types.Position{uncached}, orig.prefetched, "should only prefetch uncached positions")
+}
+
+func TestCachingTraceProvider_Disabled(t *testing.T) {
+	orig := &countingTraceProvider{}
+	require.Same(t, orig, NewCachingTraceProvider(nil, "test", 0, orig))
+}
+
+func TestCachingTraceProvider_Passthrough(t *testing.T) {
+	depth := 4
+	orig := &countingTraceProvider{shortTraceProvider: shortTraceProvider{depth: depth, length: 16}}
+	provider := NewCachingTraceProvider(nil, "test", 10, orig)
+
+	prestate, _, _, err := provider.GetStepData(context.Background(), types.NewPosition(depth, big.NewInt(5)))
+	require.NoError(t, err)
+	require.Equal(t, stepPrestate(5), prestate)
+
+	commitment, err := provider.AbsolutePreStateCommitment(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{0xaa}, commitment)
+}
+
+type countingTraceProvider struct {
+	shortTraceProvider
+	gets int
+	err  error
+}
+
+func (c *countingTraceProvider) Get(ctx context.Context, pos types.Position) (common.Hash, error) {
+	c.gets++
+	if c.err != nil {
+		return common.Hash{}, c.err
+	}
+	return c.shortTraceProvider.Get(ctx, pos)
+}
+
+type stubCacheMetrics struct {
+	hits   int
+	misses int
+}
+
+func (s *stubCacheMetrics) CacheAdd(_ string, _ int, _ bool) {}
+
+func (s *stubCacheMetrics) CacheGet(_ string, hit bool) {
+	if hit {
+		s.hits++
+	} else {
+		s.misses++
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/codec"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
 )
 
@@ -21,3 +23,22 @@ func parseState(path string) (*mipsevm.State, error) {
 	}
 	return &state, nil
 }
+
+// parseVMState loads the cannon state at path and converts it to a vm.State.
+func parseVMState(path string) (*vm.State, error) {
+	state, err := parseState(path)
+	if err != nil {
+		return nil, err
+	}
+	witness := state.EncodeWitness()
+	witnessHash, err := codec.MIPSState.Commitment(witness)
+	if err != nil {
+		return nil, fmt.Errorf("cannot hash witness: %w", err)
+	}
+	return &vm.State{
+		Step:        state.Step,
+		Exited:      state.Exited,
+		Witness:     witness,
+		WitnessHash: witnessHash,
+	}, nil
+}
@@ -48,3 +48,22 @@ func TestLoadState(t *testing.T) {
 		require.Equal(t, &expected, state)
 	})
 }
+
+func TestParseVMState(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+	require.NoError(t, os.WriteFile(path, testState, 0644))
+
+	state, err := parseVMState(path)
+	require.NoError(t, err)
+
+	var expected mipsevm.State
+	require.NoError(t, json.Unmarshal(testState, &expected))
+	expectedWitness := expected.EncodeWitness()
+	expectedHash, err := expectedWitness.StateHash()
+	require.NoError(t, err)
+	require.Equal(t, expected.Step, state.Step)
+	require.Equal(t, expected.Exited, state.Exited)
+	require.Equal(t, []byte(expectedWitness), state.Witness)
+	require.Equal(t, expectedHash, state.WitnessHash)
+}
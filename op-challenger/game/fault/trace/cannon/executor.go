@@ -4,16 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -27,7 +30,11 @@ const (
 var snapshotNameRegexp = regexp.MustCompile(`^[0-9]+\.json.gz$`)
 
 type snapshotSelect func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error)
-type cmdExecutor func(ctx context.Context, l log.Logger, binary string, args ...string) error
+type cmdExecutor func(ctx context.Context, l log.Logger, memoryLimit uint64, binary string, args ...string) (uint64, error)
+
+// maxMemoryRetries is the number of times an execution that exceeds the memory limit is retried.
+// The snapshot frequency is doubled for each retry.
+const maxMemoryRetries = 2
 
 type Executor struct {
 	logger           log.Logger
@@ -43,6 +50,7 @@ type Executor struct {
 	absolutePreState string
 	snapshotFreq     uint
 	infoFreq         uint
+	memoryLimit      uint64
 	selectSnapshot   snapshotSelect
 	cmdExecutor      cmdExecutor
 }
@@ -62,56 +70,42 @@ func NewExecutor(logger log.Logger, m CannonMetricer, cfg *config.Config, inputs
 		absolutePreState: cfg.CannonAbsolutePreState,
 		snapshotFreq:     cfg.CannonSnapshotFreq,
 		infoFreq:         cfg.CannonInfoFreq,
+		memoryLimit:      cfg.CannonMemoryLimit,
 		selectSnapshot:   findStartingSnapshot,
 		cmdExecutor:      runCmd,
 	}
 }
 
+var _ vm.VMExecutor = (*Executor)(nil)
+
 func (e *Executor) GenerateProof(ctx context.Context, dir string, i uint64) error {
+	return e.GenerateProofs(ctx, dir, []uint64{i})
+}
+
+// GenerateProofs executes cannon once to generate proofs at all the specified trace indices.
+// Execution starts from the closest snapshot before the first index and stops after the last one.
+func (e *Executor) GenerateProofs(ctx context.Context, dir string, indices []uint64) error {
+	if len(indices) == 0 {
+		return nil
+	}
+	indices = slices.Clone(indices)
+	slices.Sort(indices)
+	indices = slices.Compact(indices)
+	first := indices[0]
+	last := indices[len(indices)-1]
+	proofAt := make([]string, len(indices))
+	for idx, i := range indices {
+		proofAt[idx] = strconv.FormatUint(i, 10)
+	}
+
 	snapshotDir := filepath.Join(dir, snapsDir)
-	start, err := e.selectSnapshot(e.logger, snapshotDir, e.absolutePreState, i)
+	start, err := e.selectSnapshot(e.logger, snapshotDir, e.absolutePreState, first)
 	if err != nil {
 		return fmt.Errorf("find starting snapshot: %w", err)
 	}
 	proofDir := filepath.Join(dir, proofsDir)
 	dataDir := filepath.Join(dir, preimagesDir)
 	lastGeneratedState := filepath.Join(dir, finalState)
-	args := []string{
-		"run",
-		"--input", start,
-		"--output", lastGeneratedState,
-		"--meta", "",
-		"--info-at", "%" + strconv.FormatUint(uint64(e.infoFreq), 10),
-		"--proof-at", "=" + strconv.FormatUint(i, 10),
-		"--proof-fmt", filepath.Join(proofDir, "%d.json.gz"),
-		"--snapshot-at", "%" + strconv.FormatUint(uint64(e.snapshotFreq), 10),
-		"--snapshot-fmt", filepath.Join(snapshotDir, "%d.json.gz"),
-	}
-	if i < math.MaxUint64 {
-		args = append(args, "--stop-at", "="+strconv.FormatUint(i+1, 10))
-	}
-	args = append(args,
-		"--",
-		e.server, "--server",
-		"--l1", e.l1,
-		"--l2", e.l2,
-		"--datadir", dataDir,
-		"--l1.head", e.inputs.L1Head.Hex(),
-		"--l2.head", e.inputs.L2Head.Hex(),
-		"--l2.outputroot", e.inputs.L2OutputRoot.Hex(),
-		"--l2.claim", e.inputs.L2Claim.Hex(),
-		"--l2.blocknumber", e.inputs.L2BlockNumber.Text(10),
-	)
-	if e.network != "" {
-		args = append(args, "--network", e.network)
-	}
-	if e.rollupConfig != "" {
-		args = append(args, "--rollup.config", e.rollupConfig)
-	}
-	if e.l2Genesis != "" {
-		args = append(args, "--l2.genesis", e.l2Genesis)
-	}
-
 	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
 		return fmt.Errorf("could not create snapshot directory %v: %w", snapshotDir, err)
 	}
@@ -121,14 +115,129 @@ func (e *Executor) GenerateProof(ctx context.Context, dir string, i uint64) erro
 	if err := os.MkdirAll(proofDir, 0755); err != nil {
 		return fmt.Errorf("could not create proofs directory %v: %w", proofDir, err)
 	}
-	e.logger.Info("Generating trace", "proof", i, "cmd", e.cannon, "args", strings.Join(args, ", "))
-	execStart := time.Now()
-	err = e.cmdExecutor(ctx, e.logger.New("proof", i), e.cannon, args...)
-	e.metrics.RecordCannonExecutionTime(time.Since(execStart).Seconds())
-	return err
+	cannonArgs := func(snapshotFreq uint) []string {
+		args := []string{
+			"run",
+			"--input", start,
+			"--output", lastGeneratedState,
+			"--meta", "",
+			"--info-at", "%" + strconv.FormatUint(uint64(e.infoFreq), 10),
+			"--proof-at", "=" + strings.Join(proofAt, ","),
+			"--proof-fmt", filepath.Join(proofDir, "%d.json.gz"),
+			"--snapshot-at", "%" + strconv.FormatUint(uint64(snapshotFreq), 10),
+			"--snapshot-fmt", filepath.Join(snapshotDir, "%d.json.gz"),
+		}
+		if last < math.MaxUint64 {
+			args = append(args, "--stop-at", "="+strconv.FormatUint(last+1, 10))
+		}
+		args = append(args,
+			"--",
+			e.server, "--server",
+			"--l1", e.l1,
+			"--l2", e.l2,
+			"--datadir", dataDir,
+			"--l1.head", e.inputs.L1Head.Hex(),
+			"--l2.head", e.inputs.L2Head.Hex(),
+			"--l2.outputroot", e.inputs.L2OutputRoot.Hex(),
+			"--l2.claim", e.inputs.L2Claim.Hex(),
+			"--l2.blocknumber", e.inputs.L2BlockNumber.Text(10),
+		)
+		if e.network != "" {
+			args = append(args, "--network", e.network)
+		}
+		if e.rollupConfig != "" {
+			args = append(args, "--rollup.config", e.rollupConfig)
+		}
+		if e.l2Genesis != "" {
+			args = append(args, "--l2.genesis", e.l2Genesis)
+		}
+		return args
+	}
+	snapshotFreq := e.snapshotFreq
+	for attempt := 0; ; attempt++ {
+		args := cannonArgs(snapshotFreq)
+		e.logger.Info("Generating trace", "proofs", len(indices), "first", first, "last", last, "cmd", e.cannon, "args", strings.Join(args, ", "))
+		execStart := time.Now()
+		peakMemory, err := e.cmdExecutor(ctx, e.logger.New("proof", first), e.memoryLimit, e.cannon, args...)
+		e.metrics.RecordCannonExecutionTime(time.Since(execStart).Seconds())
+		if peakMemory > 0 {
+			e.metrics.RecordCannonMemoryUsage(peakMemory)
+		}
+		if errors.Is(err, ErrMemoryLimitExceeded) {
+			e.metrics.RecordCannonMemoryLimitExceeded()
+			if attempt < maxMemoryRetries && snapshotFreq <= math.MaxUint/2 {
+				snapshotFreq *= 2
+				e.logger.Warn("Retrying cannon execution with larger snapshot frequency", "err", err, "snapshotFreq", snapshotFreq)
+				continue
+			}
+		}
+		if err != nil {
+			return err
+		}
+		break
+	}
+	e.snapshotFinalState(lastGeneratedState, snapshotDir)
+	return nil
 }
 
-func runCmd(ctx context.Context, l log.Logger, binary string, args ...string) error {
+// snapshotFinalState copies the state cannon stopped at into snapshotDir so later executions for nearby trace
+// indices can start from it instead of the previous regular snapshot.
+// Failures are only logged as the snapshot is an optimisation and the proofs have already been generated.
+func (e *Executor) snapshotFinalState(finalPath string, snapshotDir string) {
+	state, err := parseState(finalPath)
+	if err != nil {
+		e.logger.Warn("Unable to read final state to create snapshot", "path", finalPath, "err", err)
+		return
+	}
+	if state.Step == 0 {
+		return
+	}
+	snapshotPath := filepath.Join(snapshotDir, fmt.Sprintf("%d.json.gz", state.Step))
+	if _, err := os.Stat(snapshotPath); err == nil {
+		return
+	}
+	if err := copyFile(finalPath, snapshotPath); err != nil {
+		e.logger.Warn("Unable to create snapshot from final state", "step", state.Step, "err", err)
+	}
+}
+
+// copyFile copies src to dest, writing to a temporary file first so a partial copy is never used as a snapshot.
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open %v: %w", src, err)
+	}
+	defer in.Close()
+	tmp := dest + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("create %v: %w", tmp, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("copy %v to %v: %w", src, tmp, err)
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("close %v: %w", tmp, err)
+	}
+	return os.Rename(tmp, dest)
+}
+
+// FinalState loads the state cannon stopped at during the last execution in dir.
+func (e *Executor) FinalState(dir string) (*vm.State, error) {
+	return parseVMState(filepath.Join(dir, finalState))
+}
+
+// AbsolutePreState loads the configured absolute prestate.
+func (e *Executor) AbsolutePreState() (*vm.State, error) {
+	return parseVMState(e.absolutePreState)
+}
+
+// runCmd executes binary and returns the peak memory used by it and its child processes.
+// The process and its children are killed if their memory usage exceeds memoryLimit, unless it is 0.
+func runCmd(ctx context.Context, l log.Logger, memoryLimit uint64, binary string, args ...string) (uint64, error) {
 	cmd := exec.CommandContext(ctx, binary, args...)
 	stdOut := oplog.NewWriter(l, log.LvlInfo)
 	defer stdOut.Close()
@@ -137,7 +246,17 @@ func runCmd(ctx context.Context, l log.Logger, binary string, args ...string) er
 	defer stdErr.Close()
 	cmd.Stdout = stdOut
 	cmd.Stderr = stdErr
-	return cmd.Run()
+	configureProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+	monitor := startMemoryMonitor(l, cmd, memoryLimit)
+	err := cmd.Wait()
+	peak, memErr := monitor.stop()
+	if memErr != nil {
+		return peak, memErr
+	}
+	return peak, err
 }
 
 // findStartingSnapshot finds the closest snapshot before the specified traceIndex in snapDir.
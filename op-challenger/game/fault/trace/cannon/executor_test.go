@@ -10,8 +10,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -39,7 +41,7 @@ func TestGenerateProof(t *testing.T) {
 		L2Claim:       common.Hash{0x44},
 		L2BlockNumber: big.NewInt(3333),
 	}
-	captureExec := func(t *testing.T, cfg config.Config, proofAt uint64) (string, string, map[string]string) {
+	captureExec := func(t *testing.T, cfg config.Config, proofAt ...uint64) (string, string, map[string]string) {
 		m := &cannonDurationMetrics{}
 		executor := NewExecutor(testlog.Logger(t, log.LvlInfo), m, &cfg, inputs)
 		executor.selectSnapshot = func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error) {
@@ -48,7 +50,7 @@ func TestGenerateProof(t *testing.T) {
 		var binary string
 		var subcommand string
 		args := make(map[string]string)
-		executor.cmdExecutor = func(ctx context.Context, l log.Logger, b string, a ...string) error {
+		executor.cmdExecutor = func(ctx context.Context, l log.Logger, memoryLimit uint64, b string, a ...string) (uint64, error) {
 			binary = b
 			subcommand = a[0]
 			for i := 1; i < len(a); {
@@ -60,9 +62,9 @@ func TestGenerateProof(t *testing.T) {
 				args[a[i]] = a[i+1]
 				i += 2
 			}
-			return nil
+			return 0, nil
 		}
-		err := executor.GenerateProof(context.Background(), dir, proofAt)
+		err := executor.GenerateProofs(context.Background(), dir, proofAt)
 		require.NoError(t, err)
 		require.Equal(t, 1, m.executionTimeRecordCount, "Should record cannon execution time")
 		return binary, subcommand, args
@@ -117,6 +119,15 @@ func TestGenerateProof(t *testing.T) {
 		require.Equal(t, cfg.CannonL2GenesisPath, args["--l2.genesis"])
 	})
 
+	t.Run("MultipleProofs", func(t *testing.T) {
+		cfg.CannonNetwork = "mainnet"
+		cfg.CannonRollupConfigPath = ""
+		cfg.CannonL2GenesisPath = ""
+		_, _, args := captureExec(t, cfg, 150_000_010, 150_000_000, 150_000_005, 150_000_000)
+		require.Equal(t, "=150000000,150000005,150000010", args["--proof-at"])
+		require.Equal(t, "=150000011", args["--stop-at"])
+	})
+
 	t.Run("NoStopAtWhenProofIsMaxUInt", func(t *testing.T) {
 		cfg.CannonNetwork = "mainnet"
 		cfg.CannonRollupConfigPath = "rollup.json"
@@ -128,6 +139,30 @@ func TestGenerateProof(t *testing.T) {
 	})
 }
 
+func TestGenerateProofSnapshotsFinalState(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.NewConfig(common.Address{0xbb}, "http://localhost:8888", dir, config.TraceTypeCannon)
+	cfg.CannonAbsolutePreState = execTestCannonPrestate
+	executor := NewExecutor(testlog.Logger(t, log.LvlInfo), &cannonDurationMetrics{}, &cfg, LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+	executor.cmdExecutor = func(ctx context.Context, l log.Logger, memoryLimit uint64, b string, a ...string) (uint64, error) {
+		state := &mipsevm.State{Memory: mipsevm.NewMemory(), Step: 1235}
+		return 0, ioutil.WriteCompressedJson(filepath.Join(dir, finalState), state)
+	}
+
+	require.NoError(t, executor.GenerateProof(context.Background(), dir, 1234))
+	snapshotDir := filepath.Join(dir, snapsDir)
+	require.FileExists(t, filepath.Join(snapshotDir, "1235.json.gz"))
+
+	snapshot, err := findStartingSnapshot(testlog.Logger(t, log.LvlInfo), snapshotDir, execTestCannonPrestate, 1240)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(snapshotDir, "1235.json.gz"), snapshot)
+
+	// Requests before the final state must still start from an earlier snapshot.
+	snapshot, err = findStartingSnapshot(testlog.Logger(t, log.LvlInfo), snapshotDir, execTestCannonPrestate, 1235)
+	require.NoError(t, err)
+	require.Equal(t, execTestCannonPrestate, snapshot)
+}
+
 func TestRunCmdLogsOutput(t *testing.T) {
 	bin := "/bin/echo"
 	if _, err := os.Stat(bin); err != nil {
@@ -137,7 +172,7 @@ func TestRunCmdLogsOutput(t *testing.T) {
 	defer cancel()
 	logger := testlog.Logger(t, log.LvlInfo)
 	logs := testlog.Capture(logger)
-	err := runCmd(ctx, logger, bin, "Hello World")
+	_, err := runCmd(ctx, logger, 0, bin, "Hello World")
 	require.NoError(t, err)
 	require.NotNil(t, logs.FindLog(log.LvlInfo, "Hello World"))
 }
@@ -214,11 +249,67 @@ func TestFindStartingSnapshot(t *testing.T) {
 	})
 }
 
+func TestGenerateProofRetriesWhenMemoryLimitExceeded(t *testing.T) {
+	setup := func(t *testing.T, failures int) (*Executor, *cannonDurationMetrics, *[]string, *[]uint64) {
+		dir := t.TempDir()
+		cfg := config.NewConfig(common.Address{0xbb}, "http://localhost:8888", dir, config.TraceTypeCannon)
+		cfg.CannonAbsolutePreState = execTestCannonPrestate
+		cfg.CannonSnapshotFreq = 500
+		cfg.CannonMemoryLimit = 1000
+		m := &cannonDurationMetrics{}
+		executor := NewExecutor(testlog.Logger(t, log.LvlInfo), m, &cfg, LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		var snapshotFreqs []string
+		var limits []uint64
+		executor.cmdExecutor = func(ctx context.Context, l log.Logger, memoryLimit uint64, b string, a ...string) (uint64, error) {
+			limits = append(limits, memoryLimit)
+			for i, arg := range a {
+				if arg == "--snapshot-at" {
+					snapshotFreqs = append(snapshotFreqs, a[i+1])
+				}
+			}
+			if len(snapshotFreqs) <= failures {
+				return 1001, ErrMemoryLimitExceeded
+			}
+			return 800, nil
+		}
+		return executor, m, &snapshotFreqs, &limits
+	}
+
+	t.Run("SucceedsOnRetry", func(t *testing.T) {
+		executor, m, snapshotFreqs, limits := setup(t, 2)
+		require.NoError(t, executor.GenerateProof(context.Background(), t.TempDir(), 1234))
+		require.Equal(t, []string{"%500", "%1000", "%2000"}, *snapshotFreqs)
+		require.Equal(t, []uint64{1000, 1000, 1000}, *limits)
+		require.Equal(t, 2, m.memoryLimitExceededCount)
+		require.Equal(t, []uint64{1001, 1001, 800}, m.memoryUsage)
+	})
+
+	t.Run("FailsAfterMaxRetries", func(t *testing.T) {
+		executor, m, snapshotFreqs, _ := setup(t, maxMemoryRetries+1)
+		err := executor.GenerateProof(context.Background(), t.TempDir(), 1234)
+		require.ErrorIs(t, err, ErrMemoryLimitExceeded)
+		require.Len(t, *snapshotFreqs, maxMemoryRetries+1)
+		require.Equal(t, maxMemoryRetries+1, m.memoryLimitExceededCount)
+	})
+}
+
 type cannonDurationMetrics struct {
 	metrics.NoopMetricsImpl
 	executionTimeRecordCount int
+	memoryUsage              []uint64
+	memoryLimitExceededCount int
+}
+
+func (c *cannonDurationMetrics) RecordCannonMemoryUsage(bytes uint64) {
+	c.memoryUsage = append(c.memoryUsage, bytes)
+}
+
+func (c *cannonDurationMetrics) RecordCannonMemoryLimitExceeded() {
+	c.memoryLimitExceededCount++
 }
 
 func (c *cannonDurationMetrics) RecordCannonExecutionTime(_ float64) {
 	c.executionTimeRecordCount++
 }
+
+func (c *cannonDurationMetrics) RecordProofCacheLookup(_ bool) {}
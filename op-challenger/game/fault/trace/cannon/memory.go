@@ -0,0 +1,98 @@
+package cannon
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ErrMemoryLimitExceeded is returned when a cannon execution is killed for exceeding the configured memory limit.
+var ErrMemoryLimitExceeded = errors.New("cannon memory limit exceeded")
+
+// errMemoryMonitorUnsupported is returned when the memory usage of a process can't be measured on this platform.
+var errMemoryMonitorUnsupported = errors.New("memory monitoring not supported")
+
+// memorySampleInterval is the interval at which the memory usage of cannon executions is sampled.
+var memorySampleInterval = time.Second
+
+// memoryMonitor samples the memory used by a command and its child processes, killing them all if the total
+// exceeds the limit. The command must have been configured with configureProcessGroup before it was started.
+type memoryMonitor struct {
+	logger log.Logger
+	cmd    *exec.Cmd
+	limit  uint64
+
+	done     chan struct{}
+	wg       sync.WaitGroup
+	peak     uint64
+	exceeded bool
+}
+
+func startMemoryMonitor(logger log.Logger, cmd *exec.Cmd, limit uint64) *memoryMonitor {
+	m := &memoryMonitor{
+		logger: logger,
+		cmd:    cmd,
+		limit:  limit,
+		done:   make(chan struct{}),
+	}
+	m.wg.Add(1)
+	go m.run()
+	return m
+}
+
+func (m *memoryMonitor) run() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(memorySampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			if !m.sample() {
+				return
+			}
+		}
+	}
+}
+
+// sample records the current memory usage and returns false if monitoring should stop.
+func (m *memoryMonitor) sample() bool {
+	usage, err := processGroupMemory(m.cmd.Process.Pid)
+	if errors.Is(err, errMemoryMonitorUnsupported) {
+		if m.limit > 0 {
+			m.logger.Warn("Cannon memory limit not enforced on this platform", "limit", m.limit)
+		}
+		return false
+	} else if err != nil {
+		m.logger.Debug("Failed to sample cannon memory usage", "err", err)
+		return true
+	}
+	if usage > m.peak {
+		m.peak = usage
+	}
+	if m.limit > 0 && usage > m.limit {
+		m.logger.Error("Killing cannon execution that exceeded memory limit", "usage", usage, "limit", m.limit)
+		m.exceeded = true
+		if err := killProcessGroup(m.cmd); err != nil {
+			m.logger.Error("Failed to kill cannon execution", "err", err)
+		}
+		return false
+	}
+	return true
+}
+
+// stop stops sampling and returns the peak memory usage observed.
+// Returns ErrMemoryLimitExceeded if the command was killed for exceeding the limit.
+func (m *memoryMonitor) stop() (uint64, error) {
+	close(m.done)
+	m.wg.Wait()
+	if m.exceeded {
+		return m.peak, fmt.Errorf("%w: used more than %v bytes", ErrMemoryLimitExceeded, m.limit)
+	}
+	return m.peak, nil
+}
@@ -0,0 +1,74 @@
+package cannon
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+)
+
+// configureProcessGroup runs cmd in a new process group so that it can be killed along with its child processes.
+func configureProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return killProcessGroup(cmd)
+	}
+}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+// processGroupMemory returns the total resident memory, in bytes, of all processes in the process group pgid.
+func processGroupMemory(pgid int) (uint64, error) {
+	statFiles, err := filepath.Glob("/proc/[0-9]*/stat")
+	if err != nil {
+		return 0, err
+	}
+	pageSize := uint64(os.Getpagesize())
+	total := uint64(0)
+	for _, path := range statFiles {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			// Process exited
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		group, rss, err := parseProcStat(data)
+		if err != nil {
+			return 0, fmt.Errorf("parse %v: %w", path, err)
+		}
+		if group == pgid {
+			total += rss * pageSize
+		}
+	}
+	return total, nil
+}
+
+// parseProcStat returns the process group and resident set size, in pages, from the contents of /proc/[pid]/stat.
+func parseProcStat(data []byte) (int, uint64, error) {
+	// The command name may contain spaces and parentheses so fields are counted from the last closing parenthesis.
+	end := bytes.LastIndexByte(data, ')')
+	if end < 0 {
+		return 0, 0, errors.New("missing command name")
+	}
+	// Fields after the command name start at field 3 (state). pgrp is field 5 and rss is field 24.
+	fields := bytes.Fields(data[end+1:])
+	if len(fields) < 22 {
+		return 0, 0, fmt.Errorf("expected at least 22 fields after command name but got %v", len(fields))
+	}
+	group, err := strconv.Atoi(string(fields[2]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid pgrp: %w", err)
+	}
+	rss, err := strconv.ParseUint(string(fields[21]), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid rss: %w", err)
+	}
+	return group, rss, nil
+}
@@ -0,0 +1,42 @@
+package cannon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcStat(t *testing.T) {
+	stat := "1234 (cannon (run) x) S 1 1200 1200 0 -1 4194560 100 0 0 0 1 2 0 0 20 0 8 0 100 1000000 256 18446744073709551615\n"
+	group, rss, err := parseProcStat([]byte(stat))
+	require.NoError(t, err)
+	require.Equal(t, 1200, group)
+	require.Equal(t, uint64(256), rss)
+
+	_, _, err = parseProcStat([]byte("1234 (cannon) S 1 1200"))
+	require.ErrorContains(t, err, "expected at least 22 fields")
+}
+
+func TestRunCmdMemoryLimit(t *testing.T) {
+	memorySampleInterval = 10 * time.Millisecond
+	t.Cleanup(func() { memorySampleInterval = time.Second })
+	logger := testlog.Logger(t, log.LvlInfo)
+
+	t.Run("RecordsPeakMemory", func(t *testing.T) {
+		peak, err := runCmd(context.Background(), logger, 0, "sh", "-c", "sleep 0.2")
+		require.NoError(t, err)
+		require.NotZero(t, peak)
+	})
+
+	t.Run("KillsProcessGroupOverLimit", func(t *testing.T) {
+		start := time.Now()
+		// The child sleep process must be killed along with the shell for the command to exit.
+		_, err := runCmd(context.Background(), logger, 1, "sh", "-c", "sleep 30; true")
+		require.ErrorIs(t, err, ErrMemoryLimitExceeded)
+		require.Less(t, time.Since(start), 10*time.Second)
+	})
+}
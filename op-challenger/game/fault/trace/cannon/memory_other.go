@@ -0,0 +1,15 @@
+//go:build !linux
+
+package cannon
+
+import "os/exec"
+
+func configureProcessGroup(_ *exec.Cmd) {}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
+
+func processGroupMemory(_ int) (uint64, error) {
+	return 0, errMemoryMonitorUnsupported
+}
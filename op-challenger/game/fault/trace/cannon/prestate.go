@@ -6,7 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
-	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/codec"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 )
 
@@ -33,7 +33,7 @@ func (p *CannonPrestateProvider) AbsolutePreStateCommitment(_ context.Context) (
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("cannot load absolute pre-state: %w", err)
 	}
-	hash, err := mipsevm.StateWitness(state).StateHash()
+	hash, err := codec.MIPSState.Commitment(state)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("cannot hash absolute pre-state: %w", err)
 	}
@@ -5,17 +5,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
-
-	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
 )
 
 const (
@@ -34,18 +36,16 @@ type proofData struct {
 
 type CannonMetricer interface {
 	RecordCannonExecutionTime(t float64)
-}
-
-type ProofGenerator interface {
-	// GenerateProof executes cannon to generate a proof at the specified trace index in dataDir.
-	GenerateProof(ctx context.Context, dataDir string, proofAt uint64) error
+	RecordCannonMemoryUsage(bytes uint64)
+	RecordCannonMemoryLimitExceeded()
+	RecordProofCacheLookup(hit bool)
 }
 
 type CannonTraceProvider struct {
 	logger       log.Logger
+	metrics      CannonMetricer
 	dir          string
-	prestate     string
-	generator    ProofGenerator
+	generator    vm.VMExecutor
 	gameDepth    uint64
 	localContext common.Hash
 
@@ -55,11 +55,17 @@ type CannonTraceProvider struct {
 }
 
 func NewTraceProvider(logger log.Logger, m CannonMetricer, cfg *config.Config, localContext common.Hash, localInputs LocalGameInputs, dir string, gameDepth uint64) *CannonTraceProvider {
+	return NewTraceProviderWithExecutor(logger, m, NewExecutor(logger, m, cfg, localInputs), localContext, dir, gameDepth)
+}
+
+// NewTraceProviderWithExecutor creates a trace provider that uses executor to run the fault proof VM.
+// This allows VMs other than cannon to be used, provided they write proofs in the same format.
+func NewTraceProviderWithExecutor(logger log.Logger, m CannonMetricer, executor vm.VMExecutor, localContext common.Hash, dir string, gameDepth uint64) *CannonTraceProvider {
 	return &CannonTraceProvider{
 		logger:       logger,
+		metrics:      m,
 		dir:          dir,
-		prestate:     cfg.CannonAbsolutePreState,
-		generator:    NewExecutor(logger, m, cfg, localInputs),
+		generator:    executor,
 		gameDepth:    gameDepth,
 		localContext: localContext,
 	}
@@ -70,6 +76,9 @@ func (p *CannonTraceProvider) SetMaxDepth(gameDepth uint64) {
 }
 
 func (p *CannonTraceProvider) Get(ctx context.Context, pos types.Position) (common.Hash, error) {
+	if err := pos.Validate(p.gameDepth); err != nil {
+		return common.Hash{}, fmt.Errorf("trace index out of bounds: %w", err)
+	}
 	traceIndex := pos.TraceIndex(int(p.gameDepth))
 	if !traceIndex.IsUint64() {
 		return common.Hash{}, errors.New("trace index out of bounds")
@@ -87,6 +96,9 @@ func (p *CannonTraceProvider) Get(ctx context.Context, pos types.Position) (comm
 }
 
 func (p *CannonTraceProvider) GetStepData(ctx context.Context, pos types.Position) ([]byte, []byte, *types.PreimageOracleData, error) {
+	if err := pos.Validate(p.gameDepth); err != nil {
+		return nil, nil, nil, fmt.Errorf("trace index out of bounds: %w", err)
+	}
 	traceIndex := pos.TraceIndex(int(p.gameDepth))
 	if !traceIndex.IsUint64() {
 		return nil, nil, nil, errors.New("trace index out of bounds")
@@ -105,49 +117,75 @@ func (p *CannonTraceProvider) GetStepData(ctx context.Context, pos types.Positio
 	}
 	var oracleData *types.PreimageOracleData
 	if len(proof.OracleKey) > 0 {
+		if len(proof.OracleValue) == 0 {
+			return nil, nil, nil, fmt.Errorf("%w: proof missing value for oracle key %v", types.ErrOracleDataRequired, proof.OracleKey)
+		}
 		oracleData = types.NewPreimageOracleData(proof.OracleKey, proof.OracleValue, proof.OracleOffset)
 	}
 	return value, data, oracleData, nil
 }
 
-func (p *CannonTraceProvider) absolutePreState() ([]byte, error) {
-	state, err := parseState(p.prestate)
-	if err != nil {
-		return nil, fmt.Errorf("cannot load absolute pre-state: %w", err)
+// Prefetch generates the proofs for all the specified positions that are not already available on disk
+// using a single cannon execution, rather than executing cannon separately for each position.
+func (p *CannonTraceProvider) Prefetch(ctx context.Context, positions []types.Position) error {
+	p.loadLastStep()
+	var missing []uint64
+	for _, pos := range positions {
+		if err := pos.Validate(p.gameDepth); err != nil {
+			return fmt.Errorf("trace index out of bounds: %w", err)
+		}
+		traceIndex := pos.TraceIndex(int(p.gameDepth))
+		if !traceIndex.IsUint64() {
+			return errors.New("trace index out of bounds")
+		}
+		i := p.clampToLastStep(traceIndex.Uint64())
+		if slices.Contains(missing, i) {
+			continue
+		}
+		if _, err := os.Stat(p.proofPath(i)); errors.Is(err, os.ErrNotExist) {
+			p.metrics.RecordProofCacheLookup(false)
+			missing = append(missing, i)
+		} else if err != nil {
+			return fmt.Errorf("cannot check for existing proof at %v: %w", i, err)
+		} else {
+			p.metrics.RecordProofCacheLookup(true)
+		}
 	}
-	return state.EncodeWitness(), nil
+	if len(missing) == 0 {
+		return nil
+	}
+	p.logger.Debug("Prefetching proofs", "count", len(missing))
+	if err := p.generator.GenerateProofs(ctx, p.dir, missing); err != nil {
+		return fmt.Errorf("generate cannon trace with proofs at %v: %w", missing, err)
+	}
+	// Proofs requested after the end of the trace won't have been generated.
+	// Record the extended final proof now to avoid executing cannon again when it is requested.
+	last := slices.Max(missing)
+	if _, err := os.Stat(p.proofPath(last)); errors.Is(err, os.ErrNotExist) {
+		if _, err := p.proofAfterFinalState(last); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (p *CannonTraceProvider) AbsolutePreStateCommitment(_ context.Context) (common.Hash, error) {
-	state, err := p.absolutePreState()
+	state, err := p.generator.AbsolutePreState()
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("cannot load absolute pre-state: %w", err)
 	}
-	hash, err := mipsevm.StateWitness(state).StateHash()
-	if err != nil {
-		return common.Hash{}, fmt.Errorf("cannot hash absolute pre-state: %w", err)
-	}
-	return hash, nil
+	return state.WitnessHash, nil
 }
 
 // loadProof will attempt to load or generate the proof data at the specified index
 // If the requested index is beyond the end of the actual trace it is extended with no-op instructions.
 func (p *CannonTraceProvider) loadProof(ctx context.Context, i uint64) (*proofData, error) {
-	// Attempt to read the last step from disk cache
-	if p.lastStep == 0 {
-		step, err := readLastStep(p.dir)
-		if err != nil {
-			p.logger.Warn("Failed to read last step from disk cache", "err", err)
-		} else {
-			p.lastStep = step
-		}
-	}
+	p.loadLastStep()
 	// If the last step is tracked, set i to the last step to generate or load the final proof
-	if p.lastStep != 0 && i > p.lastStep {
-		i = p.lastStep
-	}
-	path := filepath.Join(p.dir, proofsDir, fmt.Sprintf("%d.json.gz", i))
+	i = p.clampToLastStep(i)
+	path := p.proofPath(i)
 	file, err := ioutil.OpenDecompressed(path)
+	p.metrics.RecordProofCacheLookup(!errors.Is(err, os.ErrNotExist))
 	if errors.Is(err, os.ErrNotExist) {
 		if err := p.generator.GenerateProof(ctx, p.dir, i); err != nil {
 			return nil, fmt.Errorf("generate cannon trace with proof at %v: %w", i, err)
@@ -156,37 +194,7 @@ func (p *CannonTraceProvider) loadProof(ctx context.Context, i uint64) (*proofDa
 		file, err = ioutil.OpenDecompressed(path)
 		if errors.Is(err, os.ErrNotExist) {
 			// Expected proof wasn't generated, check if we reached the end of execution
-			state, err := parseState(filepath.Join(p.dir, finalState))
-			if err != nil {
-				return nil, fmt.Errorf("cannot read final state: %w", err)
-			}
-			if state.Exited && state.Step <= i {
-				p.logger.Warn("Requested proof was after the program exited", "proof", i, "last", state.Step)
-				// The final instruction has already been applied to this state, so the last step we can execute
-				// is one before its Step value.
-				p.lastStep = state.Step - 1
-				// Extend the trace out to the full length using a no-op instruction that doesn't change any state
-				// No execution is done, so no proof-data or oracle values are required.
-				witness := state.EncodeWitness()
-				witnessHash, err := mipsevm.StateWitness(witness).StateHash()
-				if err != nil {
-					return nil, fmt.Errorf("cannot hash witness: %w", err)
-				}
-				proof := &proofData{
-					ClaimValue:   witnessHash,
-					StateData:    hexutil.Bytes(witness),
-					ProofData:    []byte{},
-					OracleKey:    nil,
-					OracleValue:  nil,
-					OracleOffset: 0,
-				}
-				if err := writeLastStep(p.dir, proof, p.lastStep); err != nil {
-					p.logger.Warn("Failed to write last step to disk cache", "step", p.lastStep)
-				}
-				return proof, nil
-			} else {
-				return nil, fmt.Errorf("expected proof not generated but final state was not exited, requested step %v, final state at step %v", i, state.Step)
-			}
+			return p.proofAfterFinalState(i)
 		}
 	}
 	if err != nil {
@@ -201,6 +209,60 @@ func (p *CannonTraceProvider) loadProof(ctx context.Context, i uint64) (*proofDa
 	return &proof, nil
 }
 
+// loadLastStep attempts to read the last step from disk cache if it is not already known.
+func (p *CannonTraceProvider) loadLastStep() {
+	if p.lastStep != 0 {
+		return
+	}
+	step, err := readLastStep(p.dir)
+	if err != nil {
+		p.logger.Warn("Failed to read last step from disk cache", "err", err)
+	} else {
+		p.lastStep = step
+	}
+}
+
+func (p *CannonTraceProvider) clampToLastStep(i uint64) uint64 {
+	if p.lastStep != 0 && i > p.lastStep {
+		return p.lastStep
+	}
+	return i
+}
+
+func (p *CannonTraceProvider) proofPath(i uint64) string {
+	return filepath.Join(p.dir, proofsDir, fmt.Sprintf("%d.json.gz", i))
+}
+
+// proofAfterFinalState creates the proof for trace index i when it is after the end of the actual trace,
+// by extending the trace out to the full length using a no-op instruction from the final state.
+func (p *CannonTraceProvider) proofAfterFinalState(i uint64) (*proofData, error) {
+	state, err := p.generator.FinalState(p.dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read final state: %w", err)
+	}
+	if !state.Exited || state.Step > i {
+		return nil, fmt.Errorf("expected proof not generated but final state was not exited, requested step %v, final state at step %v", i, state.Step)
+	}
+	p.logger.Warn("Requested proof was after the program exited", "proof", i, "last", state.Step)
+	// The final instruction has already been applied to this state, so the last step we can execute
+	// is one before its Step value.
+	p.lastStep = state.Step - 1
+	// Extend the trace out to the full length using a no-op instruction that doesn't change any state
+	// No execution is done, so no proof-data or oracle values are required.
+	proof := &proofData{
+		ClaimValue:   state.WitnessHash,
+		StateData:    hexutil.Bytes(state.Witness),
+		ProofData:    []byte{},
+		OracleKey:    nil,
+		OracleValue:  nil,
+		OracleOffset: 0,
+	}
+	if err := writeLastStep(p.dir, proof, p.lastStep); err != nil {
+		p.logger.Warn("Failed to write last step to disk cache", "step", p.lastStep)
+	}
+	return proof, nil
+}
+
 type diskStateCacheObj struct {
 	Step uint64 `json:"step"`
 }
@@ -232,3 +294,35 @@ func writeLastStep(dir string, proof *proofData, step uint64) error {
 	}
 	return nil
 }
+
+// MeasureDiskUsage returns the disk space used by the files in dir and the number of cannon snapshots and proofs
+// they include. Subdirectories are included so the separate cannon traces used by output games are all counted.
+func MeasureDiskUsage(dir string) (metrics.GameDiskUsage, error) {
+	var usage metrics.GameDiskUsage
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		usage.Bytes += info.Size()
+		switch filepath.Base(filepath.Dir(path)) {
+		case snapsDir:
+			usage.Snapshots++
+		case proofsDir:
+			usage.Proofs++
+		}
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return metrics.GameDiskUsage{}, nil
+	} else if err != nil {
+		return metrics.GameDiskUsage{}, fmt.Errorf("failed to measure disk usage of %v: %w", dir, err)
+	}
+	return usage, nil
+}
@@ -13,7 +13,9 @@ import (
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/vm"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
@@ -36,6 +38,7 @@ func TestGet(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, common.HexToHash("0x45fd9aa59768331c726e719e76aa343e73123af888804604785ae19506e65e87"), value)
 		require.Empty(t, generator.generated)
+		require.Equal(t, &proofCacheMetrics{hits: 1}, provider.metrics)
 	})
 
 	t.Run("ErrorsTraceIndexOutOfBounds", func(t *testing.T) {
@@ -68,6 +71,19 @@ func TestGet(t *testing.T) {
 		require.Empty(t, generator.generated)
 	})
 
+	t.Run("MissingOracleValue", func(t *testing.T) {
+		dataDir, prestate := setupTestData(t)
+		provider, generator := setupWithTestData(t, dataDir, prestate)
+		generator.proof = &proofData{
+			ClaimValue: common.Hash{0xaa},
+			StateData:  []byte{0xbb},
+			ProofData:  []byte{0xcc},
+			OracleKey:  common.Hash{0xdd}.Bytes(),
+		}
+		_, _, _, err := provider.GetStepData(context.Background(), PositionFromTraceIndex(provider, big.NewInt(4)))
+		require.ErrorIs(t, err, types.ErrOracleDataRequired)
+	})
+
 	t.Run("IgnoreUnknownFields", func(t *testing.T) {
 		provider, generator := setupWithTestData(t, dataDir, prestate)
 		value, err := provider.Get(context.Background(), PositionFromTraceIndex(provider, big.NewInt(2)))
@@ -121,6 +137,7 @@ func TestGetStepData(t *testing.T) {
 		preimage, proof, data, err := provider.GetStepData(context.Background(), PositionFromTraceIndex(provider, big.NewInt(4)))
 		require.NoError(t, err)
 		require.Contains(t, generator.generated, 4, "should have tried to generate the proof")
+		require.Equal(t, &proofCacheMetrics{misses: 1}, provider.metrics)
 
 		require.EqualValues(t, generator.proof.StateData, preimage)
 		require.EqualValues(t, generator.proof.ProofData, proof)
@@ -216,6 +233,72 @@ func TestGetStepData(t *testing.T) {
 	})
 }
 
+func TestPrefetch(t *testing.T) {
+	t.Run("SkipExistingProofs", func(t *testing.T) {
+		dataDir, prestate := setupTestData(t)
+		provider, generator := setupWithTestData(t, dataDir, prestate)
+		err := provider.Prefetch(context.Background(), []types.Position{
+			PositionFromTraceIndex(provider, big.NewInt(0)),
+			PositionFromTraceIndex(provider, big.NewInt(2)),
+		})
+		require.NoError(t, err)
+		require.Empty(t, generator.generated)
+		require.Zero(t, generator.batches)
+	})
+
+	t.Run("GenerateMissingProofsInSingleExecution", func(t *testing.T) {
+		dataDir, prestate := setupTestData(t)
+		provider, generator := setupWithTestData(t, dataDir, prestate)
+		generator.proof = &proofData{
+			ClaimValue: common.Hash{0xaa},
+			StateData:  []byte{0xbb},
+			ProofData:  []byte{0xcc},
+		}
+		err := provider.Prefetch(context.Background(), []types.Position{
+			PositionFromTraceIndex(provider, big.NewInt(0)),
+			PositionFromTraceIndex(provider, big.NewInt(5)),
+			PositionFromTraceIndex(provider, big.NewInt(4)),
+			PositionFromTraceIndex(provider, big.NewInt(5)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, generator.batches)
+		require.Equal(t, []int{5, 4}, generator.generated)
+		require.Equal(t, &proofCacheMetrics{hits: 1, misses: 2}, provider.metrics)
+
+		// Subsequent requests use the prefetched proofs
+		generator.generated = nil
+		value, err := provider.Get(context.Background(), PositionFromTraceIndex(provider, big.NewInt(4)))
+		require.NoError(t, err)
+		require.Equal(t, common.Hash{0xaa}, value)
+		require.Empty(t, generator.generated)
+	})
+
+	t.Run("ProofsAfterEndOfTrace", func(t *testing.T) {
+		dataDir, prestate := setupTestData(t)
+		provider, generator := setupWithTestData(t, dataDir, prestate)
+		generator.finalState = &mipsevm.State{
+			Memory: &mipsevm.Memory{},
+			Step:   10,
+			Exited: true,
+		}
+		err := provider.Prefetch(context.Background(), []types.Position{
+			PositionFromTraceIndex(provider, big.NewInt(7000)),
+			PositionFromTraceIndex(provider, big.NewInt(8000)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, generator.batches)
+		require.Equal(t, uint64(9), provider.lastStep)
+
+		generator.generated = nil
+		value, err := provider.Get(context.Background(), PositionFromTraceIndex(provider, big.NewInt(7500)))
+		require.NoError(t, err)
+		stateHash, err := generator.finalState.EncodeWitness().StateHash()
+		require.NoError(t, err)
+		require.Equal(t, stateHash, value)
+		require.Empty(t, generator.generated, "should not need to execute cannon again")
+	})
+}
+
 func setupTestData(t *testing.T) (string, string) {
 	srcDir := filepath.Join("test_data", "proofs")
 	entries, err := testData.ReadDir(srcDir)
@@ -233,20 +316,59 @@ func setupTestData(t *testing.T) (string, string) {
 }
 
 func setupWithTestData(t *testing.T, dataDir string, prestate string) (*CannonTraceProvider, *stubGenerator) {
-	generator := &stubGenerator{}
+	generator := &stubGenerator{prestate: filepath.Join(dataDir, prestate)}
 	return &CannonTraceProvider{
 		logger:    testlog.Logger(t, log.LvlInfo),
+		metrics:   &proofCacheMetrics{},
 		dir:       dataDir,
 		generator: generator,
-		prestate:  filepath.Join(dataDir, prestate),
 		gameDepth: 63,
 	}, generator
 }
 
+type proofCacheMetrics struct {
+	hits   int
+	misses int
+}
+
+func (m *proofCacheMetrics) RecordCannonExecutionTime(_ float64) {}
+
+func (m *proofCacheMetrics) RecordCannonMemoryUsage(_ uint64) {}
+
+func (m *proofCacheMetrics) RecordCannonMemoryLimitExceeded() {}
+
+func (m *proofCacheMetrics) RecordProofCacheLookup(hit bool) {
+	if hit {
+		m.hits++
+	} else {
+		m.misses++
+	}
+}
+
 type stubGenerator struct {
 	generated  []int // Using int makes assertions easier
+	batches    int
 	finalState *mipsevm.State
 	proof      *proofData
+	prestate   string
+}
+
+func (e *stubGenerator) FinalState(dir string) (*vm.State, error) {
+	return parseVMState(filepath.Join(dir, finalState))
+}
+
+func (e *stubGenerator) AbsolutePreState() (*vm.State, error) {
+	return parseVMState(e.prestate)
+}
+
+func (e *stubGenerator) GenerateProofs(ctx context.Context, dir string, indices []uint64) error {
+	e.batches++
+	for _, i := range indices {
+		if err := e.GenerateProof(ctx, dir, i); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *stubGenerator) GenerateProof(ctx context.Context, dir string, i uint64) error {
@@ -279,3 +401,31 @@ func writeGzip(path string, data []byte) error {
 	_, err = writer.Write(data)
 	return err
 }
+
+func TestMeasureDiskUsage(t *testing.T) {
+	t.Run("MissingDir", func(t *testing.T) {
+		usage, err := MeasureDiskUsage(filepath.Join(t.TempDir(), "missing"))
+		require.NoError(t, err)
+		require.Equal(t, metrics.GameDiskUsage{}, usage)
+	})
+
+	t.Run("CountSnapshotsAndProofs", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile := func(path string, size int) {
+			path = filepath.Join(dir, path)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, os.WriteFile(path, make([]byte, size), 0644))
+		}
+		writeFile(filepath.Join(snapsDir, "100.json.gz"), 10)
+		writeFile(filepath.Join(proofsDir, "5.json.gz"), 20)
+		writeFile(filepath.Join(proofsDir, "6.json.gz"), 30)
+		writeFile(finalState, 40)
+		// Output games store separate cannon traces in subdirectories
+		writeFile(filepath.Join("0x1234", snapsDir, "200.json.gz"), 50)
+		writeFile(filepath.Join("0x1234", preimagesDir, "abcd"), 60)
+
+		usage, err := MeasureDiskUsage(dir)
+		require.NoError(t, err)
+		require.Equal(t, metrics.GameDiskUsage{Bytes: 210, Snapshots: 2, Proofs: 2}, usage)
+	})
+}
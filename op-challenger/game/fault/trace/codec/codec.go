@@ -0,0 +1,55 @@
+// Package codec provides the types.ClaimCodec implementations for the claim values of the supported game types.
+package codec
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+)
+
+var (
+	// OutputRoot commits to L2 output roots. The rollup node computes the output root by hashing the encoded
+	// output, so the state is the 32 byte output root and is used as the claim value as is.
+	OutputRoot types.ClaimCodec = outputRootCodec{}
+
+	// MIPSState commits to a cannon MIPS VM state witness with its keccak256 hash, with the first byte replaced by
+	// the VM status derived from the witness.
+	MIPSState types.ClaimCodec = mipsStateCodec{}
+)
+
+var (
+	_ types.ClaimCodec = outputRootCodec{}
+	_ types.ClaimCodec = mipsStateCodec{}
+	_ types.ClaimCodec = KeccakWithStatus{}
+)
+
+type outputRootCodec struct{}
+
+func (outputRootCodec) Commitment(state []byte) (common.Hash, error) {
+	if len(state) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid output root length: %v", len(state))
+	}
+	return common.BytesToHash(state), nil
+}
+
+type mipsStateCodec struct{}
+
+func (mipsStateCodec) Commitment(state []byte) (common.Hash, error) {
+	return mipsevm.StateWitness(state).StateHash()
+}
+
+// KeccakWithStatus commits to a state with its keccak256 hash, with the first byte replaced by a fixed VM status.
+// It is used by traces that are not generated by executing a VM, such as the alphabet trace.
+type KeccakWithStatus struct {
+	Status uint8
+}
+
+func (c KeccakWithStatus) Commitment(state []byte) (common.Hash, error) {
+	hash := crypto.Keccak256Hash(state)
+	hash[0] = c.Status
+	return hash, nil
+}
@@ -0,0 +1,44 @@
+package codec
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+func TestOutputRoot(t *testing.T) {
+	root := common.Hash{0xaa, 0xbb}
+	value, err := OutputRoot.Commitment(root[:])
+	require.NoError(t, err)
+	require.Equal(t, root, value)
+
+	_, err = OutputRoot.Commitment([]byte{1, 2, 3})
+	require.ErrorContains(t, err, "invalid output root length")
+}
+
+func TestMIPSState(t *testing.T) {
+	state := &mipsevm.State{Memory: mipsevm.NewMemory(), Exited: true, ExitCode: 0}
+	witness := state.EncodeWitness()
+	expected, err := witness.StateHash()
+	require.NoError(t, err)
+	value, err := MIPSState.Commitment(witness)
+	require.NoError(t, err)
+	require.Equal(t, expected, value)
+	require.EqualValues(t, mipsevm.VMStatusValid, value[0])
+
+	_, err = MIPSState.Commitment([]byte{1})
+	require.Error(t, err)
+}
+
+func TestKeccakWithStatus(t *testing.T) {
+	state := []byte{1, 2, 3}
+	expected := crypto.Keccak256Hash(state)
+	expected[0] = mipsevm.VMStatusInvalid
+	value, err := KeccakWithStatus{Status: mipsevm.VMStatusInvalid}.Commitment(state)
+	require.NoError(t, err)
+	require.Equal(t, expected, value)
+}
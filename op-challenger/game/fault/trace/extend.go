@@ -0,0 +1,78 @@
+package trace
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ExtendedTraceProvider wraps a provider whose actual trace is shorter than the maximum trace length for the game.
+// Trace indices beyond the end of the actual trace repeat the final state, as the dispute game expects, so the
+// wrapped provider is only ever asked for indices within its trace.
+type ExtendedTraceProvider struct {
+	depth     uint64
+	lastIndex *big.Int
+	provider  types.TraceProvider
+}
+
+// Extend returns a new TraceProvider that extends the trace from provider, which has traceLength states, to the full
+// trace length for a game of the specified depth. traceLength must be at least 1.
+func Extend(provider types.TraceProvider, depth uint64, traceLength uint64) *ExtendedTraceProvider {
+	if traceLength == 0 {
+		panic("cannot extend an empty trace")
+	}
+	return &ExtendedTraceProvider{
+		depth:     depth,
+		lastIndex: new(big.Int).SetUint64(traceLength - 1),
+		provider:  provider,
+	}
+}
+
+func (p *ExtendedTraceProvider) Original() types.TraceProvider {
+	return p.provider
+}
+
+// clamp returns the position at max depth for the trace index of pos, capped to maxIndex.
+// Positions within the bounds are returned unchanged.
+func (p *ExtendedTraceProvider) clamp(pos types.Position, maxIndex *big.Int) types.Position {
+	if pos.TraceIndex(int(p.depth)).Cmp(maxIndex) <= 0 {
+		return pos
+	}
+	return types.NewPosition(int(p.depth), maxIndex)
+}
+
+func (p *ExtendedTraceProvider) Get(ctx context.Context, pos types.Position) (common.Hash, error) {
+	return p.provider.Get(ctx, p.clamp(pos, p.lastIndex))
+}
+
+// GetStepData returns the step data for pos. Steps beyond the end of the trace all use the final state as their
+// prestate, so are served by the step immediately after the last index.
+func (p *ExtendedTraceProvider) GetStepData(ctx context.Context, pos types.Position) (prestate []byte, proofData []byte, preimageData *types.PreimageOracleData, err error) {
+	return p.provider.GetStepData(ctx, p.clamp(pos, p.stepIndex()))
+}
+
+func (p *ExtendedTraceProvider) stepIndex() *big.Int {
+	return new(big.Int).Add(p.lastIndex, big.NewInt(1))
+}
+
+// Prefetch clamps the positions and passes them on to the underlying provider if it supports prefetching.
+func (p *ExtendedTraceProvider) Prefetch(ctx context.Context, positions []types.Position) error {
+	prefetcher, ok := p.provider.(types.PrefetchingTraceProvider)
+	if !ok {
+		return nil
+	}
+	clamped := make([]types.Position, 0, len(positions))
+	for _, pos := range positions {
+		clamped = append(clamped, p.clamp(pos, p.lastIndex))
+	}
+	return prefetcher.Prefetch(ctx, clamped)
+}
+
+func (p *ExtendedTraceProvider) AbsolutePreStateCommitment(ctx context.Context) (common.Hash, error) {
+	return p.provider.AbsolutePreStateCommitment(ctx)
+}
+
+var _ types.TraceProvider = (*ExtendedTraceProvider)(nil)
+var _ types.PrefetchingTraceProvider = (*ExtendedTraceProvider)(nil)
@@ -0,0 +1,128 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtend_Get(t *testing.T) {
+	depth := 4
+	orig := &shortTraceProvider{depth: depth, length: 5}
+	extended := Extend(orig, uint64(depth), 5)
+
+	for i := int64(0); i < 1<<depth; i++ {
+		pos := types.NewPosition(depth, big.NewInt(i))
+		expected := min(i, 4)
+		actual, err := extended.Get(context.Background(), pos)
+		require.NoError(t, err, "trace index %v", i)
+		require.Equal(t, indexHash(expected), actual, "trace index %v", i)
+	}
+
+	// Positions above max depth use the trace index of their right-most leaf
+	for d := 0; d < depth; d++ {
+		for i := int64(0); i < 1<<d; i++ {
+			pos := types.NewPosition(d, big.NewInt(i))
+			expected := min(pos.TraceIndex(depth).Int64(), 4)
+			actual, err := extended.Get(context.Background(), pos)
+			require.NoError(t, err, "position %v", pos)
+			require.Equal(t, indexHash(expected), actual, "position %v", pos)
+		}
+	}
+}
+
+func TestExtend_GetStepData(t *testing.T) {
+	depth := 4
+	orig := &shortTraceProvider{depth: depth, length: 5}
+	extended := Extend(orig, uint64(depth), 5)
+
+	for i := int64(0); i < 1<<depth; i++ {
+		pos := types.NewPosition(depth, big.NewInt(i))
+		prestate, _, _, err := extended.GetStepData(context.Background(), pos)
+		require.NoError(t, err, "trace index %v", i)
+		// The step at index i uses the state from i-1 as its prestate, which is repeated after the end of the trace.
+		expected := min(i, 5)
+		require.Equal(t, stepPrestate(expected), prestate, "trace index %v", i)
+	}
+}
+
+func TestExtend_FullLengthTraceUnchanged(t *testing.T) {
+	depth := 3
+	orig := &shortTraceProvider{depth: depth, length: 1 << depth}
+	extended := Extend(orig, uint64(depth), 1<<depth)
+	for i := int64(0); i < 1<<depth; i++ {
+		pos := types.NewPosition(depth, big.NewInt(i))
+		actual, err := extended.Get(context.Background(), pos)
+		require.NoError(t, err)
+		require.Equal(t, indexHash(i), actual)
+	}
+}
+
+func TestExtend_Prefetch(t *testing.T) {
+	depth := 4
+	orig := &shortTraceProvider{depth: depth, length: 5}
+	extended := Extend(orig, uint64(depth), 5)
+	inRange := types.NewPosition(depth, big.NewInt(3))
+	beyond := types.NewPosition(depth, big.NewInt(12))
+	require.NoError(t, extended.Prefetch(context.Background(), []types.Position{inRange, beyond}))
+	require.Equal(t, []types.Position{inRange, types.NewPosition(depth, big.NewInt(4))}, orig.prefetched)
+}
+
+func TestExtend_AbsolutePrestate(t *testing.T) {
+	orig := &shortTraceProvider{depth: 4, length: 5}
+	extended := Extend(orig, 4, 5)
+	prestate, err := extended.AbsolutePreStateCommitment(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{0xaa}, prestate)
+}
+
+func TestExtend_EmptyTrace(t *testing.T) {
+	require.Panics(t, func() {
+		Extend(&shortTraceProvider{depth: 4}, 4, 0)
+	})
+}
+
+func indexHash(i int64) common.Hash {
+	return common.BigToHash(big.NewInt(i + 1))
+}
+
+func stepPrestate(i int64) []byte {
+	return []byte(fmt.Sprintf("prestate-%v", i))
+}
+
+// shortTraceProvider is a trace with only length states which fails if asked for any index beyond the end of its trace.
+type shortTraceProvider struct {
+	depth      int
+	length     int64
+	prefetched []types.Position
+}
+
+func (s *shortTraceProvider) Get(_ context.Context, pos types.Position) (common.Hash, error) {
+	i := pos.TraceIndex(s.depth).Int64()
+	if i >= s.length {
+		return common.Hash{}, fmt.Errorf("trace index %v beyond trace length %v", i, s.length)
+	}
+	return indexHash(i), nil
+}
+
+func (s *shortTraceProvider) GetStepData(_ context.Context, pos types.Position) ([]byte, []byte, *types.PreimageOracleData, error) {
+	i := pos.TraceIndex(s.depth).Int64()
+	if i > s.length {
+		return nil, nil, nil, fmt.Errorf("step %v beyond trace length %v", i, s.length)
+	}
+	return stepPrestate(i), nil, nil, nil
+}
+
+func (s *shortTraceProvider) Prefetch(_ context.Context, positions []types.Position) error {
+	s.prefetched = append(s.prefetched, positions...)
+	return nil
+}
+
+func (s *shortTraceProvider) AbsolutePreStateCommitment(_ context.Context) (common.Hash, error) {
+	return common.Hash{0xaa}, nil
+}
@@ -18,7 +18,7 @@ import (
 
 func NewOutputCannonTraceAccessor(
 	logger log.Logger,
-	m metrics.Metricer,
+	m metrics.GameMetricer,
 	cfg *config.Config,
 	l2Client cannon.L2HeaderSource,
 	contract cannon.L1HeadSource,
@@ -38,10 +38,11 @@ func NewOutputCannonTraceAccessor(
 			return nil, fmt.Errorf("failed to fetch cannon local inputs: %w", err)
 		}
 		provider := cannon.NewTraceProvider(logger, m, cfg, localContext, localInputs, subdir, depth)
-		return provider, nil
+		return trace.NewCachingTraceProvider(m, "output_cannon_trace", cfg.TraceCacheSize, provider), nil
 	}
 
 	cache := NewProviderCache(m, "output_cannon_provider", cannonCreator)
-	selector := split.NewSplitProviderSelector(outputProvider, int(splitDepth), OutputRootSplitAdapter(outputProvider, cache.GetOrCreate))
+	topProvider := trace.NewCachingTraceProvider(m, "output_trace", cfg.TraceCacheSize, outputProvider)
+	selector := split.NewSplitProviderSelector(topProvider, int(splitDepth), OutputRootSplitAdapter(outputProvider, cache.GetOrCreate))
 	return trace.NewAccessor(selector), nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/codec"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -56,6 +57,9 @@ func NewTraceProviderFromInputs(logger log.Logger, prestateProvider types.Presta
 }
 
 func (o *OutputTraceProvider) BlockNumber(pos types.Position) (uint64, error) {
+	if err := pos.Validate(o.gameDepth); err != nil {
+		return 0, err
+	}
 	traceIndex := pos.TraceIndex(int(o.gameDepth))
 	if !traceIndex.IsUint64() {
 		return 0, fmt.Errorf("%w: %v", ErrIndexTooBig, traceIndex)
@@ -85,5 +89,5 @@ func (o *OutputTraceProvider) outputAtBlock(ctx context.Context, block uint64) (
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("failed to fetch output at block %v: %w", o.prestateBlock, err)
 	}
-	return common.Hash(output.OutputRoot), nil
+	return codec.OutputRoot.Commitment(output.OutputRoot[:])
 }
@@ -94,6 +94,13 @@ func TestGetBlockNumber(t *testing.T) {
 		_, err := provider.BlockNumber(pos)
 		require.ErrorIs(t, err, ErrIndexTooBig)
 	})
+
+	t.Run("ErrorsPositionDeeperThanGameDepth", func(t *testing.T) {
+		provider, _ := setupWithTestData(t, prestateBlock, poststateBlock)
+		pos := types.NewPosition(int(gameDepth)+1, big.NewInt(0))
+		_, err := provider.BlockNumber(pos)
+		require.ErrorIs(t, err, types.ErrPositionDepthTooLarge)
+	})
 }
 
 func TestGetStepData(t *testing.T) {
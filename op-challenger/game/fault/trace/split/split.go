@@ -11,7 +11,7 @@ import (
 )
 
 var (
-	errRefClaimNotDeepEnough = errors.New("reference claim is not deep enough")
+	ErrRefClaimNotDeepEnough = errors.New("reference claim is not deep enough")
 )
 
 type ProviderCreator func(ctx context.Context, depth uint64, pre types.Claim, post types.Claim) (types.TraceProvider, error)
@@ -22,7 +22,7 @@ func NewSplitProviderSelector(topProvider types.TraceProvider, topDepth int, bot
 			return topProvider, nil
 		}
 		if ref.Position.Depth() < topDepth {
-			return nil, fmt.Errorf("%w, claim depth: %v, depth required: %v", errRefClaimNotDeepEnough, ref.Position.Depth(), topDepth)
+			return nil, fmt.Errorf("%w, claim depth: %v, depth required: %v", ErrRefClaimNotDeepEnough, ref.Position.Depth(), topDepth)
 		}
 
 		// Find the ancestor claim at the leaf level for the top game.
@@ -68,7 +68,12 @@ func NewSplitProviderSelector(topProvider types.TraceProvider, topDepth int, bot
 	}
 }
 
+// findAncestorAtDepth walks up the parents of claim to find the claim at depth that it descends from.
+// Claim parents are followed rather than position ancestors, as the parent of a defense move is not its position ancestor.
 func findAncestorAtDepth(game types.Game, claim types.Claim, depth int) (types.Claim, error) {
+	if _, err := claim.Position.AncestorAtDepth(depth); err != nil {
+		return types.Claim{}, fmt.Errorf("failed to find ancestor at depth %v: %w", depth, err)
+	}
 	for claim.Depth() > depth {
 		parent, err := game.GetParent(claim)
 		if err != nil {
@@ -79,7 +84,14 @@ func findAncestorAtDepth(game types.Game, claim types.Claim, depth int) (types.C
 	return claim, nil
 }
 
+// findAncestorWithTraceIndex walks up the parents of ref to find the first claim that commits to the trace at traceIdx
+// in a game with the specified depth.
 func findAncestorWithTraceIndex(game types.Game, ref types.Claim, depth int, traceIdx *big.Int) (types.Claim, error) {
+	// Every position committing to traceIdx is on the path from the root to its leaf, so reject indices with no leaf
+	// rather than walking to the root.
+	if _, err := types.NewPosition(0, big.NewInt(0)).PathToTraceIndex(depth, traceIdx); err != nil {
+		return types.Claim{}, fmt.Errorf("invalid trace index %v: %w", traceIdx, err)
+	}
 	candidate := ref
 	for candidate.TraceIndex(depth).Cmp(traceIdx) != 0 {
 		parent, err := game.GetParent(candidate)
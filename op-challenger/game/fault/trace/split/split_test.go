@@ -44,11 +44,27 @@ func TestErrorWhenRefAboveTopGameLeafButPositionInBottom(t *testing.T) {
 	for _, ref := range gameBuilder.Game.Claims() {
 		pos := types.NewPosition(splitDepth+1, big.NewInt(0))
 		provider, err := selector(ctx, gameBuilder.Game, ref, pos)
-		require.ErrorIsf(t, err, errRefClaimNotDeepEnough, "should not get provider with ref claim at depth: %v", ref.Depth())
+		require.ErrorIsf(t, err, ErrRefClaimNotDeepEnough, "should not get provider with ref claim at depth: %v", ref.Depth())
 		require.Nil(t, provider)
 	}
 }
 
+func TestFindAncestorDepthOutOfRange(t *testing.T) {
+	_, _, gameBuilder := setupAlphabetSplitSelector(t)
+	createClaimsToDepth(gameBuilder, splitDepth)
+	ref := latestClaim(gameBuilder)
+
+	t.Run("AncestorBelowClaim", func(t *testing.T) {
+		_, err := findAncestorAtDepth(gameBuilder.Game, ref, splitDepth+1)
+		require.ErrorIs(t, err, types.ErrPositionDepthTooLarge)
+	})
+
+	t.Run("TraceIndexBeyondLastLeaf", func(t *testing.T) {
+		_, err := findAncestorWithTraceIndex(gameBuilder.Game, ref, splitDepth, big.NewInt(1<<splitDepth))
+		require.ErrorIs(t, err, types.ErrInvalidIndexAtDepth)
+	})
+}
+
 func TestTranslatePositionsForBottomProvider(t *testing.T) {
 	tests := []struct {
 		name  string
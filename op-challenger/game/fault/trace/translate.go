@@ -2,11 +2,17 @@ package trace
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// ErrPositionAboveRoot is returned when a TranslatingProvider is asked for a position above its root depth.
+// For split games this means the position belongs to the top game rather than the provider for the bottom game.
+var ErrPositionAboveRoot = errors.New("position is above the translated root")
+
 type TranslatingProvider struct {
 	rootDepth uint64
 	provider  types.TraceProvider
@@ -26,8 +32,17 @@ func (p *TranslatingProvider) Original() types.TraceProvider {
 	return p.provider
 }
 
-func (p *TranslatingProvider) Get(ctx context.Context, pos types.Position) (common.Hash, error) {
+// translate converts pos to the equivalent position in the original provider.
+func (p *TranslatingProvider) translate(pos types.Position) (types.Position, error) {
 	relativePos, err := pos.RelativeToAncestorAtDepth(p.rootDepth)
+	if err != nil {
+		return types.Position{}, fmt.Errorf("%w: position depth %v, root depth %v: %w", ErrPositionAboveRoot, pos.Depth(), p.rootDepth, err)
+	}
+	return relativePos, nil
+}
+
+func (p *TranslatingProvider) Get(ctx context.Context, pos types.Position) (common.Hash, error) {
+	relativePos, err := p.translate(pos)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -35,15 +50,33 @@ func (p *TranslatingProvider) Get(ctx context.Context, pos types.Position) (comm
 }
 
 func (p *TranslatingProvider) GetStepData(ctx context.Context, pos types.Position) (prestate []byte, proofData []byte, preimageData *types.PreimageOracleData, err error) {
-	relativePos, err := pos.RelativeToAncestorAtDepth(p.rootDepth)
+	relativePos, err := p.translate(pos)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	return p.provider.GetStepData(ctx, relativePos)
 }
 
+// Prefetch translates the positions and passes them on to the underlying provider if it supports prefetching.
+func (p *TranslatingProvider) Prefetch(ctx context.Context, positions []types.Position) error {
+	prefetcher, ok := p.provider.(types.PrefetchingTraceProvider)
+	if !ok {
+		return nil
+	}
+	relativePositions := make([]types.Position, 0, len(positions))
+	for _, pos := range positions {
+		relativePos, err := p.translate(pos)
+		if err != nil {
+			return err
+		}
+		relativePositions = append(relativePositions, relativePos)
+	}
+	return prefetcher.Prefetch(ctx, relativePositions)
+}
+
 func (p *TranslatingProvider) AbsolutePreStateCommitment(ctx context.Context) (hash common.Hash, err error) {
 	return p.provider.AbsolutePreStateCommitment(ctx)
 }
 
 var _ types.TraceProvider = (*TranslatingProvider)(nil)
+var _ types.PrefetchingTraceProvider = (*TranslatingProvider)(nil)
@@ -31,6 +31,19 @@ func TestTranslate(t *testing.T) {
 	}
 }
 
+func TestTranslate_PositionAboveRoot(t *testing.T) {
+	orig := alphabet.NewTraceProvider("abcdefghij", 4)
+	translated := Translate(orig, 3)
+	pos := types.NewPosition(2, big.NewInt(1))
+
+	_, err := translated.Get(context.Background(), pos)
+	require.ErrorIs(t, err, ErrPositionAboveRoot)
+	require.ErrorIs(t, err, types.ErrPositionDepthTooSmall)
+
+	_, _, _, err = translated.GetStepData(context.Background(), pos)
+	require.ErrorIs(t, err, ErrPositionAboveRoot)
+}
+
 func requireSameValue(t *testing.T, a types.TraceProvider, aGIdx int64, b types.TraceProvider, bGIdx int64) {
 	// Check Get returns the same results
 	aValue, err := a.Get(context.Background(), types.NewPositionFromGIndex(big.NewInt(aGIdx)))
@@ -58,3 +71,19 @@ func TestTranslate_AbsolutePreStateCommitment(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, origValue, translatedValue)
 }
+
+func TestTranslate_Prefetch(t *testing.T) {
+	orig := &stubPrefetchingProvider{TraceProvider: alphabet.NewTraceProvider("abcdefghij", 4)}
+	translated := Translate(orig, 3)
+	prefetcher, ok := translated.(types.PrefetchingTraceProvider)
+	require.True(t, ok)
+	err := prefetcher.Prefetch(context.Background(), []types.Position{
+		types.NewPositionFromGIndex(big.NewInt(8)),
+		types.NewPositionFromGIndex(big.NewInt(17)),
+	})
+	require.NoError(t, err)
+	require.Len(t, orig.prefetched, 1)
+	require.Len(t, orig.prefetched[0], 2)
+	require.Equal(t, big.NewInt(1), orig.prefetched[0][0].ToGIndex())
+	require.Equal(t, big.NewInt(3), orig.prefetched[0][1].ToGIndex())
+}
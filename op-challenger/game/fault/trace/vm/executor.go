@@ -0,0 +1,37 @@
+package vm
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// State is the VM agnostic view of a fault proof VM state used by trace providers.
+type State struct {
+	// Step is the number of instructions executed to reach this state.
+	Step uint64
+	// Exited is true if the program being executed has exited.
+	Exited bool
+	// Witness is the encoded state, as provided to the on-chain VM when executing a step.
+	Witness []byte
+	// WitnessHash is the hash of Witness used as the claim value for this state.
+	WitnessHash common.Hash
+}
+
+// VMExecutor runs a fault proof VM to generate the proofs and states required by a trace provider.
+// Proofs are written to dir in the format and layout expected by the trace provider so that trace providers can be
+// shared between different VMs.
+type VMExecutor interface {
+	// GenerateProof runs the VM to the specified step and generates a proof at that step in dir.
+	GenerateProof(ctx context.Context, dir string, proofAt uint64) error
+
+	// GenerateProofs runs the VM once to generate proofs at all the specified steps in dir.
+	// Execution stops after the last step, or earlier if the program exits.
+	GenerateProofs(ctx context.Context, dir string, proofAt []uint64) error
+
+	// FinalState loads the state the VM stopped at during the last execution in dir.
+	FinalState(dir string) (*State, error)
+
+	// AbsolutePreState loads the absolute prestate the VM starts executing from.
+	AbsolutePreState() (*State, error)
+}
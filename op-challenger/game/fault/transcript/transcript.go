@@ -0,0 +1,219 @@
+package transcript
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Version is the version of the transcript schema produced by Export.
+// It must be incremented whenever a change is made to the schema that is not backwards compatible.
+const Version = 1
+
+const (
+	MoveRoot   = "root"
+	MoveAttack = "attack"
+	MoveDefend = "defend"
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported transcript version")
+	ErrInvalidTranscript  = errors.New("invalid transcript")
+)
+
+// GameSource provides the on-chain data required to export a transcript.
+type GameSource interface {
+	GetGameType(ctx context.Context) (uint8, error)
+	GetStatus(ctx context.Context) (gameTypes.GameStatus, error)
+	GetMaxGameDepth(ctx context.Context) (uint64, error)
+	GetAbsolutePrestateHash(ctx context.Context) (common.Hash, error)
+	GetL1Head(ctx context.Context) (common.Hash, error)
+	GetAllClaims(ctx context.Context) ([]types.Claim, error)
+}
+
+// Transcript is a self-contained record of a dispute game, suitable for publication and for
+// third-party verification with Verify.
+type Transcript struct {
+	Version          int            `json:"version"`
+	Game             common.Address `json:"game"`
+	GameType         uint8          `json:"gameType"`
+	MaxDepth         uint64         `json:"maxDepth"`
+	AbsolutePrestate common.Hash    `json:"absolutePrestate"`
+	L1Head           common.Hash    `json:"l1Head"`
+	Resolution       string         `json:"resolution"`
+	Claims           []Claim        `json:"claims"`
+}
+
+// Claim is a single claim in a Transcript, in the order it was added to the game.
+// The dispute game contracts in this tree have no bonds, so there are no bond amounts or recipients to record.
+type Claim struct {
+	Index uint64 `json:"index"`
+	// ParentIndex is the index of the claim this claim responds to. It is omitted for the root claim.
+	ParentIndex *uint64      `json:"parentIndex,omitempty"`
+	Move        string       `json:"move"`
+	Value       common.Hash  `json:"value"`
+	Position    *hexutil.Big `json:"position"`
+	Depth       int          `json:"depth"`
+	Countered   bool         `json:"countered"`
+	// Timestamp is the L1 timestamp at which the claim was added to the game.
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// Export loads the current state of the game at addr and creates a transcript of it.
+func Export(ctx context.Context, addr common.Address, source GameSource) (*Transcript, error) {
+	gameType, err := source.GetGameType(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err := source.GetStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxDepth, err := source.GetMaxGameDepth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prestate, err := source.GetAbsolutePrestateHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l1Head, err := source.GetL1Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := source.GetAllClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	game := types.NewGameState(claims, maxDepth)
+	t := &Transcript{
+		Version:          Version,
+		Game:             addr,
+		GameType:         gameType,
+		MaxDepth:         maxDepth,
+		AbsolutePrestate: prestate,
+		L1Head:           l1Head,
+		Resolution:       status.String(),
+		Claims:           make([]Claim, 0, len(claims)),
+	}
+	for _, claim := range claims {
+		t.Claims = append(t.Claims, newClaim(game, claim))
+	}
+	return t, nil
+}
+
+func newClaim(game types.Game, claim types.Claim) Claim {
+	c := Claim{
+		Index:     uint64(claim.ContractIndex),
+		Move:      MoveRoot,
+		Value:     claim.Value,
+		Position:  (*hexutil.Big)(claim.Position.ToGIndex()),
+		Depth:     claim.Depth(),
+		Countered: claim.Countered,
+		Timestamp: claim.Clock,
+	}
+	if !claim.IsRoot() {
+		parentIdx := uint64(claim.ParentContractIndex)
+		c.ParentIndex = &parentIdx
+		if game.DefendsParent(claim) {
+			c.Move = MoveDefend
+		} else {
+			c.Move = MoveAttack
+		}
+	}
+	return c
+}
+
+// Write writes the transcript to out as indented JSON.
+func (t *Transcript) Write(out io.Writer) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
+
+// Read reads and validates a transcript from in.
+func Read(in io.Reader) (*Transcript, error) {
+	var t Transcript
+	if err := json.NewDecoder(in).Decode(&t); err != nil {
+		return nil, fmt.Errorf("failed to decode transcript: %w", err)
+	}
+	if t.Version != Version {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedVersion, t.Version)
+	}
+	if _, err := t.GameState(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// GameState reconstructs the game state recorded in the transcript.
+// An error is returned if the claims do not form a valid game tree, or if the recorded moves, depths and
+// positions are not consistent with each other.
+func (t *Transcript) GameState() (types.Game, error) {
+	claims := make([]types.Claim, 0, len(t.Claims))
+	for i, c := range t.Claims {
+		if c.Index != uint64(i) {
+			return nil, fmt.Errorf("%w: claim %v recorded at index %v", ErrInvalidTranscript, c.Index, i)
+		}
+		if c.Position == nil {
+			return nil, fmt.Errorf("%w: claim %v has no position", ErrInvalidTranscript, i)
+		}
+		pos := types.NewPositionFromGIndex((*big.Int)(c.Position))
+		if pos.Depth() != c.Depth || uint64(c.Depth) > t.MaxDepth {
+			return nil, fmt.Errorf("%w: claim %v has invalid depth %v", ErrInvalidTranscript, i, c.Depth)
+		}
+		claim := types.Claim{
+			ClaimData: types.ClaimData{
+				Value:    c.Value,
+				Position: pos,
+			},
+			Countered:     c.Countered,
+			Clock:         c.Timestamp,
+			ContractIndex: i,
+		}
+		if i == 0 {
+			if c.ParentIndex != nil || c.Move != MoveRoot || !pos.IsRootPosition() {
+				return nil, fmt.Errorf("%w: first claim must be the root claim", ErrInvalidTranscript)
+			}
+			claims = append(claims, claim)
+			continue
+		}
+		if c.ParentIndex == nil || *c.ParentIndex >= uint64(i) {
+			return nil, fmt.Errorf("%w: claim %v has invalid parent", ErrInvalidTranscript, i)
+		}
+		parent := claims[*c.ParentIndex]
+		claim.ParentContractIndex = parent.ContractIndex
+		var expected types.Position
+		switch c.Move {
+		case MoveAttack:
+			expected = parent.Position.Attack()
+		case MoveDefend:
+			if parent.IsRoot() {
+				return nil, fmt.Errorf("%w: claim %v defends the root claim", ErrInvalidTranscript, i)
+			}
+			expected = parent.Position.Defend()
+		default:
+			return nil, fmt.Errorf("%w: claim %v has unknown move %q", ErrInvalidTranscript, i, c.Move)
+		}
+		if expected.ToGIndex().Cmp(pos.ToGIndex()) != 0 {
+			return nil, fmt.Errorf("%w: claim %v position does not match %v of parent %v", ErrInvalidTranscript, i, c.Move, *c.ParentIndex)
+		}
+		claims = append(claims, claim)
+	}
+	if len(claims) == 0 {
+		return nil, fmt.Errorf("%w: no claims", ErrInvalidTranscript)
+	}
+	game, err := types.NewValidatedGameState(claims, t.MaxDepth)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTranscript, err)
+	}
+	return game, nil
+}
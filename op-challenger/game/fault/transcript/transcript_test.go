@@ -0,0 +1,155 @@
+package transcript
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	faultTest "github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var gameAddr = common.Address{0xaa}
+
+func TestExport(t *testing.T) {
+	source := newStubSource(t, 4)
+	transcript, err := Export(context.Background(), gameAddr, source)
+	require.NoError(t, err)
+
+	require.Equal(t, Version, transcript.Version)
+	require.Equal(t, gameAddr, transcript.Game)
+	require.Equal(t, source.gameType, transcript.GameType)
+	require.Equal(t, source.maxDepth, transcript.MaxDepth)
+	require.Equal(t, source.prestate, transcript.AbsolutePrestate)
+	require.Equal(t, source.l1Head, transcript.L1Head)
+	require.Equal(t, gameTypes.GameStatusChallengerWon.String(), transcript.Resolution)
+	require.Len(t, transcript.Claims, len(source.claims))
+
+	require.Equal(t, MoveRoot, transcript.Claims[0].Move)
+	require.Nil(t, transcript.Claims[0].ParentIndex)
+	require.Equal(t, MoveAttack, transcript.Claims[1].Move)
+	require.Equal(t, uint64(0), *transcript.Claims[1].ParentIndex)
+	require.Equal(t, MoveDefend, transcript.Claims[2].Move)
+	require.Equal(t, uint64(1), *transcript.Claims[2].ParentIndex)
+	for i, claim := range source.claims {
+		require.Equal(t, uint64(i), transcript.Claims[i].Index)
+		require.Equal(t, claim.Value, transcript.Claims[i].Value)
+		require.Equal(t, claim.Depth(), transcript.Claims[i].Depth)
+		require.Equal(t, claim.Clock, transcript.Claims[i].Timestamp)
+		require.Zero(t, claim.Position.ToGIndex().Cmp(transcript.Claims[i].Position.ToInt()))
+	}
+}
+
+func TestExport_SourceError(t *testing.T) {
+	source := newStubSource(t, 4)
+	source.err = errors.New("boom")
+	_, err := Export(context.Background(), gameAddr, source)
+	require.ErrorIs(t, err, source.err)
+}
+
+func TestWriteAndRead(t *testing.T) {
+	transcript, err := Export(context.Background(), gameAddr, newStubSource(t, 4))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, transcript.Write(&buf))
+	actual, err := Read(&buf)
+	require.NoError(t, err)
+	require.Equal(t, transcript, actual)
+}
+
+func TestRead_UnsupportedVersion(t *testing.T) {
+	_, err := Read(bytes.NewBufferString(`{"version": 999}`))
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestGameState(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(t *Transcript)
+	}{
+		{"NoClaims", func(t *Transcript) { t.Claims = nil }},
+		{"IndexMismatch", func(t *Transcript) { t.Claims[1].Index = 5 }},
+		{"RootWithParent", func(t *Transcript) { t.Claims[0].ParentIndex = t.Claims[1].ParentIndex }},
+		{"MissingParent", func(t *Transcript) { t.Claims[1].ParentIndex = nil }},
+		{"FutureParent", func(t *Transcript) {
+			idx := uint64(2)
+			t.Claims[1].ParentIndex = &idx
+		}},
+		{"WrongMove", func(t *Transcript) { t.Claims[1].Move = MoveDefend }},
+		{"UnknownMove", func(t *Transcript) { t.Claims[1].Move = "step" }},
+		{"WrongDepth", func(t *Transcript) { t.Claims[1].Depth = 3 }},
+		{"MissingPosition", func(t *Transcript) { t.Claims[2].Position = nil }},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			transcript, err := Export(context.Background(), gameAddr, newStubSource(t, 4))
+			require.NoError(t, err)
+			_, err = transcript.GameState()
+			require.NoError(t, err)
+
+			test.modify(transcript)
+			_, err = transcript.GameState()
+			require.ErrorIs(t, err, ErrInvalidTranscript)
+		})
+	}
+}
+
+type stubSource struct {
+	err      error
+	gameType uint8
+	status   gameTypes.GameStatus
+	maxDepth uint64
+	prestate common.Hash
+	l1Head   common.Hash
+	claims   []types.Claim
+}
+
+// newStubSource creates a source for a game with an incorrect root claim that was attacked correctly and then
+// defended with an incorrect claim.
+func newStubSource(t *testing.T, maxDepth int) *stubSource {
+	builder := faultTest.NewAlphabetClaimBuilder(t, maxDepth)
+	game := builder.GameBuilder(false)
+	game.Seq().AttackCorrect().Defend(common.Hash{0xdd})
+	claims := game.Game.Claims()
+	for i := range claims {
+		claims[i].Clock = uint64(1000 + i)
+	}
+	return &stubSource{
+		gameType: 255,
+		status:   gameTypes.GameStatusChallengerWon,
+		maxDepth: uint64(maxDepth),
+		prestate: common.Hash{0x01},
+		l1Head:   common.Hash{0x02},
+		claims:   claims,
+	}
+}
+
+func (s *stubSource) GetGameType(_ context.Context) (uint8, error) {
+	return s.gameType, s.err
+}
+
+func (s *stubSource) GetStatus(_ context.Context) (gameTypes.GameStatus, error) {
+	return s.status, s.err
+}
+
+func (s *stubSource) GetMaxGameDepth(_ context.Context) (uint64, error) {
+	return s.maxDepth, s.err
+}
+
+func (s *stubSource) GetAbsolutePrestateHash(_ context.Context) (common.Hash, error) {
+	return s.prestate, s.err
+}
+
+func (s *stubSource) GetL1Head(_ context.Context) (common.Hash, error) {
+	return s.l1Head, s.err
+}
+
+func (s *stubSource) GetAllClaims(_ context.Context) ([]types.Claim, error) {
+	return s.claims, s.err
+}
@@ -0,0 +1,100 @@
+package transcript
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrGameMismatch is returned when the game parameters recorded in a transcript differ from those of the game contract.
+var ErrGameMismatch = errors.New("transcript does not match game")
+
+// ClaimVerification records the result of comparing a single transcript claim to the honest trace.
+type ClaimVerification struct {
+	Index    uint64      `json:"index"`
+	Honest   bool        `json:"honest"`
+	Expected common.Hash `json:"expected"`
+}
+
+// Report is the result of verifying a Transcript against the honest trace.
+type Report struct {
+	Game common.Address `json:"game"`
+	// ExpectedResolution is the resolution the game should reach when played honestly.
+	ExpectedResolution string              `json:"expectedResolution"`
+	Resolution         string              `json:"resolution"`
+	Claims             []ClaimVerification `json:"claims"`
+}
+
+// ResolutionMatches returns true unless the game has resolved with a result that differs from the
+// result the honest trace requires.
+func (r *Report) ResolutionMatches() bool {
+	return r.Resolution == gameTypes.GameStatusInProgress.String() || r.Resolution == r.ExpectedResolution
+}
+
+// Verify replays every claim in the transcript against the honest trace provided by accessor.
+func Verify(ctx context.Context, t *Transcript, accessor types.TraceAccessor) (*Report, error) {
+	game, err := t.GameState()
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{
+		Game:       t.Game,
+		Resolution: t.Resolution,
+	}
+	for _, claim := range game.Claims() {
+		expected, err := accessor.Get(ctx, game, claim, claim.Position)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load honest value for claim %v: %w", claim.ContractIndex, err)
+		}
+		report.Claims = append(report.Claims, ClaimVerification{
+			Index:    uint64(claim.ContractIndex),
+			Honest:   expected == claim.Value,
+			Expected: expected,
+		})
+	}
+	if report.Claims[0].Honest {
+		report.ExpectedResolution = gameTypes.GameStatusDefenderWon.String()
+	} else {
+		report.ExpectedResolution = gameTypes.GameStatusChallengerWon.String()
+	}
+	return report, nil
+}
+
+// CheckGame returns an error if the game type, max depth, absolute prestate or L1 head recorded in the transcript
+// differ from those of the game contract. The honest trace is derived from these values, so a transcript that doesn't
+// match its game would otherwise be verified against a different trace.
+func CheckGame(ctx context.Context, t *Transcript, source GameSource) error {
+	gameType, err := source.GetGameType(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load game type: %w", err)
+	}
+	if gameType != t.GameType {
+		return fmt.Errorf("%w: game type %v but game has %v", ErrGameMismatch, t.GameType, gameType)
+	}
+	maxDepth, err := source.GetMaxGameDepth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load max depth: %w", err)
+	}
+	if maxDepth != t.MaxDepth {
+		return fmt.Errorf("%w: max depth %v but game has %v", ErrGameMismatch, t.MaxDepth, maxDepth)
+	}
+	prestate, err := source.GetAbsolutePrestateHash(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load absolute prestate: %w", err)
+	}
+	if prestate != t.AbsolutePrestate {
+		return fmt.Errorf("%w: absolute prestate %v but game has %v", ErrGameMismatch, t.AbsolutePrestate, prestate)
+	}
+	l1Head, err := source.GetL1Head(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load L1 head: %w", err)
+	}
+	if l1Head != t.L1Head {
+		return fmt.Errorf("%w: L1 head %v but game has %v", ErrGameMismatch, t.L1Head, l1Head)
+	}
+	return nil
+}
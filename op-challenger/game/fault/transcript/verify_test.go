@@ -0,0 +1,109 @@
+package transcript
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	maxDepth := 4
+	accessor := trace.NewSimpleTraceAccessor(alphabet.NewTraceProvider("abcdefghijklmnopqrstuvwxyz", uint64(maxDepth)))
+
+	t.Run("ReportsHonestClaims", func(t *testing.T) {
+		transcript, err := Export(context.Background(), gameAddr, newStubSource(t, maxDepth))
+		require.NoError(t, err)
+
+		report, err := Verify(context.Background(), transcript, accessor)
+		require.NoError(t, err)
+		require.Equal(t, gameAddr, report.Game)
+		require.Len(t, report.Claims, 3)
+		require.False(t, report.Claims[0].Honest)
+		require.True(t, report.Claims[1].Honest)
+		require.Equal(t, transcript.Claims[1].Value, report.Claims[1].Expected)
+		require.False(t, report.Claims[2].Honest)
+		require.Equal(t, gameTypes.GameStatusChallengerWon.String(), report.ExpectedResolution)
+		require.True(t, report.ResolutionMatches())
+	})
+
+	t.Run("DetectsIncorrectResolution", func(t *testing.T) {
+		source := newStubSource(t, maxDepth)
+		source.status = gameTypes.GameStatusDefenderWon
+		transcript, err := Export(context.Background(), gameAddr, source)
+		require.NoError(t, err)
+
+		report, err := Verify(context.Background(), transcript, accessor)
+		require.NoError(t, err)
+		require.False(t, report.ResolutionMatches())
+	})
+
+	t.Run("InProgressGameMatches", func(t *testing.T) {
+		source := newStubSource(t, maxDepth)
+		source.status = gameTypes.GameStatusInProgress
+		transcript, err := Export(context.Background(), gameAddr, source)
+		require.NoError(t, err)
+
+		report, err := Verify(context.Background(), transcript, accessor)
+		require.NoError(t, err)
+		require.True(t, report.ResolutionMatches())
+	})
+
+	t.Run("TraceError", func(t *testing.T) {
+		transcript, err := Export(context.Background(), gameAddr, newStubSource(t, maxDepth))
+		require.NoError(t, err)
+
+		expectedErr := errors.New("boom")
+		_, err = Verify(context.Background(), transcript, &errorAccessor{expectedErr})
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
+
+type errorAccessor struct {
+	err error
+}
+
+func (e *errorAccessor) Get(_ context.Context, _ types.Game, _ types.Claim, _ types.Position) (common.Hash, error) {
+	return common.Hash{}, e.err
+}
+
+func (e *errorAccessor) GetStepData(_ context.Context, _ types.Game, _ types.Claim, _ types.Position) ([]byte, []byte, *types.PreimageOracleData, error) {
+	return nil, nil, nil, e.err
+}
+
+func TestCheckGame(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(source *stubSource)
+	}{
+		{"GameType", func(source *stubSource) { source.gameType = 0 }},
+		{"MaxDepth", func(source *stubSource) { source.maxDepth = 8 }},
+		{"AbsolutePrestate", func(source *stubSource) { source.prestate = common.Hash{0xaa} }},
+		{"L1Head", func(source *stubSource) { source.l1Head = common.Hash{0xbb} }},
+	}
+	source := newStubSource(t, 4)
+	transcript, err := Export(context.Background(), gameAddr, source)
+	require.NoError(t, err)
+	require.NoError(t, CheckGame(context.Background(), transcript, source))
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			source := newStubSource(t, 4)
+			test.modify(source)
+			require.ErrorIs(t, CheckGame(context.Background(), transcript, source), ErrGameMismatch)
+		})
+	}
+
+	t.Run("SourceError", func(t *testing.T) {
+		source := newStubSource(t, 4)
+		source.err = errors.New("boom")
+		require.ErrorIs(t, CheckGame(context.Background(), transcript, source), source.err)
+	})
+}
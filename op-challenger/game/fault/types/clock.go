@@ -0,0 +1,23 @@
+package types
+
+import (
+	"time"
+)
+
+// ChessClockDeadline returns the time at which the chess clock of the team responding to claim expires.
+// maxClockDuration is the total time each team has available, which is half the game duration.
+//
+// The responding team's clock has been running since claim was posted. Any time already used by that team is
+// recorded in the clock of claim's parent, the last claim they posted in this part of the game. The root claim has
+// no parent so its responding team starts with the full clock.
+func ChessClockDeadline(game Game, claim Claim, maxClockDuration time.Duration) (time.Time, error) {
+	var used time.Duration
+	if !claim.IsRoot() {
+		parent, err := game.GetParent(claim)
+		if err != nil {
+			return time.Time{}, err
+		}
+		used = time.Duration(parent.ClockDuration) * time.Second
+	}
+	return time.Unix(int64(claim.Clock), 0).Add(maxClockDuration - used), nil
+}
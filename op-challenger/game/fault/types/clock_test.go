@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChessClockDeadline(t *testing.T) {
+	maxClockDuration := 100 * time.Second
+	root, top, middle, _ := createTestClaims()
+	root.Clock = 1000
+	top.Clock = 1010
+	top.ClockDuration = 10
+	middle.Clock = 1050
+	middle.ClockDuration = 40
+	g := NewGameState([]Claim{root, top, middle}, testMaxDepth)
+
+	t.Run("RootClaim", func(t *testing.T) {
+		deadline, err := ChessClockDeadline(g, root, maxClockDuration)
+		require.NoError(t, err)
+		require.Equal(t, time.Unix(1100, 0), deadline)
+	})
+
+	t.Run("UsesDurationFromParent", func(t *testing.T) {
+		deadline, err := ChessClockDeadline(g, middle, maxClockDuration)
+		require.NoError(t, err)
+		// The responding team used 10 seconds before posting top, leaving 90 seconds from when middle was posted.
+		require.Equal(t, time.Unix(1140, 0), deadline)
+	})
+
+	t.Run("UnknownParent", func(t *testing.T) {
+		_, _, _, bottom := createTestClaims()
+		bottom.ParentContractIndex = 5
+		_, err := ChessClockDeadline(g, bottom, maxClockDuration)
+		require.ErrorIs(t, err, ErrClaimNotFound)
+	})
+}
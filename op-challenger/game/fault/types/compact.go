@@ -0,0 +1,232 @@
+package types
+
+import (
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// packedPosition is a Position stored as its gindex in a fixed size uint128, avoiding a heap allocated big.Int per
+// claim. The contracts encode positions as uint128 gindices so every position that can exist onchain fits.
+type packedPosition struct {
+	hi, lo uint64
+}
+
+// packPosition packs p into a packedPosition.
+// Returns false if the gindex of p does not fit in 128 bits.
+func packPosition(p Position) (packedPosition, bool) {
+	gindex := p.ToGIndex()
+	if gindex.Sign() < 0 || gindex.BitLen() > 128 {
+		return packedPosition{}, false
+	}
+	var buf [16]byte
+	gindex.FillBytes(buf[:])
+	return packedPosition{
+		hi: binary.BigEndian.Uint64(buf[:8]),
+		lo: binary.BigEndian.Uint64(buf[8:]),
+	}, true
+}
+
+// parent returns the gindex one level up from p, which is the position p is a move against if p was an attack, or
+// the position to the left of the one p is a move against if p was a defense.
+func (p packedPosition) parent() packedPosition {
+	return p.shiftRight()
+}
+
+func (p packedPosition) shiftRight() packedPosition {
+	return packedPosition{
+		hi: p.hi >> 1,
+		lo: p.lo>>1 | p.hi<<63,
+	}
+}
+
+// indexAtDepth returns the index of p at its depth, which is the gindex without the depth bit.
+func (p packedPosition) indexAtDepth() packedPosition {
+	depth := p.depth()
+	if depth >= 64 {
+		p.hi &^= 1 << (depth - 64)
+	} else {
+		p.lo &^= 1 << depth
+	}
+	return p
+}
+
+// rightOf returns true if p is to the right of the parent position, matching Position.RightOf.
+func (p packedPosition) rightOf(parent packedPosition) bool {
+	return p.indexAtDepth().shiftRight() != parent.indexAtDepth()
+}
+
+// isMoveAgainst returns true if p is either the attack or defense position of parent.
+// The root claim can only be attacked.
+func (p packedPosition) isMoveAgainst(parent packedPosition) bool {
+	// Both attack and defense positions are left children
+	if p.lo&1 != 0 {
+		return false
+	}
+	up := p.parent()
+	if up == parent {
+		return true
+	}
+	return parent != packedPosition{lo: 1} && up == packedPosition{hi: parent.hi, lo: parent.lo | 1}
+}
+
+func (p packedPosition) depth() int {
+	if p.hi != 0 {
+		return 127 - bits.LeadingZeros64(p.hi)
+	}
+	return 63 - bits.LeadingZeros64(p.lo)
+}
+
+// unpack returns the Position represented by p.
+func (p packedPosition) unpack() Position {
+	depth := p.depth()
+	index := p.indexAtDepth()
+	hi, lo := index.hi, index.lo
+	if hi == 0 {
+		return NewPosition(depth, new(big.Int).SetUint64(lo))
+	}
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], hi)
+	binary.BigEndian.PutUint64(buf[8:], lo)
+	return NewPosition(depth, new(big.Int).SetBytes(buf[:]))
+}
+
+// compactClaim is the in-memory representation of a Claim used by gameState.
+// The value is stored as an index into the interned values of the game state, as large games often repeat the same
+// claim values, particularly when an adversary is spamming the game.
+type compactClaim struct {
+	position            packedPosition
+	clock               uint64
+	clockDuration       uint64
+	contractIndex       int
+	parentContractIndex int
+	value               uint32
+	countered           bool
+}
+
+// compactKey identifies a claim for duplicate detection. Like the contract, a claim is a duplicate if an existing
+// claim has the same position, value and parent.
+type compactKey struct {
+	position            packedPosition
+	parentContractIndex int
+	value               uint32
+}
+
+// compactClaims stores claims compactly, in contract order.
+// As claims are in contract order, the index of a claim in claims is used to look up parents by contract index. The
+// ContractIndex of each claim is kept as provided rather than derived from its index. Claims are additionally indexed by
+// gindex, parent and value in keys for duplicate detection.
+// Claims whose position cannot be packed are kept in full in wide, though they cannot exist onchain.
+// Full claims are unpacked on every read rather than cached, so reads never modify the stored claims and the claims
+// are not retained twice.
+type compactClaims struct {
+	claims   []compactClaim
+	values   []common.Hash
+	valueIdx map[common.Hash]uint32
+	keys     map[compactKey]struct{}
+	wide     map[int]Claim
+	wideIDs  map[claimID]bool
+}
+
+func newCompactClaims(claims []Claim) *compactClaims {
+	c := &compactClaims{
+		claims:   make([]compactClaim, 0, len(claims)),
+		valueIdx: make(map[common.Hash]uint32),
+		keys:     make(map[compactKey]struct{}, len(claims)),
+	}
+	for _, claim := range claims {
+		c.append(claim)
+	}
+	return c
+}
+
+func (c *compactClaims) append(claim Claim) {
+	pos, ok := packPosition(claim.Position)
+	if !ok {
+		if c.wide == nil {
+			c.wide = make(map[int]Claim)
+			c.wideIDs = make(map[claimID]bool)
+		}
+		c.wide[len(c.claims)] = claim
+		c.wideIDs[computeClaimID(claim)] = true
+		c.claims = append(c.claims, compactClaim{})
+		return
+	}
+	value := c.intern(claim.Value)
+	c.claims = append(c.claims, compactClaim{
+		position:            pos,
+		clock:               claim.Clock,
+		clockDuration:       claim.ClockDuration,
+		contractIndex:       claim.ContractIndex,
+		parentContractIndex: claim.ParentContractIndex,
+		value:               value,
+		countered:           claim.Countered,
+	})
+	c.keys[compactKey{position: pos, parentContractIndex: claim.ParentContractIndex, value: value}] = struct{}{}
+}
+
+func (c *compactClaims) intern(value common.Hash) uint32 {
+	if idx, ok := c.valueIdx[value]; ok {
+		return idx
+	}
+	idx := uint32(len(c.values))
+	c.values = append(c.values, value)
+	c.valueIdx[value] = idx
+	return idx
+}
+
+func (c *compactClaims) len() int {
+	return len(c.claims)
+}
+
+// get returns the claim at index i, which must be in range.
+func (c *compactClaims) get(i int) Claim {
+	if claim, ok := c.wide[i]; ok {
+		return claim
+	}
+	compact := c.claims[i]
+	return Claim{
+		ClaimData: ClaimData{
+			Value:    c.values[compact.value],
+			Position: compact.position.unpack(),
+		},
+		Countered:           compact.countered,
+		Clock:               compact.clock,
+		ClockDuration:       compact.clockDuration,
+		ContractIndex:       compact.contractIndex,
+		ParentContractIndex: compact.parentContractIndex,
+	}
+}
+
+// isWide returns true if the claim at index i is stored in full rather than compactly.
+func (c *compactClaims) isWide(i int) bool {
+	_, ok := c.wide[i]
+	return ok
+}
+
+// all returns every claim in contract order, unpacked into a new slice.
+func (c *compactClaims) all() []Claim {
+	if len(c.claims) == 0 {
+		return nil
+	}
+	claims := make([]Claim, len(c.claims))
+	for i := range c.claims {
+		claims[i] = c.get(i)
+	}
+	return claims
+}
+
+func (c *compactClaims) contains(claim Claim) bool {
+	pos, ok := packPosition(claim.Position)
+	if !ok {
+		return c.wideIDs[computeClaimID(claim)]
+	}
+	value, ok := c.valueIdx[claim.Value]
+	if !ok {
+		return false
+	}
+	_, ok = c.keys[compactKey{position: pos, parentContractIndex: claim.ParentContractIndex, value: value}]
+	return ok
+}
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"math/big"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackPosition(t *testing.T) {
+	maxIndex := func(depth int) *big.Int {
+		return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(depth)), big.NewInt(1))
+	}
+	tests := []Position{
+		NewPosition(0, big.NewInt(0)),
+		NewPosition(1, big.NewInt(1)),
+		NewPosition(30, big.NewInt(12345)),
+		NewPosition(63, maxIndex(63)),
+		NewPosition(64, big.NewInt(0)),
+		NewPosition(64, maxIndex(64)),
+		NewPosition(65, new(big.Int).Lsh(big.NewInt(1), 64)),
+		NewPosition(MaxGameDepthLimit, maxIndex(MaxGameDepthLimit)),
+		NewPosition(MaxGameDepthLimit+1, maxIndex(MaxGameDepthLimit+1)),
+	}
+	for _, pos := range tests {
+		pos := pos
+		t.Run(pos.String(), func(t *testing.T) {
+			packed, ok := packPosition(pos)
+			require.True(t, ok)
+			actual := packed.unpack()
+			require.Equal(t, pos.Depth(), actual.Depth())
+			require.Zero(t, pos.IndexAtDepth().Cmp(actual.IndexAtDepth()))
+		})
+	}
+
+	t.Run("TooLarge", func(t *testing.T) {
+		_, ok := packPosition(NewPosition(128, big.NewInt(0)))
+		require.False(t, ok)
+	})
+}
+
+func TestPackedPositionMoves(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		depth := rng.Intn(MaxGameDepthLimit)
+		parent := NewPosition(depth, new(big.Int).Rand(rng, new(big.Int).Lsh(big.NewInt(1), uint(depth))))
+		packedParent, ok := packPosition(parent)
+		require.True(t, ok)
+
+		attack, ok := packPosition(parent.Attack())
+		require.True(t, ok)
+		require.True(t, attack.isMoveAgainst(packedParent))
+		require.Equal(t, parent.Attack().RightOf(parent), attack.rightOf(packedParent))
+
+		if !parent.IsRootPosition() {
+			defend, ok := packPosition(parent.Defend())
+			require.True(t, ok)
+			require.True(t, defend.isMoveAgainst(packedParent))
+			require.Equal(t, parent.Defend().RightOf(parent), defend.rightOf(packedParent))
+		}
+
+		other, ok := packPosition(parent.Attack().MoveRight())
+		require.True(t, ok)
+		require.False(t, other.isMoveAgainst(packedParent))
+		require.Equal(t, parent.Attack().MoveRight().RightOf(parent), other.rightOf(packedParent))
+	}
+}
+
+func TestCompactClaims(t *testing.T) {
+	root, top, middle, bottom := createTestClaims()
+	// Reuse the root claim value to check interned values are resolved correctly
+	middle.Value = root.Value
+	middle.Countered = true
+	middle.Clock = 42
+	wide := Claim{
+		ClaimData: ClaimData{
+			Value:    common.Hash{0xaa},
+			Position: NewPosition(200, big.NewInt(3)),
+		},
+		ContractIndex:       4,
+		ParentContractIndex: 3,
+	}
+	claims := []Claim{root, top, middle, bottom, wide}
+	c := newCompactClaims(claims)
+	require.Len(t, c.values, 3, "middle reuses the root value and wide claims are stored in full")
+	require.Equal(t, claims, c.all())
+	for i, claim := range claims {
+		require.Equal(t, claim, c.get(i))
+		require.True(t, c.contains(claim))
+	}
+
+	// Reads return new claims so modifying them does not affect the stored claims.
+	read := c.all()
+	read[1].Value = common.Hash{0xcc}
+	require.Equal(t, claims, c.all())
+
+	differentParent := middle
+	differentParent.ParentContractIndex = 0
+	require.False(t, c.contains(differentParent))
+	differentValue := middle
+	differentValue.Value = common.Hash{0xbb}
+	require.False(t, c.contains(differentValue))
+	differentWide := wide
+	differentWide.Position = wide.Position.MoveRight()
+	require.False(t, c.contains(differentWide))
+
+	t.Run("KeepsContractIndex", func(t *testing.T) {
+		// A subset of claims whose contract indices don't match their position in the slice.
+		subset := []Claim{root, bottom}
+		require.Equal(t, subset, newCompactClaims(subset).all())
+	})
+}
+
+// BenchmarkGameStateMemory reports the heap retained to hold 10k claims in a deep game, compared to a flat slice of
+// claims. Claim values are drawn from a small set, as happens when an adversary repeats the same claims.
+// The claims are read with Claims after creating the game state, as the agent does every update, so any heap retained
+// by reading them is included.
+func BenchmarkGameStateMemory(b *testing.B) {
+	const numClaims = 10_000
+	const depth = 73
+	rng := rand.New(rand.NewSource(1))
+	values := make([]common.Hash, 16)
+	for i := range values {
+		rng.Read(values[i][:])
+	}
+	claims := make([]Claim, numClaims)
+	for i := range claims {
+		claimDepth := rng.Intn(depth + 1)
+		indexAtDepth := new(big.Int).Rand(rng, new(big.Int).Lsh(big.NewInt(1), uint(claimDepth)))
+		claims[i] = Claim{
+			ClaimData: ClaimData{
+				Value:    values[rng.Intn(len(values))],
+				Position: NewPosition(claimDepth, indexAtDepth),
+			},
+			ContractIndex:       i,
+			ParentContractIndex: rng.Intn(i + 1),
+		}
+	}
+
+	measure := func(b *testing.B, create func() any) {
+		var sink any
+		for i := 0; i < b.N; i++ {
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			sink = create()
+			runtime.GC()
+			runtime.ReadMemStats(&after)
+			b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc), "retained-bytes/10k-claims")
+		}
+		runtime.KeepAlive(sink)
+	}
+	b.Run("Flat", func(b *testing.B) {
+		measure(b, func() any {
+			flat := make([]Claim, len(claims))
+			ids := make(map[claimID]bool, len(claims))
+			for i, claim := range claims {
+				// Copy the position so the heap used by the big.Int is included
+				claim.Position = NewPosition(claim.Depth(), new(big.Int).Set(claim.IndexAtDepth()))
+				flat[i] = claim
+				ids[computeClaimID(claim)] = true
+			}
+			return []any{flat, ids}
+		})
+	})
+	b.Run("Compact", func(b *testing.B) {
+		measure(b, func() any {
+			game := NewGameState(claims, depth)
+			if len(game.Claims()) != len(claims) {
+				b.Fatal("claims not loaded")
+			}
+			return game
+		})
+	})
+}
@@ -2,17 +2,49 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// MaxGameDepthLimit is the largest max game depth that can be played. The contracts encode positions as uint128
+// gindices and a step creates a position one level below the max game depth.
+const MaxGameDepthLimit = 126
+
 var (
 	// ErrClaimNotFound is returned when a claim does not exist in the game state.
 	ErrClaimNotFound = errors.New("claim not found in game state")
+
+	ErrInvalidGameDepth  = errors.New("invalid max game depth")
+	ErrInvalidSplitDepth = errors.New("invalid split depth")
+
+	// ErrInvalidClaim is returned by AddClaim when a claim cannot be the next claim in the game.
+	ErrInvalidClaim = errors.New("invalid claim")
 )
 
+// ValidateGameDepth returns an error if maxDepth is larger than the contracts support.
+// The max depth is not required to be a power of two.
+func ValidateGameDepth(maxDepth uint64) error {
+	if maxDepth > MaxGameDepthLimit {
+		return fmt.Errorf("%w: %v exceeds limit %v", ErrInvalidGameDepth, maxDepth, MaxGameDepthLimit)
+	}
+	return nil
+}
+
+// ValidateSplitDepth returns an error if splitDepth is not valid for a game with the specified max depth.
+// As in the contract, the split depth must be strictly less than the max depth.
+func ValidateSplitDepth(maxDepth uint64, splitDepth uint64) error {
+	if err := ValidateGameDepth(maxDepth); err != nil {
+		return err
+	}
+	if splitDepth >= maxDepth {
+		return fmt.Errorf("%w: %v must be less than max game depth %v", ErrInvalidSplitDepth, splitDepth, maxDepth)
+	}
+	return nil
+}
+
 // Game is an interface that represents the state of a dispute game.
 type Game interface {
 	// Claims returns all of the claims in the game.
@@ -32,6 +64,11 @@ type Game interface {
 	// AgreeWithClaimLevel returns if the game state agrees with the provided claim level.
 	AgreeWithClaimLevel(claim Claim, agreeWithRootClaim bool) bool
 
+	// ClaimsRequiringResponse returns the claims, in contract order, at levels the game state disagrees with that
+	// may still need to be countered. honest is the set of contract indices of claims at agreeing levels that are
+	// on an honest path. Claims whose parent is not honest, or that already have an honest child, are excluded.
+	ClaimsRequiringResponse(agreeWithRootClaim bool, honest map[int]bool) []Claim
+
 	MaxDepth() uint64
 }
 
@@ -47,25 +84,54 @@ func computeClaimID(claim Claim) claimID {
 
 // gameState is a struct that represents the state of a dispute game.
 // The game state implements the [Game] interface.
+// Claims are stored compactly as adversaries can force very large games.
 type gameState struct {
 	// claims is the list of claims in the same order as the contract
-	claims   []Claim
-	claimIDs map[claimID]bool
-	depth    uint64
+	claims *compactClaims
+	depth  uint64
 }
 
 // NewGameState returns a new game state.
 // The provided [Claim] is used as the root node.
 func NewGameState(claims []Claim, depth uint64) *gameState {
-	claimIDs := make(map[claimID]bool)
+	return &gameState{
+		claims: newCompactClaims(claims),
+		depth:  depth,
+	}
+}
+
+// NewValidatedGameState returns a new game state, after checking that depth is a valid max game depth and that
+// the claims form a valid game within it. See AddClaim.
+func NewValidatedGameState(claims []Claim, depth uint64) (*gameState, error) {
+	if err := ValidateGameDepth(depth); err != nil {
+		return nil, err
+	}
+	g := NewGameState(nil, depth)
 	for _, claim := range claims {
-		claimIDs[computeClaimID(claim)] = true
+		if err := g.AddClaim(claim); err != nil {
+			return nil, err
+		}
 	}
-	return &gameState{
-		claims:   claims,
-		claimIDs: claimIDs,
-		depth:    depth,
+	return g, nil
+}
+
+// CounteredClaims returns whether each claim would be countered if the game were resolved with no further claims,
+// indexed by contract index. As in the contract, a claim is countered if any of its children are not countered.
+// The countered flag reported by the contract is only used for claims at maxDepth, where it can only have been set by
+// a step. Above max depth the contract sets it whenever the claim is moved against, regardless of whether that move
+// was countered. Claims must be ordered by contract index. Children always have a larger index than their parent so
+// are resolved first.
+func CounteredClaims(claims []Claim, maxDepth int) []bool {
+	countered := make([]bool, len(claims))
+	for i := len(claims) - 1; i >= 0; i-- {
+		if claims[i].Depth() >= maxDepth {
+			countered[i] = claims[i].Countered
+		}
+		if i > 0 && !countered[i] {
+			countered[claims[i].ParentContractIndex] = true
+		}
 	}
+	return countered
 }
 
 // AgreeWithClaimLevel returns if the game state agrees with the provided claim level.
@@ -80,13 +146,33 @@ func (g *gameState) AgreeWithClaimLevel(claim Claim, agreeWithRootClaim bool) bo
 	}
 }
 
+func (g *gameState) ClaimsRequiringResponse(agreeWithRootClaim bool, honest map[int]bool) []Claim {
+	claims := g.claims.all()
+	countered := make(map[int]bool)
+	for _, claim := range claims {
+		if !claim.IsRoot() && honest[claim.ContractIndex] {
+			countered[claim.ParentContractIndex] = true
+		}
+	}
+	var result []Claim
+	for _, claim := range claims {
+		if g.AgreeWithClaimLevel(claim, agreeWithRootClaim) || countered[claim.ContractIndex] {
+			continue
+		}
+		if !claim.IsRoot() && !honest[claim.ParentContractIndex] {
+			continue
+		}
+		result = append(result, claim)
+	}
+	return result
+}
+
 func (g *gameState) IsDuplicate(claim Claim) bool {
-	return g.claimIDs[computeClaimID(claim)]
+	return g.claims.contains(claim)
 }
 
 func (g *gameState) Claims() []Claim {
-	// Defensively copy to avoid modifications to the underlying array.
-	return append([]Claim(nil), g.claims...)
+	return g.claims.all()
 }
 
 func (g *gameState) MaxDepth() uint64 {
@@ -102,20 +188,65 @@ func (g *gameState) GetParent(claim Claim) (Claim, error) {
 }
 
 func (g *gameState) DefendsParent(claim Claim) bool {
-	parent := g.getParent(claim)
-	if parent == nil {
+	if !g.hasParent(claim) {
 		return false
 	}
-	return claim.RightOf(parent.Position)
+	pos, ok := packPosition(claim.Position)
+	if !ok || g.claims.isWide(claim.ParentContractIndex) {
+		return claim.RightOf(g.claims.get(claim.ParentContractIndex).Position)
+	}
+	return pos.rightOf(g.claims.claims[claim.ParentContractIndex].position)
 }
 
-func (g *gameState) getParent(claim Claim) *Claim {
+// AddClaim appends claim to the game, after checking that it is the next claim in contract order and is a valid
+// move against its parent claim.
+func (g *gameState) AddClaim(claim Claim) error {
+	if claim.ContractIndex != g.claims.len() {
+		return fmt.Errorf("%w: contract index %v is not the next claim index %v", ErrInvalidClaim, claim.ContractIndex, g.claims.len())
+	}
+	if err := claim.Position.Validate(g.depth); err != nil {
+		return fmt.Errorf("%w: claim %v: %w", ErrInvalidClaim, claim.ContractIndex, err)
+	}
 	if claim.IsRoot() {
-		return nil
+		if g.claims.len() != 0 {
+			return fmt.Errorf("%w: root claim must be the first claim", ErrInvalidClaim)
+		}
+	} else {
+		if !g.hasParent(claim) {
+			return fmt.Errorf("%w: claim %v has unknown parent %v", ErrInvalidClaim, claim.ContractIndex, claim.ParentContractIndex)
+		}
+		if !g.isMoveAgainstParent(claim) {
+			return fmt.Errorf("%w: claim %v at %v is not a move against parent %v", ErrInvalidClaim, claim.ContractIndex, claim.Position, claim.ParentContractIndex)
+		}
+		if g.claims.contains(claim) {
+			return fmt.Errorf("%w: claim %v is a duplicate", ErrInvalidClaim, claim.ContractIndex)
+		}
+	}
+	g.claims.append(claim)
+	return nil
+}
+
+func (g *gameState) isMoveAgainstParent(claim Claim) bool {
+	pos, ok := packPosition(claim.Position)
+	if !ok || g.claims.isWide(claim.ParentContractIndex) {
+		parent := g.claims.get(claim.ParentContractIndex).Position
+		gindex := claim.Position.ToGIndex()
+		if gindex.Cmp(parent.Attack().ToGIndex()) == 0 {
+			return true
+		}
+		return !parent.IsRootPosition() && gindex.Cmp(parent.Defend().ToGIndex()) == 0
 	}
-	if claim.ParentContractIndex >= len(g.claims) || claim.ParentContractIndex < 0 {
+	return pos.isMoveAgainst(g.claims.claims[claim.ParentContractIndex].position)
+}
+
+func (g *gameState) hasParent(claim Claim) bool {
+	return !claim.IsRoot() && claim.ParentContractIndex >= 0 && claim.ParentContractIndex < g.claims.len()
+}
+
+func (g *gameState) getParent(claim Claim) *Claim {
+	if !g.hasParent(claim) {
 		return nil
 	}
-	parent := g.claims[claim.ParentContractIndex]
+	parent := g.claims.get(claim.ParentContractIndex)
 	return &parent
 }
@@ -73,6 +73,25 @@ func TestGame_Claims(t *testing.T) {
 	require.ElementsMatch(t, expected, actual)
 }
 
+func TestGame_ClaimsRequiringResponse(t *testing.T) {
+	root, top, middle, bottom := createTestClaims()
+	g := NewGameState([]Claim{root, top, middle, bottom}, testMaxDepth)
+
+	t.Run("DisagreeWithRoot", func(t *testing.T) {
+		// Root is countered by an honest claim so only middle, which disputes the honest top claim, needs a response.
+		require.Equal(t, []Claim{middle}, g.ClaimsRequiringResponse(false, map[int]bool{1: true}))
+		// Countering middle with an honest claim leaves nothing to respond to.
+		require.Empty(t, g.ClaimsRequiringResponse(false, map[int]bool{1: true, 3: true}))
+		// A dishonest counter to the root still requires a response to the root and no claims below it.
+		require.Equal(t, []Claim{root}, g.ClaimsRequiringResponse(false, map[int]bool{}))
+	})
+
+	t.Run("AgreeWithRoot", func(t *testing.T) {
+		require.Equal(t, []Claim{top}, g.ClaimsRequiringResponse(true, map[int]bool{0: true}))
+		require.Equal(t, []Claim{bottom}, g.ClaimsRequiringResponse(true, map[int]bool{0: true, 2: true}))
+	})
+}
+
 func TestGame_DefendsParent(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -147,3 +166,103 @@ func buildGameWithClaim(claimGIndex *big.Int, parentGIndex *big.Int) *gameState
 	}
 	return NewGameState([]Claim{parentClaim, claim}, testMaxDepth)
 }
+
+func TestNewValidatedGameState(t *testing.T) {
+	root, top, middle, bottom := createTestClaims()
+	claims := []Claim{root, top, middle, bottom}
+
+	t.Run("Valid", func(t *testing.T) {
+		g, err := NewValidatedGameState(claims, testMaxDepth)
+		require.NoError(t, err)
+		require.Equal(t, claims, g.Claims())
+	})
+
+	t.Run("ClaimDeeperThanMaxDepth", func(t *testing.T) {
+		_, err := NewValidatedGameState(claims, testMaxDepth-1)
+		require.ErrorIs(t, err, ErrPositionDepthTooLarge)
+	})
+
+	t.Run("MaxDepthTooLarge", func(t *testing.T) {
+		_, err := NewValidatedGameState(claims, MaxGameDepthLimit+1)
+		require.ErrorIs(t, err, ErrInvalidGameDepth)
+	})
+
+	t.Run("NotMoveAgainstParent", func(t *testing.T) {
+		invalid := bottom
+		invalid.ParentContractIndex = top.ContractIndex
+		_, err := NewValidatedGameState([]Claim{root, top, middle, invalid}, testMaxDepth)
+		require.ErrorIs(t, err, ErrInvalidClaim)
+	})
+}
+
+func TestClaimsNotShared(t *testing.T) {
+	root, top, middle, bottom := createTestClaims()
+	g := NewGameState([]Claim{root, top, middle}, testMaxDepth)
+	claims := g.Claims()
+	claims[0] = bottom
+	require.Equal(t, []Claim{root, top, middle}, g.Claims())
+
+	require.NoError(t, g.AddClaim(bottom))
+	require.Equal(t, []Claim{root, top, middle, bottom}, g.Claims())
+}
+
+func TestValidateSplitDepth(t *testing.T) {
+	require.NoError(t, ValidateSplitDepth(73, 30))
+	require.NoError(t, ValidateSplitDepth(MaxGameDepthLimit, 0))
+	require.ErrorIs(t, ValidateSplitDepth(30, 30), ErrInvalidSplitDepth)
+	require.ErrorIs(t, ValidateSplitDepth(30, 31), ErrInvalidSplitDepth)
+	require.ErrorIs(t, ValidateSplitDepth(MaxGameDepthLimit+1, 30), ErrInvalidGameDepth)
+}
+
+func TestAddClaim(t *testing.T) {
+	root, top, middle, bottom := createTestClaims()
+
+	t.Run("ValidClaims", func(t *testing.T) {
+		g := NewGameState(nil, testMaxDepth)
+		for _, claim := range []Claim{root, top, middle, bottom} {
+			require.NoError(t, g.AddClaim(claim))
+		}
+		require.Equal(t, []Claim{root, top, middle, bottom}, g.Claims())
+		require.True(t, g.IsDuplicate(bottom))
+		require.True(t, g.DefendsParent(middle))
+		parent, err := g.GetParent(bottom)
+		require.NoError(t, err)
+		require.Equal(t, middle, parent)
+	})
+
+	withIndices := func(claim Claim, contractIndex int, parentContractIndex int) Claim {
+		claim.ContractIndex = contractIndex
+		claim.ParentContractIndex = parentContractIndex
+		return claim
+	}
+	withPosition := func(claim Claim, pos Position) Claim {
+		claim.Position = pos
+		return claim
+	}
+	duplicate := top
+	duplicate.ContractIndex = 2
+	tests := []struct {
+		name   string
+		claims []Claim
+		claim  Claim
+	}{
+		{name: "SecondRoot", claims: []Claim{root}, claim: withIndices(root, 1, 0)},
+		{name: "FirstClaimNotRoot", claim: withIndices(top, 0, 0)},
+		{name: "WrongContractIndex", claims: []Claim{root, top}, claim: withIndices(middle, 3, 1)},
+		{name: "UnknownParent", claims: []Claim{root, top}, claim: withIndices(middle, 2, 2)},
+		{name: "NotChildOfParent", claims: []Claim{root, top}, claim: withIndices(middle, 2, 0)},
+		{name: "RightChildOfParent", claims: []Claim{root, top}, claim: withPosition(middle, NewPosition(2, big.NewInt(1)))},
+		{name: "DefendRoot", claims: []Claim{root}, claim: withPosition(top, NewPosition(1, big.NewInt(1)))},
+		{name: "BeyondMaxDepth", claims: []Claim{root, top, middle, bottom}, claim: withPosition(withIndices(bottom, 4, 3), bottom.Position.Attack())},
+		{name: "Duplicate", claims: []Claim{root, top}, claim: duplicate},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGameState(test.claims, testMaxDepth)
+			err := g.AddClaim(test.claim)
+			require.ErrorIs(t, err, ErrInvalidClaim)
+			require.Len(t, g.Claims(), len(test.claims), "invalid claim must not be added")
+		})
+	}
+}
@@ -1,15 +1,22 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
 )
 
 var (
 	ErrPositionDepthTooSmall = errors.New("position depth is too small")
+	ErrPositionDepthTooLarge = errors.New("position depth is too large")
+	ErrInvalidIndexAtDepth   = errors.New("index at depth is out of range")
+	ErrInvalidGIndex         = errors.New("invalid gindex")
+	ErrNotDescendant         = errors.New("position is not a descendant")
 )
 
 // Position is a golang wrapper around the dispute game Position type.
@@ -50,11 +57,100 @@ func (p Position) RelativeToAncestorAtDepth(ancestor uint64) (Position, error) {
 		return Position{}, ErrPositionDepthTooSmall
 	}
 	newPosDepth := uint64(p.depth) - ancestor
-	nodesAtDepth := 1 << newPosDepth
-	newIndexAtDepth := new(big.Int).Mod(p.indexAtDepth, big.NewInt(int64(nodesAtDepth)))
+	nodesAtDepth := new(big.Int).Lsh(big.NewInt(1), uint(newPosDepth))
+	newIndexAtDepth := new(big.Int).Mod(p.IndexAtDepth(), nodesAtDepth)
 	return NewPosition(int(newPosDepth), newIndexAtDepth), nil
 }
 
+// AncestorAtDepth returns the position at depth on the path from p to the root.
+// Returns p if depth is the depth of p.
+func (p Position) AncestorAtDepth(depth int) (Position, error) {
+	if depth < 0 {
+		return Position{}, fmt.Errorf("%w: ancestor depth %v", ErrPositionDepthTooSmall, depth)
+	}
+	if depth > p.depth {
+		return Position{}, fmt.Errorf("%w: ancestor depth %v, position depth %v", ErrPositionDepthTooLarge, depth, p.depth)
+	}
+	return p.ancestorAtDepth(depth), nil
+}
+
+func (p Position) ancestorAtDepth(depth int) Position {
+	return NewPosition(depth, new(big.Int).Rsh(p.IndexAtDepth(), uint(p.depth-depth)))
+}
+
+// PathToRoot returns an iterator over the positions from p up to and including the root.
+func (p Position) PathToRoot() *PathIterator {
+	return &PathIterator{
+		current: p,
+		target:  NewPosition(0, big.NewInt(0)),
+	}
+}
+
+// PathToTraceIndex returns an iterator over the positions from p down to and including the leaf at traceIdx
+// in a game with the specified max depth.
+// Returns ErrNotDescendant if the leaf is not in the subtree rooted at p.
+func (p Position) PathToTraceIndex(maxDepth int, traceIdx *big.Int) (*PathIterator, error) {
+	if maxDepth < p.depth {
+		return nil, fmt.Errorf("%w: depth %v, max depth %v", ErrPositionDepthTooLarge, p.depth, maxDepth)
+	}
+	leaf := NewPosition(maxDepth, traceIdx)
+	if err := leaf.Validate(uint64(maxDepth)); err != nil {
+		return nil, err
+	}
+	if leaf.ancestorAtDepth(p.depth).IndexAtDepth().Cmp(p.IndexAtDepth()) != 0 {
+		return nil, fmt.Errorf("%w: trace index %v of %v", ErrNotDescendant, traceIdx, p)
+	}
+	return &PathIterator{
+		current: p,
+		target:  leaf,
+	}, nil
+}
+
+// PathIterator iterates over the positions on the path between a position and one of its ancestors or descendants.
+// Both ends of the path are included.
+type PathIterator struct {
+	current Position
+	target  Position
+	started bool
+}
+
+// Next advances to the next position on the path, returning false when the path has been exhausted.
+// Next must be called before the first position is available.
+func (i *PathIterator) Next() bool {
+	if !i.started {
+		i.started = true
+		return true
+	}
+	switch {
+	case i.current.depth > i.target.depth:
+		i.current = i.current.parent()
+	case i.current.depth < i.target.depth:
+		i.current = i.target.ancestorAtDepth(i.current.depth + 1)
+	default:
+		return false
+	}
+	return true
+}
+
+// Position returns the current position on the path.
+func (i *PathIterator) Position() Position {
+	return i.current
+}
+
+// Validate returns an error if the position cannot exist in a game with the specified max depth.
+// Positions deeper than maxDepth, or with an index that does not fit at their depth, would produce
+// gindices the contracts reject.
+func (p Position) Validate(maxDepth uint64) error {
+	if p.depth < 0 || uint64(p.depth) > maxDepth {
+		return fmt.Errorf("%w: depth %v, max depth %v", ErrPositionDepthTooLarge, p.depth, maxDepth)
+	}
+	idx := p.IndexAtDepth()
+	if idx.Sign() < 0 || idx.BitLen() > p.depth {
+		return fmt.Errorf("%w: index %v at depth %v", ErrInvalidIndexAtDepth, idx, p.depth)
+	}
+	return nil
+}
+
 func (p Position) Depth() int {
 	return p.depth
 }
@@ -127,14 +223,79 @@ func (p Position) Defend() Position {
 	return p.parent().move(true).move(false)
 }
 
-func (p Position) Print(maxDepth int) {
-	fmt.Printf("GIN: %4b\tTrace Position is %4b\tTrace Depth is: %d\tTrace Index is: %d\n", p.ToGIndex(), p.indexAtDepth, p.depth, p.TraceIndex(maxDepth))
+// MoveTo returns the position of the honest response to a claim at p, given whether the claim agrees with the
+// honest trace. Claims that agree are defended and claims that disagree are attacked.
+// Returns ErrNoMovePossible if the claim agrees and is at the root position, as the root claim can't be defended.
+func (p Position) MoveTo(agree bool) (Position, error) {
+	if !agree {
+		return p.Attack(), nil
+	}
+	if p.IsRootPosition() {
+		return Position{}, fmt.Errorf("%w: cannot defend the root claim", ErrNoMovePossible)
+	}
+	return p.Defend(), nil
+}
+
+// Log writes the position to logger at debug level, including its trace index in a game with the specified max depth.
+func (p Position) Log(logger log.Logger, maxDepth int) {
+	logger.Debug("Position", "gindex", p.ToGIndex(), "depth", p.depth, "index_at_depth", p.IndexAtDepth(), "trace_index", p.TraceIndex(maxDepth))
 }
 
 func (p Position) ToGIndex() *big.Int {
 	return new(big.Int).Or(new(big.Int).Lsh(big.NewInt(1), uint(p.depth)), p.IndexAtDepth())
 }
 
+// MarshalText encodes the position as its gindex in hex, matching the encoding used by the contracts.
+func (p Position) MarshalText() ([]byte, error) {
+	return []byte(hexutil.EncodeBig(p.ToGIndex())), nil
+}
+
+// UnmarshalText decodes a position from its gindex in hex.
+func (p *Position) UnmarshalText(text []byte) error {
+	var gindex hexutil.Big
+	if err := gindex.UnmarshalText(text); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidGIndex, err)
+	}
+	if gindex.ToInt().Sign() <= 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidGIndex, string(text))
+	}
+	*p = NewPositionFromGIndex(gindex.ToInt())
+	return nil
+}
+
+type positionJSON struct {
+	Depth        int          `json:"depth"`
+	IndexAtDepth *hexutil.Big `json:"indexAtDepth"`
+}
+
+// MarshalJSON encodes the position as its depth and index at depth, which is easier to read than the gindex.
+func (p Position) MarshalJSON() ([]byte, error) {
+	return json.Marshal(positionJSON{
+		Depth:        p.depth,
+		IndexAtDepth: (*hexutil.Big)(p.IndexAtDepth()),
+	})
+}
+
+func (p *Position) UnmarshalJSON(data []byte) error {
+	var dec positionJSON
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if dec.Depth < 0 {
+		return fmt.Errorf("%w: %v", ErrPositionDepthTooSmall, dec.Depth)
+	}
+	if dec.IndexAtDepth == nil {
+		return fmt.Errorf("%w: missing index at depth", ErrInvalidIndexAtDepth)
+	}
+	idx := dec.IndexAtDepth.ToInt()
+	if idx.Sign() < 0 || idx.BitLen() > dec.Depth {
+		return fmt.Errorf("%w: index %v at depth %v", ErrInvalidIndexAtDepth, idx, dec.Depth)
+	}
+	// Copy the index to normalise the internal representation of zero so decoded positions are comparable
+	*p = NewPosition(dec.Depth, new(big.Int).Set(idx))
+	return nil
+}
+
 // bigMSB returns the index of the most significant bit
 func bigMSB(x *big.Int) int {
 	if x.Cmp(big.NewInt(0)) == 0 {
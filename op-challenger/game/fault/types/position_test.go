@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -139,6 +140,32 @@ func TestDefend(t *testing.T) {
 	}
 }
 
+func TestMoveTo(t *testing.T) {
+	for _, test := range treeNodes {
+		pos := NewPosition(test.Depth, test.IndexAtDepth)
+		if test.AttackGIndex != nil && test.AttackGIndex.Cmp(big.NewInt(0)) != 0 {
+			result, err := pos.MoveTo(false)
+			require.NoError(t, err)
+			require.Equalf(t, pos.Attack(), result, "Attack from GIndex %v", pos.ToGIndex())
+		}
+		if test.DefendGIndex != nil && test.DefendGIndex.Cmp(big.NewInt(0)) != 0 {
+			result, err := pos.MoveTo(true)
+			require.NoError(t, err)
+			require.Equalf(t, test.DefendGIndex, result.ToGIndex(), "Defend from GIndex %v", pos.ToGIndex())
+		}
+	}
+
+	t.Run("CannotDefendRoot", func(t *testing.T) {
+		root := NewPosition(0, big.NewInt(0))
+		_, err := root.MoveTo(true)
+		require.ErrorIs(t, err, ErrNoMovePossible)
+
+		result, err := root.MoveTo(false)
+		require.NoError(t, err)
+		require.Equal(t, root.Attack(), result)
+	})
+}
+
 func TestRelativeToAncestorAtDepth(t *testing.T) {
 	t.Run("ErrorsForDeepAncestor", func(t *testing.T) {
 		pos := NewPosition(1, big.NewInt(1))
@@ -146,6 +173,14 @@ func TestRelativeToAncestorAtDepth(t *testing.T) {
 		require.ErrorIs(t, err, ErrPositionDepthTooSmall)
 	})
 
+	t.Run("LargeRelativeDepth", func(t *testing.T) {
+		index := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 100), big.NewInt(1))
+		pos := NewPosition(101, index)
+		relative, err := pos.RelativeToAncestorAtDepth(1)
+		require.NoError(t, err)
+		require.Equal(t, NewPosition(100, index), relative)
+	})
+
 	tests := []struct {
 		gindex         int64
 		newRootDepth   uint64
@@ -249,3 +284,179 @@ func TestRelativeMoves(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		pos      Position
+		maxDepth uint64
+		err      error
+	}{
+		{name: "Root", pos: NewPosition(0, bi(0)), maxDepth: 0},
+		{name: "AtMaxDepth", pos: NewPosition(5, bi(31)), maxDepth: 5},
+		{name: "NonPowerOfTwoMaxDepth", pos: NewPosition(73, new(big.Int).Lsh(bi(1), 72)), maxDepth: 73},
+		{name: "TooDeep", pos: NewPosition(6, bi(0)), maxDepth: 5, err: ErrPositionDepthTooLarge},
+		{name: "IndexTooLarge", pos: NewPosition(5, bi(32)), maxDepth: 5, err: ErrInvalidIndexAtDepth},
+		{name: "NegativeIndex", pos: NewPosition(5, bi(-1)), maxDepth: 5, err: ErrInvalidIndexAtDepth},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.pos.Validate(test.maxDepth)
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestPositionMarshalText(t *testing.T) {
+	tests := []struct {
+		pos      Position
+		expected string
+	}{
+		{NewPosition(0, bi(0)), "0x1"},
+		{NewPosition(1, bi(1)), "0x3"},
+		{NewPosition(4, bi(5)), "0x15"},
+		{NewPosition(MaxGameDepthLimit, new(big.Int).Lsh(bi(1), 100)), "0x40000010000000000000000000000000"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.expected, func(t *testing.T) {
+			text, err := test.pos.MarshalText()
+			require.NoError(t, err)
+			require.Equal(t, test.expected, string(text))
+
+			var actual Position
+			require.NoError(t, actual.UnmarshalText(text))
+			require.Equal(t, test.pos.Depth(), actual.Depth())
+			require.Zero(t, test.pos.IndexAtDepth().Cmp(actual.IndexAtDepth()))
+		})
+	}
+
+	for _, invalid := range []string{"0x0", "0x", "12", "-0x1"} {
+		invalid := invalid
+		t.Run("Invalid-"+invalid, func(t *testing.T) {
+			var pos Position
+			require.ErrorIs(t, pos.UnmarshalText([]byte(invalid)), ErrInvalidGIndex)
+		})
+	}
+}
+
+func TestPositionMarshalJSON(t *testing.T) {
+	pos := NewPosition(4, bi(5))
+	data, err := json.Marshal(pos)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"depth":4,"indexAtDepth":"0x5"}`, string(data))
+
+	var actual Position
+	require.NoError(t, json.Unmarshal(data, &actual))
+	require.Equal(t, pos, actual)
+
+	t.Run("ZeroValue", func(t *testing.T) {
+		data, err := json.Marshal(Position{})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"depth":0,"indexAtDepth":"0x0"}`, string(data))
+	})
+
+	tests := []struct {
+		name     string
+		json     string
+		expected error
+	}{
+		{"NegativeDepth", `{"depth":-1,"indexAtDepth":"0x0"}`, ErrPositionDepthTooSmall},
+		{"MissingIndex", `{"depth":1}`, ErrInvalidIndexAtDepth},
+		{"IndexTooLarge", `{"depth":2,"indexAtDepth":"0x4"}`, ErrInvalidIndexAtDepth},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var pos Position
+			require.ErrorIs(t, json.Unmarshal([]byte(test.json), &pos), test.expected)
+		})
+	}
+}
+
+func TestAncestorAtDepth(t *testing.T) {
+	pos := NewPosition(4, bi(13))
+	tests := []struct {
+		depth    int
+		expected Position
+	}{
+		{4, NewPosition(4, bi(13))},
+		{3, NewPosition(3, bi(6))},
+		{2, NewPosition(2, bi(3))},
+		{1, NewPosition(1, bi(1))},
+		{0, NewPosition(0, bi(0))},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(fmt.Sprintf("Depth-%v", test.depth), func(t *testing.T) {
+			actual, err := pos.AncestorAtDepth(test.depth)
+			require.NoError(t, err)
+			require.Equal(t, test.expected.ToGIndex(), actual.ToGIndex())
+		})
+	}
+
+	t.Run("TooDeep", func(t *testing.T) {
+		_, err := pos.AncestorAtDepth(5)
+		require.ErrorIs(t, err, ErrPositionDepthTooLarge)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		_, err := pos.AncestorAtDepth(-1)
+		require.ErrorIs(t, err, ErrPositionDepthTooSmall)
+	})
+}
+
+func collectPath(iter *PathIterator) []*big.Int {
+	var gindices []*big.Int
+	for iter.Next() {
+		gindices = append(gindices, iter.Position().ToGIndex())
+	}
+	return gindices
+}
+
+func TestPathToRoot(t *testing.T) {
+	t.Run("Root", func(t *testing.T) {
+		require.Equal(t, []*big.Int{bi(1)}, collectPath(NewPosition(0, bi(0)).PathToRoot()))
+	})
+
+	t.Run("Leaf", func(t *testing.T) {
+		actual := collectPath(NewPositionFromGIndex(bi(29)).PathToRoot())
+		require.Equal(t, []*big.Int{bi(29), bi(14), bi(7), bi(3), bi(1)}, actual)
+	})
+}
+
+func TestPathToTraceIndex(t *testing.T) {
+	t.Run("FromRoot", func(t *testing.T) {
+		iter, err := NewPosition(0, bi(0)).PathToTraceIndex(4, bi(13))
+		require.NoError(t, err)
+		require.Equal(t, []*big.Int{bi(1), bi(3), bi(7), bi(14), bi(29)}, collectPath(iter))
+	})
+
+	t.Run("FromLeaf", func(t *testing.T) {
+		iter, err := NewPosition(4, bi(13)).PathToTraceIndex(4, bi(13))
+		require.NoError(t, err)
+		require.Equal(t, []*big.Int{bi(29)}, collectPath(iter))
+	})
+
+	t.Run("FromIntermediate", func(t *testing.T) {
+		iter, err := NewPosition(2, bi(1)).PathToTraceIndex(4, bi(6))
+		require.NoError(t, err)
+		require.Equal(t, []*big.Int{bi(5), bi(11), bi(22)}, collectPath(iter))
+	})
+
+	t.Run("NotDescendant", func(t *testing.T) {
+		_, err := NewPosition(2, bi(1)).PathToTraceIndex(4, bi(8))
+		require.ErrorIs(t, err, ErrNotDescendant)
+	})
+
+	t.Run("PositionBelowMaxDepth", func(t *testing.T) {
+		_, err := NewPosition(5, bi(0)).PathToTraceIndex(4, bi(0))
+		require.ErrorIs(t, err, ErrPositionDepthTooLarge)
+	})
+
+	t.Run("TraceIndexOutOfRange", func(t *testing.T) {
+		_, err := NewPosition(0, bi(0)).PathToTraceIndex(4, bi(16))
+		require.ErrorIs(t, err, ErrInvalidIndexAtDepth)
+	})
+}
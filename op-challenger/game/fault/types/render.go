@@ -0,0 +1,156 @@
+package types
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RenderFormat is a format the claim tree of a game can be rendered in.
+type RenderFormat string
+
+const (
+	// RenderFormatDOT renders the claim tree as a Graphviz digraph.
+	RenderFormatDOT RenderFormat = "dot"
+	// RenderFormatASCII renders the claim tree as indented text.
+	RenderFormatASCII RenderFormat = "ascii"
+)
+
+var RenderFormats = []RenderFormat{RenderFormatDOT, RenderFormatASCII}
+
+func (f RenderFormat) String() string {
+	return string(f)
+}
+
+func (f *RenderFormat) Set(value string) error {
+	if !ValidRenderFormat(RenderFormat(value)) {
+		return fmt.Errorf("unknown render format: %q", value)
+	}
+	*f = RenderFormat(value)
+	return nil
+}
+
+func ValidRenderFormat(value RenderFormat) bool {
+	for _, f := range RenderFormats {
+		if f == value {
+			return true
+		}
+	}
+	return false
+}
+
+// assessmentColors are the DOT colors used for each assessment. Claims without an assessment are black.
+var assessmentColors = map[Assessment]string{
+	AssessmentAgree:     "darkgreen",
+	AssessmentDisagree:  "red",
+	AssessmentCountered: "gray",
+}
+
+// Render writes the claim tree of the game to w in the specified format.
+// assessments are indexed by contract index, as returned by the solver, and may be nil if the claims were not assessed.
+func (g *gameState) Render(w io.Writer, format RenderFormat, assessments []Assessment) error {
+	switch format {
+	case RenderFormatDOT:
+		return g.WriteDOT(w, assessments)
+	case RenderFormatASCII:
+		return g.WriteASCII(w, assessments)
+	default:
+		return fmt.Errorf("unknown render format: %q", format)
+	}
+}
+
+// WriteDOT writes the claim tree of the game to w as a Graphviz digraph. Each claim is a node, with an edge to the
+// claim it counters labelled with the type of move. Nodes are colored by assessment and countered claims are dashed.
+func (g *gameState) WriteDOT(w io.Writer, assessments []Assessment) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "digraph game {")
+	fmt.Fprintln(out, "  rankdir=TB;")
+	fmt.Fprintln(out, `  node [shape=box, fontname="monospace"];`)
+	claims := g.claims.all()
+	for _, claim := range claims {
+		// Labels are built by hand rather than with %q so the \n line breaks are left for Graphviz to interpret.
+		label := fmt.Sprintf("#%v depth=%v index=%v\\n%v", claim.ContractIndex, claim.Depth(), claim.IndexAtDepth(), claim.Value)
+		var color string
+		if assessment := assessmentOf(assessments, claim); assessment != "" {
+			label += "\\n" + string(assessment)
+			color = "color=" + assessmentColors[assessment]
+		}
+		attrs := []string{fmt.Sprintf(`label="%v"`, label)}
+		if color != "" {
+			attrs = append(attrs, color)
+		}
+		if claim.Countered {
+			attrs = append(attrs, "style=dashed")
+		}
+		fmt.Fprintf(out, "  c%v [%v];\n", claim.ContractIndex, strings.Join(attrs, ", "))
+	}
+	for _, claim := range claims {
+		if !g.hasParent(claim) {
+			continue
+		}
+		fmt.Fprintf(out, "  c%v -> c%v [label=%q];\n", claim.ContractIndex, claim.ParentContractIndex, g.moveType(claim))
+	}
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+// WriteASCII writes the claim tree of the game to w as indented text, with each claim listed under the claim it
+// counters in contract order.
+func (g *gameState) WriteASCII(w io.Writer, assessments []Assessment) error {
+	claims := g.claims.all()
+	children := make(map[int][]Claim)
+	var roots []Claim
+	for _, claim := range claims {
+		if g.hasParent(claim) {
+			children[claim.ParentContractIndex] = append(children[claim.ParentContractIndex], claim)
+		} else {
+			roots = append(roots, claim)
+		}
+	}
+	out := bufio.NewWriter(w)
+	var writeClaim func(claim Claim, prefix string, connector string, childPrefix string)
+	writeClaim = func(claim Claim, prefix string, connector string, childPrefix string) {
+		line := fmt.Sprintf("#%v %v depth=%v index=%v %v", claim.ContractIndex, g.moveType(claim), claim.Depth(), claim.IndexAtDepth(), claim.Value)
+		if assessment := assessmentOf(assessments, claim); assessment != "" {
+			line += fmt.Sprintf(" [%v]", assessment)
+		}
+		if claim.Countered {
+			line += " (countered)"
+		}
+		fmt.Fprintf(out, "%v%v%v\n", prefix, connector, line)
+		next := children[claim.ContractIndex]
+		for i, child := range next {
+			if i == len(next)-1 {
+				writeClaim(child, prefix+childPrefix, "└── ", "    ")
+			} else {
+				writeClaim(child, prefix+childPrefix, "├── ", "│   ")
+			}
+		}
+	}
+	for _, root := range roots {
+		writeClaim(root, "", "", "")
+	}
+	return out.Flush()
+}
+
+// moveType describes how claim counters its parent.
+func (g *gameState) moveType(claim Claim) string {
+	switch {
+	case claim.IsRoot():
+		return "root"
+	case !g.hasParent(claim):
+		return "orphan"
+	case g.DefendsParent(claim):
+		return "defend"
+	default:
+		return "attack"
+	}
+}
+
+func assessmentOf(assessments []Assessment, claim Claim) Assessment {
+	if claim.ContractIndex < 0 || claim.ContractIndex >= len(assessments) {
+		return ""
+	}
+	return assessments[claim.ContractIndex]
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func createRenderTestGame() *gameState {
+	root, top, middle, bottom := createTestClaims()
+	alt := Claim{
+		ClaimData: ClaimData{
+			Value:    common.HexToHash("0x99"),
+			Position: NewPosition(1, common.Big0),
+		},
+		Countered:           true,
+		ContractIndex:       4,
+		ParentContractIndex: 0,
+	}
+	return NewGameState([]Claim{root, top, middle, bottom, alt}, testMaxDepth)
+}
+
+var renderTestAssessments = []Assessment{
+	AssessmentDisagree,
+	AssessmentAgree,
+	AssessmentDisagree,
+	AssessmentAgree,
+	AssessmentCountered,
+}
+
+func TestWriteASCII(t *testing.T) {
+	g := createRenderTestGame()
+	var out bytes.Buffer
+	require.NoError(t, g.WriteASCII(&out, renderTestAssessments))
+	expected := strings.Join([]string{
+		"#0 root depth=0 index=0 0x000000000000000000000000000000000000000000000000000000000000077a [disagree]",
+		"├── #1 attack depth=1 index=0 0x0000000000000000000000000000000000000000000000000000000000000364 [agree]",
+		"│   └── #2 defend depth=2 index=2 0x0000000000000000000000000000000000000000000000000000000000000578 [disagree]",
+		"│       └── #3 attack depth=3 index=4 0x0000000000000000000000000000000000000000000000000000000000000465 [agree]",
+		"└── #4 attack depth=1 index=0 0x0000000000000000000000000000000000000000000000000000000000000099 [countered] (countered)",
+		"",
+	}, "\n")
+	require.Equal(t, expected, out.String())
+}
+
+func TestWriteASCII_NoAssessments(t *testing.T) {
+	g := createRenderTestGame()
+	var out bytes.Buffer
+	require.NoError(t, g.WriteASCII(&out, nil))
+	require.NotContains(t, out.String(), "[")
+	require.Equal(t, 5, strings.Count(out.String(), "\n"))
+}
+
+func TestWriteDOT(t *testing.T) {
+	g := createRenderTestGame()
+	var out bytes.Buffer
+	require.NoError(t, g.WriteDOT(&out, renderTestAssessments))
+	dot := out.String()
+	require.True(t, strings.HasPrefix(dot, "digraph game {\n"))
+	require.True(t, strings.HasSuffix(dot, "}\n"))
+	require.Contains(t, dot, `c0 [label="#0 depth=0 index=0\n0x000000000000000000000000000000000000000000000000000000000000077a\ndisagree", color=red];`)
+	require.Contains(t, dot, `c1 [label="#1 depth=1 index=0\n0x0000000000000000000000000000000000000000000000000000000000000364\nagree", color=darkgreen];`)
+	require.Contains(t, dot, `c4 [label="#4 depth=1 index=0\n0x0000000000000000000000000000000000000000000000000000000000000099\ncountered", color=gray, style=dashed];`)
+	require.Contains(t, dot, `c1 -> c0 [label="attack"];`)
+	require.Contains(t, dot, `c2 -> c1 [label="defend"];`)
+	require.Contains(t, dot, `c3 -> c2 [label="attack"];`)
+	require.Contains(t, dot, `c4 -> c0 [label="attack"];`)
+	require.NotContains(t, dot, "c0 ->")
+}
+
+func TestWriteDOT_NoAssessments(t *testing.T) {
+	g := createRenderTestGame()
+	var out bytes.Buffer
+	require.NoError(t, g.WriteDOT(&out, nil))
+	require.Contains(t, out.String(), `c1 [label="#1 depth=1 index=0\n0x0000000000000000000000000000000000000000000000000000000000000364"];`)
+	require.NotContains(t, out.String(), "color=")
+}
+
+func TestRender(t *testing.T) {
+	g := createRenderTestGame()
+	for _, format := range RenderFormats {
+		format := format
+		t.Run(format.String(), func(t *testing.T) {
+			var expected, actual bytes.Buffer
+			if format == RenderFormatDOT {
+				require.NoError(t, g.WriteDOT(&expected, renderTestAssessments))
+			} else {
+				require.NoError(t, g.WriteASCII(&expected, renderTestAssessments))
+			}
+			require.NoError(t, g.Render(&actual, format, renderTestAssessments))
+			require.Equal(t, expected.String(), actual.String())
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		require.ErrorContains(t, g.Render(&bytes.Buffer{}, "svg", nil), "unknown render format")
+	})
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"math/big"
 
@@ -9,7 +10,14 @@ import (
 )
 
 var (
-	ErrGameDepthReached = errors.New("game depth reached")
+	// ErrGameDepthExceeded is returned when a move would create a claim below the max depth of the game.
+	ErrGameDepthExceeded = errors.New("game depth exceeded")
+	// ErrClaimAlreadyCountered is returned when attempting to respond to a claim that has already been countered.
+	ErrClaimAlreadyCountered = errors.New("claim already countered")
+	// ErrNoMovePossible is returned when no valid move can be made against a claim.
+	ErrNoMovePossible = errors.New("no move possible")
+	// ErrOracleDataRequired is returned when a step requires preimage oracle data that is not available.
+	ErrOracleDataRequired = errors.New("oracle data required")
 
 	// NoLocalContext is the LocalContext value used when the cannon trace provider is used alone instead of as part
 	// of a split game.
@@ -65,18 +73,46 @@ type TraceAccessor interface {
 	GetStepData(ctx context.Context, game Game, ref Claim, pos Position) (prestate []byte, proofData []byte, preimageData *PreimageOracleData, err error)
 }
 
+// PrefetchRequest identifies a position whose value will be required, evaluated in the context of the Ref claim.
+type PrefetchRequest struct {
+	Ref Claim
+	Pos Position
+}
+
+// TracePrefetcher is an optional interface for a TraceAccessor that can prepare the values for many positions
+// more efficiently than by requesting each position individually.
+type TracePrefetcher interface {
+	// Prefetch prepares the values for the requested positions so that subsequent calls to Get are cheap.
+	Prefetch(ctx context.Context, game Game, requests []PrefetchRequest) error
+}
+
+// PrefetchingTraceProvider is an optional interface for a TraceProvider that can compute the values for many
+// positions in a single pass, for example by executing cannon once to generate proofs for multiple trace indices.
+type PrefetchingTraceProvider interface {
+	// Prefetch prepares the values for the specified positions so that subsequent calls to Get are cheap.
+	Prefetch(ctx context.Context, positions []Position) error
+}
+
 // PrestateProvider defines an interface to request the absolute prestate.
 type PrestateProvider interface {
 	// AbsolutePreStateCommitment is the commitment of the pre-image value of the trace that transitions to the trace value at index 0
 	AbsolutePreStateCommitment(ctx context.Context) (hash common.Hash, err error)
 }
 
+// ClaimCodec defines how the claim values of a trace commit to its states.
+// Claim values are opaque to the solver, which only compares them, so games using a new commitment scheme only
+// require a new codec and trace provider.
+type ClaimCodec interface {
+	// Commitment returns the claim value committing to the encoded state.
+	Commitment(state []byte) (common.Hash, error)
+}
+
 // TraceProvider is a generic way to get a claim value at a specific step in the trace.
 type TraceProvider interface {
 	PrestateProvider
 
 	// Get returns the claim value at the requested index.
-	// Get(i) = Keccak256(GetPreimage(i))
+	// The claim value is the commitment to the state at the index, as defined by the [ClaimCodec] for the trace.
 	Get(ctx context.Context, i Position) (common.Hash, error)
 
 	// GetStepData returns the data required to execute the step at the specified trace index.
@@ -92,6 +128,30 @@ type ClaimData struct {
 	Position
 }
 
+type claimDataJSON struct {
+	Value    common.Hash `json:"value"`
+	Position Position    `json:"position"`
+}
+
+// MarshalJSON encodes the claim data. It is required as the embedded Position's MarshalJSON would otherwise be
+// promoted and only the position encoded.
+func (c ClaimData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(claimDataJSON{
+		Value:    c.Value,
+		Position: c.Position,
+	})
+}
+
+func (c *ClaimData) UnmarshalJSON(data []byte) error {
+	var dec claimDataJSON
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	c.Value = dec.Value
+	c.Position = dec.Position
+	return nil
+}
+
 func (c *ClaimData) ValueBytes() [32]byte {
 	responseBytes := c.Value.Bytes()
 	var responseArr [32]byte
@@ -107,17 +167,74 @@ type Claim struct {
 	ClaimData
 	// WARN: Countered is a mutable field in the FaultDisputeGame contract
 	//       and rely on it for determining whether to step on leaf claims.
-	//       When caching is implemented for the Challenger, this will need
-	//       to be changed/removed to avoid invalid/stale contract state.
+	//       Stored claims are only reused while Countered can't change, see
+	//       storedClaimLoader.
 	Countered bool
-	Clock     uint64
+	// Clock is the timestamp, in seconds, at which the claim was posted.
+	Clock uint64
+	// ClockDuration is the time, in seconds, used on the chess clock of the team that posted the claim up to the
+	// point it was posted.
+	ClockDuration uint64
 	// Location of the claim & it's parent inside the contract. Does not exist
 	// for claims that have not made it to the contract.
 	ContractIndex       int
 	ParentContractIndex int
 }
 
+type claimJSON struct {
+	Value               common.Hash `json:"value"`
+	Position            Position    `json:"position"`
+	Countered           bool        `json:"countered"`
+	Clock               uint64      `json:"clock"`
+	ClockDuration       uint64      `json:"clockDuration,omitempty"`
+	ContractIndex       int         `json:"contractIndex"`
+	ParentContractIndex int         `json:"parentContractIndex"`
+}
+
+func (c Claim) MarshalJSON() ([]byte, error) {
+	return json.Marshal(claimJSON{
+		Value:               c.Value,
+		Position:            c.Position,
+		Countered:           c.Countered,
+		Clock:               c.Clock,
+		ClockDuration:       c.ClockDuration,
+		ContractIndex:       c.ContractIndex,
+		ParentContractIndex: c.ParentContractIndex,
+	})
+}
+
+func (c *Claim) UnmarshalJSON(data []byte) error {
+	var dec claimJSON
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	*c = Claim{
+		ClaimData: ClaimData{
+			Value:    dec.Value,
+			Position: dec.Position,
+		},
+		Countered:           dec.Countered,
+		Clock:               dec.Clock,
+		ClockDuration:       dec.ClockDuration,
+		ContractIndex:       dec.ContractIndex,
+		ParentContractIndex: dec.ParentContractIndex,
+	}
+	return nil
+}
+
 // IsRoot returns true if this claim is the root claim.
 func (c *Claim) IsRoot() bool {
 	return c.Position.IsRootPosition()
 }
+
+// Assessment is the solver's view of a claim.
+type Assessment string
+
+const (
+	// AssessmentAgree marks claims on the solver's side of the game, which it does not respond to.
+	AssessmentAgree Assessment = "agree"
+	// AssessmentDisagree marks claims on the opposing side of the game, which the solver counters.
+	AssessmentDisagree Assessment = "disagree"
+	// AssessmentCountered marks claims that would be countered if the game were resolved without further moves.
+	AssessmentCountered Assessment = "countered"
+)
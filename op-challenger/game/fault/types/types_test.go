@@ -1,9 +1,11 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -54,3 +56,56 @@ func TestIsRootPosition(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimJSON(t *testing.T) {
+	claim := Claim{
+		ClaimData: ClaimData{
+			Value:    common.Hash{0xaa},
+			Position: NewPosition(2, big.NewInt(3)),
+		},
+		Countered:           true,
+		Clock:               1234,
+		ContractIndex:       5,
+		ParentContractIndex: 2,
+	}
+
+	t.Run("Claim", func(t *testing.T) {
+		data, err := json.Marshal(claim)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"value": "0xaa00000000000000000000000000000000000000000000000000000000000000",
+			"position": {"depth": 2, "indexAtDepth": "0x3"},
+			"countered": true,
+			"clock": 1234,
+			"contractIndex": 5,
+			"parentContractIndex": 2
+		}`, string(data))
+
+		var actual Claim
+		require.NoError(t, json.Unmarshal(data, &actual))
+		require.Equal(t, claim, actual)
+	})
+
+	t.Run("ClaimData", func(t *testing.T) {
+		data, err := json.Marshal(claim.ClaimData)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"value": "0xaa00000000000000000000000000000000000000000000000000000000000000",
+			"position": {"depth": 2, "indexAtDepth": "0x3"}
+		}`, string(data))
+
+		var actual ClaimData
+		require.NoError(t, json.Unmarshal(data, &actual))
+		require.Equal(t, claim.ClaimData, actual)
+	})
+
+	t.Run("Claims", func(t *testing.T) {
+		root, top, middle, bottom := createTestClaims()
+		claims := []Claim{root, top, middle, bottom, claim}
+		data, err := json.Marshal(claims)
+		require.NoError(t, err)
+		var actual []Claim
+		require.NoError(t, json.Unmarshal(data, &actual))
+		require.Equal(t, claims, actual)
+	})
+}
@@ -0,0 +1,158 @@
+package loader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// reorgDepth is the number of blocks before the previously loaded head that are scanned again on each update,
+	// so that games created in blocks that have since been reorged out are replaced.
+	reorgDepth = 64
+	// maxLogRange is the maximum number of blocks to request logs for in a single request.
+	maxLogRange = 5000
+)
+
+var ErrChainReorged = errors.New("L1 chain reorged while loading games")
+
+// GameCreatedLogs decodes the DisputeGameCreated events emitted by the dispute game factory.
+type GameCreatedLogs interface {
+	DisputeGameCreatedQuery(from uint64, to uint64) ethereum.FilterQuery
+	DecodeDisputeGameCreated(log *ethtypes.Log, timestamp uint64) (types.GameMetadata, error)
+}
+
+// LogSource provides access to L1 headers and logs.
+type LogSource interface {
+	HeaderByHash(ctx context.Context, hash common.Hash) (*ethtypes.Header, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]ethtypes.Log, error)
+}
+
+type createdGame struct {
+	block uint64
+	game  types.GameMetadata
+}
+
+// EventGameLoader discovers games from the DisputeGameCreated events emitted by the dispute game factory.
+// Games created since startBlock are loaded on the first update, then only new blocks and the most recent reorgDepth
+// blocks are scanned.
+type EventGameLoader struct {
+	factory    GameCreatedLogs
+	l1         LogSource
+	startBlock uint64
+
+	lock sync.Mutex
+	// nextBlock is the first block that has not yet been scanned for games.
+	nextBlock uint64
+	// games contains the games discovered so far in order of creation.
+	games []createdGame
+}
+
+// NewEventGameLoader creates a loader that discovers games created at or after startBlock.
+func NewEventGameLoader(factory GameCreatedLogs, l1 LogSource, startBlock uint64) *EventGameLoader {
+	return &EventGameLoader{
+		factory:    factory,
+		l1:         l1,
+		startBlock: startBlock,
+		nextBlock:  startBlock,
+	}
+}
+
+// FetchAllGamesAtBlock returns the games created at or before the specified block with a timestamp at or after
+// earliestTimestamp, newest first.
+func (l *EventGameLoader) FetchAllGamesAtBlock(ctx context.Context, earliestTimestamp uint64, blockHash common.Hash) (games []types.GameMetadata, err error) {
+	ctx, span := tracer.Start(ctx, "EventGameLoader.FetchAllGamesAtBlock", trace.WithAttributes(attribute.String("block_hash", blockHash.Hex())))
+	defer func() {
+		span.SetAttributes(attribute.Int("games", len(games)))
+		tracing.EndSpan(span, err)
+	}()
+	head, err := l.l1.HeaderByHash(ctx, blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header for block %v: %w", blockHash, err)
+	}
+	headNum := head.Number.Uint64()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	from := min(l.nextBlock, headNum+1)
+	if from >= l.startBlock+reorgDepth {
+		from -= reorgDepth
+	} else {
+		from = l.startBlock
+	}
+	created, err := l.loadCreatedGames(ctx, from, headNum)
+	if err != nil {
+		return nil, err
+	}
+	// Verify that the games were loaded from the chain the requested block is part of.
+	canonical, err := l.l1.HeaderByNumber(ctx, head.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header for block %v: %w", headNum, err)
+	}
+	if canonical.Hash() != blockHash {
+		return nil, fmt.Errorf("%w: block %v is no longer canonical", ErrChainReorged, blockHash)
+	}
+
+	// Replace all games from rescanned blocks and drop games that are now too old to be played.
+	l.games = slices.DeleteFunc(l.games, func(g createdGame) bool {
+		return g.block >= from || g.game.Timestamp < earliestTimestamp
+	})
+	l.games = append(l.games, created...)
+	l.nextBlock = headNum + 1
+
+	games = make([]types.GameMetadata, 0, len(l.games))
+	for i := len(l.games) - 1; i >= 0; i-- {
+		if l.games[i].game.Timestamp >= earliestTimestamp {
+			games = append(games, l.games[i].game)
+		}
+	}
+	return games, nil
+}
+
+// loadCreatedGames loads the games created between from and to inclusive, in order of creation.
+func (l *EventGameLoader) loadCreatedGames(ctx context.Context, from uint64, to uint64) ([]createdGame, error) {
+	var created []createdGame
+	blockTimes := make(map[uint64]uint64)
+	for start := from; start <= to; start += maxLogRange {
+		end := min(start+maxLogRange-1, to)
+		logs, err := l.l1.FilterLogs(ctx, l.factory.DisputeGameCreatedQuery(start, end))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch game creation logs from block %v to %v: %w", start, end, err)
+		}
+		for _, log := range logs {
+			if log.Removed {
+				continue
+			}
+			timestamp, ok := blockTimes[log.BlockNumber]
+			if !ok {
+				header, err := l.l1.HeaderByNumber(ctx, new(big.Int).SetUint64(log.BlockNumber))
+				if err != nil {
+					return nil, fmt.Errorf("failed to fetch header for block %v: %w", log.BlockNumber, err)
+				}
+				if header.Hash() != log.BlockHash {
+					return nil, fmt.Errorf("%w: game creation log from non-canonical block %v", ErrChainReorged, log.BlockHash)
+				}
+				timestamp = header.Time
+				blockTimes[log.BlockNumber] = timestamp
+			}
+			game, err := l.factory.DecodeDisputeGameCreated(&log, timestamp)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode game creation log in tx %v: %w", log.TxHash, err)
+			}
+			created = append(created, createdGame{block: log.BlockNumber, game: game})
+		}
+	}
+	return created, nil
+}
@@ -0,0 +1,212 @@
+package loader
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventGameLoader_Backfill(t *testing.T) {
+	chain := newStubChain(100)
+	chain.createGame(5, common.Address{0x05})
+	game20 := chain.createGame(20, common.Address{0x20})
+	game50 := chain.createGame(50, common.Address{0x50})
+	loader := NewEventGameLoader(&stubGameCreatedLogs{}, chain, 10)
+
+	games, err := loader.FetchAllGamesAtBlock(context.Background(), 0, chain.hash(100))
+	require.NoError(t, err)
+	require.Equal(t, []types.GameMetadata{game50, game20}, games)
+	require.Equal(t, []blockRange{{10, 100}}, chain.queries)
+}
+
+func TestEventGameLoader_SplitsLargeRanges(t *testing.T) {
+	chain := newStubChain(12000)
+	game := chain.createGame(11000, common.Address{0xaa})
+	loader := NewEventGameLoader(&stubGameCreatedLogs{}, chain, 0)
+
+	games, err := loader.FetchAllGamesAtBlock(context.Background(), 0, chain.hash(12000))
+	require.NoError(t, err)
+	require.Equal(t, []types.GameMetadata{game}, games)
+	require.Equal(t, []blockRange{{0, 4999}, {5000, 9999}, {10000, 12000}}, chain.queries)
+}
+
+func TestEventGameLoader_IncrementalUpdates(t *testing.T) {
+	chain := newStubChain(100)
+	game20 := chain.createGame(20, common.Address{0x20})
+	loader := NewEventGameLoader(&stubGameCreatedLogs{}, chain, 10)
+	_, err := loader.FetchAllGamesAtBlock(context.Background(), 0, chain.hash(100))
+	require.NoError(t, err)
+
+	chain.extend(200)
+	game150 := chain.createGame(150, common.Address{0x15})
+	chain.queries = nil
+	games, err := loader.FetchAllGamesAtBlock(context.Background(), 0, chain.hash(200))
+	require.NoError(t, err)
+	require.Equal(t, []types.GameMetadata{game150, game20}, games)
+	// Only new blocks and the most recent blocks that may have been reorged are scanned again.
+	require.Equal(t, []blockRange{{101 - reorgDepth, 200}}, chain.queries)
+}
+
+func TestEventGameLoader_ReplacesReorgedGames(t *testing.T) {
+	chain := newStubChain(200)
+	game20 := chain.createGame(20, common.Address{0x20})
+	chain.createGame(180, common.Address{0x18})
+	loader := NewEventGameLoader(&stubGameCreatedLogs{}, chain, 10)
+	_, err := loader.FetchAllGamesAtBlock(context.Background(), 0, chain.hash(200))
+	require.NoError(t, err)
+
+	chain.reorg(170, 205)
+	replacement := chain.createGame(190, common.Address{0x19})
+	games, err := loader.FetchAllGamesAtBlock(context.Background(), 0, chain.hash(205))
+	require.NoError(t, err)
+	require.Equal(t, []types.GameMetadata{replacement, game20}, games)
+}
+
+func TestEventGameLoader_RequestedBlockNotCanonical(t *testing.T) {
+	chain := newStubChain(100)
+	chain.createGame(20, common.Address{0x20})
+	loader := NewEventGameLoader(&stubGameCreatedLogs{}, chain, 10)
+	oldHead := chain.hash(100)
+	chain.reorg(90, 100)
+	// The old head can still be retrieved by hash, but is no longer part of the canonical chain.
+	chain.byHash[oldHead] = &ethtypes.Header{Number: big.NewInt(100)}
+
+	_, err := loader.FetchAllGamesAtBlock(context.Background(), 0, oldHead)
+	require.ErrorIs(t, err, ErrChainReorged)
+}
+
+func TestEventGameLoader_EarliestTimestamp(t *testing.T) {
+	chain := newStubChain(100)
+	chain.createGame(20, common.Address{0x20})
+	game50 := chain.createGame(50, common.Address{0x50})
+	loader := NewEventGameLoader(&stubGameCreatedLogs{}, chain, 10)
+
+	games, err := loader.FetchAllGamesAtBlock(context.Background(), game50.Timestamp, chain.hash(100))
+	require.NoError(t, err)
+	require.Equal(t, []types.GameMetadata{game50}, games)
+}
+
+func TestEventGameLoader_Errors(t *testing.T) {
+	chain := newStubChain(100)
+	loader := NewEventGameLoader(&stubGameCreatedLogs{}, chain, 10)
+
+	_, err := loader.FetchAllGamesAtBlock(context.Background(), 0, common.Hash{0xaa})
+	require.ErrorIs(t, err, ethereum.NotFound)
+
+	logsErr := errors.New("boom")
+	chain.logsErr = logsErr
+	_, err = loader.FetchAllGamesAtBlock(context.Background(), 0, chain.hash(100))
+	require.ErrorIs(t, err, logsErr)
+}
+
+type blockRange struct {
+	from uint64
+	to   uint64
+}
+
+type stubChain struct {
+	fork    uint8
+	headers []*ethtypes.Header
+	byHash  map[common.Hash]*ethtypes.Header
+	logs    []ethtypes.Log
+	queries []blockRange
+	logsErr error
+}
+
+func newStubChain(head uint64) *stubChain {
+	chain := &stubChain{byHash: make(map[common.Hash]*ethtypes.Header)}
+	chain.extend(head)
+	return chain
+}
+
+func (c *stubChain) extend(head uint64) {
+	for num := uint64(len(c.headers)); num <= head; num++ {
+		header := &ethtypes.Header{
+			Number: new(big.Int).SetUint64(num),
+			Time:   num * 12,
+			Extra:  []byte{c.fork},
+		}
+		c.headers = append(c.headers, header)
+		c.byHash[header.Hash()] = header
+	}
+}
+
+// reorg replaces all blocks from the fork point with new blocks up to head, removing any logs from the replaced blocks.
+func (c *stubChain) reorg(fork uint64, head uint64) {
+	c.fork++
+	c.headers = c.headers[:fork]
+	var logs []ethtypes.Log
+	for _, log := range c.logs {
+		if log.BlockNumber < fork {
+			logs = append(logs, log)
+		}
+	}
+	c.logs = logs
+	c.extend(head)
+}
+
+func (c *stubChain) hash(num uint64) common.Hash {
+	return c.headers[num].Hash()
+}
+
+func (c *stubChain) createGame(num uint64, proxy common.Address) types.GameMetadata {
+	c.logs = append(c.logs, ethtypes.Log{
+		Topics:      []common.Hash{common.BytesToHash(proxy.Bytes())},
+		BlockNumber: num,
+		BlockHash:   c.hash(num),
+	})
+	return types.GameMetadata{Proxy: proxy, Timestamp: c.headers[num].Time}
+}
+
+func (c *stubChain) HeaderByHash(_ context.Context, hash common.Hash) (*ethtypes.Header, error) {
+	header, ok := c.byHash[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+func (c *stubChain) HeaderByNumber(_ context.Context, number *big.Int) (*ethtypes.Header, error) {
+	if !number.IsUint64() || number.Uint64() >= uint64(len(c.headers)) {
+		return nil, ethereum.NotFound
+	}
+	return c.headers[number.Uint64()], nil
+}
+
+func (c *stubChain) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	if c.logsErr != nil {
+		return nil, c.logsErr
+	}
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	c.queries = append(c.queries, blockRange{from, to})
+	var logs []ethtypes.Log
+	for _, log := range c.logs {
+		if log.BlockNumber >= from && log.BlockNumber <= to {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+type stubGameCreatedLogs struct{}
+
+func (s *stubGameCreatedLogs) DisputeGameCreatedQuery(from uint64, to uint64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+	}
+}
+
+func (s *stubGameCreatedLogs) DecodeDisputeGameCreated(log *ethtypes.Log, timestamp uint64) (types.GameMetadata, error) {
+	return types.GameMetadata{
+		Proxy:     common.BytesToAddress(log.Topics[0].Bytes()),
+		Timestamp: timestamp,
+	}, nil
+}
@@ -5,9 +5,15 @@ import (
 	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/ethereum-optimism/optimism/op-challenger/game/loader")
+
 // MinimalDisputeGameFactoryCaller is a minimal interface around [bindings.DisputeGameFactoryCaller].
 // This needs to be updated if the [bindings.DisputeGameFactoryCaller] interface changes.
 type MinimalDisputeGameFactoryCaller interface {
@@ -27,13 +33,18 @@ func NewGameLoader(caller MinimalDisputeGameFactoryCaller) *GameLoader {
 }
 
 // FetchAllGamesAtBlock fetches all dispute games from the factory at a given block number.
-func (l *GameLoader) FetchAllGamesAtBlock(ctx context.Context, earliestTimestamp uint64, blockHash common.Hash) ([]types.GameMetadata, error) {
+func (l *GameLoader) FetchAllGamesAtBlock(ctx context.Context, earliestTimestamp uint64, blockHash common.Hash) (games []types.GameMetadata, err error) {
+	ctx, span := tracer.Start(ctx, "GameLoader.FetchAllGamesAtBlock", trace.WithAttributes(attribute.String("block_hash", blockHash.Hex())))
+	defer func() {
+		span.SetAttributes(attribute.Int("games", len(games)))
+		tracing.EndSpan(span, err)
+	}()
 	gameCount, err := l.caller.GetGameCount(ctx, blockHash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch game count: %w", err)
 	}
 
-	games := make([]types.GameMetadata, 0, gameCount)
+	games = make([]types.GameMetadata, 0, gameCount)
 	for i := gameCount; i > 0; i-- {
 		game, err := l.caller.GetGame(ctx, i-1, blockHash)
 		if err != nil {
@@ -21,6 +21,9 @@ import (
 
 type blockNumberFetcher func(ctx context.Context) (uint64, error)
 
+// gameTypeFilter returns true if games of the specified type can be played.
+type gameTypeFilter func(gameType uint8) bool
+
 // gameSource loads information about the games available to play
 type gameSource interface {
 	FetchAllGamesAtBlock(ctx context.Context, earliest uint64, blockHash common.Hash) ([]types.GameMetadata, error)
@@ -38,9 +41,22 @@ type gameMonitor struct {
 	gameWindow       time.Duration
 	fetchBlockNumber blockNumberFetcher
 	allowedGames     []common.Address
+	supportedType    gameTypeFilter
 	l1HeadsSub       ethereum.Subscription
 	l1Source         *headSource
 	runState         sync.Mutex
+
+	discoveryInterval time.Duration
+	discoveryLock     sync.Mutex
+	discoveredGames   []types.GameMetadata
+	lastDiscovery     time.Time
+
+	moveSource    LogSubscriber
+	moveQuery     ethereum.FilterQuery
+	moveEventsSub event.Subscription
+	lastMoveBlock common.Hash
+	trackedGames  map[common.Address]bool
+	trackedLock   sync.Mutex
 }
 
 type MinimalSubscriber interface {
@@ -63,6 +79,7 @@ func newGameMonitor(
 	gameWindow time.Duration,
 	fetchBlockNumber blockNumberFetcher,
 	allowedGames []common.Address,
+	supportedType gameTypeFilter,
 	l1Source MinimalSubscriber,
 ) *gameMonitor {
 	return &gameMonitor{
@@ -73,10 +90,18 @@ func newGameMonitor(
 		gameWindow:       gameWindow,
 		fetchBlockNumber: fetchBlockNumber,
 		allowedGames:     allowedGames,
+		supportedType:    supportedType,
 		l1Source:         &headSource{inner: l1Source},
 	}
 }
 
+// WithDiscoveryInterval sets the minimum time between loading the list of games from the source.
+// Until it elapses, games are progressed using the previously loaded list.
+func (m *gameMonitor) WithDiscoveryInterval(interval time.Duration) *gameMonitor {
+	m.discoveryInterval = interval
+	return m
+}
+
 func (m *gameMonitor) allowedGame(game common.Address) bool {
 	if len(m.allowedGames) == 0 {
 		return true
@@ -101,10 +126,28 @@ func (m *gameMonitor) minGameTimestamp() uint64 {
 	return 0
 }
 
-func (m *gameMonitor) progressGames(ctx context.Context, blockHash common.Hash) error {
+// loadGames returns the games available at blockHash, reusing the previously loaded games if the discovery interval
+// has not yet elapsed.
+func (m *gameMonitor) loadGames(ctx context.Context, blockHash common.Hash) ([]types.GameMetadata, error) {
+	m.discoveryLock.Lock()
+	defer m.discoveryLock.Unlock()
+	now := m.clock.Now()
+	if m.discoveryInterval > 0 && !m.lastDiscovery.IsZero() && now.Before(m.lastDiscovery.Add(m.discoveryInterval)) {
+		return m.discoveredGames, nil
+	}
 	games, err := m.source.FetchAllGamesAtBlock(ctx, m.minGameTimestamp(), blockHash)
 	if err != nil {
-		return fmt.Errorf("failed to load games: %w", err)
+		return nil, fmt.Errorf("failed to load games: %w", err)
+	}
+	m.discoveredGames = games
+	m.lastDiscovery = now
+	return games, nil
+}
+
+func (m *gameMonitor) progressGames(ctx context.Context, blockHash common.Hash) error {
+	games, err := m.loadGames(ctx, blockHash)
+	if err != nil {
+		return err
 	}
 	var gamesToPlay []types.GameMetadata
 	for _, game := range games {
@@ -112,8 +155,19 @@ func (m *gameMonitor) progressGames(ctx context.Context, blockHash common.Hash)
 			m.logger.Debug("Skipping game not on allow list", "game", game.Proxy)
 			continue
 		}
+		if !m.supportedType(game.GameType) {
+			m.logger.Debug("Skipping game with unsupported game type", "game", game.Proxy, "gameType", game.GameType)
+			continue
+		}
 		gamesToPlay = append(gamesToPlay, game)
 	}
+	if m.moveSource != nil {
+		tracked := make([]common.Address, 0, len(gamesToPlay))
+		for _, game := range gamesToPlay {
+			tracked = append(tracked, game.Proxy)
+		}
+		m.trackGames(tracked)
+	}
 	if err := m.scheduler.Schedule(gamesToPlay); errors.Is(err, scheduler.ErrBusy) {
 		m.logger.Info("Scheduler still busy with previous update")
 	} else if err != nil {
@@ -146,6 +200,9 @@ func (m *gameMonitor) StartMonitoring() {
 		return // already started
 	}
 	m.l1HeadsSub = event.ResubscribeErr(time.Second*10, m.resubscribeFunction())
+	if m.moveSource != nil {
+		m.moveEventsSub = event.ResubscribeErr(moveEventsResubscribeBackoff, m.resubscribeMoveEvents())
+	}
 }
 
 func (m *gameMonitor) StopMonitoring() {
@@ -156,4 +213,8 @@ func (m *gameMonitor) StopMonitoring() {
 	}
 	m.l1HeadsSub.Unsubscribe()
 	m.l1HeadsSub = nil
+	if m.moveEventsSub != nil {
+		m.moveEventsSub.Unsubscribe()
+		m.moveEventsSub = nil
+	}
 }
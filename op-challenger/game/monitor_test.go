@@ -20,6 +20,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 )
 
+const unsupportedGameType = uint8(200)
+
 func TestMonitorMinGameTimestamp(t *testing.T) {
 	t.Parallel()
 
@@ -166,6 +168,42 @@ func TestMonitorOnlyScheduleSpecifiedGame(t *testing.T) {
 	require.Equal(t, []common.Address{addr2}, sched.Scheduled()[0])
 }
 
+func TestMonitorSkipsUnsupportedGameTypes(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	monitor, source, sched, _ := setupMonitorTest(t, []common.Address{})
+	unsupported := newFDG(addr1, 9999)
+	unsupported.GameType = unsupportedGameType
+	source.games = []types.GameMetadata{unsupported, newFDG(addr2, 9999)}
+
+	require.NoError(t, monitor.progressGames(context.Background(), common.Hash{0x01}))
+
+	require.Len(t, sched.Scheduled(), 1)
+	require.Equal(t, []common.Address{addr2}, sched.Scheduled()[0])
+}
+
+func TestMonitorDiscoveryInterval(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	monitor, source, sched, _ := setupMonitorTest(t, []common.Address{})
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	monitor.clock = cl
+	monitor.WithDiscoveryInterval(time.Minute)
+	source.games = []types.GameMetadata{newFDG(addr1, 9999)}
+
+	require.NoError(t, monitor.progressGames(context.Background(), common.Hash{0x01}))
+
+	// New games are not discovered until the interval elapses, but known games are still scheduled
+	source.games = []types.GameMetadata{newFDG(addr1, 9999), newFDG(addr2, 9999)}
+	cl.AdvanceTime(59 * time.Second)
+	require.NoError(t, monitor.progressGames(context.Background(), common.Hash{0x02}))
+
+	cl.AdvanceTime(time.Second)
+	require.NoError(t, monitor.progressGames(context.Background(), common.Hash{0x03}))
+
+	require.Equal(t, [][]common.Address{{addr1}, {addr1}, {addr1, addr2}}, sched.Scheduled())
+}
+
 func newFDG(proxy common.Address, timestamp uint64) types.GameMetadata {
 	return types.GameMetadata{
 		Proxy:     proxy,
@@ -194,6 +232,7 @@ func setupMonitorTest(
 		time.Duration(0),
 		fetchBlockNum,
 		allowedGames,
+		func(gameType uint8) bool { return gameType != unsupportedGameType },
 		mockHeadSource,
 	)
 	return monitor, source, sched, mockHeadSource
@@ -0,0 +1,125 @@
+// Package notify sends notifications of game lifecycle events to external services, such as webhooks and Slack, so
+// that operators are alerted to significant events without relying solely on metrics based alerting.
+package notify
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// notifyTimeout is the maximum time allowed to deliver a single event to a single Notifier.
+const notifyTimeout = 10 * time.Second
+
+// queueSize is the maximum number of events waiting to be delivered by a Dispatcher.
+// Further events are dropped until the queue drains.
+const queueSize = 100
+
+type EventType string
+
+const (
+	// EventGameCreated is sent when a new game is discovered after the challenger has started.
+	EventGameCreated EventType = "game_created"
+	// EventMoveSubmitted is sent when the challenger's transaction to counter a claim is included.
+	EventMoveSubmitted EventType = "move_submitted"
+	// EventStepExecuted is sent when the challenger's transaction to step against a claim is included.
+	EventStepExecuted EventType = "step_executed"
+	// EventGameResolved is sent when a game resolves.
+	EventGameResolved EventType = "game_resolved"
+	// EventIncorrectResolution is sent when a game resolves with a different result than the local trace expects.
+	EventIncorrectResolution EventType = "incorrect_resolution"
+)
+
+// Event describes a game lifecycle event.
+type Event struct {
+	Type    EventType      `json:"type"`
+	Game    common.Address `json:"game"`
+	Time    time.Time      `json:"time"`
+	Message string         `json:"message"`
+	// Details are additional event specific values, such as the index of the claim a move countered.
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Notifier delivers notifications of events.
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// NoopNotifier discards all events.
+type NoopNotifier struct{}
+
+func (NoopNotifier) Notify(_ context.Context, _ Event) error {
+	return nil
+}
+
+// Dispatcher delivers events to a set of Notifiers in the background so that slow or unavailable services don't
+// delay progressing games. Events are dropped if too many are waiting to be delivered.
+type Dispatcher struct {
+	logger    log.Logger
+	notifiers []Notifier
+	queue     chan Event
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewDispatcher(logger log.Logger, notifiers ...Notifier) *Dispatcher {
+	d := &Dispatcher{
+		logger:    logger,
+		notifiers: notifiers,
+		queue:     make(chan Event, queueSize),
+		done:      make(chan struct{}),
+	}
+	d.wg.Add(1)
+	go d.loop()
+	return d
+}
+
+// Notify queues event to be delivered to each Notifier. It never blocks and always returns nil.
+func (d *Dispatcher) Notify(_ context.Context, event Event) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	select {
+	case d.queue <- event:
+	default:
+		d.logger.Warn("Notification queue full, dropping event", "type", event.Type, "game", event.Game)
+	}
+	return nil
+}
+
+// Close stops delivering events. Events that have not yet been delivered are dropped.
+func (d *Dispatcher) Close() {
+	d.closeOnce.Do(func() {
+		close(d.done)
+	})
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) loop() {
+	defer d.wg.Done()
+	for {
+		select {
+		case event := <-d.queue:
+			d.deliver(event)
+		case <-d.done:
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(event Event) {
+	for _, notifier := range d.notifiers {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		if err := notifier.Notify(ctx, event); err != nil {
+			d.logger.Warn("Failed to send notification", "type", event.Type, "game", event.Game, "err", err)
+		}
+		cancel()
+	}
+}
+
+var _ Notifier = (*Dispatcher)(nil)
+var _ Notifier = NoopNotifier{}
@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestDispatcher(t *testing.T) {
+	t.Run("DeliversToAllNotifiers", func(t *testing.T) {
+		failing := &stubNotifier{err: errors.New("boom")}
+		working := &stubNotifier{}
+		d := NewDispatcher(testlog.Logger(t, log.LvlError), failing, working)
+		defer d.Close()
+
+		require.NoError(t, d.Notify(context.Background(), Event{Type: EventGameCreated}))
+		require.NoError(t, d.Notify(context.Background(), Event{Type: EventGameResolved}))
+		require.Eventually(t, func() bool {
+			return len(working.Events()) == 2
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Len(t, failing.Events(), 2, "should continue delivering after an error")
+		require.Equal(t, EventGameCreated, working.Events()[0].Type)
+		require.False(t, working.Events()[0].Time.IsZero(), "should set event time")
+	})
+
+	t.Run("DropsEventsWhenQueueFull", func(t *testing.T) {
+		blocked := &stubNotifier{wait: make(chan struct{})}
+		d := NewDispatcher(testlog.Logger(t, log.LvlError), blocked)
+		// One event is taken by the delivery loop and blocks, the rest fill the queue.
+		for i := 0; i < queueSize+10; i++ {
+			require.NoError(t, d.Notify(context.Background(), Event{Type: EventGameCreated}))
+		}
+		close(blocked.wait)
+		d.Close()
+		require.LessOrEqual(t, len(blocked.Events()), queueSize+1)
+	})
+}
+
+type stubNotifier struct {
+	lock   sync.Mutex
+	events []Event
+	err    error
+	wait   chan struct{}
+}
+
+func (s *stubNotifier) Notify(_ context.Context, event Event) error {
+	if s.wait != nil {
+		<-s.wait
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func (s *stubNotifier) Events() []Event {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]Event(nil), s.events...)
+}
@@ -0,0 +1,90 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// WebhookNotifier posts each event as JSON to a URL.
+type WebhookNotifier struct {
+	client *http.Client
+	url    string
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		client: &http.Client{Timeout: notifyTimeout},
+		url:    url,
+	}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, event Event) error {
+	return postJSON(ctx, n.client, n.url, event)
+}
+
+// SlackNotifier posts each event as a message to a Slack incoming webhook URL.
+type SlackNotifier struct {
+	client *http.Client
+	url    string
+}
+
+func NewSlackNotifier(url string) *SlackNotifier {
+	return &SlackNotifier{
+		client: &http.Client{Timeout: notifyTimeout},
+		url:    url,
+	}
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (n *SlackNotifier) Notify(ctx context.Context, event Event) error {
+	return postJSON(ctx, n.client, n.url, slackMessage{Text: slackText(event)})
+}
+
+// slackText formats event as a Slack mrkdwn message, listing the details in a stable order.
+func slackText(event Event) string {
+	var text strings.Builder
+	fmt.Fprintf(&text, "*%v* `%v`\n%v", event.Type, event.Game, event.Message)
+	keys := make([]string, 0, len(event.Details))
+	for key := range event.Details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&text, "\n• %v: `%v`", key, event.Details[key])
+	}
+	return text.String()
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to send notification: %v: %s", resp.Status, body)
+	}
+	return nil
+}
+
+var _ Notifier = (*WebhookNotifier)(nil)
+var _ Notifier = (*SlackNotifier)(nil)
@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvent = Event{
+	Type:    EventMoveSubmitted,
+	Game:    common.Address{0xaa},
+	Time:    time.Unix(1000, 0).UTC(),
+	Message: "Attacked claim 3",
+	Details: map[string]string{"parent": "3", "attack": "true"},
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	t.Run("PostsEvent", func(t *testing.T) {
+		var method, contentType string
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			contentType = r.Header.Get("Content-Type")
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer server.Close()
+
+		require.NoError(t, NewWebhookNotifier(server.URL).Notify(context.Background(), testEvent))
+		require.Equal(t, http.MethodPost, method)
+		require.Equal(t, "application/json", contentType)
+		var actual Event
+		require.NoError(t, json.Unmarshal(body, &actual))
+		require.Equal(t, testEvent, actual)
+	})
+
+	t.Run("ErrorStatus", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(server.URL).Notify(context.Background(), testEvent)
+		require.ErrorContains(t, err, "503 Service Unavailable")
+		require.ErrorContains(t, err, "unavailable")
+	})
+}
+
+func TestSlackNotifier(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewSlackNotifier(server.URL).Notify(context.Background(), testEvent))
+	var actual slackMessage
+	require.NoError(t, json.Unmarshal(body, &actual))
+	expected := "*move_submitted* `" + testEvent.Game.Hex() + "`\nAttacked claim 3\n• attack: `true`\n• parent: `3`"
+	require.Equal(t, expected, actual.Text)
+}
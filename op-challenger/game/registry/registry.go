@@ -31,6 +31,12 @@ func (r *GameTypeRegistry) RegisterGameType(gameType uint8, creator scheduler.Pl
 	r.types[gameType] = creator
 }
 
+// Supports returns true if a scheduler.PlayerCreator is registered for the game type.
+func (r *GameTypeRegistry) Supports(gameType uint8) bool {
+	_, ok := r.types[gameType]
+	return ok
+}
+
 // CreatePlayer creates a new game player for the given game, using the specified directory for persisting data.
 func (r *GameTypeRegistry) CreatePlayer(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
 	creator, ok := r.types[game.GameType]
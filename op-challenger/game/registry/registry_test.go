@@ -28,6 +28,15 @@ func TestKnownGameType(t *testing.T) {
 	require.Same(t, expectedPlayer, player)
 }
 
+func TestSupports(t *testing.T) {
+	registry := NewGameTypeRegistry()
+	registry.RegisterGameType(0, func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
+		return nil, nil
+	})
+	require.True(t, registry.Supports(0))
+	require.False(t, registry.Supports(1))
+}
+
 func TestPanicsOnDuplicateGameType(t *testing.T) {
 	registry := NewGameTypeRegistry()
 	creator := func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
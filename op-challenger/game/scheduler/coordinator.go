@@ -4,8 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -17,9 +23,15 @@ var errUnknownGame = errors.New("unknown game")
 type PlayerCreator func(game types.GameMetadata, dir string) (GamePlayer, error)
 
 type gameState struct {
+	game     types.GameMetadata
 	player   GamePlayer
 	inflight bool
 	status   types.GameStatus
+	// prestateMismatch is set if the game's absolute prestate does not match the local prestate.
+	// The game is never played, as doing so would lose the challenger's bonds.
+	prestateMismatch bool
+	// lastRefresh is when the game was last scheduled to be progressed.
+	lastRefresh time.Time
 }
 
 // coordinator manages the set of current games, queues games to be played (on separate worker threads) and
@@ -34,9 +46,19 @@ type coordinator struct {
 
 	logger       log.Logger
 	m            SchedulerMetricer
+	clock        clock.Clock
 	createPlayer PlayerCreator
 	states       map[common.Address]*gameState
 	disk         DiskManager
+
+	// refreshInterval is the minimum time between progressing each in-progress game, unless it has a move due
+	// before the interval would elapse. Zero progresses every game each time it is scheduled.
+	refreshInterval time.Duration
+
+	notifier notify.Notifier
+	// scheduled is true once the first set of games has been scheduled. Games discovered after that were created
+	// while the challenger was running.
+	scheduled bool
 }
 
 // schedule takes the current list of games to attempt to progress, filters out games that have previous
@@ -84,6 +106,20 @@ func (c *coordinator) schedule(ctx context.Context, games []types.GameMetadata)
 		}
 	}
 	c.m.RecordGamesStatus(gamesInProgress, gamesDefenderWon, gamesChallengerWon)
+	c.recordMinClockRemaining()
+	c.recordPrestateMismatches()
+	c.recordIncorrectForecasts()
+	c.scheduled = true
+
+	// Progress the games closest to timing out first. Games with nothing to respond to keep their original order.
+	sort.SliceStable(jobs, func(i, j int) bool {
+		deadlineI, okI := jobs[i].player.ClockDeadline()
+		deadlineJ, okJ := jobs[j].player.ClockDeadline()
+		if !okJ {
+			return okI
+		}
+		return okI && deadlineI.Before(deadlineJ)
+	})
 
 	// Finally, enqueue the jobs
 	for _, j := range jobs {
@@ -94,38 +130,130 @@ func (c *coordinator) schedule(ctx context.Context, games []types.GameMetadata)
 	return errors.Join(errs...)
 }
 
+// recordMinClockRemaining records the shortest time remaining on any chess clock that a tracked game needs to
+// respond to. Records +Inf if no tracked game has anything to respond to.
+func (c *coordinator) recordMinClockRemaining() {
+	var earliest time.Time
+	for _, state := range c.states {
+		if state.player == nil || state.status != types.GameStatusInProgress {
+			continue
+		}
+		deadline, ok := state.player.ClockDeadline()
+		if ok && (earliest.IsZero() || deadline.Before(earliest)) {
+			earliest = deadline
+		}
+	}
+	if earliest.IsZero() {
+		c.m.RecordMinClockRemaining(math.Inf(1))
+		return
+	}
+	c.m.RecordMinClockRemaining(earliest.Sub(c.clock.Now()).Seconds())
+}
+
+// recordPrestateMismatches records the number of tracked games that are not played because of a prestate mismatch.
+func (c *coordinator) recordPrestateMismatches() {
+	var mismatches int
+	for _, state := range c.states {
+		if state.prestateMismatch {
+			mismatches++
+		}
+	}
+	c.m.RecordPrestateMismatches(mismatches)
+}
+
+// recordIncorrectForecasts records the number of tracked games that are heading toward, or resolved with, a different
+// result than the local trace provider expects.
+func (c *coordinator) recordIncorrectForecasts() {
+	var inProgress, resolved int
+	for _, state := range c.states {
+		if state.player == nil {
+			continue
+		}
+		forecast, ok := state.player.Forecast()
+		if !ok {
+			continue
+		}
+		if state.status == types.GameStatusInProgress {
+			if forecast.Incorrect() {
+				inProgress++
+			}
+		} else if state.status != forecast.Expected {
+			resolved++
+		}
+	}
+	c.m.RecordIncorrectForecasts(inProgress, resolved)
+}
+
 // createJob updates the state for the specified game and returns the job to enqueue for it, if any
 // Returns (nil, nil) when there is no error and no job to enqueue
 func (c *coordinator) createJob(ctx context.Context, game types.GameMetadata) (*job, error) {
 	state, ok := c.states[game.Proxy]
 	if !ok {
-		state = &gameState{}
+		state = &gameState{game: game}
 		c.states[game.Proxy] = state
+		if c.scheduled {
+			_ = c.notifier.Notify(ctx, notify.Event{
+				Type:    notify.EventGameCreated,
+				Game:    game.Proxy,
+				Message: fmt.Sprintf("New game of type %v created", game.GameType),
+				Details: map[string]string{
+					"gameType":  strconv.FormatUint(uint64(game.GameType), 10),
+					"timestamp": strconv.FormatUint(game.Timestamp, 10),
+				},
+			})
+		}
 	}
 	if state.inflight {
 		c.logger.Debug("Not rescheduling already in-flight game", "game", game.Proxy)
 		return nil, nil
 	}
+	if state.prestateMismatch {
+		c.logger.Debug("Not scheduling game with mismatched prestate", "game", game.Proxy)
+		return nil, nil
+	}
 	// Create the player separately to the state so we retry creating it if it fails on the first attempt.
 	if state.player == nil {
 		player, err := c.createPlayer(game, c.disk.DirForGame(game.Proxy))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create game player: %w", err)
 		}
-		if err := player.ValidatePrestate(ctx); err != nil {
+		if err := player.ValidatePrestate(ctx); errors.Is(err, types.ErrPrestateMismatch) {
+			c.logger.Error("CRITICAL: Game prestate does not match local prestate, refusing to play game", "game", game.Proxy, "err", err)
+			state.prestateMismatch = true
+			return nil, nil
+		} else if err != nil {
 			return nil, fmt.Errorf("failed to validate prestate: %w", err)
 		}
 		state.player = player
 		state.status = player.Status()
 	}
+	if state.status == types.GameStatusInProgress && !c.refreshDue(state) {
+		c.logger.Trace("Not rescheduling game before refresh interval elapses", "game", game.Proxy)
+		return nil, nil
+	}
 	state.inflight = true
 	if state.status != types.GameStatusInProgress {
 		c.logger.Debug("Not rescheduling resolved game", "game", game.Proxy, "status", state.status)
 		return nil, nil
 	}
+	state.lastRefresh = c.clock.Now()
 	return &job{addr: game.Proxy, player: state.player, status: state.status}, nil
 }
 
+// refreshDue returns true if the game should be progressed now. That is when the refresh interval has elapsed since it
+// was last progressed, or it has a move due before the interval would elapse again.
+func (c *coordinator) refreshDue(state *gameState) bool {
+	if c.refreshInterval == 0 || state.lastRefresh.IsZero() {
+		return true
+	}
+	now := c.clock.Now()
+	if !now.Before(state.lastRefresh.Add(c.refreshInterval)) {
+		return true
+	}
+	deadline, ok := state.player.ClockDeadline()
+	return ok && deadline.Before(now.Add(c.refreshInterval))
+}
+
 func (c *coordinator) enqueueJob(ctx context.Context, j job) error {
 	for {
 		select {
@@ -148,31 +276,35 @@ func (c *coordinator) processResult(j job) error {
 	}
 	state.inflight = false
 	state.status = j.status
-	c.deleteResolvedGameFiles()
+	c.pruneGameFiles()
 	c.m.RecordGameUpdateCompleted()
 	return nil
 }
 
-func (c *coordinator) deleteResolvedGameFiles() {
-	var keepGames []common.Address
-	for addr, state := range c.states {
-		if state.status == types.GameStatusInProgress || state.inflight {
-			keepGames = append(keepGames, addr)
-		}
+func (c *coordinator) pruneGameFiles() {
+	games := make([]GameDisk, 0, len(c.states))
+	for _, state := range c.states {
+		games = append(games, GameDisk{
+			Game:   state.game,
+			Status: state.status,
+			InUse:  state.inflight,
+		})
 	}
-	if err := c.disk.RemoveAllExcept(keepGames); err != nil {
+	if err := c.disk.Prune(games); err != nil {
 		c.logger.Error("Unable to cleanup game data", "err", err)
 	}
 }
 
-func newCoordinator(logger log.Logger, m SchedulerMetricer, jobQueue chan<- job, resultQueue <-chan job, createPlayer PlayerCreator, disk DiskManager) *coordinator {
+func newCoordinator(logger log.Logger, m SchedulerMetricer, cl clock.Clock, jobQueue chan<- job, resultQueue <-chan job, createPlayer PlayerCreator, disk DiskManager) *coordinator {
 	return &coordinator{
 		logger:       logger,
 		m:            m,
+		clock:        cl,
 		jobQueue:     jobQueue,
 		resultQueue:  resultQueue,
 		createPlayer: createPlayer,
 		disk:         disk,
 		states:       make(map[common.Address]*gameState),
+		notifier:     notify.NoopNotifier{},
 	}
 }
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -73,6 +76,51 @@ func TestSchedule_PrestateValidationErrors(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSchedule_PrestateMismatch(t *testing.T) {
+	c, workQueue, _, games, _ := setupCoordinatorTest(t, 10)
+	games.PrestateErr = fmt.Errorf("%w: bad prestate", types.ErrPrestateMismatch)
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	ctx := context.Background()
+
+	err := c.schedule(ctx, asGames(gameAddr1))
+	require.NoError(t, err, "mismatch should be reported by alarm rather than failing to schedule other games")
+	require.Empty(t, workQueue, "should not play game with mismatched prestate")
+	require.True(t, c.states[gameAddr1].prestateMismatch)
+
+	// The game is not recreated or validated again, and other games are still played.
+	games.PrestateErr = nil
+	err = c.schedule(ctx, asGames(gameAddr1, gameAddr2))
+	require.NoError(t, err)
+	require.Len(t, workQueue, 1)
+	j := <-workQueue
+	require.Equal(t, gameAddr2, j.addr)
+}
+
+func TestSchedule_RecordIncorrectForecasts(t *testing.T) {
+	c, _, _, games, _ := setupCoordinatorTest(t, 10)
+	m := &stubForecastMetrics{}
+	c.m = m
+	incorrectInProgress := common.Address{0xaa}
+	correctInProgress := common.Address{0xbb}
+	incorrectResolved := common.Address{0xcc}
+	notEvaluated := common.Address{0xdd}
+	games.createCompleted = incorrectResolved
+	ctx := context.Background()
+
+	gameList := asGames(incorrectInProgress, correctInProgress, incorrectResolved, notEvaluated)
+	require.NoError(t, c.schedule(ctx, gameList))
+	require.Zero(t, m.inProgress)
+	require.Zero(t, m.resolved)
+
+	games.created[incorrectInProgress].ForecastValue = &types.Forecast{Expected: types.GameStatusChallengerWon, Projected: types.GameStatusDefenderWon}
+	games.created[correctInProgress].ForecastValue = &types.Forecast{Expected: types.GameStatusChallengerWon, Projected: types.GameStatusChallengerWon}
+	games.created[incorrectResolved].ForecastValue = &types.Forecast{Expected: types.GameStatusChallengerWon, Projected: types.GameStatusChallengerWon}
+	require.NoError(t, c.schedule(ctx, gameList))
+	require.Equal(t, 1, m.inProgress)
+	require.Equal(t, 1, m.resolved)
+}
+
 func TestScheduleGameAgainAfterCompletion(t *testing.T) {
 	c, workQueue, _, _, _ := setupCoordinatorTest(t, 10)
 	gameAddr1 := common.Address{0xaa}
@@ -238,6 +286,95 @@ func TestDropOldGameStates(t *testing.T) {
 	require.Contains(t, c.states, gameAddr4, "should create state for game 4")
 }
 
+func TestSchedulePrioritisesGamesClosestToTimingOut(t *testing.T) {
+	c, workQueue, _, games, _ := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	gameAddr3 := common.Address{0xcc}
+	gameAddr4 := common.Address{0xdd}
+	ctx := context.Background()
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2, gameAddr3, gameAddr4)))
+	for len(workQueue) > 0 {
+		require.NoError(t, c.processResult(<-workQueue))
+	}
+
+	games.created[gameAddr2].Deadline = time.Unix(2000, 0)
+	games.created[gameAddr3].Deadline = time.Unix(1500, 0)
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2, gameAddr3, gameAddr4)))
+
+	var order []common.Address
+	for len(workQueue) > 0 {
+		order = append(order, (<-workQueue).addr)
+	}
+	require.Equal(t, []common.Address{gameAddr3, gameAddr2, gameAddr1, gameAddr4}, order)
+}
+
+func TestScheduleRefreshInterval(t *testing.T) {
+	c, workQueue, _, games, _ := setupCoordinatorTest(t, 10)
+	cl := c.clock.(*clock.DeterministicClock)
+	c.refreshInterval = time.Minute
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	ctx := context.Background()
+	progressed := func() []common.Address {
+		var addrs []common.Address
+		for len(workQueue) > 0 {
+			j := <-workQueue
+			addrs = append(addrs, j.addr)
+			require.NoError(t, c.processResult(j))
+		}
+		return addrs
+	}
+
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1)))
+	require.Equal(t, []common.Address{gameAddr1}, progressed())
+
+	// New games are progressed immediately but known games wait for the interval
+	cl.AdvanceTime(30 * time.Second)
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2)))
+	require.Equal(t, []common.Address{gameAddr2}, progressed())
+
+	// Games with a move due before the interval would elapse are progressed immediately
+	games.created[gameAddr2].Deadline = cl.Now().Add(59 * time.Second)
+	cl.AdvanceTime(time.Second)
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2)))
+	require.Equal(t, []common.Address{gameAddr2}, progressed())
+
+	cl.AdvanceTime(30 * time.Second)
+	games.created[gameAddr2].Deadline = time.Time{}
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2)))
+	require.Equal(t, []common.Address{gameAddr1}, progressed())
+}
+
+func TestScheduleNotifiesCreatedGames(t *testing.T) {
+	c, workQueue, _, _, _ := setupCoordinatorTest(t, 10)
+	notifier := &stubNotifier{}
+	c.notifier = notifier
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	ctx := context.Background()
+
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1)))
+	require.Empty(t, notifier.events, "should not notify games that existed on startup")
+	for len(workQueue) > 0 {
+		require.NoError(t, c.processResult(<-workQueue))
+	}
+
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1, gameAddr2)))
+	require.Len(t, notifier.events, 1)
+	require.Equal(t, notify.EventGameCreated, notifier.events[0].Type)
+	require.Equal(t, gameAddr2, notifier.events[0].Game)
+}
+
+type stubNotifier struct {
+	events []notify.Event
+}
+
+func (s *stubNotifier) Notify(_ context.Context, event notify.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
 func setupCoordinatorTest(t *testing.T, bufferSize int) (*coordinator, <-chan job, chan job, *createdGames, *stubDiskManager) {
 	logger := testlog.Logger(t, log.LvlInfo)
 	workQueue := make(chan job, bufferSize)
@@ -247,10 +384,21 @@ func setupCoordinatorTest(t *testing.T, bufferSize int) (*coordinator, <-chan jo
 		created: make(map[common.Address]*test.StubGamePlayer),
 	}
 	disk := &stubDiskManager{gameDirExists: make(map[common.Address]bool)}
-	c := newCoordinator(logger, metrics.NoopMetrics, workQueue, resultQueue, games.CreateGame, disk)
+	c := newCoordinator(logger, metrics.NoopMetrics, clock.NewDeterministicClock(time.Unix(1000, 0)), workQueue, resultQueue, games.CreateGame, disk)
 	return c, workQueue, resultQueue, games, disk
 }
 
+type stubForecastMetrics struct {
+	metrics.NoopMetricsImpl
+	inProgress int
+	resolved   int
+}
+
+func (s *stubForecastMetrics) RecordIncorrectForecasts(inProgress, resolved int) {
+	s.inProgress = inProgress
+	s.resolved = resolved
+}
+
 type createdGames struct {
 	t               *testing.T
 	createCompleted common.Address
@@ -293,7 +441,13 @@ func (s *stubDiskManager) DirForGame(addr common.Address) string {
 	return addr.Hex()
 }
 
-func (s *stubDiskManager) RemoveAllExcept(addrs []common.Address) error {
+func (s *stubDiskManager) Prune(games []GameDisk) error {
+	var addrs []common.Address
+	for _, game := range games {
+		if game.Status == types.GameStatusInProgress || game.InUse {
+			addrs = append(addrs, game.Game.Proxy)
+		}
+	}
 	for address := range s.gameDirExists {
 		keep := slices.Contains(addrs, address)
 		s.gameDirExists[address] = keep
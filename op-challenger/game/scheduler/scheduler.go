@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -13,6 +16,9 @@ var ErrBusy = errors.New("busy scheduling previous update")
 
 type SchedulerMetricer interface {
 	RecordGamesStatus(inProgress, defenderWon, challengerWon int)
+	RecordMinClockRemaining(remaining float64)
+	RecordPrestateMismatches(count int)
+	RecordIncorrectForecasts(inProgress, resolved int)
 	RecordGameUpdateScheduled()
 	RecordGameUpdateCompleted()
 	IncActiveExecutors()
@@ -33,7 +39,7 @@ type Scheduler struct {
 	cancel         func()
 }
 
-func NewScheduler(logger log.Logger, m SchedulerMetricer, disk DiskManager, maxConcurrency uint, createPlayer PlayerCreator) *Scheduler {
+func NewScheduler(logger log.Logger, m SchedulerMetricer, cl clock.Clock, disk DiskManager, maxConcurrency uint, createPlayer PlayerCreator) *Scheduler {
 	// Size job and results queues to be fairly small so backpressure is applied early
 	// but with enough capacity to keep the workers busy
 	jobQueue := make(chan job, maxConcurrency*2)
@@ -46,7 +52,7 @@ func NewScheduler(logger log.Logger, m SchedulerMetricer, disk DiskManager, maxC
 	return &Scheduler{
 		logger:         logger,
 		m:              m,
-		coordinator:    newCoordinator(logger, m, jobQueue, resultQueue, createPlayer, disk),
+		coordinator:    newCoordinator(logger, m, cl, jobQueue, resultQueue, createPlayer, disk),
 		maxConcurrency: maxConcurrency,
 		scheduleQueue:  scheduleQueue,
 		jobQueue:       jobQueue,
@@ -54,6 +60,19 @@ func NewScheduler(logger log.Logger, m SchedulerMetricer, disk DiskManager, maxC
 	}
 }
 
+// WithRefreshInterval sets the minimum time between progressing each in-progress game. Games with a move due before
+// the interval would elapse are still progressed each time they are scheduled.
+func (s *Scheduler) WithRefreshInterval(interval time.Duration) *Scheduler {
+	s.coordinator.refreshInterval = interval
+	return s
+}
+
+// WithNotifier sends a notification to notifier for each game discovered after the first set of games is scheduled.
+func (s *Scheduler) WithNotifier(notifier notify.Notifier) *Scheduler {
+	s.coordinator.notifier = notifier
+	return s
+}
+
 func (s *Scheduler) ThreadActive() {
 	s.m.IncActiveExecutors()
 	s.m.DecIdleExecutors()
@@ -79,6 +98,10 @@ func (s *Scheduler) Start(ctx context.Context) {
 }
 
 func (s *Scheduler) Close() error {
+	if s.cancel == nil {
+		// Not started
+		return nil
+	}
 	s.cancel()
 	s.wg.Wait()
 	return nil
@@ -93,6 +116,11 @@ func (s *Scheduler) Schedule(games []types.GameMetadata) error {
 	}
 }
 
+// QueueDepth returns the number of game updates waiting for a worker to become available.
+func (s *Scheduler) QueueDepth() int {
+	return len(s.jobQueue)
+}
+
 func (s *Scheduler) loop(ctx context.Context) {
 	defer s.wg.Done()
 	for {
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler/test"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -19,9 +20,9 @@ func TestSchedulerProcessesGames(t *testing.T) {
 	createPlayer := func(g types.GameMetadata, dir string) (GamePlayer, error) {
 		return &test.StubGamePlayer{}, nil
 	}
-	removeExceptCalls := make(chan []common.Address)
-	disk := &trackingDiskManager{removeExceptCalls: removeExceptCalls}
-	s := NewScheduler(logger, metrics.NoopMetrics, disk, 2, createPlayer)
+	pruneCalls := make(chan []GameDisk)
+	disk := &trackingDiskManager{pruneCalls: pruneCalls}
+	s := NewScheduler(logger, metrics.NoopMetrics, clock.SystemClock, disk, 2, createPlayer)
 	s.Start(ctx)
 
 	gameAddr1 := common.Address{0xaa}
@@ -33,7 +34,12 @@ func TestSchedulerProcessesGames(t *testing.T) {
 
 	// All jobs should be executed and completed, the last step being to clean up disk resources
 	for i := 0; i < len(games); i++ {
-		kept := <-removeExceptCalls
+		var kept []common.Address
+		for _, game := range <-pruneCalls {
+			if game.Status == types.GameStatusInProgress || game.InUse {
+				kept = append(kept, game.Game.Proxy)
+			}
+		}
 		require.Len(t, kept, len(games), "should keep all games")
 		for _, game := range games {
 			require.Containsf(t, kept, game.Proxy, "should keep game %v", game.Proxy)
@@ -47,9 +53,9 @@ func TestReturnBusyWhenScheduleQueueFull(t *testing.T) {
 	createPlayer := func(game types.GameMetadata, dir string) (GamePlayer, error) {
 		return &test.StubGamePlayer{}, nil
 	}
-	removeExceptCalls := make(chan []common.Address)
-	disk := &trackingDiskManager{removeExceptCalls: removeExceptCalls}
-	s := NewScheduler(logger, metrics.NoopMetrics, disk, 2, createPlayer)
+	pruneCalls := make(chan []GameDisk)
+	disk := &trackingDiskManager{pruneCalls: pruneCalls}
+	s := NewScheduler(logger, metrics.NoopMetrics, clock.SystemClock, disk, 2, createPlayer)
 
 	// Scheduler not started - first call fills the queue
 	require.NoError(t, s.Schedule(asGames(common.Address{0xaa})))
@@ -59,15 +65,37 @@ func TestReturnBusyWhenScheduleQueueFull(t *testing.T) {
 	require.ErrorIs(t, err, ErrBusy)
 }
 
+func TestQueueDepth(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	createPlayer := func(game types.GameMetadata, dir string) (GamePlayer, error) {
+		return &test.StubGamePlayer{}, nil
+	}
+	s := NewScheduler(logger, metrics.NoopMetrics, clock.SystemClock, &trackingDiskManager{}, 2, createPlayer)
+	require.Zero(t, s.QueueDepth())
+
+	// Workers are not started so scheduled updates remain queued
+	require.NoError(t, s.coordinator.schedule(context.Background(), asGames(common.Address{0xaa}, common.Address{0xbb})))
+	require.Equal(t, 2, s.QueueDepth())
+}
+
+func TestCloseBeforeStart(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	createPlayer := func(game types.GameMetadata, dir string) (GamePlayer, error) {
+		return &test.StubGamePlayer{}, nil
+	}
+	s := NewScheduler(logger, metrics.NoopMetrics, clock.SystemClock, &trackingDiskManager{}, 2, createPlayer)
+	require.NoError(t, s.Close())
+}
+
 type trackingDiskManager struct {
-	removeExceptCalls chan []common.Address
+	pruneCalls chan []GameDisk
 }
 
 func (t *trackingDiskManager) DirForGame(addr common.Address) string {
 	return addr.Hex()
 }
 
-func (t *trackingDiskManager) RemoveAllExcept(addrs []common.Address) error {
-	t.removeExceptCalls <- addrs
+func (t *trackingDiskManager) Prune(games []GameDisk) error {
+	t.pruneCalls <- games
 	return nil
 }
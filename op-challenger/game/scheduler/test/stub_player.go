@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,6 +14,8 @@ type StubGamePlayer struct {
 	StatusValue   types.GameStatus
 	Dir           string
 	PrestateErr   error
+	Deadline      time.Time
+	ForecastValue *types.Forecast
 }
 
 func (g *StubGamePlayer) ValidatePrestate(_ context.Context) error {
@@ -27,3 +30,14 @@ func (g *StubGamePlayer) ProgressGame(_ context.Context) types.GameStatus {
 func (g *StubGamePlayer) Status() types.GameStatus {
 	return g.StatusValue
 }
+
+func (g *StubGamePlayer) ClockDeadline() (time.Time, bool) {
+	return g.Deadline, !g.Deadline.IsZero()
+}
+
+func (g *StubGamePlayer) Forecast() (types.Forecast, bool) {
+	if g.ForecastValue == nil {
+		return types.Forecast{}, false
+	}
+	return *g.ForecastValue, true
+}
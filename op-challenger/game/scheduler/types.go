@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -12,11 +13,28 @@ type GamePlayer interface {
 	ValidatePrestate(ctx context.Context) error
 	ProgressGame(ctx context.Context) types.GameStatus
 	Status() types.GameStatus
+	// ClockDeadline returns the earliest time at which a chess clock the player needs to respond to expires.
+	// Returns false if the player has nothing it still needs to respond to.
+	ClockDeadline() (time.Time, bool)
+	// Forecast returns the forecast of the game's result from the last time the player evaluated the game.
+	// Returns false if the game has not been evaluated.
+	Forecast() (types.Forecast, bool)
+}
+
+// GameDisk is a tracked game whose data is managed by the DiskManager.
+type GameDisk struct {
+	Game   types.GameMetadata
+	Status types.GameStatus
+	// InUse is true if the game is being progressed so its data must not be removed.
+	InUse bool
 }
 
 type DiskManager interface {
 	DirForGame(addr common.Address) string
-	RemoveAllExcept(addrs []common.Address) error
+	// Prune removes the data of resolved games that are not in use and of games that are no longer tracked.
+	// If a disk usage budget is set, the data of the oldest in-progress games that are not in use is evicted until
+	// the remaining data is within it.
+	Prune(games []GameDisk) error
 }
 
 type job struct {
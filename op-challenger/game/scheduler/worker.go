@@ -7,7 +7,8 @@ import (
 
 // progressGames accepts jobs from in channel, calls ProgressGame on the job.player and returns the job
 // with updated job.resolved via the out channel.
-// The loop exits when the ctx is done.  wg.Done() is called when the function returns.
+// The loop exits when the ctx is done, without waiting for the result of an in-progress job to be accepted.
+// wg.Done() is called when the function returns.
 func progressGames(ctx context.Context, in <-chan job, out chan<- job, wg *sync.WaitGroup, threadActive, threadIdle func()) {
 	defer wg.Done()
 	for {
@@ -17,7 +18,13 @@ func progressGames(ctx context.Context, in <-chan job, out chan<- job, wg *sync.
 		case j := <-in:
 			threadActive()
 			j.status = j.player.ProgressGame(ctx)
-			out <- j
+			select {
+			case out <- j:
+			case <-ctx.Done():
+				// Shutting down so the result will never be processed.
+				threadIdle()
+				return
+			}
 			threadIdle()
 		}
 	}
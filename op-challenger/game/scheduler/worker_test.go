@@ -56,6 +56,30 @@ func TestWorkerShouldProcessJobsUntilContextDone(t *testing.T) {
 	wg.Wait()
 }
 
+func TestWorkerShouldExitWhenResultNotAccepted(t *testing.T) {
+	in := make(chan job, 1)
+	// Nothing reads from out, as happens when the scheduler loop has already exited during shutdown.
+	out := make(chan job)
+
+	ms := &metricSink{}
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go progressGames(ctx, in, out, &wg, ms.ThreadActive, ms.ThreadIdle)
+
+	in <- job{
+		player: &test.StubGamePlayer{StatusValue: types.GameStatusInProgress},
+	}
+	waitErr := wait.For(context.Background(), 100*time.Millisecond, func() (bool, error) {
+		return ms.activeCalls.Load() >= 1, nil
+	})
+	require.NoError(t, waitErr)
+
+	cancel()
+	wg.Wait()
+	require.EqualValues(t, 1, ms.idleCalls.Load())
+}
+
 type metricSink struct {
 	activeCalls atomic.Int32
 	idleCalls   atomic.Int32
@@ -5,30 +5,46 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync/atomic"
 
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/archive"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/responder"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/loader"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/notify"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/registry"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
 	"github.com/ethereum-optimism/optimism/op-challenger/version"
 	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/handoff"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
+	"github.com/ethereum-optimism/optimism/op-service/kvstore"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
+// gameStateDir is the directory within the datadir that the game state store is kept in.
+const gameStateDir = "state"
+
 type Service struct {
 	logger  log.Logger
 	metrics metrics.Metricer
@@ -36,20 +52,32 @@ type Service struct {
 	sched   *scheduler.Scheduler
 
 	faultGamesCloser fault.CloseFunc
+	incidentMode     *fault.IncidentMode
+	kvStore          *kvstore.Store
+	gameStore        *store.Store
 
-	txMgr *txmgr.SimpleTxManager
+	signers  *responder.SignerPool
+	notifier *notify.Dispatcher
+	// handoff passes listeners to a new process when upgrading. It is nil if graceful upgrades are disabled.
+	handoff *handoff.Handoff
 
-	loader *loader.GameLoader
+	loader   gameSource
+	registry *registry.GameTypeRegistry
 
 	rollupClient *sources.RollupClient
 
 	l1Client   *ethclient.Client
+	l1WsClient *ethclient.Client
 	pollClient client.RPC
 
 	pprofSrv   *httputil.HTTPServer
 	metricsSrv *httputil.HTTPServer
+	rpcServer  *oprpc.Server
+
+	tracingShutdown optracing.ShutdownFunc
 
-	balanceMetricer io.Closer
+	balanceMetricer        io.Closer
+	signerBalanceMetricers []io.Closer
 
 	stopped atomic.Bool
 }
@@ -70,12 +98,23 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 }
 
 func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error {
+	if cfg.GracefulUpgrade {
+		s.handoff = handoff.Process()
+	}
+	if cfg.SelfTest {
+		if err := fault.RunSelfTest(ctx, s.logger); err != nil {
+			return err
+		}
+	}
 	if err := s.initTxManager(cfg); err != nil {
 		return err
 	}
 	if err := s.initL1Client(ctx, cfg); err != nil {
 		return err
 	}
+	if err := s.initL1WsClient(ctx, cfg); err != nil {
+		return err
+	}
 	if err := s.initRollupClient(ctx, cfg); err != nil {
 		return err
 	}
@@ -88,14 +127,26 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 	if err := s.initMetricsServer(&cfg.MetricsConfig); err != nil {
 		return err
 	}
+	if err := s.initTracing(ctx, &cfg.TracingConfig); err != nil {
+		return err
+	}
 	if err := s.initGameLoader(cfg); err != nil {
 		return err
 	}
+	s.initNotifier(cfg)
+	if err := s.waitForParent(ctx); err != nil {
+		return err
+	}
 	if err := s.initScheduler(ctx, cfg); err != nil {
 		return err
 	}
+	if err := s.initRPCServer(cfg); err != nil {
+		return err
+	}
 
-	s.initMonitor(cfg)
+	if err := s.initMonitor(cfg); err != nil {
+		return err
+	}
 
 	s.metrics.RecordInfo(version.SimpleWithMeta)
 	s.metrics.RecordUp()
@@ -103,11 +154,31 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 }
 
 func (s *Service) initTxManager(cfg *config.Config) error {
+	if cfg.DryRun {
+		s.logger.Warn("Running in dry-run mode. Transactions will be logged but not sent")
+		return nil
+	}
+	if cfg.ReadOnly() {
+		s.logger.Warn("Running in read-only mode. Games will be monitored but not responded to", "monitorOnly", cfg.MonitorOnly)
+		return nil
+	}
 	txMgr, err := txmgr.NewSimpleTxManager("challenger", s.logger, s.metrics, cfg.TxMgrConfig)
 	if err != nil {
 		return fmt.Errorf("failed to create the transaction manager: %w", err)
 	}
-	s.txMgr = txMgr
+	txMgrs := []txmgr.TxManager{txMgr}
+	for i, txMgrCfg := range cfg.AdditionalTxMgrConfigs() {
+		txMgr, err := txmgr.NewSimpleTxManager("challenger", s.logger, s.metrics, txMgrCfg)
+		if err != nil {
+			responder.NewSignerPool(txMgrs...).Close()
+			return fmt.Errorf("failed to create the transaction manager for additional signer %v: %w", i, err)
+		}
+		txMgrs = append(txMgrs, txMgr)
+	}
+	s.signers = responder.NewSignerPool(txMgrs...)
+	if len(txMgrs) > 1 {
+		s.logger.Info("Distributing games across signers", "signers", s.signers.Addresses())
+	}
 	return nil
 }
 
@@ -120,6 +191,18 @@ func (s *Service) initL1Client(ctx context.Context, cfg *config.Config) error {
 	return nil
 }
 
+func (s *Service) initL1WsClient(ctx context.Context, cfg *config.Config) error {
+	if cfg.L1EthWs == "" {
+		return nil
+	}
+	l1WsClient, err := dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, s.logger, cfg.L1EthWs)
+	if err != nil {
+		return fmt.Errorf("failed to dial L1 websocket: %w", err)
+	}
+	s.l1WsClient = l1WsClient
+	return nil
+}
+
 func (s *Service) initPollClient(ctx context.Context, cfg *config.Config) error {
 	pollClient, err := client.NewRPCWithClient(ctx, s.logger, cfg.L1EthRpc, client.NewBaseRPCClient(s.l1Client.Client()), cfg.PollInterval)
 	if err != nil {
@@ -129,12 +212,39 @@ func (s *Service) initPollClient(ctx context.Context, cfg *config.Config) error
 	return nil
 }
 
+// httpOptions returns the options for the service's HTTP servers, reusing listeners inherited from the previous
+// process if graceful upgrades are enabled.
+func (s *Service) httpOptions() []httputil.HTTPOption {
+	if s.handoff == nil {
+		return nil
+	}
+	return []httputil.HTTPOption{httputil.WithListenFunc(s.handoff.Listen)}
+}
+
+// waitForParent completes a graceful upgrade by waiting for the process being upgraded from, if any, to stop and
+// release the datadir. Everything that doesn't require the datadir is set up first to minimise the time that games
+// aren't being progressed.
+func (s *Service) waitForParent(ctx context.Context) error {
+	if s.handoff == nil || !s.handoff.HasParent() {
+		return nil
+	}
+	if err := s.handoff.Ready(); err != nil {
+		return err
+	}
+	s.logger.Info("Waiting for previous process to stop")
+	if err := s.handoff.WaitForParent(ctx); err != nil {
+		return fmt.Errorf("failed to wait for previous process: %w", err)
+	}
+	s.logger.Info("Previous process stopped, taking over")
+	return nil
+}
+
 func (s *Service) initPProfServer(cfg *oppprof.CLIConfig) error {
 	if !cfg.Enabled {
 		return nil
 	}
 	s.logger.Debug("starting pprof", "addr", cfg.ListenAddr, "port", cfg.ListenPort)
-	pprofSrv, err := oppprof.StartServer(cfg.ListenAddr, cfg.ListenPort)
+	pprofSrv, err := oppprof.StartServer(cfg.ListenAddr, cfg.ListenPort, s.httpOptions()...)
 	if err != nil {
 		return fmt.Errorf("failed to start pprof server: %w", err)
 	}
@@ -152,23 +262,49 @@ func (s *Service) initMetricsServer(cfg *opmetrics.CLIConfig) error {
 	if !ok {
 		return fmt.Errorf("metrics were enabled, but metricer %T does not expose registry for metrics-server", s.metrics)
 	}
-	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.ListenAddr, cfg.ListenPort)
+	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.ListenAddr, cfg.ListenPort, s.httpOptions()...)
 	if err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
 	}
 	s.logger.Info("started metrics server", "addr", metricsSrv.Addr())
 	s.metricsSrv = metricsSrv
-	s.balanceMetricer = s.metrics.StartBalanceMetrics(s.logger, s.l1Client, s.txMgr.From())
+	if s.signers != nil {
+		s.balanceMetricer = s.metrics.StartBalanceMetrics(s.logger, s.l1Client, s.signers.Primary().From())
+		for _, signer := range s.signers.Addresses() {
+			if closer := s.metrics.StartSignerBalanceMetrics(s.logger, s.l1Client, signer); closer != nil {
+				s.signerBalanceMetricers = append(s.signerBalanceMetricers, closer)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) initTracing(ctx context.Context, cfg *optracing.CLIConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	s.logger.Debug("starting tracing", "endpoint", cfg.Endpoint)
+	shutdown, err := optracing.Start(ctx, *cfg, "op-challenger", version.SimpleWithMeta)
+	if err != nil {
+		return fmt.Errorf("failed to start tracing: %w", err)
+	}
+	s.tracingShutdown = shutdown
+	s.logger.Info("started tracing", "endpoint", cfg.Endpoint, "sample_ratio", cfg.SampleRatio)
 	return nil
 }
 
 func (s *Service) initGameLoader(cfg *config.Config) error {
 	factoryContract, err := contracts.NewDisputeGameFactoryContract(cfg.GameFactoryAddress,
-		batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
+		batching.NewMultiCaller(s.l1Client.Client(), int(cfg.L1BatchSize)))
 	if err != nil {
 		return fmt.Errorf("failed to bind the fault dispute game factory contract: %w", err)
 	}
-	s.loader = loader.NewGameLoader(factoryContract)
+	if cfg.GameFactoryStartBlock != 0 {
+		s.logger.Info("Discovering games from factory events", "startBlock", cfg.GameFactoryStartBlock)
+		s.loader = loader.NewEventGameLoader(factoryContract, s.l1Client, cfg.GameFactoryStartBlock)
+	} else {
+		s.loader = loader.NewGameLoader(factoryContract)
+	}
 	return nil
 }
 
@@ -184,23 +320,153 @@ func (s *Service) initRollupClient(ctx context.Context, cfg *config.Config) erro
 	return nil
 }
 
+func (s *Service) initNotifier(cfg *config.Config) {
+	var notifiers []notify.Notifier
+	if cfg.NotifyWebhookURL != "" {
+		notifiers = append(notifiers, notify.NewWebhookNotifier(cfg.NotifyWebhookURL))
+	}
+	if cfg.NotifySlackWebhookURL != "" {
+		notifiers = append(notifiers, notify.NewSlackNotifier(cfg.NotifySlackWebhookURL))
+	}
+	if len(notifiers) == 0 {
+		return
+	}
+	s.notifier = notify.NewDispatcher(s.logger, notifiers...)
+}
+
 func (s *Service) initScheduler(ctx context.Context, cfg *config.Config) error {
 	gameTypeRegistry := registry.NewGameTypeRegistry()
-	caller := batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize)
-	closer, err := fault.RegisterGameTypes(gameTypeRegistry, ctx, s.logger, s.metrics, cfg, s.rollupClient, s.txMgr, caller)
+	s.registry = gameTypeRegistry
+	caller := batching.NewMultiCaller(s.l1Client.Client(), int(cfg.L1BatchSize))
+	signers := s.signers
+	if cfg.DryRun {
+		signers = responder.NewSignerPool(responder.NewDryRunTxManager(s.logger, common.Address{}, s.l1Client))
+	}
+	s.incidentMode = fault.NewIncidentMode(cfg.IncidentMode)
+	if cfg.IncidentMode {
+		s.logger.Warn("Starting in incident mode, offensive moves frozen")
+	}
+	if err := prepareDatadir(s.logger, cfg.Datadir); err != nil {
+		return fmt.Errorf("failed to prepare datadir: %w", err)
+	}
+	gameStore, err := s.openGameStore(cfg)
+	if err != nil {
+		return err
+	}
+	var archiver fault.GameArchiver
+	if cfg.ArchiveURL != "" {
+		archiver = archive.NewArchiver(s.logger, archive.NewHTTPObjectStore(cfg.ArchiveURL, cfg.ArchiveAuthToken))
+	}
+	var notifier notify.Notifier = notify.NoopNotifier{}
+	if s.notifier != nil {
+		notifier = s.notifier
+	}
+	gameNotifier := notifier
+	if cfg.DryRun {
+		// Moves are never sent in dry-run mode so must not be notified as submitted.
+		gameNotifier = notify.NoopNotifier{}
+	}
+	closer, err := fault.RegisterGameTypes(gameTypeRegistry, ctx, s.logger, s.metrics, cfg, s.rollupClient, signers, caller, s.l1Client, s.l1Client, s.l1Client, s.incidentMode, gameStore, archiver, gameNotifier)
 	if err != nil {
 		return err
 	}
 	s.faultGamesCloser = closer
 
-	disk := newDiskManager(cfg.Datadir)
-	s.sched = scheduler.NewScheduler(s.logger, s.metrics, disk, cfg.MaxConcurrency, gameTypeRegistry.CreatePlayer)
+	disk := newDiskManager(s.logger, s.metrics, clock.SystemClock, cfg.Datadir).WithMaxUsage(int64(cfg.MaxDiskUsage))
+	s.sched = scheduler.NewScheduler(s.logger, s.metrics, clock.SystemClock, disk, cfg.MaxConcurrency, gameTypeRegistry.CreatePlayer).
+		WithRefreshInterval(cfg.ClaimRefreshInterval).
+		WithNotifier(notifier)
 	return nil
 }
 
-func (s *Service) initMonitor(cfg *config.Config) {
+// openGameStore opens the store used to persist game state across restarts.
+// In dry-run mode the game state is only kept in memory. Moves are never sent so must not be recorded as confirmed in
+// the persisted game state, but the state of the games being played is still served by the admin RPC.
+func (s *Service) openGameStore(cfg *config.Config) (*store.Store, error) {
+	var kv *kvstore.Store
+	var err error
+	if cfg.DryRun {
+		kv, err = kvstore.OpenInMemory(s.logger, s.metrics)
+	} else {
+		kv, err = kvstore.Open(s.logger, s.metrics, filepath.Join(cfg.Datadir, gameStateDir))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open game state store: %w", err)
+	}
+	s.kvStore = kv
+	gameStore, err := store.NewStore(kv)
+	if err != nil {
+		return nil, err
+	}
+	games, err := gameStore.Games()
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Loaded game state store", "games", len(games))
+	s.gameStore = gameStore
+	return gameStore, nil
+}
+
+// initRPCServer starts the RPC server if the admin API is enabled.
+// The gas used by the challenger's transactions is also served, combined across every signer in the pool.
+func (s *Service) initRPCServer(cfg *config.Config) error {
+	rpcCfg := cfg.RPCConfig
+	if !rpcCfg.EnableAdmin {
+		return nil
+	}
+	opts := []oprpc.ServerOption{oprpc.WithLogger(s.logger)}
+	if s.handoff != nil {
+		opts = append(opts, oprpc.WithListenFunc(s.handoff.Listen))
+	}
+	if cfg.RPCJWTSecretPath != "" {
+		secret, err := readJWTSecret(cfg.RPCJWTSecretPath)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, oprpc.WithJWTSecret(secret))
+	} else {
+		s.logger.Warn("Admin RPC enabled without JWT authentication, restrict access to the RPC port")
+	}
+	server := oprpc.NewServer(rpcCfg.ListenAddr, rpcCfg.ListenPort, version.SimpleWithMeta, opts...)
+	server.AddAPI(rpc.GetAdminAPI(rpc.NewAdminAPI(s.incidentMode, s.gameStore, s.sched, s.metrics, s.logger)))
+	if s.signers != nil {
+		server.AddAPI(txmgr.GetGasUsageAPI(s.signers))
+	}
+	s.logger.Debug("starting RPC server", "addr", rpcCfg.ListenAddr, "port", rpcCfg.ListenPort)
+	if err := server.Start(); err != nil {
+		return fmt.Errorf("failed to start RPC server: %w", err)
+	}
+	s.rpcServer = server
+	s.logger.Info("started RPC server", "endpoint", server.Endpoint())
+	return nil
+}
+
+// readJWTSecret reads the hex-encoded 32 byte JWT secret from path.
+func readJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read RPC JWT secret: %w", err)
+	}
+	secret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("invalid RPC JWT secret in path %s, not 32 hex-formatted bytes", path)
+	}
+	return secret, nil
+}
+
+func (s *Service) initMonitor(cfg *config.Config) error {
 	cl := clock.SystemClock
-	s.monitor = newGameMonitor(s.logger, cl, s.loader, s.sched, cfg.GameWindow, s.l1Client.BlockNumber, cfg.GameAllowlist, s.pollClient)
+	s.monitor = newGameMonitor(s.logger, cl, s.loader, s.sched, cfg.GameWindow, s.l1Client.BlockNumber, cfg.GameAllowlist, s.registry.Supports, s.pollClient).
+		WithDiscoveryInterval(cfg.GameDiscoveryInterval)
+	if s.l1WsClient != nil {
+		query, err := contracts.MoveEventQuery()
+		if err != nil {
+			return err
+		}
+		s.monitor.WithMoveEvents(s.l1WsClient, query)
+		s.logger.Info("Subscribing to move events", "url", cfg.L1EthWs)
+	}
+	return nil
 }
 
 func (s *Service) Start(ctx context.Context) error {
@@ -231,19 +497,42 @@ func (s *Service) Stop(ctx context.Context) error {
 	if s.faultGamesCloser != nil {
 		s.faultGamesCloser()
 	}
+	if s.notifier != nil {
+		s.notifier.Close()
+	}
+	if s.kvStore != nil {
+		if err := s.kvStore.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close game state store: %w", err))
+		}
+	}
+	if s.rpcServer != nil {
+		if err := s.rpcServer.Stop(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close RPC server: %w", err))
+		}
+	}
 	if s.pprofSrv != nil {
 		if err := s.pprofSrv.Stop(ctx); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close pprof server: %w", err))
 		}
 	}
+	if s.tracingShutdown != nil {
+		if err := s.tracingShutdown(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to shutdown tracing: %w", err))
+		}
+	}
 	if s.balanceMetricer != nil {
 		if err := s.balanceMetricer.Close(); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close balance metricer: %w", err))
 		}
 	}
+	for _, closer := range s.signerBalanceMetricers {
+		if err := closer.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close signer balance metricer: %w", err))
+		}
+	}
 
-	if s.txMgr != nil {
-		s.txMgr.Close()
+	if s.signers != nil {
+		s.signers.Close()
 	}
 
 	if s.rollupClient != nil {
@@ -252,6 +541,9 @@ func (s *Service) Stop(ctx context.Context) error {
 	if s.pollClient != nil {
 		s.pollClient.Close()
 	}
+	if s.l1WsClient != nil {
+		s.l1WsClient.Close()
+	}
 	if s.l1Client != nil {
 		s.l1Client.Close()
 	}
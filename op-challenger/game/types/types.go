@@ -1,11 +1,16 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// ErrPrestateMismatch is returned when the absolute prestate of a game does not match the locally configured prestate.
+// Playing a game with the wrong prestate guarantees losing bonds, so such games must not be acted on.
+var ErrPrestateMismatch = errors.New("local prestate does not match the game's absolute prestate")
+
 type GameStatus uint8
 
 const (
@@ -41,3 +46,16 @@ type GameMetadata struct {
 	Timestamp uint64
 	Proxy     common.Address
 }
+
+// Forecast predicts the result of a game.
+type Forecast struct {
+	// Expected is the status the game should resolve with according to the local trace provider.
+	Expected GameStatus
+	// Projected is the status the game would resolve with if no further claims were posted.
+	Projected GameStatus
+}
+
+// Incorrect returns true if the game would currently resolve with a different status than expected.
+func (f Forecast) Incorrect() bool {
+	return f.Projected != f.Expected
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import "github.com/ethereum/go-ethereum/common"
+
+// GameMetricer records metrics for the trace providers of a single game.
+type GameMetricer interface {
+	Metricer
+	RecordProofCacheLookup(hit bool)
+}
+
+type gameMetrics struct {
+	Metricer
+	game common.Address
+}
+
+// ForGame returns a GameMetricer that attributes per-game metrics to game.
+func ForGame(m Metricer, game common.Address) GameMetricer {
+	return &gameMetrics{
+		Metricer: m,
+		game:     game,
+	}
+}
+
+func (g *gameMetrics) RecordProofCacheLookup(hit bool) {
+	g.RecordCannonProofCacheLookup(g.game, hit)
+}
@@ -1,15 +1,22 @@
 package metrics
 
 import (
+	"context"
 	"io"
+	"math/big"
+	"sync"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
+	"github.com/ethereum-optimism/optimism/op-service/kvstore"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 )
@@ -21,6 +28,7 @@ type Metricer interface {
 	RecordUp()
 
 	StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer
+	StartSignerBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer
 
 	// Record Tx metrics
 	txmetrics.TxMetricer
@@ -28,11 +36,29 @@ type Metricer interface {
 	// Record cache metrics
 	caching.Metrics
 
+	opmetrics.RPCMetricer
+
+	// Record game store metrics
+	kvstore.Metricer
+
 	RecordGameStep()
 	RecordGameMove()
+	RecordGameActionResult(action string, landed bool)
+	RecordClaimResolved()
+	RecordGameLosingAtMaxDepth()
+	RecordMoveBeaten()
+	RecordPreimageUploaded(local bool)
+	RecordSolveTime(t float64)
 	RecordCannonExecutionTime(t float64)
+	RecordCannonMemoryUsage(bytes uint64)
+	RecordCannonMemoryLimitExceeded()
+	RecordCannonProofCacheLookup(game common.Address, hit bool)
+	RecordGameDiskUsage(usage map[common.Address]GameDiskUsage)
 
 	RecordGamesStatus(inProgress, defenderWon, challengerWon int)
+	RecordMinClockRemaining(remaining float64)
+	RecordPrestateMismatches(count int)
+	RecordIncorrectForecasts(inProgress, resolved int)
 
 	RecordGameUpdateScheduled()
 	RecordGameUpdateCompleted()
@@ -51,19 +77,71 @@ type Metrics struct {
 	txmetrics.TxMetrics
 
 	*opmetrics.CacheMetrics
+	opmetrics.RPCMetrics
+
+	*kvstore.Metrics
 
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
 
+	signerBalance prometheus.GaugeVec
+
 	executors prometheus.GaugeVec
 
-	moves prometheus.Counter
-	steps prometheus.Counter
+	moves            prometheus.Counter
+	steps            prometheus.Counter
+	actionResults    prometheus.CounterVec
+	claimsResolved   prometheus.Counter
+	losingAtMaxDepth prometheus.Counter
+	movesBeaten      prometheus.Counter
+	preimages        prometheus.CounterVec
+	solveTime        prometheus.Histogram
 
 	cannonExecutionTime prometheus.Histogram
+	cannonMemoryUsage   prometheus.Histogram
+	cannonMemoryLimited prometheus.Counter
+
+	trackedGames      prometheus.GaugeVec
+	inflightGames     prometheus.Gauge
+	minClockRemaining prometheus.Gauge
+	prestateMismatch  prometheus.Gauge
+	incorrectGames    prometheus.GaugeVec
+
+	cannonDatadirBytes           prometheus.Gauge
+	gameCannonDatadirBytes       prometheus.GaugeVec
+	cannonSnapshots              prometheus.Gauge
+	gameCannonSnapshots          prometheus.GaugeVec
+	cannonProofs                 prometheus.Gauge
+	gameCannonProofs             prometheus.GaugeVec
+	cannonProofCacheHitRatio     prometheus.Gauge
+	gameCannonProofCacheHitRatio prometheus.GaugeVec
+
+	// gameStatsLock guards the per-game state used to calculate cache hit ratios and remove metrics for games that
+	// are no longer tracked.
+	gameStatsLock sync.Mutex
+	cacheLookups  map[common.Address]*cacheLookups
+	totalLookups  cacheLookups
+	diskGames     map[common.Address]bool
+}
+
+// GameDiskUsage is the disk space used by the trace data of a single game.
+type GameDiskUsage struct {
+	Bytes     int64
+	Snapshots int
+	Proofs    int
+}
 
-	trackedGames  prometheus.GaugeVec
-	inflightGames prometheus.Gauge
+type cacheLookups struct {
+	hits   uint64
+	misses uint64
+}
+
+func (c *cacheLookups) hitRatio() float64 {
+	total := c.hits + c.misses
+	if total == 0 {
+		return 0
+	}
+	return float64(c.hits) / float64(total)
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -81,6 +159,10 @@ func NewMetrics() *Metrics {
 
 		CacheMetrics: opmetrics.NewCacheMetrics(factory, Namespace, "provider_cache", "Provider cache"),
 
+		RPCMetrics: opmetrics.MakeRPCMetrics(Namespace, factory),
+
+		Metrics: kvstore.MakeMetrics(Namespace, factory),
+
 		info: *factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: Namespace,
 			Name:      "info",
@@ -93,6 +175,13 @@ func NewMetrics() *Metrics {
 			Name:      "up",
 			Help:      "1 if the op-challenger has finished starting up",
 		}),
+		signerBalance: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "signer_balance",
+			Help:      "Balance (in ether) of each signer used to send transactions",
+		}, []string{
+			"signer",
+		}),
 		executors: *factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: Namespace,
 			Name:      "executors",
@@ -110,6 +199,42 @@ func NewMetrics() *Metrics {
 			Name:      "steps",
 			Help:      "Number of game steps made by the challenge agent",
 		}),
+		actionResults: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "action_results",
+			Help:      "Number of game moves and steps attempted by the challenge agent by whether they landed or failed",
+		}, []string{
+			"action",
+			"result",
+		}),
+		claimsResolved: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "claims_resolved",
+			Help:      "Number of claims resolved by the challenge agent",
+		}),
+		losingAtMaxDepth: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "games_losing_at_max_depth",
+			Help:      "Number of games in which all honest claims at max depth were countered or steps failed",
+		}),
+		movesBeaten: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "moves_beaten",
+			Help:      "Number of pending moves cancelled because the same claim was posted by another sender first",
+		}),
+		preimages: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "preimages_uploaded",
+			Help:      "Number of pre-images uploaded to the pre-image oracle by the challenge agent",
+		}, []string{
+			"type",
+		}),
+		solveTime: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "solve_time",
+			Help:      "Time (in seconds) to calculate the next actions for a game",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2.0, 16),
+		}),
 		cannonExecutionTime: factory.NewHistogram(prometheus.HistogramOpts{
 			Namespace: Namespace,
 			Name:      "cannon_execution_time",
@@ -118,6 +243,17 @@ func NewMetrics() *Metrics {
 				[]float64{1.0, 10.0},
 				prometheus.ExponentialBuckets(30.0, 2.0, 14)...),
 		}),
+		cannonMemoryUsage: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "cannon_memory_bytes",
+			Help:      "Peak resident memory (in bytes) of each cannon execution, including op-program",
+			Buckets:   prometheus.ExponentialBuckets(128*1024*1024, 2.0, 10),
+		}),
+		cannonMemoryLimited: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "cannon_memory_limit_exceeded",
+			Help:      "Number of cannon executions killed for exceeding the memory limit",
+		}),
 		trackedGames: *factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: Namespace,
 			Name:      "tracked_games",
@@ -130,6 +266,73 @@ func NewMetrics() *Metrics {
 			Name:      "inflight_games",
 			Help:      "Number of games being tracked by the challenger",
 		}),
+		minClockRemaining: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "min_clock_remaining",
+			Help:      "Shortest time (in seconds) remaining on any chess clock the challenger still needs to respond to, +Inf if none",
+		}),
+		prestateMismatch: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "prestate_mismatch_games",
+			Help:      "Number of tracked games not played because their absolute prestate does not match the local prestate",
+		}),
+		incorrectGames: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "incorrect_games",
+			Help:      "Number of tracked games heading toward, or resolved with, a different result than the local trace provider expects",
+		}, []string{
+			"status",
+		}),
+		cannonDatadirBytes: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cannon_datadir_bytes",
+			Help:      "Disk space used by the trace data of all tracked games",
+		}),
+		gameCannonDatadirBytes: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_cannon_datadir_bytes",
+			Help:      "Disk space used by the trace data of each tracked game",
+		}, []string{
+			"game",
+		}),
+		cannonSnapshots: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cannon_snapshots",
+			Help:      "Number of cannon snapshots stored for all tracked games",
+		}),
+		gameCannonSnapshots: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_cannon_snapshots",
+			Help:      "Number of cannon snapshots stored for each tracked game",
+		}, []string{
+			"game",
+		}),
+		cannonProofs: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cannon_proofs",
+			Help:      "Number of cannon proofs generated for all tracked games",
+		}),
+		gameCannonProofs: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_cannon_proofs",
+			Help:      "Number of cannon proofs generated for each tracked game",
+		}, []string{
+			"game",
+		}),
+		cannonProofCacheHitRatio: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cannon_proof_cache_hit_ratio",
+			Help:      "Ratio of cannon proof lookups served from disk without executing cannon for all tracked games",
+		}),
+		gameCannonProofCacheHitRatio: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_cannon_proof_cache_hit_ratio",
+			Help:      "Ratio of cannon proof lookups served from disk without executing cannon for each tracked game",
+		}, []string{
+			"game",
+		}),
+		cacheLookups: make(map[common.Address]*cacheLookups),
+		diskGames:    make(map[common.Address]bool),
 	}
 }
 
@@ -145,6 +348,30 @@ func (m *Metrics) StartBalanceMetrics(
 	return opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
 }
 
+// StartSignerBalanceMetrics periodically records the balance of account to the signer_balance metric, labelled by the
+// account, so the balance of each signer in a pool is reported separately.
+func (m *Metrics) StartSignerBalanceMetrics(
+	l log.Logger,
+	client *ethclient.Client,
+	account common.Address,
+) io.Closer {
+	gauge := m.signerBalance.WithLabelValues(account.Hex())
+	return clock.NewLoopFn(clock.SystemClock, func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+		defer cancel()
+		balance, err := client.BalanceAt(ctx, account, nil)
+		if err != nil {
+			l.Warn("Failed to get balance of signer", "err", err, "signer", account)
+			return
+		}
+		ether, _ := new(big.Rat).SetFrac(balance, big.NewInt(params.Ether)).Float64()
+		gauge.Set(ether)
+	}, func() error {
+		m.signerBalance.DeleteLabelValues(account.Hex())
+		return nil
+	}, 10*time.Second)
+}
+
 // RecordInfo sets a pseudo-metric that contains versioning and
 // config info for the op-proposer.
 func (m *Metrics) RecordInfo(version string) {
@@ -169,10 +396,50 @@ func (m *Metrics) RecordGameStep() {
 	m.steps.Add(1)
 }
 
+func (m *Metrics) RecordGameActionResult(action string, landed bool) {
+	result := "landed"
+	if !landed {
+		result = "failed"
+	}
+	m.actionResults.WithLabelValues(action, result).Inc()
+}
+
+func (m *Metrics) RecordClaimResolved() {
+	m.claimsResolved.Inc()
+}
+
+func (m *Metrics) RecordGameLosingAtMaxDepth() {
+	m.losingAtMaxDepth.Inc()
+}
+
+func (m *Metrics) RecordMoveBeaten() {
+	m.movesBeaten.Inc()
+}
+
+func (m *Metrics) RecordPreimageUploaded(local bool) {
+	preimageType := "global"
+	if local {
+		preimageType = "local"
+	}
+	m.preimages.WithLabelValues(preimageType).Inc()
+}
+
+func (m *Metrics) RecordSolveTime(t float64) {
+	m.solveTime.Observe(t)
+}
+
 func (m *Metrics) RecordCannonExecutionTime(t float64) {
 	m.cannonExecutionTime.Observe(t)
 }
 
+func (m *Metrics) RecordCannonMemoryUsage(bytes uint64) {
+	m.cannonMemoryUsage.Observe(float64(bytes))
+}
+
+func (m *Metrics) RecordCannonMemoryLimitExceeded() {
+	m.cannonMemoryLimited.Inc()
+}
+
 func (m *Metrics) IncActiveExecutors() {
 	m.executors.WithLabelValues("active").Inc()
 }
@@ -195,6 +462,19 @@ func (m *Metrics) RecordGamesStatus(inProgress, defenderWon, challengerWon int)
 	m.trackedGames.WithLabelValues("challenger_won").Set(float64(challengerWon))
 }
 
+func (m *Metrics) RecordMinClockRemaining(remaining float64) {
+	m.minClockRemaining.Set(remaining)
+}
+
+func (m *Metrics) RecordPrestateMismatches(count int) {
+	m.prestateMismatch.Set(float64(count))
+}
+
+func (m *Metrics) RecordIncorrectForecasts(inProgress, resolved int) {
+	m.incorrectGames.WithLabelValues("in_progress").Set(float64(inProgress))
+	m.incorrectGames.WithLabelValues("resolved").Set(float64(resolved))
+}
+
 func (m *Metrics) RecordGameUpdateScheduled() {
 	m.inflightGames.Add(1)
 }
@@ -202,3 +482,64 @@ func (m *Metrics) RecordGameUpdateScheduled() {
 func (m *Metrics) RecordGameUpdateCompleted() {
 	m.inflightGames.Sub(1)
 }
+
+func (m *Metrics) RecordCannonProofCacheLookup(game common.Address, hit bool) {
+	m.gameStatsLock.Lock()
+	defer m.gameStatsLock.Unlock()
+	lookups, ok := m.cacheLookups[game]
+	if !ok {
+		lookups = &cacheLookups{}
+		m.cacheLookups[game] = lookups
+	}
+	if hit {
+		lookups.hits++
+		m.totalLookups.hits++
+	} else {
+		lookups.misses++
+		m.totalLookups.misses++
+	}
+	m.gameCannonProofCacheHitRatio.WithLabelValues(game.Hex()).Set(lookups.hitRatio())
+	m.cannonProofCacheHitRatio.Set(m.totalLookups.hitRatio())
+}
+
+// RecordGameDiskUsage records the disk usage of every tracked game.
+// Per-game metrics for any game not included in usage are removed as the game is no longer tracked.
+func (m *Metrics) RecordGameDiskUsage(usage map[common.Address]GameDiskUsage) {
+	m.gameStatsLock.Lock()
+	defer m.gameStatsLock.Unlock()
+	var total GameDiskUsage
+	for game, gameUsage := range usage {
+		label := game.Hex()
+		m.gameCannonDatadirBytes.WithLabelValues(label).Set(float64(gameUsage.Bytes))
+		m.gameCannonSnapshots.WithLabelValues(label).Set(float64(gameUsage.Snapshots))
+		m.gameCannonProofs.WithLabelValues(label).Set(float64(gameUsage.Proofs))
+		total.Bytes += gameUsage.Bytes
+		total.Snapshots += gameUsage.Snapshots
+		total.Proofs += gameUsage.Proofs
+		m.diskGames[game] = true
+	}
+	m.cannonDatadirBytes.Set(float64(total.Bytes))
+	m.cannonSnapshots.Set(float64(total.Snapshots))
+	m.cannonProofs.Set(float64(total.Proofs))
+
+	for game := range m.diskGames {
+		if _, ok := usage[game]; ok {
+			continue
+		}
+		label := game.Hex()
+		m.gameCannonDatadirBytes.DeleteLabelValues(label)
+		m.gameCannonSnapshots.DeleteLabelValues(label)
+		m.gameCannonProofs.DeleteLabelValues(label)
+		delete(m.diskGames, game)
+	}
+	for game, lookups := range m.cacheLookups {
+		if _, ok := usage[game]; ok {
+			continue
+		}
+		m.gameCannonProofCacheHitRatio.DeleteLabelValues(game.Hex())
+		m.totalLookups.hits -= lookups.hits
+		m.totalLookups.misses -= lookups.misses
+		delete(m.cacheLookups, game)
+	}
+	m.cannonProofCacheHitRatio.Set(m.totalLookups.hitRatio())
+}
@@ -2,22 +2,29 @@ package metrics
 
 import (
 	"io"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 )
 
 type NoopMetricsImpl struct {
 	txmetrics.NoopTxMetrics
+	opmetrics.NoopRPCMetrics
 }
 
 func (i *NoopMetricsImpl) StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer {
 	return nil
 }
 
+func (i *NoopMetricsImpl) StartSignerBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer {
+	return nil
+}
+
 var NoopMetrics Metricer = new(NoopMetricsImpl)
 
 func (*NoopMetricsImpl) RecordInfo(version string) {}
@@ -26,9 +33,23 @@ func (*NoopMetricsImpl) RecordUp()                 {}
 func (*NoopMetricsImpl) RecordGameMove() {}
 func (*NoopMetricsImpl) RecordGameStep() {}
 
-func (*NoopMetricsImpl) RecordCannonExecutionTime(t float64) {}
+func (*NoopMetricsImpl) RecordGameActionResult(action string, landed bool) {}
+func (*NoopMetricsImpl) RecordClaimResolved()                              {}
+func (*NoopMetricsImpl) RecordGameLosingAtMaxDepth()                       {}
+func (*NoopMetricsImpl) RecordMoveBeaten()                                 {}
+func (*NoopMetricsImpl) RecordPreimageUploaded(local bool)                 {}
+func (*NoopMetricsImpl) RecordSolveTime(t float64)                         {}
+
+func (*NoopMetricsImpl) RecordCannonExecutionTime(t float64)                        {}
+func (*NoopMetricsImpl) RecordCannonMemoryUsage(bytes uint64)                       {}
+func (*NoopMetricsImpl) RecordCannonMemoryLimitExceeded()                           {}
+func (*NoopMetricsImpl) RecordCannonProofCacheLookup(game common.Address, hit bool) {}
+func (*NoopMetricsImpl) RecordGameDiskUsage(usage map[common.Address]GameDiskUsage) {}
 
 func (*NoopMetricsImpl) RecordGamesStatus(inProgress, defenderWon, challengerWon int) {}
+func (*NoopMetricsImpl) RecordMinClockRemaining(remaining float64)                    {}
+func (*NoopMetricsImpl) RecordPrestateMismatches(count int)                           {}
+func (*NoopMetricsImpl) RecordIncorrectForecasts(inProgress, resolved int)            {}
 
 func (*NoopMetricsImpl) RecordGameUpdateScheduled() {}
 func (*NoopMetricsImpl) RecordGameUpdateCompleted() {}
@@ -38,5 +59,8 @@ func (*NoopMetricsImpl) DecActiveExecutors() {}
 func (*NoopMetricsImpl) IncIdleExecutors()   {}
 func (*NoopMetricsImpl) DecIdleExecutors()   {}
 
+func (*NoopMetricsImpl) RecordOperation(_ string, _ string, _ time.Duration, _ error) {}
+func (*NoopMetricsImpl) RecordDiskUsage(_ uint64)                                     {}
+
 func (*NoopMetricsImpl) CacheAdd(_ string, _ int, _ bool) {}
 func (*NoopMetricsImpl) CacheGet(_ string, _ bool)        {}
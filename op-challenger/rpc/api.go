@@ -0,0 +1,232 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/rpc"
+)
+
+type IncidentMode interface {
+	Enabled() bool
+	SetEnabled(enabled bool)
+}
+
+type Scheduler interface {
+	QueueDepth() int
+}
+
+// GameSummary is the state of a game tracked by the challenger.
+type GameSummary struct {
+	Address      common.Address `json:"address"`
+	Status       string         `json:"status"`
+	Claims       int            `json:"claims"`
+	PendingMoves int            `json:"pendingMoves"`
+}
+
+// GameDetails is the state of a game tracked by the challenger including its claims and the moves made.
+type GameDetails struct {
+	Address common.Address `json:"address"`
+	Status  string         `json:"status"`
+	Claims  []ClaimDetails `json:"claims"`
+	Moves   []store.Move   `json:"moves"`
+}
+
+// ClaimDetails is a claim in a game and the solver's last assessment of it.
+type ClaimDetails struct {
+	Index        int          `json:"index"`
+	ParentIndex  int          `json:"parentIndex"`
+	Depth        int          `json:"depth"`
+	IndexAtDepth *hexutil.Big `json:"indexAtDepth"`
+	Value        common.Hash  `json:"value"`
+	Countered    bool         `json:"countered"`
+	Clock        uint64       `json:"clock"`
+	// Assessment is empty if the claim has not yet been assessed.
+	Assessment types.Assessment `json:"assessment,omitempty"`
+}
+
+// PendingMove is a move the challenger has sent but that has not yet been confirmed.
+type PendingMove struct {
+	Game common.Address `json:"game"`
+	store.Move
+}
+
+type adminAPI struct {
+	*rpc.CommonAdminAPI
+	incidentMode IncidentMode
+	games        *store.Store
+	scheduler    Scheduler
+	log          log.Logger
+}
+
+func NewAdminAPI(incidentMode IncidentMode, games *store.Store, scheduler Scheduler, m metrics.RPCMetricer, log log.Logger) *adminAPI {
+	return &adminAPI{
+		CommonAdminAPI: rpc.NewCommonAdminAPI(m, log),
+		incidentMode:   incidentMode,
+		games:          games,
+		scheduler:      scheduler,
+		log:            log,
+	}
+}
+
+func GetAdminAPI(api *adminAPI) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "admin",
+		Service:   api,
+	}
+}
+
+// EnableIncidentMode freezes offensive moves while continuing defensive moves and resolutions.
+func (a *adminAPI) EnableIncidentMode(_ context.Context) error {
+	recordDur := a.M.RecordRPCServerRequest("admin_enableIncidentMode")
+	defer recordDur()
+	a.log.Warn("Incident mode enabled, offensive moves frozen")
+	a.incidentMode.SetEnabled(true)
+	return nil
+}
+
+// DisableIncidentMode resumes offensive moves.
+func (a *adminAPI) DisableIncidentMode(_ context.Context) error {
+	recordDur := a.M.RecordRPCServerRequest("admin_disableIncidentMode")
+	defer recordDur()
+	a.log.Info("Incident mode disabled, offensive moves resumed")
+	a.incidentMode.SetEnabled(false)
+	return nil
+}
+
+// IncidentMode returns true if incident mode is currently enabled.
+func (a *adminAPI) IncidentMode(_ context.Context) (bool, error) {
+	recordDur := a.M.RecordRPCServerRequest("admin_incidentMode")
+	defer recordDur()
+	return a.incidentMode.Enabled(), nil
+}
+
+// ListGames returns a summary of every game tracked by the challenger, ordered by address.
+func (a *adminAPI) ListGames(_ context.Context) ([]GameSummary, error) {
+	recordDur := a.M.RecordRPCServerRequest("admin_listGames")
+	defer recordDur()
+	games, err := a.games.Games()
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]GameSummary, 0, len(games))
+	for addr, status := range games {
+		game := a.games.Game(addr)
+		claims, err := game.Claims()
+		if err != nil {
+			return nil, err
+		}
+		moves, err := game.Moves()
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, GameSummary{
+			Address:      addr,
+			Status:       status.String(),
+			Claims:       len(claims),
+			PendingMoves: len(pendingMoves(addr, moves)),
+		})
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return bytes.Compare(summaries[i].Address[:], summaries[j].Address[:]) < 0
+	})
+	return summaries, nil
+}
+
+// GetGame returns the claims of a tracked game, the solver's assessment of each claim and the moves made in it.
+// Claims and moves are only retained while the game is in progress.
+func (a *adminAPI) GetGame(_ context.Context, addr common.Address) (*GameDetails, error) {
+	recordDur := a.M.RecordRPCServerRequest("admin_getGame")
+	defer recordDur()
+	game := a.games.Game(addr)
+	status, ok, err := game.Status()
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, fmt.Errorf("game %v is not tracked", addr)
+	}
+	claims, err := game.Claims()
+	if err != nil {
+		return nil, err
+	}
+	assessments, err := game.Assessments()
+	if err != nil {
+		return nil, err
+	}
+	moves, err := game.Moves()
+	if err != nil {
+		return nil, err
+	}
+	details := &GameDetails{
+		Address: addr,
+		Status:  status.String(),
+		Claims:  make([]ClaimDetails, len(claims)),
+		Moves:   moves,
+	}
+	for i, claim := range claims {
+		details.Claims[i] = ClaimDetails{
+			Index:        claim.ContractIndex,
+			ParentIndex:  claim.ParentContractIndex,
+			Depth:        claim.Depth(),
+			IndexAtDepth: (*hexutil.Big)(new(big.Int).Set(claim.IndexAtDepth())),
+			Value:        claim.Value,
+			Countered:    claim.Countered,
+			Clock:        claim.Clock,
+		}
+		// Assessments may lag behind the stored claims if new claims were loaded since the game was last assessed.
+		if i < len(assessments) {
+			details.Claims[i].Assessment = assessments[i]
+		}
+	}
+	return details, nil
+}
+
+// PendingTransactions returns the moves that have been sent in any tracked game but not yet confirmed.
+func (a *adminAPI) PendingTransactions(_ context.Context) ([]PendingMove, error) {
+	recordDur := a.M.RecordRPCServerRequest("admin_pendingTransactions")
+	defer recordDur()
+	games, err := a.games.Games()
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]PendingMove, 0)
+	for addr := range games {
+		moves, err := a.games.Game(addr).Moves()
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, pendingMoves(addr, moves)...)
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return bytes.Compare(pending[i].Game[:], pending[j].Game[:]) < 0
+	})
+	return pending, nil
+}
+
+// SchedulerQueueDepth returns the number of game updates waiting for a worker to become available.
+func (a *adminAPI) SchedulerQueueDepth(_ context.Context) (int, error) {
+	recordDur := a.M.RecordRPCServerRequest("admin_schedulerQueueDepth")
+	defer recordDur()
+	return a.scheduler.QueueDepth(), nil
+}
+
+func pendingMoves(addr common.Address, moves []store.Move) []PendingMove {
+	var pending []PendingMove
+	for _, move := range moves {
+		if move.Status == store.MoveStatusPending {
+			pending = append(pending, PendingMove{Game: addr, Move: move})
+		}
+	}
+	return pending
+}
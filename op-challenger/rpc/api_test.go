@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/store"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/kvstore"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+var (
+	inProgressGame = common.Address{0xaa}
+	resolvedGame   = common.Address{0xbb}
+)
+
+func TestListGames(t *testing.T) {
+	api := setupAdminAPI(t)
+	games, err := api.ListGames(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []GameSummary{
+		{Address: inProgressGame, Status: "In Progress", Claims: 2, PendingMoves: 1},
+		{Address: resolvedGame, Status: "Challenger Won"},
+	}, games)
+}
+
+func TestGetGame(t *testing.T) {
+	api := setupAdminAPI(t)
+	game, err := api.GetGame(context.Background(), inProgressGame)
+	require.NoError(t, err)
+	require.Equal(t, &GameDetails{
+		Address: inProgressGame,
+		Status:  "In Progress",
+		Claims: []ClaimDetails{
+			{Index: 0, ParentIndex: -1, Depth: 0, IndexAtDepth: (*hexutil.Big)(big.NewInt(0)), Value: common.Hash{0x01}, Clock: 1000, Assessment: types.AssessmentDisagree},
+			{Index: 1, ParentIndex: 0, Depth: 1, IndexAtDepth: (*hexutil.Big)(big.NewInt(0)), Value: common.Hash{0x02}, Clock: 1001},
+		},
+		Moves: []store.Move{testPendingMove()},
+	}, game)
+
+	_, err = api.GetGame(context.Background(), common.Address{0xcc})
+	require.ErrorContains(t, err, "not tracked")
+}
+
+func TestPendingTransactions(t *testing.T) {
+	api := setupAdminAPI(t)
+	pending, err := api.PendingTransactions(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []PendingMove{{Game: inProgressGame, Move: testPendingMove()}}, pending)
+}
+
+func TestSchedulerQueueDepth(t *testing.T) {
+	api := setupAdminAPI(t)
+	depth, err := api.SchedulerQueueDepth(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, depth)
+}
+
+func setupAdminAPI(t *testing.T) *adminAPI {
+	logger := testlog.Logger(t, log.LvlInfo)
+	kv, err := kvstore.OpenInMemory(logger, kvstore.NoopMetrics)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, kv.Close())
+	})
+	games, err := store.NewStore(kv)
+	require.NoError(t, err)
+
+	game := games.Game(inProgressGame)
+	require.NoError(t, game.SetStatus(gameTypes.GameStatusInProgress))
+	require.NoError(t, game.UpdateClaims([]types.Claim{testClaim(0, 0, -1), testClaim(1, 1, 0)}, 2))
+	// The second claim was loaded after the game was last assessed
+	require.NoError(t, game.SetAssessments([]types.Assessment{types.AssessmentDisagree}))
+	require.NoError(t, game.RecordMove(testPendingMove()))
+	require.NoError(t, games.Game(resolvedGame).SetStatus(gameTypes.GameStatusChallengerWon))
+
+	return NewAdminAPI(nil, games, &stubScheduler{queueDepth: 3}, &metrics.NoopRPCMetrics{}, logger)
+}
+
+func testClaim(idx int, depth int, parentIdx int) types.Claim {
+	return types.Claim{
+		ClaimData: types.ClaimData{
+			Value:    common.Hash{byte(idx + 1)},
+			Position: types.NewPosition(depth, big.NewInt(0)),
+		},
+		Clock:               uint64(1000 + idx),
+		ContractIndex:       idx,
+		ParentContractIndex: parentIdx,
+	}
+}
+
+func testPendingMove() store.Move {
+	return store.Move{Type: types.ActionTypeMove, ParentIdx: 0, IsAttack: true, Value: common.Hash{0x03}, Status: store.MoveStatusPending}
+}
+
+type stubScheduler struct {
+	queueDepth int
+}
+
+func (s *stubScheduler) QueueDepth() int {
+	return s.queueDepth
+}
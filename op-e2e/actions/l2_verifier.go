@@ -110,6 +110,10 @@ func (s *l2VerifierBackend) SyncStatus(ctx context.Context) (*eth.SyncStatus, er
 	return s.verifier.SyncStatus(), nil
 }
 
+func (s *l2VerifierBackend) DerivationStatus(ctx context.Context) (*derive.DerivationStatus, error) {
+	return s.verifier.derivation.DerivationStatus(), nil
+}
+
 func (s *l2VerifierBackend) ResetDerivationPipeline(ctx context.Context) error {
 	s.verifier.derivation.Reset()
 	return nil
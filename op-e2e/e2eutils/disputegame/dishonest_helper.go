@@ -3,6 +3,7 @@ package disputegame
 import (
 	"context"
 	"errors"
+	"math/rand"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
@@ -114,3 +115,55 @@ func (d *DishonestHelper) ExhaustDishonestClaims(ctx context.Context) {
 		}
 	}
 }
+
+// RandomDishonestClaims responds to new claims with randomly selected dishonest moves until the honest challenger
+// stops responding. Before each move it waits for a random latency of up to maxLatency.
+// Correct moves are only made against claims the honest challenger agrees with, so they can't duplicate the honest
+// challenger's own responses.
+func (d *DishonestHelper) RandomDishonestClaims(ctx context.Context, rng *rand.Rand, maxLatency time.Duration) {
+	depth := d.MaxDepth(ctx)
+
+	move := func(claimIndex int64, claimData ContractClaim) {
+		pos := types.NewPositionFromGIndex(claimData.Position)
+		if int64(pos.Depth()) == depth {
+			return
+		}
+		// Leave some claims alone so the adversary doesn't always respond.
+		if rng.Intn(3) == 0 {
+			return
+		}
+		moves := []func(context.Context, int64){d.Attack}
+		if claimIndex != 0 {
+			moves = append(moves, d.Defend)
+		}
+		agreeWithLevel := d.defender == (pos.Depth()%2 == 0)
+		if !agreeWithLevel {
+			moves = append(moves, d.AttackCorrect)
+			if claimIndex != 0 {
+				moves = append(moves, d.DefendCorrect)
+			}
+		}
+		if maxLatency > 0 {
+			time.Sleep(time.Duration(rng.Int63n(int64(maxLatency))))
+		}
+		d.FaultGameHelper.t.Logf("Random dishonest move against claimIndex %d", claimIndex)
+		moves[rng.Intn(len(moves))](ctx, claimIndex)
+	}
+
+	var numClaimsSeen int64
+	for {
+		// Use a short timeout since we don't know the challenger will respond,
+		// and this is only designed for the alphabet game where the response should be fast.
+		newCount, err := d.waitForNewClaim(ctx, numClaimsSeen, 30*time.Second)
+		if errors.Is(err, context.DeadlineExceeded) {
+			// we assume that the honest challenger has stopped responding
+			// There's nothing to respond to.
+			break
+		}
+		d.FaultGameHelper.require.NoError(err)
+
+		for ; numClaimsSeen < newCount; numClaimsSeen++ {
+			move(numClaimsSeen, d.getClaim(ctx, numClaimsSeen))
+		}
+	}
+}
@@ -64,6 +64,13 @@ func (g *FaultGameHelper) MaxDepth(ctx context.Context) int64 {
 	return depth.Int64()
 }
 
+// ClaimCount returns the number of claims in the game.
+func (g *FaultGameHelper) ClaimCount(ctx context.Context) int64 {
+	count, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "Failed to load claim count")
+	return count.Int64()
+}
+
 func (g *FaultGameHelper) waitForClaim(ctx context.Context, errorMsg string, predicate func(claim ContractClaim) bool) {
 	timedCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
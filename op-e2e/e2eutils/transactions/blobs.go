@@ -3,6 +3,7 @@ package transactions
 import (
 	"crypto/ecdsa"
 
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
@@ -16,9 +17,14 @@ var (
 )
 
 func init() {
+	// Encode the blob the same way rollup data is, so the transaction carries a canonical empty blob.
+	var blob eth.Blob
+	if err := blob.FromData(nil); err != nil {
+		panic("failed to encode empty blob: " + err.Error())
+	}
+	emptyBlob = *blob.KZGBlob()
 	var err error
-	emptyBlob = kzg4844.Blob{}
-	emptyBlobCommit, err = kzg4844.BlobToCommitment(emptyBlob)
+	emptyBlobCommit, err = blob.ComputeKZGCommitment()
 	if err != nil {
 		panic("failed to create empty blob commitment: " + err.Error())
 	}
@@ -0,0 +1,153 @@
+package faultproofs
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	op_e2e "github.com/ethereum-optimism/optimism/op-e2e"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/disputegame"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// soakGamesEnvVar sets the number of games played by TestChallengerSoak. The test is skipped if it is not set.
+	soakGamesEnvVar = "OP_E2E_SOAK_GAMES"
+	// soakSeedEnvVar sets the random seed so a failing soak run can be reproduced.
+	soakSeedEnvVar = "OP_E2E_SOAK_SEED"
+
+	// soakMaxLatency is the maximum delay before each move made by the adversary.
+	soakMaxLatency = 3 * time.Second
+)
+
+type soakStrategy string
+
+const (
+	// soakStrategyNone leaves the honest challenger to play against the root claim alone.
+	soakStrategyNone soakStrategy = "none"
+	// soakStrategyRandom makes random dishonest moves against new claims.
+	soakStrategyRandom soakStrategy = "random"
+	// soakStrategyExhaustive makes every significant dishonest move.
+	soakStrategyExhaustive soakStrategy = "exhaustive"
+)
+
+var soakStrategies = []soakStrategy{soakStrategyNone, soakStrategyRandom, soakStrategyRandom, soakStrategyExhaustive}
+
+type soakStats struct {
+	games    int
+	lost     int
+	claims   int64
+	duration time.Duration
+}
+
+// TestChallengerSoak plays many randomized alphabet games against a single honest challenger and checks the honest
+// challenger never loses. Each game uses a random disagreement point in the root claim, a random adversary strategy
+// and random adversary latencies. Statistics for each strategy are logged at the end of the run.
+// Run with OP_E2E_SOAK_GAMES=<number of games>, optionally setting OP_E2E_SOAK_SEED to reproduce a previous run.
+func TestChallengerSoak(t *testing.T) {
+	numGames, err := strconv.Atoi(os.Getenv(soakGamesEnvVar))
+	if err != nil || numGames <= 0 {
+		t.Skipf("Set %v to run the dispute game soak test", soakGamesEnvVar)
+	}
+	seed := time.Now().UnixNano()
+	if s := os.Getenv(soakSeedEnvVar); s != "" {
+		seed, err = strconv.ParseInt(s, 10, 64)
+		require.NoError(t, err, "invalid %v", soakSeedEnvVar)
+	}
+	t.Logf("Playing %v games with seed %v", numGames, seed)
+	rng := rand.New(rand.NewSource(seed))
+
+	op_e2e.InitParallel(t, op_e2e.UseExecutor(1))
+	ctx := context.Background()
+	sys, l1Client := startFaultDisputeSystem(t)
+	t.Cleanup(sys.Close)
+
+	disputeGameFactory := disputegame.NewFactoryHelper(t, ctx, sys)
+	disputeGameFactory.StartChallenger(ctx, "Challenger",
+		challenger.WithAlphabet(disputegame.CorrectAlphabet),
+		challenger.WithPrivKey(sys.Cfg.Secrets.Alice),
+		challenger.WithPollInterval(time.Millisecond*400),
+	)
+
+	stats := make(map[string]*soakStats)
+	for i := 0; i < numGames; i++ {
+		rootCorrect := rng.Intn(2) == 0
+		rootAlphabet := disputegame.CorrectAlphabet
+		if !rootCorrect {
+			rootAlphabet = randomDishonestAlphabet(rng)
+		}
+		strategy := soakStrategies[rng.Intn(len(soakStrategies))]
+		t.Logf("Game %v: root alphabet %v, strategy %v", i, rootAlphabet, strategy)
+
+		start := time.Now()
+		game := disputeGameFactory.StartAlphabetGame(ctx, rootAlphabet)
+		gameDuration := game.GameDuration(ctx)
+
+		dishonestHelper := game.CreateDishonestHelper(disputegame.CorrectAlphabet, 4, !rootCorrect)
+		switch strategy {
+		case soakStrategyRandom:
+			dishonestHelper.RandomDishonestClaims(ctx, rng, soakMaxLatency)
+		case soakStrategyExhaustive:
+			dishonestHelper.ExhaustDishonestClaims(ctx)
+		}
+		// Wait for the honest challenger to finish responding before the game clock expires.
+		game.WaitForInactivity(ctx, 4, false)
+
+		sys.TimeTravelClock.AdvanceTime(gameDuration)
+		require.NoError(t, wait.ForNextBlock(ctx, l1Client))
+		game.WaitForInactivity(ctx, 10, true)
+
+		expectedStatus := disputegame.StatusChallengerWins
+		if rootCorrect {
+			expectedStatus = disputegame.StatusDefenderWins
+		}
+		key := string(strategy) + "/root-incorrect"
+		if rootCorrect {
+			key = string(strategy) + "/root-correct"
+		}
+		s, ok := stats[key]
+		if !ok {
+			s = &soakStats{}
+			stats[key] = s
+		}
+		s.games++
+		s.claims += game.ClaimCount(ctx)
+		s.duration += time.Since(start)
+		if status := game.Status(ctx); status != expectedStatus {
+			s.lost++
+			game.LogGameData(ctx)
+			t.Errorf("Honest challenger lost game %v (%v): expected %v but was %v", i, game.Addr(), expectedStatus, status)
+		}
+	}
+
+	keys := make([]string, 0, len(stats))
+	for key := range stats {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := stats[key]
+		t.Logf("%-26v games: %4v lost: %4v avg claims: %6.2f avg duration: %v",
+			key, s.games, s.lost, float64(s.claims)/float64(s.games), s.duration/time.Duration(s.games))
+	}
+}
+
+// randomDishonestAlphabet returns an alphabet that agrees with the correct alphabet up to a random trace index and
+// disagrees from that index onwards.
+func randomDishonestAlphabet(rng *rand.Rand) string {
+	correct := []byte(disputegame.CorrectAlphabet)
+	disagreeIdx := rng.Intn(len(correct))
+	alphabet := make([]byte, len(correct))
+	copy(alphabet, correct[:disagreeIdx])
+	for i := disagreeIdx; i < len(correct); i++ {
+		// Use letters outside the correct alphabet so every trace index from disagreeIdx onwards disagrees.
+		alphabet[i] = byte('q' + rng.Intn(10))
+	}
+	return string(alphabet)
+}
@@ -109,7 +109,8 @@ func processFrames(cfg Config, id derive.ChannelID, frames []FrameWithMetadata)
 	var batchTypes []int
 	invalidBatches := false
 	if ch.IsReady() {
-		br, err := derive.BatchReader(ch.Reader())
+		// Accept all channel versions, the decoder does not know when Fjord activated.
+		br, err := derive.BatchReader(ch.Reader(), true)
 		if err == nil {
 			for batchData, err := br(); err != io.EOF; batchData, err = br() {
 				if err != nil {
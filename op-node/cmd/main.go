@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/cmd/genesis"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/networks"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/replay"
 	"github.com/ethereum-optimism/optimism/op-node/flags"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/node"
@@ -62,6 +63,12 @@ func main() {
 			Name:        "networks",
 			Subcommands: networks.Subcommands,
 		},
+		{
+			Name:   "replay",
+			Usage:  "Replays a derivation recording offline, writing the resulting engine API calls to a sink",
+			Flags:  replay.Flags,
+			Action: replay.Main,
+		},
 	}
 
 	ctx := opio.WithInterruptBlocker(context.Background())
@@ -0,0 +1,71 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	opnode "github.com/ethereum-optimism/optimism/op-node"
+	"github.com/ethereum-optimism/optimism/op-node/flags"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/replay"
+	opflags "github.com/ethereum-optimism/optimism/op-service/flags"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+)
+
+var (
+	recordingFlag = &cli.PathFlag{
+		Name:     "recording",
+		Usage:    "Path to the derivation recording, as written by --" + flags.RecordDerivation.Name,
+		Required: true,
+	}
+	sinkFlag = &cli.PathFlag{
+		Name:  "sink",
+		Usage: "Path to write the engine API calls made during the replay to. Discarded if not set.",
+	}
+)
+
+// Flags are the flags of the replay command.
+var Flags = append([]cli.Flag{recordingFlag, sinkFlag}, opflags.CLIFlags(flags.EnvVarPrefix)...)
+
+// Main replays a derivation recording offline, without an L1 node or execution engine. Engine API calls are not sent
+// anywhere; they are written to the sink so they can be compared with the calls in the recording.
+func Main(ctx *cli.Context) error {
+	logger := oplog.NewLogger(oplog.AppOut(ctx), oplog.ReadCLIConfig(ctx))
+	if !ctx.IsSet(opflags.NetworkFlagName) && !ctx.IsSet(opflags.RollupConfigFlagName) {
+		return errors.New("must specify either a network or rollup config")
+	}
+	rollupCfg, err := opnode.NewRollupConfig(logger, ctx)
+	if err != nil {
+		return err
+	}
+
+	in, err := os.Open(ctx.Path(recordingFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer in.Close()
+	rec, err := replay.ReadRecording(in)
+	if err != nil {
+		return fmt.Errorf("failed to read recording: %w", err)
+	}
+
+	var sink io.Writer = io.Discard
+	if path := ctx.Path(sinkFlag.Name); path != "" {
+		out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open sink: %w", err)
+		}
+		defer out.Close()
+		sink = out
+	}
+
+	safeHead, err := replay.Run(ctx.Context, logger, rollupCfg, rec, sink)
+	if err != nil {
+		return fmt.Errorf("replay failed at safe head %v: %w", safeHead, err)
+	}
+	logger.Info("Replay complete", "safe", safeHead, "unused", rec.Remaining())
+	return nil
+}
@@ -42,6 +42,18 @@ var (
 		Destination: new(string),
 	}
 	/* Optional Flags */
+	L2EngineCallTimeout = &cli.DurationFlag{
+		Name:    "l2.engine-timeout",
+		Usage:   "Timeout for each attempt of an engine API call (forkchoiceUpdated, newPayload and getPayload)",
+		EnvVars: prefixEnvVars("L2_ENGINE_TIMEOUT"),
+		Value:   sources.DefaultEngineCallTimeout,
+	}
+	L2EngineCallAttempts = &cli.IntFlag{
+		Name:    "l2.engine-attempts",
+		Usage:   "Maximum number of attempts of an engine API call that fails without a response from the engine, such as a timeout",
+		EnvVars: prefixEnvVars("L2_ENGINE_ATTEMPTS"),
+		Value:   sources.DefaultEngineCallAttempts,
+	}
 	SyncModeFlag = &cli.GenericFlag{
 		Name:    "syncmode",
 		Usage:   fmt.Sprintf("IN DEVELOPMENT: Options are: %s", openum.EnumString(sync.ModeStrings)),
@@ -71,7 +83,7 @@ var (
 	}
 	RPCAdminPersistence = &cli.StringFlag{
 		Name:    "rpc.admin-state",
-		Usage:   "File path used to persist state changes made via the admin API so they persist across restarts. Disabled if not set.",
+		Usage:   "File path used to persist state changes made via the admin API, and the applied recovery target, so they persist across restarts. Disabled if not set.",
 		EnvVars: prefixEnvVars("RPC_ADMIN_STATE"),
 	}
 	L1TrustRPC = &cli.BoolFlag{
@@ -119,6 +131,17 @@ var (
 		EnvVars: prefixEnvVars("L1_HTTP_POLL_INTERVAL"),
 		Value:   time.Second * 12,
 	}
+	L1BeaconAddr = &cli.StringFlag{
+		Name:    "l1.beacon",
+		Usage:   "Address of L1 Beacon-node HTTP endpoint to use. Required by l1.beacon-finality.",
+		EnvVars: prefixEnvVars("L1_BEACON"),
+	}
+	L1BeaconFinality = &cli.BoolFlag{
+		Name: "l1.beacon-finality",
+		Usage: "Determine the finalized L1 block from the finality checkpoints of the l1.beacon node instead of trusting the \"finalized\" tag of the L1 RPC. " +
+			"Recommended when using a third-party L1 RPC provider.",
+		EnvVars: prefixEnvVars("L1_BEACON_FINALITY"),
+	}
 	VerifierL1Confs = &cli.Uint64Flag{
 		Name:    "verifier.l1-confs",
 		Usage:   "Number of L1 blocks to keep distance from the L1 head before deriving L2 data from. Reorgs are supported, but may be slow to perform.",
@@ -135,6 +158,13 @@ var (
 		Usage:   "Initialize the sequencer in a stopped state. The sequencer can be started using the admin_startSequencer RPC",
 		EnvVars: prefixEnvVars("SEQUENCER_STOPPED"),
 	}
+	SequencerEngineMaxLatencyFlag = &cli.DurationFlag{
+		Name: "sequencer.engine-max-latency",
+		Usage: "Maximum duration of an engine API call before the engine is considered degraded. " +
+			"While degraded, the sequencer stops creating new blocks but derivation continues. Disabled if 0.",
+		EnvVars: prefixEnvVars("SEQUENCER_ENGINE_MAX_LATENCY"),
+		Value:   0,
+	}
 	SequencerMaxSafeLagFlag = &cli.Uint64Flag{
 		Name:    "sequencer.max-safe-lag",
 		Usage:   "Maximum number of L2 blocks for restricting the distance between L2 safe and unsafe. Disabled if 0.",
@@ -216,7 +246,7 @@ var (
 	}
 	RollupHalt = &cli.StringFlag{
 		Name:    "rollup.halt",
-		Usage:   "Opt-in option to halt on incompatible protocol version requirements of the given level (major/minor/patch/none), as signaled onchain in L1",
+		Usage:   "Opt-in option to halt on incompatible protocol version requirements of the given level (major/minor/patch/none), as signaled onchain in L1. Applies to both the op-node and the execution engine support.",
 		EnvVars: prefixEnvVars("ROLLUP_HALT"),
 	}
 	RollupLoadProtocolVersions = &cli.BoolFlag{
@@ -224,6 +254,27 @@ var (
 		Usage:   "Load protocol versions from the superchain L1 ProtocolVersions contract (if available), and report in logs and metrics",
 		EnvVars: prefixEnvVars("ROLLUP_LOAD_PROTOCOL_VERSIONS"),
 	}
+	RecoveryL2SafeHead = &cli.StringFlag{
+		Name: "recovery.l2-safe-head",
+		Usage: "Recovery mode: hash of the canonical L2 block to restart derivation from on startup, overriding the safe head of the execution engine. " +
+			"Must not be older than the finalized head or newer than the safe head of the execution engine. Requires --rpc.admin-state, which records the applied " +
+			"recovery so it is not applied again on restart. " +
+			"Intended for recovering from datadir corruption without a full resync.",
+		EnvVars: prefixEnvVars("RECOVERY_L2_SAFE_HEAD"),
+	}
+	RecoveryL1Start = &cli.StringFlag{
+		Name: "recovery.l1-start",
+		Usage: "Recovery mode: hash of the L1 block to restart reading batch data from, which can be used to skip a known-bad range of L1 data. " +
+			"Must be the L1 origin of the recovery L2 safe head or one of its ancestors. Requires --recovery.l2-safe-head.",
+		EnvVars: prefixEnvVars("RECOVERY_L1_START"),
+	}
+	RecordDerivation = &cli.StringFlag{
+		Name: "debug.record-derivation",
+		Usage: "File to append the L1 inputs consumed by derivation, and the resulting engine API calls, to. " +
+			"The recording can be replayed offline with the replay subcommand to reproduce derivation issues. " +
+			"The recording grows without bound, so only enable this while debugging.",
+		EnvVars: prefixEnvVars("DEBUG_RECORD_DERIVATION"),
+	}
 	/* Deprecated Flags */
 	L2EngineSyncEnabled = &cli.BoolFlag{
 		Name:    "l2.engine-sync",
@@ -277,10 +328,15 @@ var optionalFlags = []cli.Flag{
 	L1RPCMaxBatchSize,
 	L1RPCMaxConcurrency,
 	L1HTTPPollInterval,
+	L1BeaconAddr,
+	L1BeaconFinality,
+	L2EngineCallTimeout,
+	L2EngineCallAttempts,
 	VerifierL1Confs,
 	SequencerEnabledFlag,
 	SequencerStoppedFlag,
 	SequencerMaxSafeLagFlag,
+	SequencerEngineMaxLatencyFlag,
 	SequencerL1Confs,
 	L1EpochPollIntervalFlag,
 	RuntimeConfigReloadIntervalFlag,
@@ -299,6 +355,9 @@ var optionalFlags = []cli.Flag{
 	RollupHalt,
 	RollupLoadProtocolVersions,
 	L1RethDBPath,
+	RecoveryL2SafeHead,
+	RecoveryL1Start,
+	RecordDerivation,
 }
 
 var DeprecatedFlags = []cli.Flag{
@@ -52,6 +52,9 @@ var (
 	GossipMeshDhiName      = "p2p.gossip.mesh.dhi"
 	GossipMeshDlazyName    = "p2p.gossip.mesh.dlazy"
 	GossipFloodPublishName = "p2p.gossip.mesh.floodpublish"
+	GossipPeerRateName     = "p2p.gossip.peer-rate-limit"
+	GossipPeerBurstName    = "p2p.gossip.peer-rate-burst"
+	GossipThrottleName     = "p2p.gossip.throttle-duration"
 	SyncReqRespName        = "p2p.sync.req-resp"
 )
 
@@ -346,6 +349,27 @@ func P2PFlags(envPrefix string) []cli.Flag {
 			Hidden:   true,
 			EnvVars:  p2pEnv(envPrefix, "GOSSIP_FLOOD_PUBLISH"),
 		},
+		&cli.Float64Flag{
+			Name:     GossipPeerRateName,
+			Usage:    "Sustained rate of gossip, in bytes per second, accepted from each peer before it is throttled. Disabled if 0.",
+			Required: false,
+			Value:    0,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_PEER_RATE_LIMIT"),
+		},
+		&cli.IntFlag{
+			Name:     GossipPeerBurstName,
+			Usage:    "Bytes of gossip a peer may send at once above the sustained rate limit. Must be at least the max gossip message size.",
+			Required: false,
+			Value:    p2p.DefaultGossipPeerBurst,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_PEER_RATE_BURST"),
+		},
+		&cli.DurationFlag{
+			Name:     GossipThrottleName,
+			Usage:    "Duration for which all gossip from a peer is ignored after it exceeds the gossip rate limit.",
+			Required: false,
+			Value:    time.Minute,
+			EnvVars:  p2pEnv(envPrefix, "GOSSIP_THROTTLE_DURATION"),
+		},
 		&cli.BoolFlag{
 			Name:     SyncReqRespName,
 			Usage:    "Enables P2P req-resp alternative sync method, on both server and client side.",
@@ -47,11 +47,21 @@ type Metricer interface {
 	RecordL2Ref(name string, ref eth.L2BlockRef)
 	RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID)
 	RecordDerivedBatches(batchType string)
+	RecordDerivedChannel(compression string)
+	RecordChannelReaderError(reason string)
 	CountSequencedTxs(count int)
 	RecordL1ReorgDepth(d uint64)
+	RecordL1HeadGap(size uint64)
+	RecordL1FinalityDivergence(blocks int64)
+	RecordL1FinalityConflict()
 	RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordSequencerReset()
+	RecordSequencerL1OriginUnavailable()
 	RecordGossipEvent(evType int32)
+	RecordBlockSignatureRejection(reason string)
+	RecordGossipBytes(topic string, bytes int)
+	RecordGossipRateLimited(topic string)
+	SetGossipThrottledPeers(count int)
 	IncPeerCount()
 	DecPeerCount()
 	IncStreamCount()
@@ -94,7 +104,9 @@ type Metrics struct {
 	SequencingErrors *metrics.Event
 	PublishingErrors *metrics.Event
 
-	DerivedBatches metrics.EventVec
+	DerivedBatches      metrics.EventVec
+	DerivedChannels     metrics.EventVec
+	ChannelReaderErrors metrics.EventVec
 
 	P2PReqDurationSeconds *prometheus.HistogramVec
 	P2PReqTotal           *prometheus.CounterVec
@@ -104,8 +116,10 @@ type Metrics struct {
 
 	SequencerInconsistentL1Origin *metrics.Event
 	SequencerResets               *metrics.Event
+	SequencerL1OriginUnavailable  *metrics.Event
 
-	L1RequestDurationSeconds *prometheus.HistogramVec
+	L1RequestDurationSeconds     *prometheus.HistogramVec
+	EngineRequestDurationSeconds *prometheus.HistogramVec
 
 	SequencerBuildingDiffDurationSeconds prometheus.Histogram
 	SequencerBuildingDiffTotal           prometheus.Counter
@@ -119,6 +133,10 @@ type Metrics struct {
 	metrics.RefMetrics
 
 	L1ReorgDepth prometheus.Histogram
+	L1HeadGap    prometheus.Histogram
+
+	L1FinalityDivergence prometheus.Gauge
+	L1FinalityConflicts  prometheus.Counter
 
 	TransactionsSequencedTotal prometheus.Counter
 
@@ -131,7 +149,12 @@ type Metrics struct {
 	PeerCount         prometheus.Gauge
 	StreamCount       prometheus.Gauge
 	GossipEventsTotal *prometheus.CounterVec
+	SignatureRejects  *prometheus.CounterVec
 	BandwidthTotal    *prometheus.GaugeVec
+	BandwidthPeerRate *prometheus.HistogramVec
+	GossipBytes       *prometheus.CounterVec
+	GossipRateLimited *prometheus.CounterVec
+	GossipThrottled   prometheus.Gauge
 	PeerUnbans        prometheus.Counter
 	IPUnbans          prometheus.Counter
 	Dials             *prometheus.CounterVec
@@ -195,10 +218,13 @@ func NewMetrics(procName string) *Metrics {
 		SequencingErrors: metrics.NewEvent(factory, ns, "", "sequencing_errors", "sequencing errors"),
 		PublishingErrors: metrics.NewEvent(factory, ns, "", "publishing_errors", "p2p publishing errors"),
 
-		DerivedBatches: metrics.NewEventVec(factory, ns, "", "derived_batches", "derived batches", []string{"type"}),
+		DerivedBatches:      metrics.NewEventVec(factory, ns, "", "derived_batches", "derived batches", []string{"type"}),
+		DerivedChannels:     metrics.NewEventVec(factory, ns, "", "derived_channels", "channels read by the derivation pipeline, by detected compression", []string{"compression"}),
+		ChannelReaderErrors: metrics.NewEventVec(factory, ns, "", "channel_reader_errors", "channels or batches dropped by the channel reader, by reason", []string{"reason"}),
 
 		SequencerInconsistentL1Origin: metrics.NewEvent(factory, ns, "", "sequencer_inconsistent_l1_origin", "events when the sequencer selects an inconsistent L1 origin"),
 		SequencerResets:               metrics.NewEvent(factory, ns, "", "sequencer_resets", "sequencer resets"),
+		SequencerL1OriginUnavailable:  metrics.NewEvent(factory, ns, "", "sequencer_l1_origin_unavailable", "events when the sequencer stops because the next L1 origin cannot be retrieved within the max sequencer drift"),
 
 		UnsafePayloadsBufferLen: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
@@ -219,6 +245,22 @@ func NewMetrics(procName string) *Metrics {
 			Buckets:   []float64{0.5, 1.5, 2.5, 3.5, 4.5, 5.5, 6.5, 7.5, 8.5, 9.5, 10.5, 20.5, 50.5, 100.5},
 			Help:      "Histogram of L1 Reorg Depths",
 		}),
+		L1HeadGap: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "l1_head_gap_size",
+			Buckets:   []float64{1.5, 2.5, 3.5, 5.5, 10.5, 20.5, 50.5, 100.5, 500.5, 1000.5},
+			Help:      "Histogram of the number of L1 heads missed by the head subscription and backfilled",
+		}),
+		L1FinalityDivergence: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "l1_finality_divergence",
+			Help:      "Number of blocks the L1 RPC finalized block is ahead (positive) or behind (negative) the beacon node finalized block",
+		}),
+		L1FinalityConflicts: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "l1_finality_conflicts",
+			Help:      "Number of times the beacon node finalized block was not in the canonical chain of the L1 RPC",
+		}),
 
 		TransactionsSequencedTotal: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
@@ -252,6 +294,14 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"type",
 		}),
+		SignatureRejects: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "block_signature_rejections_total",
+			Help:      "Count of gossiped blocks rejected because of their signature, by reason",
+		}, []string{
+			"reason",
+		}),
 		BandwidthTotal: factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: ns,
 			Subsystem: "p2p",
@@ -260,6 +310,37 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"direction",
 		}),
+		BandwidthPeerRate: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "peer_bandwidth_rate",
+			Help:      "Histogram of the bandwidth rate, in bytes per second, of p2p peers by direction",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 10),
+		}, []string{
+			"direction",
+		}),
+		GossipBytes: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "gossip_bytes_total",
+			Help:      "Bytes of gossip messages received, by topic",
+		}, []string{
+			"topic",
+		}),
+		GossipRateLimited: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "gossip_rate_limited_total",
+			Help:      "Count of gossip messages ignored because the sending peer exceeded its rate limit, by topic",
+		}, []string{
+			"topic",
+		}),
+		GossipThrottled: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "gossip_throttled_peers",
+			Help:      "Count of peers whose gossip is currently ignored for exceeding their rate limit",
+		}),
 		PeerUnbans: factory.NewCounter(prometheus.CounterOpts{
 			Namespace: ns,
 			Subsystem: "p2p",
@@ -341,6 +422,14 @@ func NewMetrics(procName string) *Metrics {
 			Help: "Histogram of L1 request time",
 		}, []string{"request"}),
 
+		EngineRequestDurationSeconds: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "engine_request_seconds",
+			Buckets: []float64{
+				.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+			Help: "Histogram of engine API request time, including retries",
+		}, []string{"request"}),
+
 		SequencerBuildingDiffDurationSeconds: factory.NewHistogram(prometheus.HistogramOpts{
 			Namespace: ns,
 			Name:      "sequencer_building_diff_seconds",
@@ -458,6 +547,14 @@ func (m *Metrics) RecordDerivedBatches(batchType string) {
 	m.DerivedBatches.Record(batchType)
 }
 
+func (m *Metrics) RecordDerivedChannel(compression string) {
+	m.DerivedChannels.Record(compression)
+}
+
+func (m *Metrics) RecordChannelReaderError(reason string) {
+	m.ChannelReaderErrors.Record(reason)
+}
+
 func (m *Metrics) CountSequencedTxs(count int) {
 	m.TransactionsSequencedTotal.Add(float64(count))
 }
@@ -466,6 +563,18 @@ func (m *Metrics) RecordL1ReorgDepth(d uint64) {
 	m.L1ReorgDepth.Observe(float64(d))
 }
 
+func (m *Metrics) RecordL1HeadGap(size uint64) {
+	m.L1HeadGap.Observe(float64(size))
+}
+
+func (m *Metrics) RecordL1FinalityDivergence(blocks int64) {
+	m.L1FinalityDivergence.Set(float64(blocks))
+}
+
+func (m *Metrics) RecordL1FinalityConflict() {
+	m.L1FinalityConflicts.Inc()
+}
+
 func (m *Metrics) RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID) {
 	m.SequencerInconsistentL1Origin.Record()
 	m.RecordRef("l1_origin", "inconsistent_from", from.Number, 0, from.Hash)
@@ -476,10 +585,30 @@ func (m *Metrics) RecordSequencerReset() {
 	m.SequencerResets.Record()
 }
 
+func (m *Metrics) RecordSequencerL1OriginUnavailable() {
+	m.SequencerL1OriginUnavailable.Record()
+}
+
 func (m *Metrics) RecordGossipEvent(evType int32) {
 	m.GossipEventsTotal.WithLabelValues(pb.TraceEvent_Type_name[evType]).Inc()
 }
 
+func (m *Metrics) RecordGossipBytes(topic string, bytes int) {
+	m.GossipBytes.WithLabelValues(topic).Add(float64(bytes))
+}
+
+func (m *Metrics) RecordGossipRateLimited(topic string) {
+	m.GossipRateLimited.WithLabelValues(topic).Inc()
+}
+
+func (m *Metrics) SetGossipThrottledPeers(count int) {
+	m.GossipThrottled.Set(float64(count))
+}
+
+func (m *Metrics) RecordBlockSignatureRejection(reason string) {
+	m.SignatureRejects.WithLabelValues(reason).Inc()
+}
+
 func (m *Metrics) IncPeerCount() {
 	m.PeerCount.Inc()
 }
@@ -506,6 +635,12 @@ func (m *Metrics) RecordBandwidth(ctx context.Context, bwc *libp2pmetrics.Bandwi
 			bwTotals := bwc.GetBandwidthTotals()
 			m.BandwidthTotal.WithLabelValues("in").Set(float64(bwTotals.TotalIn))
 			m.BandwidthTotal.WithLabelValues("out").Set(float64(bwTotals.TotalOut))
+			// Reset the histogram each tick so it reflects the current rates rather than accumulating past observations
+			m.BandwidthPeerRate.Reset()
+			for _, stats := range bwc.GetBandwidthByPeer() {
+				m.BandwidthPeerRate.WithLabelValues("in").Observe(stats.RateIn)
+				m.BandwidthPeerRate.WithLabelValues("out").Observe(stats.RateOut)
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -517,6 +652,11 @@ func (m *Metrics) RecordL1RequestTime(method string, duration time.Duration) {
 	m.L1RequestDurationSeconds.WithLabelValues(method).Observe(float64(duration) / float64(time.Second))
 }
 
+// RecordEngineRequestTime tracks the amount of time spent waiting for engine API requests.
+func (m *Metrics) RecordEngineRequestTime(method string, duration time.Duration) {
+	m.EngineRequestDurationSeconds.WithLabelValues(method).Observe(float64(duration) / float64(time.Second))
+}
+
 // RecordSequencerBuildingDiffTime tracks the amount of time the sequencer was allowed between
 // start to finish, incl. sealing, minus the block time.
 // Ideally this is 0, realistically the sequencer scheduler may be busy with other jobs like syncing sometimes.
@@ -658,21 +798,51 @@ func (n *noopMetricer) RecordUnsafePayloadsBuffer(length uint64, memSize uint64,
 func (n *noopMetricer) RecordDerivedBatches(batchType string) {
 }
 
+func (n *noopMetricer) RecordDerivedChannel(compression string) {
+}
+
+func (n *noopMetricer) RecordChannelReaderError(reason string) {
+}
+
 func (n *noopMetricer) CountSequencedTxs(count int) {
 }
 
 func (n *noopMetricer) RecordL1ReorgDepth(d uint64) {
 }
 
+func (n *noopMetricer) RecordL1HeadGap(size uint64) {
+}
+
+func (n *noopMetricer) RecordL1FinalityDivergence(blocks int64) {
+}
+
+func (n *noopMetricer) RecordL1FinalityConflict() {
+}
+
 func (n *noopMetricer) RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID) {
 }
 
 func (n *noopMetricer) RecordSequencerReset() {
 }
 
+func (n *noopMetricer) RecordSequencerL1OriginUnavailable() {
+}
+
 func (n *noopMetricer) RecordGossipEvent(evType int32) {
 }
 
+func (n *noopMetricer) RecordBlockSignatureRejection(reason string) {
+}
+
+func (n *noopMetricer) RecordGossipBytes(topic string, bytes int) {
+}
+
+func (n *noopMetricer) RecordGossipRateLimited(topic string) {
+}
+
+func (n *noopMetricer) SetGossipThrottledPeers(count int) {
+}
+
 func (n *noopMetricer) SetPeerScores(allScores []store.PeerScores) {
 }
 
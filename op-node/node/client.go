@@ -35,6 +35,14 @@ type L2EndpointConfig struct {
 	// JWT secrets for L2 Engine API authentication during HTTP or initial Websocket communication.
 	// Any value for an IPC connection.
 	L2EngineJWTSecret [32]byte
+
+	// L2EngineCallTimeout limits the duration of each attempt of an engine API call.
+	// The default is used if 0.
+	L2EngineCallTimeout time.Duration
+
+	// L2EngineCallAttempts is the maximum number of attempts of an engine API call that fails without a response.
+	// The default is used if 0.
+	L2EngineCallAttempts int
 }
 
 var _ L2EndpointSetup = (*L2EndpointConfig)(nil)
@@ -43,6 +51,12 @@ func (cfg *L2EndpointConfig) Check() error {
 	if cfg.L2EngineAddr == "" {
 		return errors.New("empty L2 Engine Address")
 	}
+	if cfg.L2EngineCallTimeout < 0 {
+		return errors.New("negative L2 Engine call timeout")
+	}
+	if cfg.L2EngineCallAttempts < 0 {
+		return errors.New("negative L2 Engine call attempts")
+	}
 
 	return nil
 }
@@ -61,7 +75,16 @@ func (cfg *L2EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 		return nil, nil, err
 	}
 
-	return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
+	engineCfg := sources.EngineClientDefaultConfig(rollupCfg)
+	if cfg.L2EngineCallTimeout != 0 {
+		engineCfg.ForkchoiceUpdateTimeout = cfg.L2EngineCallTimeout
+		engineCfg.NewPayloadTimeout = cfg.L2EngineCallTimeout
+		engineCfg.GetPayloadTimeout = cfg.L2EngineCallTimeout
+	}
+	if cfg.L2EngineCallAttempts != 0 {
+		engineCfg.CallAttempts = cfg.L2EngineCallAttempts
+	}
+	return l2Node, engineCfg, nil
 }
 
 // PreparedL2Endpoints enables testing with in-process pre-setup RPC connections to L2 engines
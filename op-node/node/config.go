@@ -39,6 +39,12 @@ type Config struct {
 	// Used to poll the L1 for new finalized or safe blocks
 	L1EpochPollInterval time.Duration
 
+	// L1BeaconAddr is the address of the L1 beacon node HTTP API. Optional unless L1BeaconFinality is enabled.
+	L1BeaconAddr string
+	// L1BeaconFinality determines the finalized L1 block from the finality checkpoints of the L1 beacon node,
+	// rather than trusting the finalized tag of the L1 RPC.
+	L1BeaconFinality bool
+
 	ConfigPersistence ConfigPersistence
 
 	// RuntimeConfigReloadInterval defines the interval between runtime config reloads.
@@ -62,6 +68,10 @@ type Config struct {
 
 	// [OPTIONAL] The reth DB path to read receipts from
 	RethDBPath string
+
+	// [OPTIONAL] File to record the L1 inputs consumed by derivation, and the resulting engine API calls, to.
+	// The recording can be replayed offline with the replay subcommand to reproduce derivation issues.
+	RecordDerivation string
 }
 
 type RPCConfig struct {
@@ -138,6 +148,9 @@ func (cfg *Config) Check() error {
 			return fmt.Errorf("p2p config error: %w", err)
 		}
 	}
+	if cfg.L1BeaconFinality && cfg.L1BeaconAddr == "" {
+		return fmt.Errorf("%s requires %s to be set", flags.L1BeaconFinality.Name, flags.L1BeaconAddr.Name)
+	}
 	if !(cfg.RollupHalt == "" || cfg.RollupHalt == "major" || cfg.RollupHalt == "minor" || cfg.RollupHalt == "patch") {
 		return fmt.Errorf("invalid rollup halting option: %q", cfg.RollupHalt)
 	}
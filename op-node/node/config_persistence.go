@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sync"
+	gosync "sync"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 )
 
 type RunningState int
@@ -19,20 +21,22 @@ const (
 )
 
 type persistedState struct {
-	SequencerStarted *bool `json:"sequencerStarted,omitempty"`
+	SequencerStarted *bool                `json:"sequencerStarted,omitempty"`
+	RecoveryApplied  *sync.RecoveryTarget `json:"recoveryApplied,omitempty"`
 }
 
 type ConfigPersistence interface {
 	SequencerStarted() error
 	SequencerStopped() error
 	SequencerState() (RunningState, error)
+	sync.RecoveryStore
 }
 
 var _ ConfigPersistence = (*ActiveConfigPersistence)(nil)
 var _ ConfigPersistence = DisabledConfigPersistence{}
 
 type ActiveConfigPersistence struct {
-	lock sync.Mutex
+	lock gosync.Mutex
 	file string
 }
 
@@ -41,21 +45,38 @@ func NewConfigPersistence(file string) *ActiveConfigPersistence {
 }
 
 func (p *ActiveConfigPersistence) SequencerStarted() error {
-	return p.persist(true)
+	return p.persist(func(state *persistedState) {
+		started := true
+		state.SequencerStarted = &started
+	})
 }
 
 func (p *ActiveConfigPersistence) SequencerStopped() error {
-	return p.persist(false)
+	return p.persist(func(state *persistedState) {
+		started := false
+		state.SequencerStarted = &started
+	})
+}
+
+func (p *ActiveConfigPersistence) SetRecoveryApplied(target sync.RecoveryTarget) error {
+	return p.persist(func(state *persistedState) {
+		state.RecoveryApplied = &target
+	})
 }
 
-// persist writes the new config state to the file as safely as possible.
+// persist applies update to the current config state and writes it to the file as safely as possible.
 // It uses sync to ensure the data is actually persisted to disk and initially writes to a temp file
 // before renaming it into place. On UNIX systems this rename is typically atomic, ensuring the
 // actual file isn't corrupted if IO errors occur during writing.
-func (p *ActiveConfigPersistence) persist(sequencerStarted bool) error {
+func (p *ActiveConfigPersistence) persist(update func(state *persistedState)) error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	data, err := json.Marshal(persistedState{SequencerStarted: &sequencerStarted})
+	state, err := p.readLocked()
+	if err != nil {
+		return err
+	}
+	update(&state)
+	data, err := json.Marshal(state)
 	if err != nil {
 		return fmt.Errorf("marshall new config: %w", err)
 	}
@@ -102,9 +123,24 @@ func (p *ActiveConfigPersistence) SequencerState() (RunningState, error) {
 	}
 }
 
+func (p *ActiveConfigPersistence) RecoveryApplied() (sync.RecoveryTarget, error) {
+	config, err := p.read()
+	if err != nil {
+		return sync.RecoveryTarget{}, err
+	}
+	if config.RecoveryApplied == nil {
+		return sync.RecoveryTarget{}, nil
+	}
+	return *config.RecoveryApplied, nil
+}
+
 func (p *ActiveConfigPersistence) read() (persistedState, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
+	return p.readLocked()
+}
+
+func (p *ActiveConfigPersistence) readLocked() (persistedState, error) {
 	data, err := os.ReadFile(p.file)
 	if errors.Is(err, os.ErrNotExist) {
 		// persistedState.SequencerStarted == nil: SequencerState() will return StateUnset if no state is found
@@ -118,7 +154,7 @@ func (p *ActiveConfigPersistence) read() (persistedState, error) {
 	if err = dec.Decode(&config); err != nil {
 		return persistedState{}, fmt.Errorf("invalid config file (%v): %w", p.file, err)
 	}
-	if config.SequencerStarted == nil {
+	if config.SequencerStarted == nil && config.RecoveryApplied == nil {
 		return persistedState{}, fmt.Errorf("missing sequencerStarted value in config file (%v)", p.file)
 	}
 	return config, nil
@@ -140,3 +176,11 @@ func (d DisabledConfigPersistence) SequencerStarted() error {
 func (d DisabledConfigPersistence) SequencerStopped() error {
 	return nil
 }
+
+func (d DisabledConfigPersistence) RecoveryApplied() (sync.RecoveryTarget, error) {
+	return sync.RecoveryTarget{}, nil
+}
+
+func (d DisabledConfigPersistence) SetRecoveryApplied(_ sync.RecoveryTarget) error {
+	return nil
+}
@@ -3,7 +3,10 @@ package node
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 )
 
 func TestActive(t *testing.T) {
@@ -59,6 +62,38 @@ func TestActive(t *testing.T) {
 		require.Equal(t, StateStopped, state)
 	})
 
+	t.Run("PersistRecoveryApplied", func(t *testing.T) {
+		config1 := create()
+		target, err := config1.RecoveryApplied()
+		require.NoError(t, err)
+		require.Equal(t, sync.RecoveryTarget{}, target)
+
+		applied := sync.RecoveryTarget{SafeHead: common.Hash{0xaa}, L1Start: common.Hash{0xbb}}
+		require.NoError(t, config1.SetRecoveryApplied(applied))
+		config2 := NewConfigPersistence(config1.file)
+		target, err = config2.RecoveryApplied()
+		require.NoError(t, err)
+		require.Equal(t, applied, target)
+		state, err := config2.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateUnset, state)
+	})
+
+	t.Run("PreserveSequencerStateAndRecoveryApplied", func(t *testing.T) {
+		config := create()
+		applied := sync.RecoveryTarget{SafeHead: common.Hash{0xaa}}
+		require.NoError(t, config.SequencerStopped())
+		require.NoError(t, config.SetRecoveryApplied(applied))
+		require.NoError(t, config.SequencerStarted())
+
+		state, err := config.SequencerState()
+		require.NoError(t, err)
+		require.Equal(t, StateStarted, state)
+		target, err := config.RecoveryApplied()
+		require.NoError(t, err)
+		require.Equal(t, applied, target)
+	})
+
 	t.Run("CreateParentDirs", func(t *testing.T) {
 		dir := t.TempDir()
 		config := NewConfigPersistence(dir + "/some/dir/state")
@@ -0,0 +1,87 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type L1FinalityMetrics interface {
+	RecordL1FinalityDivergence(blocks int64)
+	RecordL1FinalityConflict()
+}
+
+type BeaconFinalitySource interface {
+	FinalizedExecutionBlock(ctx context.Context) (eth.BlockID, error)
+}
+
+type L1BlockRefByHashSource interface {
+	L1BlockRefSource
+	L1BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L1BlockRef, error)
+}
+
+// beaconFinalitySource determines the finalized L1 block from the finality checkpoints of an L1 beacon node, rather
+// than trusting the finalized tag of the L1 RPC. Blocks with any other label are read from the L1 RPC as usual.
+// The finalized block of the L1 RPC is still fetched, to record how far the two sources diverge.
+type beaconFinalitySource struct {
+	log    log.Logger
+	l1     L1BlockRefByHashSource
+	beacon BeaconFinalitySource
+	m      L1FinalityMetrics
+}
+
+func newBeaconFinalitySource(log log.Logger, l1 L1BlockRefByHashSource, beacon BeaconFinalitySource, m L1FinalityMetrics) *beaconFinalitySource {
+	return &beaconFinalitySource{
+		log:    log,
+		l1:     l1,
+		beacon: beacon,
+		m:      m,
+	}
+}
+
+func (s *beaconFinalitySource) L1BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L1BlockRef, error) {
+	if label != eth.Finalized {
+		return s.l1.L1BlockRefByLabel(ctx, label)
+	}
+	id, err := s.beacon.FinalizedExecutionBlock(ctx)
+	if err != nil {
+		return eth.L1BlockRef{}, fmt.Errorf("failed to fetch beacon finalized block: %w", err)
+	}
+	ref, err := s.l1.L1BlockRefByHash(ctx, id.Hash)
+	if err != nil {
+		return eth.L1BlockRef{}, fmt.Errorf("failed to fetch beacon finalized block %v: %w", id, err)
+	}
+	if ref.Number != id.Number {
+		return eth.L1BlockRef{}, fmt.Errorf("beacon finalized block %v has number %d in L1 RPC", id, ref.Number)
+	}
+	s.checkDivergence(ctx, ref)
+	return ref, nil
+}
+
+// checkDivergence records how far the finalized block of the L1 RPC is from the beacon finalized block, and whether
+// the beacon finalized block is part of the canonical chain of the L1 RPC.
+// Divergence is informational only so errors are logged rather than preventing finality being signalled.
+func (s *beaconFinalitySource) checkDivergence(ctx context.Context, finalized eth.L1BlockRef) {
+	rpcFinalized, err := s.l1.L1BlockRefByLabel(ctx, eth.Finalized)
+	if err != nil {
+		s.log.Warn("Failed to fetch L1 RPC finalized block", "err", err)
+	} else {
+		s.m.RecordL1FinalityDivergence(int64(rpcFinalized.Number) - int64(finalized.Number))
+		if rpcFinalized.Number != finalized.Number {
+			s.log.Debug("L1 RPC finalized block differs from beacon finalized block", "rpc", rpcFinalized, "beacon", finalized)
+		}
+	}
+	canonical, err := s.l1.L1BlockRefByNumber(ctx, finalized.Number)
+	if err != nil {
+		s.log.Warn("Failed to fetch canonical L1 block at beacon finalized height", "number", finalized.Number, "err", err)
+		return
+	}
+	if canonical.Hash != finalized.Hash {
+		s.m.RecordL1FinalityConflict()
+		s.log.Error("Beacon finalized block is not canonical in L1 RPC", "beacon", finalized, "canonical", canonical)
+	}
+}
@@ -0,0 +1,118 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestBeaconFinalitySource(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	finalized := testutils.RandomBlockRef(rng)
+	next := testutils.NextRandomRef(rng, finalized)
+	setup := func(t *testing.T) (*beaconFinalitySource, *testutils.MockL1Source, *stubBeaconFinality, *finalityMetrics) {
+		l1 := &testutils.MockL1Source{}
+		beacon := &stubBeaconFinality{block: finalized.ID()}
+		m := &finalityMetrics{}
+		return newBeaconFinalitySource(testlog.Logger(t, log.LvlError), l1, beacon, m), l1, beacon, m
+	}
+
+	t.Run("PassThroughOtherLabels", func(t *testing.T) {
+		s, l1, _, m := setup(t)
+		l1.ExpectL1BlockRefByLabel(eth.Safe, next, nil)
+		ref, err := s.L1BlockRefByLabel(context.Background(), eth.Safe)
+		require.NoError(t, err)
+		require.Equal(t, next, ref)
+		require.Empty(t, m.divergence)
+		l1.AssertExpectations(t)
+	})
+
+	t.Run("UseBeaconFinality", func(t *testing.T) {
+		s, l1, _, m := setup(t)
+		l1.ExpectL1BlockRefByHash(finalized.Hash, finalized, nil)
+		l1.ExpectL1BlockRefByLabel(eth.Finalized, next, nil)
+		l1.ExpectL1BlockRefByNumber(finalized.Number, finalized, nil)
+		ref, err := s.L1BlockRefByLabel(context.Background(), eth.Finalized)
+		require.NoError(t, err)
+		require.Equal(t, finalized, ref)
+		require.Equal(t, []int64{1}, m.divergence)
+		require.Zero(t, m.conflicts)
+		l1.AssertExpectations(t)
+	})
+
+	t.Run("RecordConflict", func(t *testing.T) {
+		s, l1, _, m := setup(t)
+		conflicting := finalized
+		conflicting.Hash = common.Hash{0xaa}
+		l1.ExpectL1BlockRefByHash(finalized.Hash, finalized, nil)
+		l1.ExpectL1BlockRefByLabel(eth.Finalized, conflicting, nil)
+		l1.ExpectL1BlockRefByNumber(finalized.Number, conflicting, nil)
+		ref, err := s.L1BlockRefByLabel(context.Background(), eth.Finalized)
+		require.NoError(t, err)
+		require.Equal(t, finalized, ref)
+		require.Equal(t, []int64{0}, m.divergence)
+		require.Equal(t, 1, m.conflicts)
+		l1.AssertExpectations(t)
+	})
+
+	t.Run("IgnoreDivergenceErrors", func(t *testing.T) {
+		s, l1, _, m := setup(t)
+		l1.ExpectL1BlockRefByHash(finalized.Hash, finalized, nil)
+		l1.ExpectL1BlockRefByLabel(eth.Finalized, eth.L1BlockRef{}, errors.New("boom"))
+		l1.ExpectL1BlockRefByNumber(finalized.Number, eth.L1BlockRef{}, errors.New("boom"))
+		ref, err := s.L1BlockRefByLabel(context.Background(), eth.Finalized)
+		require.NoError(t, err)
+		require.Equal(t, finalized, ref)
+		require.Empty(t, m.divergence)
+		require.Zero(t, m.conflicts)
+		l1.AssertExpectations(t)
+	})
+
+	t.Run("BeaconError", func(t *testing.T) {
+		s, l1, beacon, _ := setup(t)
+		beacon.err = errors.New("boom")
+		_, err := s.L1BlockRefByLabel(context.Background(), eth.Finalized)
+		require.ErrorIs(t, err, beacon.err)
+		l1.AssertExpectations(t)
+	})
+
+	t.Run("BlockNumberMismatch", func(t *testing.T) {
+		s, l1, beacon, _ := setup(t)
+		beacon.block.Number++
+		l1.ExpectL1BlockRefByHash(finalized.Hash, finalized, nil)
+		_, err := s.L1BlockRefByLabel(context.Background(), eth.Finalized)
+		require.ErrorContains(t, err, "has number")
+		l1.AssertExpectations(t)
+	})
+}
+
+type stubBeaconFinality struct {
+	block eth.BlockID
+	err   error
+}
+
+func (s *stubBeaconFinality) FinalizedExecutionBlock(_ context.Context) (eth.BlockID, error) {
+	return s.block, s.err
+}
+
+type finalityMetrics struct {
+	divergence []int64
+	conflicts  int
+}
+
+func (m *finalityMetrics) RecordL1FinalityDivergence(blocks int64) {
+	m.divergence = append(m.divergence, blocks)
+}
+
+func (m *finalityMetrics) RecordL1FinalityConflict() {
+	m.conflicts++
+}
@@ -0,0 +1,92 @@
+package node
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// maxL1HeadBackfill is the maximum number of missed L1 heads that are fetched and signalled after a gap.
+// Derivation traverses L1 by block number so it never skips L1 data; backfilled heads only keep reorg
+// detection continuous, which only needs the most recent blocks.
+const maxL1HeadBackfill = 128
+
+type L1HeadGapMetrics interface {
+	RecordL1HeadGap(size uint64)
+}
+
+type L1BlockRefSource interface {
+	L1BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L1BlockRef, error)
+	L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error)
+}
+
+// l1HeadGapFiller passes L1 head signals on to fn, first fetching and signalling any heads that were missed since the
+// previous signal, as happens when the head subscription drops and is re-established.
+type l1HeadGapFiller struct {
+	log log.Logger
+	src L1BlockRefSource
+	m   L1HeadGapMetrics
+	fn  eth.HeadSignalFn
+
+	mu   sync.Mutex
+	last eth.L1BlockRef
+}
+
+func newL1HeadGapFiller(log log.Logger, src L1BlockRefSource, m L1HeadGapMetrics, fn eth.HeadSignalFn) *l1HeadGapFiller {
+	return &l1HeadGapFiller{
+		log: log,
+		src: src,
+		m:   m,
+		fn:  fn,
+	}
+}
+
+// OnNewL1Head signals any missed heads between the previous head and sig, then sig itself.
+func (g *l1HeadGapFiller) OnNewL1Head(ctx context.Context, sig eth.L1BlockRef) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.last != (eth.L1BlockRef{}) && sig.Number > g.last.Number+1 {
+		g.backfill(ctx, g.last.Number+1, sig.Number)
+	}
+	g.last = sig
+	g.fn(ctx, sig)
+}
+
+// CatchUp fetches the current L1 head and signals it along with any missed heads before it.
+// It is used after the head subscription is re-established, so the gap is filled without waiting for the next block.
+func (g *l1HeadGapFiller) CatchUp(ctx context.Context) {
+	head, err := g.src.L1BlockRefByLabel(ctx, eth.Unsafe)
+	if err != nil {
+		g.log.Warn("Failed to fetch L1 head after resubscribing", "err", err)
+		return
+	}
+	g.mu.Lock()
+	last := g.last
+	g.mu.Unlock()
+	if head.Number <= last.Number {
+		// Nothing missed, or the subscription has already signalled a newer head.
+		return
+	}
+	g.OnNewL1Head(ctx, head)
+}
+
+// backfill signals the heads from start up to, but not including, end.
+func (g *l1HeadGapFiller) backfill(ctx context.Context, start uint64, end uint64) {
+	gap := end - start
+	g.m.RecordL1HeadGap(gap)
+	if gap > maxL1HeadBackfill {
+		start = end - maxL1HeadBackfill
+	}
+	g.log.Warn("Missed L1 heads, backfilling", "gap", gap, "from", start, "to", end-1)
+	for num := start; num < end; num++ {
+		ref, err := g.src.L1BlockRefByNumber(ctx, num)
+		if err != nil {
+			g.log.Warn("Failed to backfill missed L1 head", "num", num, "err", err)
+			return
+		}
+		g.fn(ctx, ref)
+	}
+}
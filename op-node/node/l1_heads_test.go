@@ -0,0 +1,116 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestL1HeadGapFiller(t *testing.T) {
+	setup := func(t *testing.T) (*l1HeadGapFiller, *testutils.MockL1Source, *gapMetrics, *[]eth.L1BlockRef) {
+		src := &testutils.MockL1Source{}
+		m := &gapMetrics{}
+		var signalled []eth.L1BlockRef
+		g := newL1HeadGapFiller(testlog.Logger(t, log.LvlError), src, m, func(_ context.Context, sig eth.L1BlockRef) {
+			signalled = append(signalled, sig)
+		})
+		return g, src, m, &signalled
+	}
+	chain := func(length int) []eth.L1BlockRef {
+		rng := rand.New(rand.NewSource(1234))
+		refs := []eth.L1BlockRef{testutils.RandomBlockRef(rng)}
+		for len(refs) < length {
+			refs = append(refs, testutils.NextRandomRef(rng, refs[len(refs)-1]))
+		}
+		return refs
+	}
+
+	t.Run("NoGap", func(t *testing.T) {
+		g, src, m, signalled := setup(t)
+		refs := chain(3)
+		for _, ref := range refs {
+			g.OnNewL1Head(context.Background(), ref)
+		}
+		require.Equal(t, refs, *signalled)
+		require.Empty(t, m.gaps)
+		src.AssertExpectations(t)
+	})
+
+	t.Run("BackfillGap", func(t *testing.T) {
+		g, src, m, signalled := setup(t)
+		refs := chain(5)
+		src.ExpectL1BlockRefByNumber(refs[1].Number, refs[1], nil)
+		src.ExpectL1BlockRefByNumber(refs[2].Number, refs[2], nil)
+		src.ExpectL1BlockRefByNumber(refs[3].Number, refs[3], nil)
+		g.OnNewL1Head(context.Background(), refs[0])
+		g.OnNewL1Head(context.Background(), refs[4])
+		require.Equal(t, refs, *signalled)
+		require.Equal(t, []uint64{3}, m.gaps)
+		src.AssertExpectations(t)
+	})
+
+	t.Run("LimitBackfill", func(t *testing.T) {
+		g, src, m, signalled := setup(t)
+		refs := chain(maxL1HeadBackfill + 10)
+		head := refs[len(refs)-1]
+		expected := append([]eth.L1BlockRef{refs[0]}, refs[len(refs)-1-maxL1HeadBackfill:]...)
+		for _, ref := range expected[1 : len(expected)-1] {
+			src.ExpectL1BlockRefByNumber(ref.Number, ref, nil)
+		}
+		g.OnNewL1Head(context.Background(), refs[0])
+		g.OnNewL1Head(context.Background(), head)
+		require.Equal(t, expected, *signalled)
+		require.Equal(t, []uint64{uint64(len(refs) - 2)}, m.gaps)
+		src.AssertExpectations(t)
+	})
+
+	t.Run("StopBackfillOnError", func(t *testing.T) {
+		g, src, _, signalled := setup(t)
+		refs := chain(4)
+		src.ExpectL1BlockRefByNumber(refs[1].Number, refs[1], nil)
+		src.ExpectL1BlockRefByNumber(refs[2].Number, eth.L1BlockRef{}, errors.New("boom"))
+		g.OnNewL1Head(context.Background(), refs[0])
+		g.OnNewL1Head(context.Background(), refs[3])
+		require.Equal(t, []eth.L1BlockRef{refs[0], refs[1], refs[3]}, *signalled)
+		src.AssertExpectations(t)
+	})
+
+	t.Run("CatchUp", func(t *testing.T) {
+		g, src, m, signalled := setup(t)
+		refs := chain(3)
+		src.ExpectL1BlockRefByLabel(eth.Unsafe, refs[2], nil)
+		src.ExpectL1BlockRefByNumber(refs[1].Number, refs[1], nil)
+		g.OnNewL1Head(context.Background(), refs[0])
+		g.CatchUp(context.Background())
+		require.Equal(t, refs, *signalled)
+		require.Equal(t, []uint64{1}, m.gaps)
+		src.AssertExpectations(t)
+	})
+
+	t.Run("CatchUpAlreadyCurrent", func(t *testing.T) {
+		g, src, m, signalled := setup(t)
+		refs := chain(1)
+		src.ExpectL1BlockRefByLabel(eth.Unsafe, refs[0], nil)
+		g.OnNewL1Head(context.Background(), refs[0])
+		g.CatchUp(context.Background())
+		require.Equal(t, refs, *signalled)
+		require.Empty(t, m.gaps)
+		src.AssertExpectations(t)
+	})
+}
+
+type gapMetrics struct {
+	gaps []uint64
+}
+
+func (m *gapMetrics) RecordL1HeadGap(size uint64) {
+	m.gaps = append(m.gaps, size)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/replay"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	"github.com/ethereum-optimism/optimism/op-service/client"
@@ -51,6 +53,7 @@ type OpNode struct {
 	p2pNode   *p2p.NodeP2P          // P2P node functionality
 	p2pSigner p2p.Signer            // p2p gogssip application messages will be signed with this signer
 	tracer    Tracer                // tracer to get events for testing/debugging
+	recording *os.File              // file derivation inputs are recorded to, if enabled
 	runCfg    *RuntimeConfig        // runtime configurables
 
 	rollupHalt string // when to halt the rollup, disabled if empty
@@ -161,7 +164,7 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 	rpcCfg.EthClientConfig.RethDBPath = cfg.RethDBPath
 
 	n.l1Source, err = sources.NewL1Client(
-		client.NewInstrumentedRPC(l1Node, n.metrics), n.log, n.metrics.L1SourceCache, rpcCfg)
+		client.NewInstrumentedRPC(client.NewConnectionStateRPC(n.log, "l1", n.metrics, l1Node), n.metrics), n.log, n.metrics.L1SourceCache, rpcCfg)
 	if err != nil {
 		return fmt.Errorf("failed to create L1 source: %w", err)
 	}
@@ -170,12 +173,18 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 		return fmt.Errorf("failed to validate the L1 config: %w", err)
 	}
 
-	// Keep subscribed to the L1 heads, which keeps the L1 maintainer pointing to the best headers to sync
+	// Keep subscribed to the L1 heads, which keeps the L1 maintainer pointing to the best headers to sync.
+	// Heads missed while the subscription was down are backfilled once it is re-established.
+	l1Heads := newL1HeadGapFiller(n.log, n.l1Source, n.metrics, n.OnNewL1Head)
 	n.l1HeadsSub = event.ResubscribeErr(time.Second*10, func(ctx context.Context, err error) (event.Subscription, error) {
 		if err != nil {
 			n.log.Warn("resubscribing after failed L1 subscription", "err", err)
 		}
-		return eth.WatchHeadChanges(ctx, n.l1Source, n.OnNewL1Head)
+		sub, subErr := eth.WatchHeadChanges(ctx, n.l1Source, l1Heads.OnNewL1Head)
+		if subErr == nil && err != nil {
+			l1Heads.CatchUp(ctx)
+		}
+		return sub, subErr
 	})
 	go func() {
 		err, ok := <-n.l1HeadsSub.Err()
@@ -189,7 +198,12 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 	// which only change once per epoch at most and may be delayed.
 	n.l1SafeSub = eth.PollBlockChanges(n.log, n.l1Source, n.OnNewL1Safe, eth.Safe,
 		cfg.L1EpochPollInterval, time.Second*10)
-	n.l1FinalizedSub = eth.PollBlockChanges(n.log, n.l1Source, n.OnNewL1Finalized, eth.Finalized,
+	var finalitySource eth.L1BlockRefsSource = n.l1Source
+	if cfg.L1BeaconFinality {
+		n.log.Info("Using L1 beacon node finality checkpoints for L1 finality", "beacon", cfg.L1BeaconAddr)
+		finalitySource = newBeaconFinalitySource(n.log, n.l1Source, sources.NewL1BeaconClient(cfg.L1BeaconAddr), n.metrics)
+	}
+	n.l1FinalizedSub = eth.PollBlockChanges(n.log, finalitySource, n.OnNewL1Finalized, eth.Finalized,
 		cfg.L1EpochPollInterval, time.Second*10)
 	return nil
 }
@@ -295,7 +309,7 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Logger
 	}
 
 	n.l2Source, err = sources.NewEngineClient(
-		client.NewInstrumentedRPC(rpcClient, n.metrics), n.log, n.metrics.L2SourceCache, rpcCfg,
+		client.NewInstrumentedRPC(client.NewConnectionStateRPC(n.log, "l2", n.metrics, rpcClient), n.metrics), n.log, n.metrics.L2SourceCache, rpcCfg,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create Engine client: %w", err)
@@ -305,7 +319,19 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Logger
 		return err
 	}
 
-	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, n, n, n.log, snapshotLog, n.metrics, cfg.ConfigPersistence, &cfg.Sync)
+	var recorder *replay.Recorder
+	if cfg.RecordDerivation != "" {
+		n.recording, err = os.OpenFile(cfg.RecordDerivation, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open derivation recording: %w", err)
+		}
+		n.log.Warn("Recording derivation inputs", "path", cfg.RecordDerivation)
+		recorder = replay.NewRecorder(n.log, n.recording)
+	}
+
+	syncCfg := cfg.Sync
+	syncCfg.RecoveryStore = cfg.ConfigPersistence
+	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, n, n, n.log, snapshotLog, n.metrics, cfg.ConfigPersistence, &syncCfg, recorder)
 
 	return nil
 }
@@ -569,6 +595,13 @@ func (n *OpNode) Stop(ctx context.Context) error {
 		}
 	}
 
+	// close derivation recording, after the driver has stopped writing to it
+	if n.recording != nil {
+		if err := n.recording.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close derivation recording: %w", err))
+		}
+	}
+
 	// Wait for the runtime config loader to be done using the data sources before closing them
 	if n.runtimeConfigReloaderDone != nil {
 		<-n.runtimeConfigReloaderDone
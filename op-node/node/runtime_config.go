@@ -34,6 +34,7 @@ type RuntimeCfgL1Source interface {
 
 type ReadonlyRuntimeConfig interface {
 	P2PSequencerAddress() common.Address
+	P2PSignerRotation() (previous common.Address, after uint64, before uint64)
 	RequiredProtocolVersion() params.ProtocolVersion
 	RecommendedProtocolVersion() params.ProtocolVersion
 }
@@ -55,6 +56,17 @@ type RuntimeConfig struct {
 	l1Ref eth.L1BlockRef
 
 	runtimeConfigData
+
+	// prevP2PBlockSignerAddr is the unsafe block signer that was replaced by the most recent rotation, if any.
+	// The rotation took effect at an L1 timestamp in (p2pSignerRotatedAfter, p2pSignerRotatedBefore],
+	// the times of the L1 blocks the runtime config was loaded from before and after the change.
+	//
+	// The rotation is only kept in memory. After a restart the runtime config is reloaded from the system config,
+	// which only records the current signer, so blocks signed by the previous signer are rejected from then on.
+	// This only affects blocks still in flight during the grace window around a rotation.
+	prevP2PBlockSignerAddr common.Address
+	p2pSignerRotatedAfter  uint64
+	p2pSignerRotatedBefore uint64
 }
 
 // runtimeConfigData is a flat bundle of configurable data, easy and light to copy around.
@@ -82,6 +94,15 @@ func (r *RuntimeConfig) P2PSequencerAddress() common.Address {
 	return r.p2pBlockSignerAddr
 }
 
+// P2PSignerRotation returns the unsafe block signer that was replaced by the current one, and the bounds of the
+// L1 timestamps between which the rotation took effect. The previous address is zero if no rotation has been observed
+// since the node started.
+func (r *RuntimeConfig) P2PSignerRotation() (previous common.Address, after uint64, before uint64) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.prevP2PBlockSignerAddr, r.p2pSignerRotatedAfter, r.p2pSignerRotatedBefore
+}
+
 func (r *RuntimeConfig) RequiredProtocolVersion() params.ProtocolVersion {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -118,8 +139,16 @@ func (r *RuntimeConfig) Load(ctx context.Context, l1Ref eth.L1BlockRef) error {
 	}
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	p2pSignerAddr := common.BytesToAddress(p2pSignerVal[:])
+	if r.p2pBlockSignerAddr != (common.Address{}) && p2pSignerAddr != r.p2pBlockSignerAddr {
+		r.prevP2PBlockSignerAddr = r.p2pBlockSignerAddr
+		r.p2pSignerRotatedAfter = r.l1Ref.Time
+		r.p2pSignerRotatedBefore = l1Ref.Time
+		r.log.Info("unsafe block signer rotated", "previous", r.prevP2PBlockSignerAddr, "new", p2pSignerAddr,
+			"after", r.p2pSignerRotatedAfter, "before", r.p2pSignerRotatedBefore)
+	}
 	r.l1Ref = l1Ref
-	r.p2pBlockSignerAddr = common.BytesToAddress(p2pSignerVal[:])
+	r.p2pBlockSignerAddr = p2pSignerAddr
 	r.required = requiredProtVersion
 	r.recommended = recommendedProtoVersion
 	r.log.Info("loaded new runtime config values!", "p2p_seq_address", r.p2pBlockSignerAddr)
@@ -0,0 +1,51 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubRuntimeCfgL1Source struct {
+	signers map[common.Hash]common.Address
+}
+
+func (s *stubRuntimeCfgL1Source) ReadStorageAt(_ context.Context, _ common.Address, _ common.Hash, blockHash common.Hash) (common.Hash, error) {
+	return common.BytesToHash(s.signers[blockHash].Bytes()), nil
+}
+
+func TestRuntimeConfigSignerRotation(t *testing.T) {
+	signerA := common.Address{0xaa}
+	signerB := common.Address{0xbb}
+	blocks := []eth.L1BlockRef{
+		{Hash: common.Hash{1}, Number: 1, Time: 100},
+		{Hash: common.Hash{2}, Number: 2, Time: 112},
+		{Hash: common.Hash{3}, Number: 3, Time: 124},
+	}
+	l1 := &stubRuntimeCfgL1Source{signers: map[common.Hash]common.Address{
+		blocks[0].Hash: signerA,
+		blocks[1].Hash: signerA,
+		blocks[2].Hash: signerB,
+	}}
+	cfg := NewRuntimeConfig(testlog.Logger(t, log.LvlCrit), l1, &rollup.Config{})
+
+	require.NoError(t, cfg.Load(context.Background(), blocks[0]))
+	require.NoError(t, cfg.Load(context.Background(), blocks[1]))
+	require.Equal(t, signerA, cfg.P2PSequencerAddress())
+	previous, _, _ := cfg.P2PSignerRotation()
+	require.Equal(t, common.Address{}, previous, "no rotation yet")
+
+	require.NoError(t, cfg.Load(context.Background(), blocks[2]))
+	require.Equal(t, signerB, cfg.P2PSequencerAddress())
+	previous, after, before := cfg.P2PSignerRotation()
+	require.Equal(t, signerA, previous)
+	require.Equal(t, blocks[1].Time, after)
+	require.Equal(t, blocks[2].Time, before)
+}
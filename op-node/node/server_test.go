@@ -16,6 +16,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	rpcclient "github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -198,6 +199,50 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, status, out)
 }
 
+func TestDerivationStatus(t *testing.T) {
+	log := testlog.Logger(t, log.LvlError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	rng := rand.New(rand.NewSource(1234))
+	status := &derive.DerivationStatus{
+		CurrentL1:     testutils.RandomBlockRef(rng),
+		SafeL2:        testutils.RandomL2BlockRef(rng),
+		PendingSafeL2: testutils.RandomL2BlockRef(rng),
+		DroppedBatches: []derive.DroppedBatch{
+			{
+				BatchType:        derive.SpanBatchType,
+				Timestamp:        rng.Uint64(),
+				L1InclusionBlock: testutils.RandomBlockID(rng),
+				SafeHead:         testutils.RandomBlockID(rng),
+				Reason:           derive.BatchDropParentMismatch,
+			},
+		},
+	}
+	drClient.On("DerivationStatus").Return(status)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(context.Background(), rpcCfg, rollupCfg, l2Client, drClient, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+
+	var out *derive.DerivationStatus
+	err = client.CallContext(context.Background(), &out, "optimism_derivationStatus")
+	require.NoError(t, err)
+	require.Equal(t, status, out)
+}
+
 type mockDriverClient struct {
 	mock.Mock
 }
@@ -215,6 +260,10 @@ func (c *mockDriverClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, err
 	return c.Mock.MethodCalled("SyncStatus").Get(0).(*eth.SyncStatus), nil
 }
 
+func (c *mockDriverClient) DerivationStatus(ctx context.Context) (*derive.DerivationStatus, error) {
+	return c.Mock.MethodCalled("DerivationStatus").Get(0).(*derive.DerivationStatus), nil
+}
+
 func (c *mockDriverClient) ResetDerivationPipeline(ctx context.Context) error {
 	return c.Mock.MethodCalled("ResetDerivationPipeline").Get(0).(error)
 }
@@ -33,12 +33,14 @@ func (n *OpNode) handleProtocolVersionsUpdate(ctx context.Context) error {
 	catalyst.LogProtocolVersionSupport(n.log.New("node", "engine"), local, required, "required")
 
 	// We may need to halt the node, if the user opted in to handling incompatible protocol-version signals
-	return n.haltMaybe()
+	return n.haltMaybe(engineSupport)
 }
 
 // haltMaybe returns errNodeHalt if the runtime config indicates an incompatible required protocol change
 // and the node is configured to opt-in to halting at this protocol-change level.
-func (n *OpNode) haltMaybe() error {
+// Both the op-node and the execution engine must support the required protocol version for the node to continue.
+// The engine support is ignored if it is unknown (empty).
+func (n *OpNode) haltMaybe(engineSupport params.ProtocolVersion) error {
 	local := rollup.OPStackSupport
 	required := n.runCfg.RequiredProtocolVersion()
 	if haltMaybe(n.rollupHalt, local.Compare(required)) { // halt if we opted in to do so at this granularity
@@ -46,6 +48,10 @@ func (n *OpNode) haltMaybe() error {
 		// Avoid deadlocking the runtime config reloader by closing the OpNode elsewhere
 		return errNodeHalt
 	}
+	if engineSupport != (params.ProtocolVersion{}) && haltMaybe(n.rollupHalt, engineSupport.Compare(required)) {
+		n.log.Error("Opted to halt, execution engine unprepared for protocol change", "required", required, "engine", engineSupport)
+		return errNodeHalt
+	}
 	return nil
 }
 
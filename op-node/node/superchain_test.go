@@ -6,7 +6,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
 
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
 func TestHaltMaybe(t *testing.T) {
@@ -37,3 +40,31 @@ func TestHaltMaybe(t *testing.T) {
 	haltTest("minor", params.OutdatedMajor, params.OutdatedMinor)
 	haltTest("patch", params.OutdatedMajor, params.OutdatedMinor, params.OutdatedPatch)
 }
+
+func TestHaltMaybeConsidersEngineSupport(t *testing.T) {
+	// Below the local op-node support so only the engine support can cause a halt.
+	required := params.ProtocolVersionV0{Major: 4, Minor: 0, Patch: 0}.Encode()
+	n := &OpNode{
+		log:        testlog.Logger(t, log.LvlInfo),
+		rollupHalt: "major",
+		runCfg: &RuntimeConfig{
+			runtimeConfigData: runtimeConfigData{required: required},
+		},
+	}
+
+	t.Run("EngineSupportUnknown", func(t *testing.T) {
+		require.NoError(t, n.haltMaybe(params.ProtocolVersion{}))
+	})
+	t.Run("EngineSupportsRequired", func(t *testing.T) {
+		require.NoError(t, n.haltMaybe(params.ProtocolVersionV0{Major: 4, Minor: 1}.Encode()))
+	})
+	t.Run("EngineOutdatedBelowHaltLevel", func(t *testing.T) {
+		n.runCfg.required = params.ProtocolVersionV0{Major: 4, Minor: 1}.Encode()
+		defer func() { n.runCfg.required = required }()
+		// Only major changes halt
+		require.NoError(t, n.haltMaybe(params.ProtocolVersionV0{Major: 4, Minor: 0}.Encode()))
+	})
+	t.Run("EngineOutdated", func(t *testing.T) {
+		require.ErrorIs(t, n.haltMaybe(params.ProtocolVersionV0{Major: 3}.Encode()), errNodeHalt)
+	})
+}
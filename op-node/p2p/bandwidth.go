@@ -0,0 +1,190 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+// maxBandwidthPeers is the number of peers gossip bandwidth is tracked for.
+// It is well above the peer count high-watermark so connected peers are not evicted.
+const maxBandwidthPeers = 1000
+
+// DefaultGossipPeerBurst is the default burst of gossip accepted from each peer, allowing a max size message.
+const DefaultGossipPeerBurst = maxGossipSize
+
+// GossipRateLimits configures the limits on the gossip bandwidth accepted from each peer.
+type GossipRateLimits struct {
+	// PeerBytesPerSecond is the sustained rate of gossip, in bytes per second, accepted from each peer.
+	// Rate limiting is disabled if 0.
+	PeerBytesPerSecond float64
+	// PeerBurstBytes is the number of bytes a peer may gossip at once, above the sustained rate.
+	// It must be at least the max gossip message size so that valid messages can always be accepted.
+	PeerBurstBytes int
+	// ThrottleDuration is the time for which all gossip from a peer is ignored after it exceeds its rate limit.
+	ThrottleDuration time.Duration
+}
+
+func (l GossipRateLimits) Enabled() bool {
+	return l.PeerBytesPerSecond > 0
+}
+
+func (l GossipRateLimits) Check() error {
+	if !l.Enabled() {
+		return nil
+	}
+	if l.PeerBurstBytes < maxGossipSize {
+		return fmt.Errorf("gossip peer burst must be at least the max gossip size of %d bytes, got %d", maxGossipSize, l.PeerBurstBytes)
+	}
+	if l.ThrottleDuration < 0 {
+		return errors.New("gossip throttle duration must not be negative")
+	}
+	return nil
+}
+
+type GossipBandwidthMetricer interface {
+	RecordGossipBytes(topic string, bytes int)
+	RecordGossipRateLimited(topic string)
+	SetGossipThrottledPeers(count int)
+}
+
+// peerBandwidth is the gossip received from a single peer.
+type peerBandwidth struct {
+	limiter *rate.Limiter
+	// received is the number of bytes of gossip received from the peer, by topic.
+	received       map[string]uint64
+	throttledUntil time.Time
+}
+
+// GossipBandwidth meters the gossip received from each peer on each topic and throttles peers that exceed the
+// configured rate limits by ignoring their gossip for a period.
+type GossipBandwidth struct {
+	log    log.Logger
+	m      GossipBandwidthMetricer
+	clock  clock.Clock
+	self   peer.ID
+	limits GossipRateLimits
+
+	mu    sync.Mutex
+	peers *simplelru.LRU[peer.ID, *peerBandwidth]
+}
+
+func NewGossipBandwidth(log log.Logger, m GossipBandwidthMetricer, cl clock.Clock, self peer.ID, limits GossipRateLimits) *GossipBandwidth {
+	peers, _ := simplelru.NewLRU[peer.ID, *peerBandwidth](maxBandwidthPeers, nil)
+	return &GossipBandwidth{
+		log:    log,
+		m:      m,
+		clock:  cl,
+		self:   self,
+		limits: limits,
+		peers:  peers,
+	}
+}
+
+// Allow records size bytes of gossip received from id on topic and returns false if the peer is over its rate limit
+// or throttled. Gossip published by the local node is never limited.
+func (b *GossipBandwidth) Allow(id peer.ID, topic string, size int) bool {
+	b.m.RecordGossipBytes(topic, size)
+	if id == b.self {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.clock.Now()
+	stats, ok := b.peers.Get(id)
+	if !ok {
+		stats = &peerBandwidth{
+			limiter:  rate.NewLimiter(rate.Limit(b.limits.PeerBytesPerSecond), b.limits.PeerBurstBytes),
+			received: make(map[string]uint64),
+		}
+		if !b.limits.Enabled() {
+			stats.limiter.SetLimit(rate.Inf)
+		}
+		b.peers.Add(id, stats)
+	}
+	stats.received[topic] += uint64(size)
+
+	if now.Before(stats.throttledUntil) {
+		b.m.RecordGossipRateLimited(topic)
+		return false
+	}
+	if !stats.limiter.AllowN(now, size) {
+		stats.throttledUntil = now.Add(b.limits.ThrottleDuration)
+		b.log.Warn("Throttling peer that exceeded gossip rate limit", "peer", id, "topic", topic, "size", size,
+			"received", stats.received[topic], "until", stats.throttledUntil)
+		b.m.RecordGossipRateLimited(topic)
+		b.m.SetGossipThrottledPeers(b.throttledPeers(now))
+		return false
+	}
+	return true
+}
+
+// Received returns the number of bytes of gossip received from id, by topic.
+func (b *GossipBandwidth) Received(id peer.ID) map[string]uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	result := make(map[string]uint64)
+	if stats, ok := b.peers.Peek(id); ok {
+		for topic, bytes := range stats.received {
+			result[topic] = bytes
+		}
+	}
+	return result
+}
+
+// RecordMetrics periodically records the number of currently throttled peers until ctx is done.
+// The count is also updated when a peer is throttled, but must be refreshed to observe throttles expiring.
+func (b *GossipBandwidth) RecordMetrics(ctx context.Context) {
+	tick := b.clock.NewTicker(10 * time.Second)
+	defer tick.Stop()
+	for {
+		select {
+		case <-tick.Ch():
+			b.updateMetrics()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (b *GossipBandwidth) updateMetrics() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.m.SetGossipThrottledPeers(b.throttledPeers(b.clock.Now()))
+}
+
+func (b *GossipBandwidth) throttledPeers(now time.Time) int {
+	count := 0
+	for _, id := range b.peers.Keys() {
+		if stats, ok := b.peers.Peek(id); ok && now.Before(stats.throttledUntil) {
+			count++
+		}
+	}
+	return count
+}
+
+// limitGossipBandwidth ignores gossip on topic from peers that exceed their rate limit, before it is validated by fn.
+// Ignored messages are not forwarded but do not count against the peer's gossip score, as the peer may be relaying
+// valid messages that are also received from other peers.
+// No limits are applied if bandwidth is nil.
+func limitGossipBandwidth(bandwidth *GossipBandwidth, topic string, fn pubsub.ValidatorEx) pubsub.ValidatorEx {
+	if bandwidth == nil {
+		return fn
+	}
+	return func(ctx context.Context, id peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+		if !bandwidth.Allow(id, topic, len(message.Data)) {
+			return pubsub.ValidationIgnore
+		}
+		return fn(ctx, id, message)
+	}
+}
@@ -0,0 +1,127 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+const (
+	testTopic    = "blocksV2"
+	testSelf     = peer.ID("self")
+	testPeerA    = peer.ID("peerA")
+	testPeerB    = peer.ID("peerB")
+	testRate     = 1000
+	testBurst    = 2000
+	testThrottle = time.Minute
+)
+
+func TestGossipRateLimitsCheck(t *testing.T) {
+	require.NoError(t, GossipRateLimits{}.Check(), "disabled")
+	require.NoError(t, GossipRateLimits{PeerBytesPerSecond: 1, PeerBurstBytes: maxGossipSize}.Check())
+	require.ErrorContains(t, GossipRateLimits{PeerBytesPerSecond: 1, PeerBurstBytes: maxGossipSize - 1}.Check(), "burst")
+	require.ErrorContains(t, GossipRateLimits{PeerBytesPerSecond: 1, PeerBurstBytes: maxGossipSize, ThrottleDuration: -1}.Check(), "throttle")
+}
+
+func TestGossipBandwidth(t *testing.T) {
+	t.Run("MetersWithoutLimit", func(t *testing.T) {
+		bw, m, _ := setupGossipBandwidth(t, GossipRateLimits{})
+		for i := 0; i < 10; i++ {
+			require.True(t, bw.Allow(testPeerA, testTopic, maxGossipSize))
+		}
+		require.True(t, bw.Allow(testPeerA, "blocksV1", 5))
+		require.Equal(t, map[string]uint64{testTopic: 10 * maxGossipSize, "blocksV1": 5}, bw.Received(testPeerA))
+		require.Empty(t, bw.Received(testPeerB))
+		require.Equal(t, 10*maxGossipSize+5, m.bytes)
+		require.Zero(t, m.rateLimited)
+	})
+
+	t.Run("ThrottlesPeerOverLimit", func(t *testing.T) {
+		bw, m, cl := setupGossipBandwidth(t, testLimits())
+		require.True(t, bw.Allow(testPeerA, testTopic, testBurst))
+		require.False(t, bw.Allow(testPeerA, testTopic, 1))
+		require.Equal(t, 1, m.rateLimited)
+		require.Equal(t, 1, m.throttled)
+
+		// Remains throttled even once the rate limit would allow more gossip
+		cl.AdvanceTime(testThrottle - time.Second)
+		require.False(t, bw.Allow(testPeerA, testTopic, 1))
+		require.Equal(t, 2, m.rateLimited)
+
+		// Other peers are not affected
+		require.True(t, bw.Allow(testPeerB, testTopic, testBurst))
+
+		cl.AdvanceTime(time.Second)
+		require.True(t, bw.Allow(testPeerA, testTopic, testRate))
+		bw.updateMetrics()
+		require.Zero(t, m.throttled)
+		require.Equal(t, uint64(testBurst+1+1+testRate), bw.Received(testPeerA)[testTopic], "throttled gossip is still metered")
+	})
+
+	t.Run("RefillsAtRate", func(t *testing.T) {
+		bw, _, cl := setupGossipBandwidth(t, testLimits())
+		require.True(t, bw.Allow(testPeerA, testTopic, testBurst))
+		cl.AdvanceTime(time.Second)
+		require.True(t, bw.Allow(testPeerA, testTopic, testRate))
+	})
+
+	t.Run("NeverLimitsSelf", func(t *testing.T) {
+		bw, m, _ := setupGossipBandwidth(t, testLimits())
+		for i := 0; i < 10; i++ {
+			require.True(t, bw.Allow(testSelf, testTopic, testBurst))
+		}
+		require.Equal(t, 10*testBurst, m.bytes)
+	})
+}
+
+func TestLimitGossipBandwidth(t *testing.T) {
+	bw, _, _ := setupGossipBandwidth(t, testLimits())
+	validator := limitGossipBandwidth(bw, testTopic, func(ctx context.Context, id peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+		return pubsub.ValidationAccept
+	})
+	msg := &pubsub.Message{Message: &pb.Message{Data: make([]byte, testBurst)}}
+	require.Equal(t, pubsub.ValidationAccept, validator(context.Background(), testPeerA, msg))
+	require.Equal(t, pubsub.ValidationIgnore, validator(context.Background(), testPeerA, msg))
+
+	unlimited := limitGossipBandwidth(nil, testTopic, func(ctx context.Context, id peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+		return pubsub.ValidationAccept
+	})
+	require.Equal(t, pubsub.ValidationAccept, unlimited(context.Background(), testPeerA, msg))
+}
+
+func testLimits() GossipRateLimits {
+	return GossipRateLimits{PeerBytesPerSecond: testRate, PeerBurstBytes: testBurst, ThrottleDuration: testThrottle}
+}
+
+func setupGossipBandwidth(t *testing.T, limits GossipRateLimits) (*GossipBandwidth, *stubBandwidthMetrics, *clock.DeterministicClock) {
+	m := &stubBandwidthMetrics{}
+	cl := clock.NewDeterministicClock(time.UnixMilli(1000))
+	return NewGossipBandwidth(testlog.Logger(t, log.LvlInfo), m, cl, testSelf, limits), m, cl
+}
+
+type stubBandwidthMetrics struct {
+	bytes       int
+	rateLimited int
+	throttled   int
+}
+
+func (s *stubBandwidthMetrics) RecordGossipBytes(_ string, bytes int) {
+	s.bytes += bytes
+}
+
+func (s *stubBandwidthMetrics) RecordGossipRateLimited(_ string) {
+	s.rateLimited++
+}
+
+func (s *stubBandwidthMetrics) SetGossipThrottledPeers(count int) {
+	s.throttled = count
+}
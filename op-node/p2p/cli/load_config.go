@@ -338,5 +338,10 @@ func loadGossipOptions(conf *p2p.Config, ctx *cli.Context) error {
 	conf.MeshDHi = ctx.Int(flags.GossipMeshDhiName)
 	conf.MeshDLazy = ctx.Int(flags.GossipMeshDlazyName)
 	conf.FloodPublish = ctx.Bool(flags.GossipFloodPublishName)
+	conf.GossipLimits = p2p.GossipRateLimits{
+		PeerBytesPerSecond: ctx.Float64(flags.GossipPeerRateName),
+		PeerBurstBytes:     ctx.Int(flags.GossipPeerBurstName),
+		ThrottleDuration:   ctx.Duration(flags.GossipThrottleName),
+	}
 	return nil
 }
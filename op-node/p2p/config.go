@@ -102,6 +102,9 @@ type Config struct {
 	MeshDHi   int // topic stable mesh high watermark
 	MeshDLazy int // gossip target
 
+	// GossipLimits limits the gossip bandwidth accepted from each peer.
+	GossipLimits GossipRateLimits
+
 	// FloodPublish publishes messages from ourselves to peers outside of the gossip topic mesh but supporting the same topic.
 	FloodPublish bool
 
@@ -156,6 +159,10 @@ func (conf *Config) BanDuration() time.Duration {
 	return conf.BanningDuration
 }
 
+func (conf *Config) GossipRateLimits() GossipRateLimits {
+	return conf.GossipLimits
+}
+
 func (conf *Config) ReqRespSyncEnabled() bool {
 	return conf.EnableReqRespSync
 }
@@ -189,5 +196,8 @@ func (conf *Config) Check() error {
 	if conf.MeshDLazy <= 0 || conf.MeshDLazy > maxMeshParam {
 		return fmt.Errorf("mesh Dlazy param must not be 0 or exceed %d, but got %d", maxMeshParam, conf.MeshDLazy)
 	}
+	if err := conf.GossipLimits.Check(); err != nil {
+		return err
+	}
 	return nil
 }
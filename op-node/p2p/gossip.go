@@ -55,10 +55,15 @@ type GossipSetupConfigurables interface {
 	PeerScoringParams() *ScoringParams
 	// ConfigureGossip creates configuration options to apply to the GossipSub setup
 	ConfigureGossip(rollupCfg *rollup.Config) []pubsub.Option
+	// GossipRateLimits returns the limits on the gossip bandwidth accepted from each peer.
+	GossipRateLimits() GossipRateLimits
 }
 
 type GossipRuntimeConfig interface {
 	P2PSequencerAddress() common.Address
+	// P2PSignerRotation returns the unsafe block signer that was replaced by the current one, and the bounds of the
+	// L1 timestamps between which the rotation took effect. The previous address is zero if there was no rotation.
+	P2PSignerRotation() (previous common.Address, after uint64, before uint64)
 }
 
 //go:generate mockery --name GossipMetricer
@@ -66,6 +71,23 @@ type GossipMetricer interface {
 	RecordGossipEvent(evType int32)
 }
 
+type BlockSignatureMetricer interface {
+	RecordBlockSignatureRejection(reason string)
+}
+
+// SignerRotationGrace is the number of seconds, relative to the L1 timestamps bounding an unsafe block signer
+// rotation, that a block timestamp may be outside the rotation and still be accepted when signed by the previous
+// or the new signer. This allows for blocks that are in-flight while the rotation happens.
+const SignerRotationGrace = 60
+
+// Block signature rejection reasons, as recorded in metrics.
+const (
+	SignatureInvalid           = "invalid_signature"
+	SignatureUnexpectedSigner  = "unexpected_signer"
+	SignaturePreviousSignerOld = "previous_signer_expired"
+	SignatureNewSignerEarly    = "new_signer_too_early"
+)
+
 func blocksTopicV1(cfg *rollup.Config) string {
 	return fmt.Sprintf("/optimism/%s/0/blocks", cfg.L2ChainID.String())
 }
@@ -243,7 +265,7 @@ func (sb *seenBlocks) markSeen(h common.Hash) {
 	sb.blockHashes = append(sb.blockHashes, h)
 }
 
-func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, blockVersion eth.BlockVersion) pubsub.ValidatorEx {
+func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, m BlockSignatureMetricer, blockVersion eth.BlockVersion) pubsub.ValidatorEx {
 
 	// Seen block hashes per block height
 	// uint64 -> *seenBlocks
@@ -281,7 +303,14 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 		signatureBytes, payloadBytes := data[:65], data[65:]
 
 		// [REJECT] if the signature by the sequencer is not valid
-		result := verifyBlockSignature(log, cfg, runCfg, id, signatureBytes, payloadBytes)
+		// The expected signer depends on the block timestamp, which is read from its fixed offset so the signature is
+		// verified before the rest of the payload is decoded.
+		timestamp, err := eth.PayloadTimestampSSZ(payloadBytes)
+		if err != nil {
+			log.Warn("invalid payload", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+		result := verifyBlockSignature(log, cfg, runCfg, m, id, signatureBytes, payloadBytes, timestamp)
 		if result != pubsub.ValidationAccept {
 			return result
 		}
@@ -358,7 +387,7 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 	}
 }
 
-func verifyBlockSignature(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, id peer.ID, signatureBytes []byte, payloadBytes []byte) pubsub.ValidationResult {
+func verifyBlockSignature(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, m BlockSignatureMetricer, id peer.ID, signatureBytes []byte, payloadBytes []byte, timestamp uint64) pubsub.ValidationResult {
 	signingHash, err := BlockSigningHash(cfg, payloadBytes)
 	if err != nil {
 		log.Warn("failed to compute block signing hash", "err", err, "peer", id)
@@ -368,20 +397,38 @@ func verifyBlockSignature(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 	pub, err := crypto.SigToPub(signingHash[:], signatureBytes)
 	if err != nil {
 		log.Warn("invalid block signature", "err", err, "peer", id)
+		m.RecordBlockSignatureRejection(SignatureInvalid)
 		return pubsub.ValidationReject
 	}
 	addr := crypto.PubkeyToAddress(*pub)
 
-	// In the future we may load & validate block metadata before checking the signature.
-	// And then check the signer based on the metadata, to support e.g. multiple p2p signers at the same time.
-	// For now we only have one signer at a time and thus check the address directly.
-	// This means we may drop old payloads upon key rotation,
-	// but this can be recovered from like any other missed unsafe payload.
-	if expected := runCfg.P2PSequencerAddress(); expected == (common.Address{}) {
+	// There is only one signer at a time, but after a rotation of the unsafe block signer the previous signer
+	// is still accepted for blocks up to the rotation, and the new signer only for blocks from the rotation onward.
+	// Both bounds are extended by a grace window, since the exact L1 timestamp of the rotation is not known.
+	expected := runCfg.P2PSequencerAddress()
+	if expected == (common.Address{}) {
 		log.Warn("no configured p2p sequencer address, ignoring gossiped block", "peer", id, "addr", addr)
 		return pubsub.ValidationIgnore
-	} else if addr != expected {
-		log.Warn("unexpected block author", "err", err, "peer", id, "addr", addr, "expected", expected)
+	}
+	previous, after, before := runCfg.P2PSignerRotation()
+	rotated := previous != (common.Address{})
+	switch {
+	case addr == expected:
+		if rotated && timestamp+SignerRotationGrace <= after {
+			log.Warn("block signed by new signer predates signer rotation", "peer", id, "addr", addr, "timestamp", timestamp, "rotation_after", after)
+			m.RecordBlockSignatureRejection(SignatureNewSignerEarly)
+			return pubsub.ValidationReject
+		}
+	case rotated && addr == previous:
+		if timestamp > before+SignerRotationGrace {
+			log.Warn("block signed by previous signer after signer rotation", "peer", id, "addr", addr, "timestamp", timestamp, "rotation_before", before)
+			m.RecordBlockSignatureRejection(SignaturePreviousSignerOld)
+			return pubsub.ValidationReject
+		}
+		log.Debug("accepting block signed by previous signer during signer rotation", "peer", id, "addr", addr, "timestamp", timestamp)
+	default:
+		log.Warn("unexpected block author", "peer", id, "addr", addr, "expected", expected)
+		m.RecordBlockSignatureRejection(SignatureUnexpectedSigner)
 		return pubsub.ValidationReject
 	}
 	return pubsub.ValidationAccept
@@ -500,11 +547,11 @@ func (p *publisher) Close() error {
 	return errors.Join(e1, e2)
 }
 
-func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, gossipIn GossipIn) (GossipOut, error) {
+func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, m BlockSignatureMetricer, bandwidth *GossipBandwidth, gossipIn GossipIn) (GossipOut, error) {
 	p2pCtx, p2pCancel := context.WithCancel(context.Background())
 
 	v1Logger := log.New("topic", "blocksV1")
-	blocksV1Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv1", v1Logger, BuildBlocksValidator(v1Logger, cfg, runCfg, eth.BlockV1)))
+	blocksV1Validator := guardGossipValidator(log, limitGossipBandwidth(bandwidth, "blocksV1", logValidationResult(self, "validated blockv1", v1Logger, BuildBlocksValidator(v1Logger, cfg, runCfg, m, eth.BlockV1))))
 	blocksV1, err := newBlockTopic(p2pCtx, blocksTopicV1(cfg), ps, v1Logger, gossipIn, blocksV1Validator)
 	if err != nil {
 		p2pCancel()
@@ -512,7 +559,7 @@ func JoinGossip(self peer.ID, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Con
 	}
 
 	v2Logger := log.New("topic", "blocksV2")
-	blocksV2Validator := guardGossipValidator(log, logValidationResult(self, "validated blockv2", v2Logger, BuildBlocksValidator(v2Logger, cfg, runCfg, eth.BlockV2)))
+	blocksV2Validator := guardGossipValidator(log, limitGossipBandwidth(bandwidth, "blocksV2", logValidationResult(self, "validated blockv2", v2Logger, BuildBlocksValidator(v2Logger, cfg, runCfg, m, eth.BlockV2))))
 	blocksV2, err := newBlockTopic(p2pCtx, blocksTopicV2(cfg), ps, v2Logger, gossipIn, blocksV2Validator)
 	if err != nil {
 		p2pCancel()
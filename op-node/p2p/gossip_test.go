@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/golang/snappy"
 
@@ -70,7 +71,7 @@ func TestVerifyBlockSignature(t *testing.T) {
 		signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
 		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
 		require.NoError(t, err)
-		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
+		result := verifyBlockSignature(logger, cfg, runCfg, metrics.NoopMetrics, peerId, sig[:65], msg, 0)
 		require.Equal(t, pubsub.ValidationAccept, result)
 	})
 
@@ -79,14 +80,14 @@ func TestVerifyBlockSignature(t *testing.T) {
 		signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
 		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
 		require.NoError(t, err)
-		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
+		result := verifyBlockSignature(logger, cfg, runCfg, metrics.NoopMetrics, peerId, sig[:65], msg, 0)
 		require.Equal(t, pubsub.ValidationReject, result)
 	})
 
 	t.Run("InvalidSignature", func(t *testing.T) {
 		runCfg := &testutils.MockRuntimeConfig{P2PSeqAddress: crypto.PubkeyToAddress(secrets.SequencerP2P.PublicKey)}
 		sig := make([]byte, 65)
-		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig, msg)
+		result := verifyBlockSignature(logger, cfg, runCfg, metrics.NoopMetrics, peerId, sig, msg, 0)
 		require.Equal(t, pubsub.ValidationReject, result)
 	})
 
@@ -95,9 +96,62 @@ func TestVerifyBlockSignature(t *testing.T) {
 		signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
 		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
 		require.NoError(t, err)
-		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
+		result := verifyBlockSignature(logger, cfg, runCfg, metrics.NoopMetrics, peerId, sig[:65], msg, 0)
 		require.Equal(t, pubsub.ValidationIgnore, result)
 	})
+
+	t.Run("SignerRotation", func(t *testing.T) {
+		previous := NewLocalSigner(secrets.SequencerP2P)
+		previousAddr := crypto.PubkeyToAddress(secrets.SequencerP2P.PublicKey)
+		current := NewLocalSigner(secrets.Alice)
+		currentAddr := crypto.PubkeyToAddress(secrets.Alice.PublicKey)
+		other := NewLocalSigner(secrets.Bob)
+		runCfg := &testutils.MockRuntimeConfig{
+			P2PSeqAddress:     currentAddr,
+			PrevP2PSeqAddress: previousAddr,
+			P2PRotationAfter:  1000,
+			P2PRotationBefore: 1100,
+		}
+		tests := []struct {
+			name      string
+			signer    Signer
+			timestamp uint64
+			expected  pubsub.ValidationResult
+			reason    string
+		}{
+			{name: "PreviousSignerBeforeRotation", signer: previous, timestamp: 900, expected: pubsub.ValidationAccept},
+			{name: "PreviousSignerDuringRotation", signer: previous, timestamp: 1050, expected: pubsub.ValidationAccept},
+			{name: "PreviousSignerInGraceWindow", signer: previous, timestamp: 1100 + SignerRotationGrace, expected: pubsub.ValidationAccept},
+			{name: "PreviousSignerAfterGraceWindow", signer: previous, timestamp: 1101 + SignerRotationGrace, expected: pubsub.ValidationReject, reason: SignaturePreviousSignerOld},
+			{name: "NewSignerBeforeGraceWindow", signer: current, timestamp: 1000 - SignerRotationGrace, expected: pubsub.ValidationReject, reason: SignatureNewSignerEarly},
+			{name: "NewSignerInGraceWindow", signer: current, timestamp: 1001 - SignerRotationGrace, expected: pubsub.ValidationAccept},
+			{name: "NewSignerAfterRotation", signer: current, timestamp: 2000, expected: pubsub.ValidationAccept},
+			{name: "OtherSigner", signer: other, timestamp: 1050, expected: pubsub.ValidationReject, reason: SignatureUnexpectedSigner},
+		}
+		for _, test := range tests {
+			test := test
+			t.Run(test.name, func(t *testing.T) {
+				m := &signatureMetrics{}
+				sig, err := (&PreparedSigner{Signer: test.signer}).Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
+				require.NoError(t, err)
+				result := verifyBlockSignature(logger, cfg, runCfg, m, peerId, sig[:65], msg, test.timestamp)
+				require.Equal(t, test.expected, result)
+				if test.reason != "" {
+					require.Equal(t, []string{test.reason}, m.rejections)
+				} else {
+					require.Empty(t, m.rejections)
+				}
+			})
+		}
+	})
+}
+
+type signatureMetrics struct {
+	rejections []string
+}
+
+func (s *signatureMetrics) RecordBlockSignatureRejection(reason string) {
+	s.rejections = append(s.rejections, reason)
 }
 
 func createSignedP2Payload(payload *eth.ExecutionPayload, signer Signer, l2ChainID *big.Int) ([]byte, error) {
@@ -131,7 +185,7 @@ func TestBlockValidator(t *testing.T) {
 	signer := &PreparedSigner{Signer: NewLocalSigner(secrets.SequencerP2P)}
 
 	// valFnV1 := BuildBlocksValidator(testlog.Logger(t, log.LvlCrit), rollupCfg, runCfg, eth.BlockV1)
-	valFnV2 := BuildBlocksValidator(testlog.Logger(t, log.LvlCrit), cfg, runCfg, eth.BlockV2)
+	valFnV2 := BuildBlocksValidator(testlog.Logger(t, log.LvlCrit), cfg, runCfg, metrics.NoopMetrics, eth.BlockV2)
 
 	// Params Set 2: Call the validation function
 	peerID := peer.ID("foo")
@@ -40,6 +40,7 @@ type NodeP2P struct {
 	peerMonitor *monitor.PeerMonitor           // peer monitor to disconnect bad peers, may be nil even with p2p enabled
 	store       store.ExtendedPeerstore        // peerstore of host, with extra bindings for scoring and banning
 	appScorer   ApplicationScorer
+	bandwidth   *GossipBandwidth // meters and rate-limits the gossip received from each peer
 	log         log.Logger
 	// the below components are all optional, and may be nil. They require the host to not be nil.
 	dv5Local *enode.LocalNode // p2p discovery identity
@@ -138,7 +139,8 @@ func (n *NodeP2P) init(resourcesCtx context.Context, rollupCfg *rollup.Config, l
 		if err != nil {
 			return fmt.Errorf("failed to start gossipsub router: %w", err)
 		}
-		n.gsOut, err = JoinGossip(n.host.ID(), n.gs, log, rollupCfg, runCfg, gossipIn)
+		n.bandwidth = NewGossipBandwidth(log, metrics, clock.SystemClock, n.host.ID(), setup.GossipRateLimits())
+		n.gsOut, err = JoinGossip(n.host.ID(), n.gs, log, rollupCfg, runCfg, metrics, n.bandwidth, gossipIn)
 		if err != nil {
 			return fmt.Errorf("failed to join blocks gossip topic: %w", err)
 		}
@@ -157,6 +159,7 @@ func (n *NodeP2P) init(resourcesCtx context.Context, rollupCfg *rollup.Config, l
 
 		if metrics != nil {
 			go metrics.RecordBandwidth(resourcesCtx, bwc)
+			go n.bandwidth.RecordMetrics(resourcesCtx)
 		}
 
 		if setup.BanPeers() {
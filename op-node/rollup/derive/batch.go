@@ -105,6 +105,9 @@ func (b *BatchData) UnmarshalBinary(data []byte) error {
 	return b.decodeTyped(data)
 }
 
+// ErrUnknownBatchType is returned when decoding a batch with a type byte that is not a known batch type.
+var ErrUnknownBatchType = errors.New("unrecognized batch type")
+
 // decodeTyped decodes a typed batchData
 func (b *BatchData) decodeTyped(data []byte) error {
 	if len(data) == 0 {
@@ -117,7 +120,7 @@ func (b *BatchData) decodeTyped(data []byte) error {
 	case SpanBatchType:
 		inner = new(RawSpanBatch)
 	default:
-		return fmt.Errorf("unrecognized batch type: %d", data[0])
+		return fmt.Errorf("%w: %d", ErrUnknownBatchType, data[0])
 	}
 	if err := inner.decode(bytes.NewReader(data[1:])); err != nil {
 		return err
@@ -32,6 +32,18 @@ type NextBatchProvider interface {
 	NextBatch(ctx context.Context) (Batch, error)
 }
 
+// maxDroppedBatches is the number of recently dropped batches retained for debugging.
+const maxDroppedBatches = 32
+
+// DroppedBatch records a batch that was dropped by the batch queue and the reason it was dropped.
+type DroppedBatch struct {
+	BatchType        int             `json:"batch_type"`
+	Timestamp        uint64          `json:"timestamp"`
+	L1InclusionBlock eth.BlockID     `json:"l1_inclusion_block"`
+	SafeHead         eth.BlockID     `json:"safe_head"`
+	Reason           BatchDropReason `json:"reason"`
+}
+
 type SafeBlockFetcher interface {
 	L2BlockRefByNumber(context.Context, uint64) (eth.L2BlockRef, error)
 	PayloadByNumber(context.Context, uint64) (*eth.ExecutionPayload, error)
@@ -60,6 +72,10 @@ type BatchQueue struct {
 	nextSpan []*SingularBatch
 
 	l2 SafeBlockFetcher
+
+	// droppedBatches contains the most recently dropped batches, oldest first.
+	// It is retained across resets so the reason for a stalled safe head can still be found.
+	droppedBatches []DroppedBatch
 }
 
 // NewBatchQueue creates a BatchQueue, which should be Reset(origin) before use.
@@ -224,6 +240,24 @@ func (bq *BatchQueue) Reset(ctx context.Context, base eth.L1BlockRef, _ eth.Syst
 	return io.EOF
 }
 
+// RecentDroppedBatches returns the most recently dropped batches, oldest first.
+func (bq *BatchQueue) RecentDroppedBatches() []DroppedBatch {
+	return append([]DroppedBatch(nil), bq.droppedBatches...)
+}
+
+func (bq *BatchQueue) recordDroppedBatch(batch *BatchWithL1InclusionBlock, parent eth.L2BlockRef, reason BatchDropReason) {
+	if len(bq.droppedBatches) >= maxDroppedBatches {
+		bq.droppedBatches = bq.droppedBatches[len(bq.droppedBatches)-maxDroppedBatches+1:]
+	}
+	bq.droppedBatches = append(bq.droppedBatches, DroppedBatch{
+		BatchType:        batch.Batch.GetBatchType(),
+		Timestamp:        batch.Batch.GetTimestamp(),
+		L1InclusionBlock: batch.L1InclusionBlock.ID(),
+		SafeHead:         parent.ID(),
+		Reason:           reason,
+	})
+}
+
 func (bq *BatchQueue) AddBatch(ctx context.Context, batch Batch, parent eth.L2BlockRef) {
 	if len(bq.l1Blocks) == 0 {
 		panic(fmt.Errorf("cannot add batch with timestamp %d, no origin was prepared", batch.GetTimestamp()))
@@ -232,8 +266,9 @@ func (bq *BatchQueue) AddBatch(ctx context.Context, batch Batch, parent eth.L2Bl
 		L1InclusionBlock: bq.origin,
 		Batch:            batch,
 	}
-	validity := CheckBatch(ctx, bq.config, bq.log, bq.l1Blocks, parent, &data, bq.l2)
+	validity, reason := CheckBatchWithReason(ctx, bq.config, bq.log, bq.l1Blocks, parent, &data, bq.l2)
 	if validity == BatchDrop {
+		bq.recordDroppedBatch(&data, parent, reason)
 		return // if we do drop the batch, CheckBatch will log the drop reason with WARN level.
 	}
 	batch.LogContext(bq.log).Debug("Adding batch")
@@ -269,7 +304,7 @@ func (bq *BatchQueue) deriveNextBatch(ctx context.Context, outOfData bool, paren
 	var remaining []*BatchWithL1InclusionBlock
 batchLoop:
 	for i, batch := range bq.batches {
-		validity := CheckBatch(ctx, bq.config, bq.log.New("batch_index", i), bq.l1Blocks, parent, batch, bq.l2)
+		validity, reason := CheckBatchWithReason(ctx, bq.config, bq.log.New("batch_index", i), bq.l1Blocks, parent, batch, bq.l2)
 		switch validity {
 		case BatchFuture:
 			remaining = append(remaining, batch)
@@ -278,7 +313,9 @@ batchLoop:
 			batch.Batch.LogContext(bq.log).Warn("Dropping batch",
 				"parent", parent.ID(),
 				"parent_time", parent.Time,
+				"reason", reason,
 			)
+			bq.recordDroppedBatch(batch, parent, reason)
 			continue
 		case BatchAccept:
 			nextBatch = batch
@@ -1012,3 +1012,64 @@ func TestBatchQueueResetSpan(t *testing.T) {
 	require.ErrorIs(t, err, io.EOF)
 	require.Equal(t, len(bq.nextSpan), 0)
 }
+
+func TestBatchQueueRecordsDroppedBatches(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	chainId := big.NewInt(1234)
+	l1 := L1Chain([]uint64{10, 20, 30})
+	safeHead := eth.L2BlockRef{
+		Hash:           mockHash(20, 2),
+		Number:         5,
+		ParentHash:     mockHash(18, 2),
+		Time:           20,
+		L1Origin:       l1[1].ID(),
+		SequenceNumber: 0,
+	}
+	cfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L2Time: 10,
+		},
+		BlockTime:         2,
+		MaxSequencerDrift: 600,
+		SeqWindowSize:     30,
+		L2ChainID:         chainId,
+	}
+	input := &fakeBatchQueueInput{origin: l1[1]}
+	bq := NewBatchQueue(log, cfg, input, nil)
+	_ = bq.Reset(context.Background(), l1[1], eth.SystemConfig{})
+	require.Empty(t, bq.RecentDroppedBatches())
+
+	bq.AddBatch(context.Background(), b(chainId, 20, l1[1]), safeHead)
+	mismatchedParent := b(chainId, 22, l1[1])
+	mismatchedParent.ParentHash = common.Hash{0xaa}
+	bq.AddBatch(context.Background(), mismatchedParent, safeHead)
+
+	require.Equal(t, []DroppedBatch{
+		{
+			BatchType:        SingularBatchType,
+			Timestamp:        20,
+			L1InclusionBlock: l1[1].ID(),
+			SafeHead:         safeHead.ID(),
+			Reason:           BatchDropOldTimestamp,
+		},
+		{
+			BatchType:        SingularBatchType,
+			Timestamp:        22,
+			L1InclusionBlock: l1[1].ID(),
+			SafeHead:         safeHead.ID(),
+			Reason:           BatchDropParentMismatch,
+		},
+	}, bq.RecentDroppedBatches())
+
+	// Only the most recent drops are retained, even across resets.
+	_ = bq.Reset(context.Background(), l1[1], eth.SystemConfig{})
+	for i := 0; i < maxDroppedBatches; i++ {
+		bq.AddBatch(context.Background(), b(chainId, 18, l1[1]), safeHead)
+	}
+	dropped := bq.RecentDroppedBatches()
+	require.Len(t, dropped, maxDroppedBatches)
+	for _, batch := range dropped {
+		require.Equal(t, uint64(18), batch.Timestamp)
+		require.Equal(t, BatchDropOldTimestamp, batch.Reason)
+	}
+}
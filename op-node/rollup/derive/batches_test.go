@@ -1538,8 +1538,13 @@ func TestValidBatch(t *testing.T) {
 		if testCase.DeltaTime != nil {
 			rcfg.DeltaTime = testCase.DeltaTime
 		}
-		validity := CheckBatch(ctx, &rcfg, logger, testCase.L1Blocks, testCase.L2SafeHead, &testCase.Batch, &l2Client)
+		validity, reason := CheckBatchWithReason(ctx, &rcfg, logger, testCase.L1Blocks, testCase.L2SafeHead, &testCase.Batch, &l2Client)
 		require.Equal(t, testCase.Expected, validity, "batch check must return expected validity level")
+		if validity == BatchDrop {
+			require.NotEmpty(t, reason, "dropped batches must have a drop reason")
+		} else {
+			require.Empty(t, reason, "only dropped batches have a drop reason")
+		}
 		if testCase.ExpectedLog != "" {
 			// Check if ExpectedLog is contained in the log buffer
 			if !strings.Contains(logBuf.String(), testCase.ExpectedLog) {
@@ -1,11 +1,13 @@
 package derive
 
 import (
+	"bufio"
 	"bytes"
 	"compress/zlib"
 	"fmt"
 	"io"
 
+	"github.com/andybalholm/brotli"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/rlp"
 )
@@ -144,19 +146,40 @@ func (ch *Channel) Reader() io.Reader {
 
 // BatchReader provides a function that iteratively consumes batches from the reader.
 // The L1Inclusion block is also provided at creation time.
+// Channel data is zlib compressed, or brotli compressed and prefixed with ChannelVersionBrotli.
+// The compression is detected from the first byte, see DetectChannelCompression.
+// Brotli compressed channels are only accepted if isFjord is true.
 // Warning: the batch reader can read every batch-type.
 // The caller of the batch-reader should filter the results.
-func BatchReader(r io.Reader) (func() (*BatchData, error), error) {
+func BatchReader(r io.Reader, isFjord bool) (func() (*BatchData, error), error) {
 	// Setup decompressor stage + RLP reader
-	zr, err := zlib.NewReader(r)
+	bufReader := bufio.NewReader(r)
+	versionByte, err := bufReader.Peek(1)
 	if err != nil {
 		return nil, err
 	}
+	compression, err := DetectChannelCompression(versionByte[0], isFjord)
+	if err != nil {
+		return nil, err
+	}
+	var zr io.Reader
+	switch compression {
+	case Zlib:
+		zr, err = zlib.NewReader(bufReader)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCompressionHeader, err)
+		}
+	case Brotli:
+		if _, err := bufReader.Discard(1); err != nil {
+			return nil, err
+		}
+		zr = brotli.NewReader(bufReader)
+	}
 	rlpReader := rlp.NewStream(zr, MaxRLPBytesPerChannel)
 	// Read each batch iteratively
 	return func() (*BatchData, error) {
 		var batchData BatchData
-		if err = rlpReader.Decode(&batchData); err != nil {
+		if err := rlpReader.Decode(&batchData); err != nil {
 			return nil, err
 		}
 		return &batchData, nil
@@ -3,15 +3,51 @@ package derive
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
+// ErrSpanBatchBeforeDelta is returned when a span batch is included in L1 before the Delta hard fork.
+var ErrSpanBatchBeforeDelta = errors.New("span batch before Delta")
+
+// Reasons used when recording channel reader errors in metrics.
+const (
+	channelErrUnknownVersion    = "unknown_channel_version"
+	channelErrBrotliBeforeFjord = "brotli_before_fjord"
+	channelErrCompressionHeader = "invalid_compression_header"
+	channelErrTooLarge          = "channel_too_large"
+	channelErrUnknownBatchType  = "unknown_batch_type"
+	channelErrSpanBeforeDelta   = "span_batch_before_delta"
+	channelErrInvalidSpanBatch  = "invalid_span_batch"
+	channelErrBatchDecode       = "batch_decode"
+)
+
+// channelErrorReason classifies an error from reading channel data so misconfigured batchers can be
+// diagnosed from metrics.
+func channelErrorReason(err error) string {
+	switch {
+	case errors.Is(err, ErrUnknownChannelVersion):
+		return channelErrUnknownVersion
+	case errors.Is(err, ErrBrotliBeforeFjord):
+		return channelErrBrotliBeforeFjord
+	case errors.Is(err, ErrInvalidCompressionHeader):
+		return channelErrCompressionHeader
+	case errors.Is(err, rlp.ErrValueTooLarge):
+		return channelErrTooLarge
+	case errors.Is(err, ErrUnknownBatchType):
+		return channelErrUnknownBatchType
+	default:
+		return channelErrBatchDecode
+	}
+}
+
 // ChannelInReader reads a batch from the channel
 // This does decompression and limits the max RLP size
 // This is a pure function from the channel, but each channel (or channel fragment)
@@ -46,12 +82,18 @@ func (cr *ChannelInReader) Origin() eth.L1BlockRef {
 
 // TODO: Take full channel for better logging
 func (cr *ChannelInReader) WriteChannel(data []byte) error {
-	if f, err := BatchReader(bytes.NewBuffer(data)); err == nil {
+	isFjord := cr.cfg.IsFjord(cr.prev.Origin().Time)
+	if f, err := BatchReader(bytes.NewBuffer(data), isFjord); err == nil {
 		cr.nextBatchFn = f
 		cr.metrics.RecordChannelInputBytes(len(data))
+		// The batch reader only accepts channels with a known compression, so detection cannot fail here.
+		compression, _ := DetectChannelCompression(data[0], isFjord)
+		cr.metrics.RecordDerivedChannel(compression.String())
 		return nil
 	} else {
-		cr.log.Error("Error creating batch reader from channel data", "err", err)
+		reason := channelErrorReason(err)
+		cr.log.Error("Error creating batch reader from channel data", "reason", reason, "err", err)
+		cr.metrics.RecordChannelReaderError(reason)
 		return err
 	}
 }
@@ -85,7 +127,9 @@ func (cr *ChannelInReader) NextBatch(ctx context.Context) (Batch, error) {
 		cr.NextChannel()
 		return nil, NotEnoughData
 	} else if err != nil {
-		cr.log.Warn("failed to read batch from channel reader, skipping to next channel now", "err", err)
+		reason := channelErrorReason(err)
+		cr.log.Warn("failed to read batch from channel reader, skipping to next channel now", "reason", reason, "err", err)
+		cr.metrics.RecordChannelReaderError(reason)
 		cr.NextChannel()
 		return nil, NotEnoughData
 	}
@@ -103,10 +147,12 @@ func (cr *ChannelInReader) NextBatch(ctx context.Context) (Batch, error) {
 			// Check hard fork activation with the L1 inclusion block time instead of the L1 origin block time.
 			// Therefore, even if the batch passed this rule, it can be dropped in the batch queue.
 			// This is just for early dropping invalid batches as soon as possible.
-			return nil, NewTemporaryError(fmt.Errorf("cannot accept span batch in L1 block %s at time %d", origin, origin.Time))
+			cr.metrics.RecordChannelReaderError(channelErrSpanBeforeDelta)
+			return nil, NewTemporaryError(fmt.Errorf("%w: cannot accept span batch in L1 block %s at time %d", ErrSpanBatchBeforeDelta, origin, origin.Time))
 		}
 		spanBatch, err := DeriveSpanBatch(batchData, cr.cfg.BlockTime, cr.cfg.Genesis.L2Time, cr.cfg.L2ChainID)
 		if err != nil {
+			cr.metrics.RecordChannelReaderError(channelErrInvalidSpanBatch)
 			return nil, err
 		}
 		spanBatch.LogContext(cr.log).Debug("decoded span batch from channel", "stage_origin", cr.Origin())
@@ -114,7 +160,8 @@ func (cr *ChannelInReader) NextBatch(ctx context.Context) (Batch, error) {
 		return spanBatch, nil
 	default:
 		// error is bubbled up to user, but pipeline can skip the batch and continue after.
-		return nil, NewTemporaryError(fmt.Errorf("unrecognized batch type: %d", batchData.GetBatchType()))
+		cr.metrics.RecordChannelReaderError(channelErrUnknownBatchType)
+		return nil, NewTemporaryError(fmt.Errorf("%w: %d", ErrUnknownBatchType, batchData.GetBatchType()))
 	}
 }
 
@@ -0,0 +1,30 @@
+package derive
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelErrorReason(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{fmt.Errorf("%w: 2", ErrUnknownChannelVersion), channelErrUnknownVersion},
+		{ErrBrotliBeforeFjord, channelErrBrotliBeforeFjord},
+		{fmt.Errorf("%w: zlib: invalid header", ErrInvalidCompressionHeader), channelErrCompressionHeader},
+		{rlp.ErrValueTooLarge, channelErrTooLarge},
+		{fmt.Errorf("%w: 5", ErrUnknownBatchType), channelErrUnknownBatchType},
+		{errors.New("unexpected EOF"), channelErrBatchDecode},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.expected, func(t *testing.T) {
+			require.Equal(t, test.expected, channelErrorReason(test.err))
+		})
+	}
+}
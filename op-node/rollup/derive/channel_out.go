@@ -198,28 +198,15 @@ func (co *SingularChannelOut) Close() error {
 // Returns nil if there is still more buffered data.
 // Returns an error if it ran into an error during processing.
 func (co *SingularChannelOut) OutputFrame(w *bytes.Buffer, maxSize uint64) (uint16, error) {
-	// Check that the maxSize is large enough for the frame overhead size.
-	if maxSize < FrameV0OverHeadSize {
-		return 0, ErrMaxFrameSizeTooSmall
-	}
-
-	f := createEmptyFrame(co.id, co.frame, co.ReadyBytes(), co.closed, maxSize)
-
-	if _, err := io.ReadFull(co.compress, f.Data); err != nil {
-		return 0, err
-	}
-
-	if err := f.MarshalBinary(w); err != nil {
+	f, err := outputFrame(w, co.id, co.frame, co.compress, co.ReadyBytes(), co.closed, maxSize)
+	if err != nil {
 		return 0, err
 	}
-
 	co.frame += 1
-	fn := f.FrameNumber
 	if f.IsLast {
-		return fn, io.EOF
-	} else {
-		return fn, nil
+		return f.FrameNumber, io.EOF
 	}
+	return f.FrameNumber, nil
 }
 
 // BlockToSingularBatch transforms a block into a batch object that can easily be RLP encoded.
@@ -311,6 +298,28 @@ func ForceCloseTxData(frames []Frame) ([]byte, error) {
 	return out.Bytes(), nil
 }
 
+// outputFrame writes the next frame of a channel to w, reading the frame data from ready which holds readyBytes of
+// compressed channel data. It is shared by all ChannelOut implementations so frames are encoded identically
+// regardless of the batch type, and is the inverse of ParseFrames.
+// Returns ErrMaxFrameSizeTooSmall if maxSize < FrameV0OverHeadSize.
+func outputFrame(w *bytes.Buffer, id ChannelID, frame uint64, ready io.Reader, readyBytes int, closed bool, maxSize uint64) (*Frame, error) {
+	// Check that the maxSize is large enough for the frame overhead size.
+	if maxSize < FrameV0OverHeadSize {
+		return nil, ErrMaxFrameSizeTooSmall
+	}
+
+	f := createEmptyFrame(id, frame, readyBytes, closed, maxSize)
+
+	if _, err := io.ReadFull(ready, f.Data); err != nil {
+		return nil, err
+	}
+
+	if err := f.MarshalBinary(w); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
 // createEmptyFrame creates new empty Frame with given information. Frame data must be copied from ChannelOut.
 func createEmptyFrame(id ChannelID, frame uint64, readyBytes int, closed bool, maxSize uint64) *Frame {
 	f := Frame{
@@ -0,0 +1,192 @@
+package derive
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	roundTripBlockTime = 2
+	maxRoundTripBlocks = 8
+)
+
+// testChannelCompressor is a Compressor with no size limit. It compresses with NewChannelCompressWriter, as the
+// batcher's compressors do, so channels it produces can be decoded with BatchReader.
+type testChannelCompressor struct {
+	buf      bytes.Buffer
+	compress ChannelCompressWriter
+}
+
+func newTestChannelCompressor(t require.TestingT, algo CompressionAlgo) *testChannelCompressor {
+	c := &testChannelCompressor{}
+	compress, err := NewChannelCompressWriter(algo, zlib.BestCompression, &c.buf)
+	require.NoError(t, err)
+	c.compress = compress
+	return c
+}
+
+func (c *testChannelCompressor) Write(p []byte) (int, error) {
+	return c.compress.Write(p)
+}
+
+func (c *testChannelCompressor) Close() error {
+	return c.compress.Close()
+}
+
+func (c *testChannelCompressor) Read(p []byte) (int, error) {
+	return c.buf.Read(p)
+}
+
+func (c *testChannelCompressor) Reset() {
+	c.buf.Reset()
+	c.compress.Reset(&c.buf)
+}
+
+func (c *testChannelCompressor) Len() int {
+	return c.buf.Len()
+}
+
+func (c *testChannelCompressor) Flush() error {
+	return c.compress.Flush()
+}
+
+func (c *testChannelCompressor) FullErr() error {
+	return nil
+}
+
+// encodeChannel encodes batches into a channel the same way the batcher does, returning the data for each L1
+// transaction, with one frame per transaction.
+func encodeChannel(t *testing.T, batchType uint, algo CompressionAlgo, batches []*SingularBatch, seqNum uint64, maxFrameSize uint64, genesisTimestamp uint64, chainID *big.Int) (ChannelID, [][]byte) {
+	co, err := NewChannelOut(batchType, newTestChannelCompressor(t, algo), NewSpanBatchBuilder(genesisTimestamp, chainID))
+	require.NoError(t, err)
+	for _, batch := range batches {
+		_, err := co.AddSingularBatch(batch, seqNum)
+		require.NoError(t, err)
+	}
+	require.NoError(t, co.Close())
+
+	var txs [][]byte
+	for {
+		buf := bytes.NewBuffer([]byte{DerivationVersion0})
+		_, err := co.OutputFrame(buf, maxFrameSize)
+		txs = append(txs, buf.Bytes())
+		if errors.Is(err, io.EOF) {
+			return co.ID(), txs
+		}
+		require.NoError(t, err)
+	}
+}
+
+// decodeChannel decodes the batches from the transaction data of a channel the same way op-node does. The
+// transactions are submitted in a random order as frames may be included on L1 out of order.
+func decodeChannel(t *testing.T, rng *rand.Rand, id ChannelID, txs [][]byte) []*BatchData {
+	ch := NewChannel(id, eth.L1BlockRef{})
+	for _, i := range rng.Perm(len(txs)) {
+		frames, err := ParseFrames(txs[i])
+		require.NoError(t, err)
+		for _, frame := range frames {
+			require.NoError(t, ch.AddFrame(frame, eth.L1BlockRef{}))
+		}
+	}
+	require.True(t, ch.IsReady())
+
+	next, err := BatchReader(ch.Reader(), true)
+	require.NoError(t, err)
+	var batches []*BatchData
+	for {
+		batch, err := next()
+		if errors.Is(err, io.EOF) {
+			return batches
+		}
+		require.NoError(t, err)
+		batches = append(batches, batch)
+	}
+}
+
+// checkChannelRoundTrip encodes random batches into a channel and checks they are decoded unchanged.
+// It is a differential test between the batcher's encoding and the derivation pipeline's decoding.
+func checkChannelRoundTrip(t *testing.T, seed int64, batchType uint, algo CompressionAlgo, maxFrameSize uint64) {
+	rng := rand.New(rand.NewSource(seed))
+	chainID := big.NewInt(rng.Int63n(1000))
+	batches := RandomValidConsecutiveSingularBatches(rng, chainID)
+	// Span channels are recompressed as each block is added, so limit the number of blocks to keep the test fast.
+	batches = batches[:min(len(batches), maxRoundTripBlocks)]
+	genesisTimestamp := 1 + batches[0].Timestamp - 128
+	seqNum := uint64(rng.Intn(2))
+
+	id, txs := encodeChannel(t, batchType, algo, batches, seqNum, maxFrameSize, genesisTimestamp, chainID)
+	for _, tx := range txs {
+		require.LessOrEqual(t, uint64(len(tx)), maxFrameSize+1, "frame exceeds max size")
+	}
+	decoded := decodeChannel(t, rng, id, txs)
+
+	switch batchType {
+	case SingularBatchType:
+		require.Len(t, decoded, len(batches))
+		for i, batch := range decoded {
+			require.Equal(t, uint8(SingularBatchType), batch.GetBatchType())
+			require.Equal(t, batches[i], batch.inner, "batch %v", i)
+		}
+	case SpanBatchType:
+		require.Len(t, decoded, 1)
+		require.Equal(t, uint8(SpanBatchType), decoded[0].GetBatchType())
+		rawSpanBatch, ok := decoded[0].inner.(*RawSpanBatch)
+		require.True(t, ok)
+		spanBatch, err := rawSpanBatch.derive(roundTripBlockTime, genesisTimestamp, chainID)
+		require.NoError(t, err)
+		require.Equal(t, batches[0].ParentHash.Bytes()[:20], spanBatch.ParentCheck[:])
+		require.Equal(t, batches[len(batches)-1].EpochHash.Bytes()[:20], spanBatch.L1OriginCheck[:])
+		require.Len(t, spanBatch.Batches, len(batches))
+		for i, batch := range spanBatch.Batches {
+			require.Equal(t, batches[i].EpochNum, batch.EpochNum, "batch %v", i)
+			require.Equal(t, batches[i].Timestamp, batch.Timestamp, "batch %v", i)
+			require.Equal(t, batches[i].Transactions, batch.Transactions, "batch %v", i)
+		}
+	}
+}
+
+func TestChannelRoundTrip(t *testing.T) {
+	for _, batchType := range []uint{SingularBatchType, SpanBatchType} {
+		for _, algo := range CompressionAlgos {
+			batchType, algo := batchType, algo
+			t.Run(batchTypeName(batchType)+"-"+algo.String(), func(t *testing.T) {
+				checkChannelRoundTrip(t, 0x5eed, batchType, algo, 200)
+			})
+		}
+	}
+}
+
+// FuzzChannelRoundTrip checks that any channel encoded by ChannelOut is decoded to the same batches by the
+// derivation pipeline, for every batch type, compression algorithm and frame size.
+func FuzzChannelRoundTrip(f *testing.F) {
+	f.Add(int64(1), false, uint8(0), uint16(0))
+	f.Add(int64(2), true, uint8(0), uint16(60_000))
+	f.Add(int64(3), true, uint8(1), uint16(500))
+	f.Add(int64(4), false, uint8(3), uint16(50_000))
+	f.Fuzz(func(t *testing.T, seed int64, span bool, algoIdx uint8, frameSize uint16) {
+		batchType := uint(SingularBatchType)
+		if span {
+			batchType = SpanBatchType
+		}
+		algo := CompressionAlgos[int(algoIdx)%len(CompressionAlgos)]
+		// Channels are limited to maxRoundTripBlocks so cannot exceed the max frame number even with 1 byte frames.
+		maxFrameSize := FrameV0OverHeadSize + 1 + uint64(frameSize)
+		checkChannelRoundTrip(t, seed, batchType, algo, maxFrameSize)
+	})
+}
+
+func batchTypeName(batchType uint) string {
+	if batchType == SpanBatchType {
+		return "span"
+	}
+	return "singular"
+}
@@ -1,9 +1,16 @@
 package derive
 
 import (
+	"bytes"
+	"compress/zlib"
+	"io"
 	"testing"
 
+	"github.com/andybalholm/brotli"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
 )
 
@@ -99,3 +106,102 @@ func TestFrameValidity(t *testing.T) {
 		t.Run(tc.name, tc.Run)
 	}
 }
+
+func TestBatchReaderCompressionAlgos(t *testing.T) {
+	batch := &SingularBatch{
+		ParentHash:   common.Hash{0x01},
+		EpochNum:     2,
+		EpochHash:    common.Hash{0x03},
+		Timestamp:    4,
+		Transactions: []hexutil.Bytes{{0x05, 0x06}},
+	}
+	var encoded bytes.Buffer
+	require.NoError(t, rlp.Encode(&encoded, NewBatchData(batch)))
+
+	compress := func(algo CompressionAlgo) []byte {
+		var buf bytes.Buffer
+		var w io.WriteCloser
+		if algo.IsBrotli() {
+			buf.WriteByte(ChannelVersionBrotli)
+			w = brotli.NewWriterLevel(&buf, algo.BrotliLevel())
+		} else {
+			w = zlib.NewWriter(&buf)
+		}
+		_, err := w.Write(encoded.Bytes())
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name      string
+		data      []byte
+		isFjord   bool
+		expectErr bool
+		errIs     error
+	}{
+		{name: "ZlibPreFjord", data: compress(Zlib)},
+		{name: "ZlibFjord", data: compress(Zlib), isFjord: true},
+		{name: "BrotliPreFjord", data: compress(Brotli10), expectErr: true, errIs: ErrBrotliBeforeFjord},
+		{name: "Brotli9Fjord", data: compress(Brotli9), isFjord: true},
+		{name: "Brotli10Fjord", data: compress(Brotli10), isFjord: true},
+		{name: "Brotli11Fjord", data: compress(Brotli11), isFjord: true},
+		{name: "UnknownVersionPreFjord", data: append([]byte{0x02}, compress(Brotli10)[1:]...), expectErr: true, errIs: ErrUnknownChannelVersion},
+		{name: "UnknownVersionFjord", data: append([]byte{0x02}, compress(Brotli10)[1:]...), isFjord: true, expectErr: true, errIs: ErrUnknownChannelVersion},
+		{name: "InvalidZlibHeader", data: append([]byte{0x78, 0x00}, compress(Zlib)[2:]...), expectErr: true, errIs: ErrInvalidCompressionHeader},
+		{name: "EmptyPreFjord", data: []byte{}, expectErr: true},
+		{name: "EmptyFjord", data: []byte{}, isFjord: true, expectErr: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			readBatch, err := BatchReader(bytes.NewReader(test.data), test.isFjord)
+			if err == nil {
+				var batchData *BatchData
+				batchData, err = readBatch()
+				if err == nil {
+					require.Equal(t, batch, batchData.inner)
+					_, err = readBatch()
+					require.ErrorIs(t, err, io.EOF)
+					return
+				}
+			}
+			require.True(t, test.expectErr, "unexpected error: %v", err)
+			if test.errIs != nil {
+				require.ErrorIs(t, err, test.errIs)
+			}
+		})
+	}
+}
+
+func TestDetectChannelCompression(t *testing.T) {
+	tests := []struct {
+		name        string
+		versionByte byte
+		isFjord     bool
+		expected    CompressionAlgo
+		errIs       error
+	}{
+		{name: "ZlibDefault", versionByte: 0x78, expected: Zlib},
+		{name: "ZlibDefaultFjord", versionByte: 0x78, isFjord: true, expected: Zlib},
+		{name: "ZlibCM15", versionByte: 0x7f, expected: Zlib},
+		{name: "BrotliPreFjord", versionByte: ChannelVersionBrotli, errIs: ErrBrotliBeforeFjord},
+		{name: "BrotliFjord", versionByte: ChannelVersionBrotli, isFjord: true, expected: Brotli},
+		{name: "Unknown", versionByte: 0x02, isFjord: true, errIs: ErrUnknownChannelVersion},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := DetectChannelCompression(test.versionByte, test.isFjord)
+			if test.errIs != nil {
+				require.ErrorIs(t, err, test.errIs)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+	// The level of brotli compressed data is unknown, so the detected algorithm can't be used to compress channels.
+	require.True(t, Brotli.IsBrotli())
+	require.False(t, ValidCompressionAlgo(Brotli))
+}
@@ -0,0 +1,144 @@
+package derive
+
+import (
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/andybalholm/brotli"
+)
+
+// CompressionAlgo is the algorithm used to compress channel data.
+type CompressionAlgo string
+
+const (
+	// Zlib is the original channel compression algorithm, valid at all forks.
+	Zlib CompressionAlgo = "zlib"
+	// Brotli9, Brotli10 and Brotli11 use brotli compression at the given quality level.
+	// Brotli compressed channels are only valid once Fjord is active.
+	Brotli9  CompressionAlgo = "brotli-9"
+	Brotli10 CompressionAlgo = "brotli-10"
+	Brotli11 CompressionAlgo = "brotli-11"
+
+	// Brotli is the compression algorithm detected for brotli compressed channel data, whose quality level is not
+	// recorded in the data. It can't be used to compress channels.
+	Brotli CompressionAlgo = "brotli"
+)
+
+// CompressionAlgos lists all supported compression algorithms.
+var CompressionAlgos = []CompressionAlgo{Zlib, Brotli9, Brotli10, Brotli11}
+
+var ErrUnknownCompressionAlgo = errors.New("unknown compression algorithm")
+
+const (
+	// ChannelVersionBrotli is the first byte of brotli compressed channel data.
+	// Zlib compressed channel data has no version byte and starts with the zlib header instead.
+	ChannelVersionBrotli byte = 0x01
+
+	// ZlibCM8 and ZlibCM15 are the compression methods that may be set in the low nibble of the
+	// first byte of a zlib stream. Neither can collide with ChannelVersionBrotli.
+	ZlibCM8  = 8
+	ZlibCM15 = 15
+)
+
+var (
+	ErrUnknownChannelVersion    = errors.New("unknown channel version")
+	ErrBrotliBeforeFjord        = errors.New("brotli compressed channel before Fjord")
+	ErrInvalidCompressionHeader = errors.New("invalid compression header")
+)
+
+// DetectChannelCompression returns the compression algorithm of channel data given its first byte, either Zlib or
+// Brotli. Brotli compressed channels are only accepted if isFjord is true.
+func DetectChannelCompression(versionByte byte, isFjord bool) (CompressionAlgo, error) {
+	switch {
+	case versionByte&0x0F == ZlibCM8 || versionByte&0x0F == ZlibCM15:
+		return Zlib, nil
+	case versionByte == ChannelVersionBrotli:
+		if !isFjord {
+			return "", ErrBrotliBeforeFjord
+		}
+		return Brotli, nil
+	default:
+		return "", fmt.Errorf("%w: %v", ErrUnknownChannelVersion, versionByte)
+	}
+}
+
+// ChannelCompressWriter is a streaming compressor that writes channel data in the format read by BatchReader.
+type ChannelCompressWriter interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// NewChannelCompressWriter creates a ChannelCompressWriter for algo that writes to w.
+// An empty algo defaults to Zlib, which is compressed at zlibLevel.
+// Brotli compressed data is prefixed with ChannelVersionBrotli so it can be detected by DetectChannelCompression.
+func NewChannelCompressWriter(algo CompressionAlgo, zlibLevel int, w io.Writer) (ChannelCompressWriter, error) {
+	if algo.IsBrotli() {
+		return newBrotliWriter(algo.BrotliLevel(), w)
+	}
+	return zlib.NewWriterLevel(w, zlibLevel)
+}
+
+// brotliWriter writes the brotli channel version byte ahead of the compressed data.
+type brotliWriter struct {
+	*brotli.Writer
+}
+
+func newBrotliWriter(level int, w io.Writer) (*brotliWriter, error) {
+	if _, err := w.Write([]byte{ChannelVersionBrotli}); err != nil {
+		return nil, err
+	}
+	return &brotliWriter{brotli.NewWriterLevel(w, level)}, nil
+}
+
+// Reset discards the writer's state and starts a new channel on w.
+// Writing the version byte cannot fail as w is always an in-memory buffer.
+func (b *brotliWriter) Reset(w io.Writer) {
+	_, _ = w.Write([]byte{ChannelVersionBrotli})
+	b.Writer.Reset(w)
+}
+
+func (a CompressionAlgo) String() string {
+	return string(a)
+}
+
+// IsBrotli returns true if the algorithm is one of the brotli variants.
+func (a CompressionAlgo) IsBrotli() bool {
+	return a == Brotli || a == Brotli9 || a == Brotli10 || a == Brotli11
+}
+
+// BrotliLevel returns the brotli quality level for the algorithm.
+// It panics if the algorithm is not a brotli variant with a quality level.
+func (a CompressionAlgo) BrotliLevel() int {
+	switch a {
+	case Brotli9:
+		return 9
+	case Brotli10:
+		return 10
+	case Brotli11:
+		return 11
+	default:
+		panic(fmt.Sprintf("not a brotli compression algorithm: %v", a))
+	}
+}
+
+// ValidCompressionAlgo returns true if algo is a supported compression algorithm.
+func ValidCompressionAlgo(algo CompressionAlgo) bool {
+	for _, a := range CompressionAlgos {
+		if a == algo {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCompressionAlgo parses a compression algorithm name.
+func ParseCompressionAlgo(s string) (CompressionAlgo, error) {
+	algo := CompressionAlgo(s)
+	if !ValidCompressionAlgo(algo) {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCompressionAlgo, s)
+	}
+	return algo, nil
+}
@@ -145,6 +145,10 @@ type EngineQueue struct {
 	l1Fetcher L1Fetcher
 
 	syncCfg *sync.Config
+	// recovering is set after a reset to the recovery safe head of syncCfg, until the engine accepts it.
+	recovering bool
+	// recovered is set once the recovery safe head of syncCfg has been applied, after which resets behave as normal.
+	recovered bool
 }
 
 var _ EngineControl = (*EngineQueue)(nil)
@@ -451,6 +455,11 @@ func (eq *EngineQueue) tryUpdateEngine(ctx context.Context) error {
 			return NewTemporaryError(fmt.Errorf("failed to sync forkchoice with engine: %w", err))
 		}
 	}
+	if eq.recovering {
+		if err := eq.recordRecovery(); err != nil {
+			return err
+		}
+	}
 	eq.needForkchoiceUpdate = false
 	return nil
 }
@@ -776,9 +785,21 @@ func (eq *EngineQueue) resetBuildingState() {
 // Reset walks the L2 chain backwards until it finds an L2 block whose L1 origin is canonical.
 // The unsafe head is set to the head of the L2 chain, unless the existing safe head is not canonical.
 func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.SystemConfig) error {
-	result, err := sync.FindL2Heads(ctx, eq.cfg, eq.l1Fetcher, eq.engine, eq.log, eq.syncCfg)
+	recovery, err := eq.recoveryPending()
 	if err != nil {
-		return NewTemporaryError(fmt.Errorf("failed to find the L2 Heads to start from: %w", err))
+		return err
+	}
+	var result *sync.FindHeadsResult
+	if recovery {
+		result, err = eq.findRecoveryHeads(ctx)
+		if err != nil {
+			return err
+		}
+	} else {
+		result, err = sync.FindL2Heads(ctx, eq.cfg, eq.l1Fetcher, eq.engine, eq.log, eq.syncCfg)
+		if err != nil {
+			return NewTemporaryError(fmt.Errorf("failed to find the L2 Heads to start from: %w", err))
+		}
 	}
 	finalized, safe, unsafe := result.Finalized, result.Safe, result.Unsafe
 	l1Origin, err := eq.l1Fetcher.L1BlockRefByHash(ctx, safe.L1Origin.Hash)
@@ -790,21 +811,14 @@ func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.System
 			safe, safe.Time, l1Origin, l1Origin.Time))
 	}
 
-	// Walk back L2 chain to find the L1 origin that is old enough to start buffering channel data from.
-	pipelineL2 := safe
-	for {
-		afterL2Genesis := pipelineL2.Number > eq.cfg.Genesis.L2.Number
-		afterL1Genesis := pipelineL2.L1Origin.Number > eq.cfg.Genesis.L1.Number
-		afterChannelTimeout := pipelineL2.L1Origin.Number+eq.cfg.ChannelTimeout > l1Origin.Number
-		if afterL2Genesis && afterL1Genesis && afterChannelTimeout {
-			parent, err := eq.engine.L2BlockRefByHash(ctx, pipelineL2.ParentHash)
-			if err != nil {
-				return NewResetError(fmt.Errorf("failed to fetch L2 parent block %s", pipelineL2.ParentID()))
-			}
-			pipelineL2 = parent
-		} else {
-			break
-		}
+	var pipelineL2 eth.L2BlockRef
+	if recovery && eq.syncCfg.RecoveryL1Start != (common.Hash{}) {
+		pipelineL2, err = eq.recoveryPipelineStart(ctx, safe)
+	} else {
+		pipelineL2, err = eq.pipelineStart(ctx, safe, l1Origin)
+	}
+	if err != nil {
+		return err
 	}
 	pipelineOrigin, err := eq.l1Fetcher.L1BlockRefByHash(ctx, pipelineL2.L1Origin.Hash)
 	if err != nil {
@@ -834,9 +848,34 @@ func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.System
 	eq.metrics.RecordL2Ref("l2_unsafe", unsafe)
 	eq.metrics.RecordL2Ref("l2_engineSyncTarget", unsafe)
 	eq.logSyncProgress("reset derivation work")
+	if recovery {
+		eq.log.Warn("Recovered derivation from explicit safe head", "safeHead", safe, "pipelineOrigin", pipelineOrigin)
+	}
+	eq.recovering = recovery
 	return io.EOF
 }
 
+// pipelineStart walks back the L2 chain from safe to find the L2 block with an L1 origin that is old enough
+// to start buffering channel data from.
+func (eq *EngineQueue) pipelineStart(ctx context.Context, safe eth.L2BlockRef, l1Origin eth.L1BlockRef) (eth.L2BlockRef, error) {
+	pipelineL2 := safe
+	for {
+		afterL2Genesis := pipelineL2.Number > eq.cfg.Genesis.L2.Number
+		afterL1Genesis := pipelineL2.L1Origin.Number > eq.cfg.Genesis.L1.Number
+		afterChannelTimeout := pipelineL2.L1Origin.Number+eq.cfg.ChannelTimeout > l1Origin.Number
+		if afterL2Genesis && afterL1Genesis && afterChannelTimeout {
+			parent, err := eq.engine.L2BlockRefByHash(ctx, pipelineL2.ParentHash)
+			if err != nil {
+				return eth.L2BlockRef{}, NewResetError(fmt.Errorf("failed to fetch L2 parent block %s", pipelineL2.ParentID()))
+			}
+			pipelineL2 = parent
+		} else {
+			break
+		}
+	}
+	return pipelineL2, nil
+}
+
 // UnsafeL2SyncTarget retrieves the first queued-up L2 unsafe payload, or a zeroed reference if there is none.
 func (eq *EngineQueue) UnsafeL2SyncTarget() eth.L2BlockRef {
 	if first := eq.unsafePayloads.Peek(); first != nil {
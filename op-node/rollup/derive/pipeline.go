@@ -22,6 +22,8 @@ type Metrics interface {
 	RecordChannelTimedOut()
 	RecordFrame()
 	RecordDerivedBatches(batchType string)
+	RecordDerivedChannel(compression string)
+	RecordChannelReaderError(reason string)
 }
 
 type L1Fetcher interface {
@@ -64,6 +66,18 @@ type EngineQueueStage interface {
 	Step(context.Context) error
 }
 
+// DerivationStatus describes the progress of the derivation pipeline, to help debug a stalled safe head.
+type DerivationStatus struct {
+	// CurrentL1 is the L1 block that the derivation process is currently at.
+	CurrentL1 eth.L1BlockRef `json:"current_l1"`
+	// SafeL2 is the safe L2 block.
+	SafeL2 eth.L2BlockRef `json:"safe_l2"`
+	// PendingSafeL2 is the L2 block processed from the batch, but not consolidated to the safe block yet.
+	PendingSafeL2 eth.L2BlockRef `json:"pending_safe_l2"`
+	// DroppedBatches contains the batches most recently dropped by the batch queue, oldest first.
+	DroppedBatches []DroppedBatch `json:"dropped_batches"`
+}
+
 // DerivationPipeline is updated with new L1 data, and the Step() function can be iterated on to keep the L2 Engine in sync.
 type DerivationPipeline struct {
 	log       log.Logger
@@ -76,8 +90,9 @@ type DerivationPipeline struct {
 	stages    []ResettableStage
 
 	// Special stages to keep track of
-	traversal *L1Traversal
-	eng       EngineQueueStage
+	traversal  *L1Traversal
+	batchQueue *BatchQueue
+	eng        EngineQueueStage
 
 	metrics Metrics
 }
@@ -105,14 +120,15 @@ func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetch
 	stages := []ResettableStage{eng, l1Traversal, l1Src, frameQueue, bank, chInReader, batchQueue, attributesQueue}
 
 	return &DerivationPipeline{
-		log:       log,
-		cfg:       cfg,
-		l1Fetcher: l1Fetcher,
-		resetting: 0,
-		stages:    stages,
-		eng:       eng,
-		metrics:   metrics,
-		traversal: l1Traversal,
+		log:        log,
+		cfg:        cfg,
+		l1Fetcher:  l1Fetcher,
+		resetting:  0,
+		stages:     stages,
+		eng:        eng,
+		metrics:    metrics,
+		traversal:  l1Traversal,
+		batchQueue: batchQueue,
 	}
 }
 
@@ -154,6 +170,16 @@ func (dp *DerivationPipeline) PendingSafeL2Head() eth.L2BlockRef {
 	return dp.eng.PendingSafeL2Head()
 }
 
+// DerivationStatus returns the current pending-safe block and the batches that were recently dropped by the pipeline.
+func (dp *DerivationPipeline) DerivationStatus() *DerivationStatus {
+	return &DerivationStatus{
+		CurrentL1:      dp.Origin(),
+		SafeL2:         dp.eng.SafeL2Head(),
+		PendingSafeL2:  dp.eng.PendingSafeL2Head(),
+		DroppedBatches: dp.batchQueue.RecentDroppedBatches(),
+	}
+}
+
 // UnsafeL2Head returns the head of the L2 chain that we are deriving for, this may be past what we derived from L1
 func (dp *DerivationPipeline) UnsafeL2Head() eth.L2BlockRef {
 	return dp.eng.UnsafeL2Head()
@@ -0,0 +1,155 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+var ErrInvalidRecoveryTarget = errors.New("invalid recovery target")
+
+// recoveryPending returns true if the recovery target of the sync config has not been applied yet.
+// Targets are recorded in the recovery store once applied, so restarts with the recovery flags still set reset as
+// normal instead of rewinding the safe head again.
+func (eq *EngineQueue) recoveryPending() (bool, error) {
+	if !eq.syncCfg.RecoveryMode() || eq.recovered {
+		return false, nil
+	}
+	if eq.syncCfg.RecoveryStore == nil {
+		return true, nil
+	}
+	applied, err := eq.syncCfg.RecoveryStore.RecoveryApplied()
+	if err != nil {
+		return false, NewTemporaryError(fmt.Errorf("failed to load the applied recovery target: %w", err))
+	}
+	if applied == eq.syncCfg.RecoveryTarget() {
+		eq.log.Info("Recovery target was already applied, resetting as normal", "safeHead", applied.SafeHead, "l1Start", applied.L1Start)
+		eq.recovered = true
+		return false, nil
+	}
+	return true, nil
+}
+
+// recordRecovery records the recovery target as applied, once the engine has accepted the recovered safe head.
+func (eq *EngineQueue) recordRecovery() error {
+	if eq.syncCfg.RecoveryStore != nil {
+		if err := eq.syncCfg.RecoveryStore.SetRecoveryApplied(eq.syncCfg.RecoveryTarget()); err != nil {
+			return NewTemporaryError(fmt.Errorf("failed to record the applied recovery target: %w", err))
+		}
+	}
+	eq.recovering = false
+	eq.recovered = true
+	return nil
+}
+
+// findRecoveryHeads validates the recovery safe head of the sync config and returns it as the safe head,
+// along with the current finalized and unsafe heads of the engine.
+// Recovery only rewinds the safe head, so the recovery safe head must not be newer than the safe head of the engine.
+// An invalid recovery target results in a critical error, as retrying cannot fix it.
+func (eq *EngineQueue) findRecoveryHeads(ctx context.Context) (*sync.FindHeadsResult, error) {
+	safe, err := eq.engine.L2BlockRefByHash(ctx, eq.syncCfg.RecoverySafeHead)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, NewCriticalError(fmt.Errorf("%w: unknown L2 safe head %s", ErrInvalidRecoveryTarget, eq.syncCfg.RecoverySafeHead))
+	} else if err != nil {
+		return nil, NewTemporaryError(fmt.Errorf("failed to fetch recovery L2 safe head: %w", err))
+	}
+	// Fetch the unsafe head before checking the safe head is canonical, so the canonical block is from a chain
+	// at least as recent as the unsafe head.
+	unsafe, err := eq.engine.L2BlockRefByLabel(ctx, eth.Unsafe)
+	if err != nil {
+		return nil, NewTemporaryError(fmt.Errorf("failed to find the L2 head block: %w", err))
+	}
+	canonical, err := eq.engine.L2BlockRefByNumber(ctx, safe.Number)
+	if err != nil {
+		return nil, NewTemporaryError(fmt.Errorf("failed to fetch canonical L2 block %d: %w", safe.Number, err))
+	}
+	if canonical.Hash != safe.Hash {
+		return nil, NewCriticalError(fmt.Errorf("%w: L2 safe head %s is not canonical, canonical block is %s", ErrInvalidRecoveryTarget, safe, canonical))
+	}
+	if err := eq.checkCanonicalL1(ctx, safe.L1Origin); err != nil {
+		return nil, err
+	}
+
+	engineSafe, err := eq.engine.L2BlockRefByLabel(ctx, eth.Safe)
+	if errors.Is(err, ethereum.NotFound) {
+		engineSafe, err = eq.engine.L2BlockRefByHash(ctx, eq.cfg.Genesis.L2.Hash)
+	}
+	if err != nil {
+		return nil, NewTemporaryError(fmt.Errorf("failed to find the L2 safe head: %w", err))
+	}
+	// Blocks after the safe head of the engine have not been derived, so must not be promoted to safe.
+	if safe.Number > engineSafe.Number {
+		return nil, NewCriticalError(fmt.Errorf("%w: L2 safe head %s is newer than the safe head %s of the engine", ErrInvalidRecoveryTarget, safe, engineSafe))
+	}
+
+	finalized, err := eq.engine.L2BlockRefByLabel(ctx, eth.Finalized)
+	if errors.Is(err, ethereum.NotFound) {
+		finalized, err = eq.engine.L2BlockRefByHash(ctx, eq.cfg.Genesis.L2.Hash)
+	}
+	if err != nil {
+		return nil, NewTemporaryError(fmt.Errorf("failed to find the finalized L2 block: %w", err))
+	}
+	if safe.Number < finalized.Number {
+		return nil, NewCriticalError(fmt.Errorf("%w: L2 safe head %s is older than the finalized head %s", ErrInvalidRecoveryTarget, safe, finalized))
+	}
+	// The unsafe head is only kept if it builds on the recovery safe head. The canonical chain contains the safe
+	// head, so this holds unless the unsafe head is behind it.
+	if unsafe.Number < safe.Number {
+		eq.log.Warn("Unsafe head does not descend from the recovery safe head, resetting it", "unsafe", unsafe, "safe", safe)
+		unsafe = safe
+	}
+	return &sync.FindHeadsResult{
+		Unsafe:    unsafe,
+		Safe:      safe,
+		Finalized: finalized,
+	}, nil
+}
+
+// recoveryPipelineStart walks back the L2 chain from safe to the most recent L2 block with the recovery L1 start
+// block as its L1 origin. The pipeline restarts reading batch data from that L1 block.
+func (eq *EngineQueue) recoveryPipelineStart(ctx context.Context, safe eth.L2BlockRef) (eth.L2BlockRef, error) {
+	l1Start, err := eq.l1Fetcher.L1BlockRefByHash(ctx, eq.syncCfg.RecoveryL1Start)
+	if errors.Is(err, ethereum.NotFound) {
+		return eth.L2BlockRef{}, NewCriticalError(fmt.Errorf("%w: unknown L1 start block %s", ErrInvalidRecoveryTarget, eq.syncCfg.RecoveryL1Start))
+	} else if err != nil {
+		return eth.L2BlockRef{}, NewTemporaryError(fmt.Errorf("failed to fetch recovery L1 start block: %w", err))
+	}
+	if err := eq.checkCanonicalL1(ctx, l1Start.ID()); err != nil {
+		return eth.L2BlockRef{}, err
+	}
+	if l1Start.Number > safe.L1Origin.Number {
+		return eth.L2BlockRef{}, NewCriticalError(fmt.Errorf("%w: L1 start block %s is after the L1 origin %s of the L2 safe head", ErrInvalidRecoveryTarget, l1Start, safe.L1Origin))
+	}
+	pipelineL2 := safe
+	for pipelineL2.L1Origin.Number > l1Start.Number {
+		if pipelineL2.Number <= eq.cfg.Genesis.L2.Number {
+			return eth.L2BlockRef{}, NewCriticalError(fmt.Errorf("%w: L1 start block %s is before the L2 genesis", ErrInvalidRecoveryTarget, l1Start))
+		}
+		parent, err := eq.engine.L2BlockRefByHash(ctx, pipelineL2.ParentHash)
+		if err != nil {
+			return eth.L2BlockRef{}, NewTemporaryError(fmt.Errorf("failed to fetch L2 parent block %s: %w", pipelineL2.ParentID(), err))
+		}
+		pipelineL2 = parent
+	}
+	if pipelineL2.L1Origin != l1Start.ID() {
+		return eth.L2BlockRef{}, NewCriticalError(fmt.Errorf("%w: L1 start block %s is not the L1 origin of an ancestor of the L2 safe head", ErrInvalidRecoveryTarget, l1Start))
+	}
+	return pipelineL2, nil
+}
+
+// checkCanonicalL1 returns a critical error if id is not part of the canonical L1 chain.
+func (eq *EngineQueue) checkCanonicalL1(ctx context.Context, id eth.BlockID) error {
+	canonical, err := eq.l1Fetcher.L1BlockRefByNumber(ctx, id.Number)
+	if err != nil {
+		return NewTemporaryError(fmt.Errorf("failed to fetch canonical L1 block %d: %w", id.Number, err))
+	}
+	if canonical.Hash != id.Hash {
+		return NewCriticalError(fmt.Errorf("%w: L1 block %s is not canonical, canonical block is %s", ErrInvalidRecoveryTarget, id, canonical))
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestEngineQueue_ResetRecovery(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	refA := testutils.RandomBlockRef(rng)
+	refB := eth.L1BlockRef{
+		Hash:       testutils.RandomHash(rng),
+		Number:     refA.Number + 1,
+		ParentHash: refA.Hash,
+		Time:       refA.Time + 2,
+	}
+	refA0 := eth.L2BlockRef{
+		Hash:     testutils.RandomHash(rng),
+		Number:   0,
+		Time:     refA.Time,
+		L1Origin: refA.ID(),
+	}
+	cfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L1:     refA.ID(),
+			L2:     refA0.ID(),
+			L2Time: refA0.Time,
+		},
+		BlockTime:      2,
+		SeqWindowSize:  2,
+		ChannelTimeout: 10,
+	}
+	l2Block := func(parent eth.L2BlockRef, origin eth.L1BlockRef) eth.L2BlockRef {
+		seqNum := uint64(0)
+		if parent.L1Origin == origin.ID() {
+			seqNum = parent.SequenceNumber + 1
+		}
+		return eth.L2BlockRef{
+			Hash:           testutils.RandomHash(rng),
+			Number:         parent.Number + 1,
+			ParentHash:     parent.Hash,
+			Time:           parent.Time + cfg.BlockTime,
+			L1Origin:       origin.ID(),
+			SequenceNumber: seqNum,
+		}
+	}
+	refA1 := l2Block(refA0, refA)
+	refB0 := l2Block(refA1, refB)
+	refB1 := l2Block(refB0, refB)
+	l1Chain := []eth.L1BlockRef{refA, refB}
+	l2Chain := []eth.L2BlockRef{refA0, refA1, refB0, refB1}
+	// nonCanonical is a known L2 block at the height of refB0 that is not on the canonical chain.
+	nonCanonical := l2Block(refA1, refB)
+	unknownHash := testutils.RandomHash(rng)
+
+	tests := []struct {
+		name            string
+		safeHead        common.Hash
+		l1Start         common.Hash
+		finalized       eth.L2BlockRef
+		engineSafe      eth.L2BlockRef
+		unsafe          eth.L2BlockRef
+		expectErr       error
+		expectSafe      eth.L2BlockRef
+		expectUnsafe    eth.L2BlockRef
+		expectOrigin    eth.L1BlockRef
+		expectSysCfgFor eth.L2BlockRef
+	}{
+		{
+			name:            "SafeHeadOnly",
+			safeHead:        refB0.Hash,
+			finalized:       refA0,
+			expectSafe:      refB0,
+			expectOrigin:    refA,
+			expectSysCfgFor: refA1,
+		},
+		{
+			name:            "EngineSafeHeadAtRecoverySafeHead",
+			safeHead:        refB0.Hash,
+			finalized:       refA0,
+			engineSafe:      refB0,
+			expectSafe:      refB0,
+			expectOrigin:    refA,
+			expectSysCfgFor: refA1,
+		},
+		{
+			name:       "SafeHeadNewerThanEngineSafeHead",
+			safeHead:   refB0.Hash,
+			finalized:  refA0,
+			engineSafe: refA1,
+			expectErr:  ErrInvalidRecoveryTarget,
+		},
+		{
+			name:            "UnsafeHeadBehindSafeHead",
+			safeHead:        refB0.Hash,
+			finalized:       refA0,
+			unsafe:          refA1,
+			expectSafe:      refB0,
+			expectUnsafe:    refB0,
+			expectOrigin:    refA,
+			expectSysCfgFor: refA1,
+		},
+		{
+			name:            "L1StartAtSafeHeadOrigin",
+			safeHead:        refB1.Hash,
+			l1Start:         refB.Hash,
+			finalized:       refA0,
+			expectSafe:      refB1,
+			expectOrigin:    refB,
+			expectSysCfgFor: refB1,
+		},
+		{
+			name:            "L1StartAtAncestorOrigin",
+			safeHead:        refB1.Hash,
+			l1Start:         refA.Hash,
+			finalized:       refA0,
+			expectSafe:      refB1,
+			expectOrigin:    refA,
+			expectSysCfgFor: refA1,
+		},
+		{
+			name:      "UnknownSafeHead",
+			safeHead:  unknownHash,
+			finalized: refA0,
+			expectErr: ErrInvalidRecoveryTarget,
+		},
+		{
+			name:      "NonCanonicalSafeHead",
+			safeHead:  nonCanonical.Hash,
+			finalized: refA0,
+			expectErr: ErrInvalidRecoveryTarget,
+		},
+		{
+			name:      "SafeHeadBeforeFinalized",
+			safeHead:  refA1.Hash,
+			finalized: refB0,
+			expectErr: ErrInvalidRecoveryTarget,
+		},
+		{
+			name:      "UnknownL1Start",
+			safeHead:  refB1.Hash,
+			l1Start:   unknownHash,
+			finalized: refA0,
+			expectErr: ErrInvalidRecoveryTarget,
+		},
+		{
+			name:      "L1StartAfterSafeHeadOrigin",
+			safeHead:  refA1.Hash,
+			l1Start:   refB.Hash,
+			finalized: refA0,
+			expectErr: ErrInvalidRecoveryTarget,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			logger := testlog.Logger(t, log.LvlInfo)
+			eng := &testutils.MockEngine{}
+			l1F := &testutils.MockL1Source{}
+			var nilErr error
+			for _, ref := range l1Chain {
+				l1F.Mock.On("L1BlockRefByNumber", ref.Number).Return(ref, nil).Maybe()
+				l1F.Mock.On("L1BlockRefByHash", ref.Hash).Return(ref, nil).Maybe()
+			}
+			l1F.Mock.On("L1BlockRefByHash", unknownHash).Return(eth.L1BlockRef{}, ethereum.NotFound).Maybe()
+			for _, ref := range l2Chain {
+				eng.Mock.On("L2BlockRefByNumber", ref.Number).Return(ref, &nilErr).Maybe()
+				eng.Mock.On("L2BlockRefByHash", ref.Hash).Return(ref, nil).Maybe()
+				eng.Mock.On("SystemConfigByL2Hash", ref.Hash).Return(eth.SystemConfig{BatcherAddr: common.Address(ref.Hash[:20])}, nil).Maybe()
+			}
+			eng.Mock.On("L2BlockRefByHash", nonCanonical.Hash).Return(nonCanonical, nil).Maybe()
+			eng.Mock.On("L2BlockRefByHash", unknownHash).Return(eth.L2BlockRef{}, ethereum.NotFound).Maybe()
+			eng.Mock.On("L2BlockRefByLabel", eth.BlockLabel(eth.Finalized)).Return(test.finalized, &nilErr).Maybe()
+			unsafe := test.unsafe
+			if unsafe == (eth.L2BlockRef{}) {
+				unsafe = refB1
+			}
+			eng.Mock.On("L2BlockRefByLabel", eth.BlockLabel(eth.Unsafe)).Return(unsafe, &nilErr).Maybe()
+			engineSafe := test.engineSafe
+			if engineSafe == (eth.L2BlockRef{}) {
+				engineSafe = refB1
+			}
+			eng.Mock.On("L2BlockRefByLabel", eth.BlockLabel(eth.Safe)).Return(engineSafe, &nilErr).Maybe()
+
+			store := &stubRecoveryStore{}
+			syncCfg := &sync.Config{RecoverySafeHead: test.safeHead, RecoveryL1Start: test.l1Start, RecoveryStore: store}
+			eq := NewEngineQueue(logger, cfg, eng, metrics.NoopMetrics, &fakeAttributesQueue{}, l1F, syncCfg)
+			err := eq.Reset(context.Background(), eth.L1BlockRef{}, eth.SystemConfig{})
+			if test.expectErr != nil {
+				require.ErrorIs(t, err, test.expectErr)
+				require.ErrorIs(t, err, ErrCritical)
+				require.False(t, eq.recovered)
+				require.Equal(t, sync.RecoveryTarget{}, store.applied)
+				return
+			}
+			require.ErrorIs(t, err, io.EOF)
+			// Recovery is only recorded once the engine accepts the recovered safe head
+			require.False(t, eq.recovered)
+			require.Equal(t, sync.RecoveryTarget{}, store.applied)
+			require.Equal(t, test.expectSafe, eq.SafeL2Head())
+			require.Equal(t, test.expectSafe, eq.pendingSafeHead)
+			expectUnsafe := test.expectUnsafe
+			if expectUnsafe == (eth.L2BlockRef{}) {
+				expectUnsafe = refB1
+			}
+			require.Equal(t, expectUnsafe, eq.UnsafeL2Head())
+			require.Equal(t, test.finalized, eq.Finalized())
+			require.Equal(t, test.expectOrigin, eq.Origin())
+			require.Equal(t, common.Address(test.expectSysCfgFor.Hash[:20]), eq.SystemConfig().BatcherAddr)
+
+			eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{
+				HeadBlockHash:      expectUnsafe.Hash,
+				SafeBlockHash:      test.expectSafe.Hash,
+				FinalizedBlockHash: test.finalized.Hash,
+			}, nil, nil, nil)
+			require.NoError(t, eq.Step(context.Background()))
+			require.True(t, eq.recovered)
+			require.Equal(t, syncCfg.RecoveryTarget(), store.applied)
+		})
+	}
+}
+
+func TestEngineQueue_RecoveryPending(t *testing.T) {
+	target := sync.RecoveryTarget{SafeHead: common.Hash{0xaa}, L1Start: common.Hash{0xbb}}
+	tests := []struct {
+		name          string
+		target        sync.RecoveryTarget
+		store         *stubRecoveryStore
+		expectPending bool
+		expectErr     error
+	}{
+		{name: "NotRecoveryMode", store: &stubRecoveryStore{}},
+		{name: "NotApplied", target: target, store: &stubRecoveryStore{}, expectPending: true},
+		{name: "AlreadyApplied", target: target, store: &stubRecoveryStore{applied: target}},
+		{
+			name:          "DifferentTargetApplied",
+			target:        target,
+			store:         &stubRecoveryStore{applied: sync.RecoveryTarget{SafeHead: target.SafeHead}},
+			expectPending: true,
+		},
+		{name: "NoStore", target: target, expectPending: true},
+		{name: "StoreError", target: target, store: &stubRecoveryStore{err: errors.New("boom")}, expectErr: ErrTemporary},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			syncCfg := &sync.Config{RecoverySafeHead: test.target.SafeHead, RecoveryL1Start: test.target.L1Start}
+			if test.store != nil {
+				syncCfg.RecoveryStore = test.store
+			}
+			eq := NewEngineQueue(testlog.Logger(t, log.LvlInfo), &rollup.Config{}, &testutils.MockEngine{}, metrics.NoopMetrics, &fakeAttributesQueue{}, &testutils.MockL1Source{}, syncCfg)
+			pending, err := eq.recoveryPending()
+			if test.expectErr != nil {
+				require.ErrorIs(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectPending, pending)
+			require.Equal(t, test.target != sync.RecoveryTarget{} && !test.expectPending, eq.recovered)
+		})
+	}
+}
+
+type stubRecoveryStore struct {
+	applied sync.RecoveryTarget
+	err     error
+}
+
+func (s *stubRecoveryStore) RecoveryApplied() (sync.RecoveryTarget, error) {
+	return s.applied, s.err
+}
+
+func (s *stubRecoveryStore) SetRecoveryApplied(target sync.RecoveryTarget) error {
+	s.applied = target
+	return nil
+}
@@ -202,26 +202,13 @@ func (co *SpanChannelOut) Close() error {
 // Returns nil if there is still more buffered data.
 // Returns an error if it ran into an error during processing.
 func (co *SpanChannelOut) OutputFrame(w *bytes.Buffer, maxSize uint64) (uint16, error) {
-	// Check that the maxSize is large enough for the frame overhead size.
-	if maxSize < FrameV0OverHeadSize {
-		return 0, ErrMaxFrameSizeTooSmall
-	}
-
-	f := createEmptyFrame(co.id, co.frame, co.ReadyBytes(), co.closed, maxSize)
-
-	if _, err := io.ReadFull(co.reader, f.Data); err != nil {
-		return 0, err
-	}
-
-	if err := f.MarshalBinary(w); err != nil {
+	f, err := outputFrame(w, co.id, co.frame, co.reader, co.ReadyBytes(), co.closed, maxSize)
+	if err != nil {
 		return 0, err
 	}
-
 	co.frame += 1
-	fn := f.FrameNumber
 	if f.IsLast {
-		return fn, io.EOF
-	} else {
-		return fn, nil
+		return f.FrameNumber, io.EOF
 	}
+	return f.FrameNumber, nil
 }
@@ -1,5 +1,7 @@
 package driver
 
+import "time"
+
 type Config struct {
 	// VerifierConfDepth is the distance to keep from the L1 head when reading L1 data for L2 derivation.
 	VerifierConfDepth uint64 `json:"verifier_conf_depth"`
@@ -20,4 +22,8 @@ type Config struct {
 	// SequencerMaxSafeLag is the maximum number of L2 blocks for restricting the distance between L2 safe and unsafe.
 	// Disabled if 0.
 	SequencerMaxSafeLag uint64 `json:"sequencer_max_safe_lag"`
+
+	// SequencerEngineMaxLatency is the maximum duration of an engine API call before the engine is considered degraded.
+	// While the engine is degraded, new blocks are not sequenced but derivation continues. Disabled if 0.
+	SequencerEngineMaxLatency time.Duration `json:"sequencer_engine_max_latency"`
 }
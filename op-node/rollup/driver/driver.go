@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/replay"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
@@ -28,6 +29,8 @@ type Metrics interface {
 	RecordFrame()
 
 	RecordDerivedBatches(batchType string)
+	RecordDerivedChannel(compression string)
+	RecordChannelReaderError(reason string)
 
 	RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID)
 
@@ -37,6 +40,7 @@ type Metrics interface {
 
 	EngineMetrics
 	L1FetcherMetrics
+	L2EngineMetrics
 	SequencerMetrics
 }
 
@@ -63,6 +67,7 @@ type DerivationPipeline interface {
 	SafeL2Head() eth.L2BlockRef
 	UnsafeL2Head() eth.L2BlockRef
 	PendingSafeL2Head() eth.L2BlockRef
+	DerivationStatus() *derive.DerivationStatus
 	Origin() eth.L1BlockRef
 	EngineReady() bool
 	EngineSyncTarget() eth.L2BlockRef
@@ -111,19 +116,34 @@ type AltSync interface {
 	RequestL2Range(ctx context.Context, start, end eth.L2BlockRef) error
 }
 
+type EngineHealth interface {
+	// Degraded returns true if the engine is currently too slow to reliably build new blocks.
+	Degraded() bool
+}
+
 type SequencerStateListener interface {
 	SequencerStarted() error
 	SequencerStopped() error
 }
 
 // NewDriver composes an events handler that tracks L1 state, triggers L2 derivation, and optionally sequences new L2 blocks.
-func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, altSync AltSync, network Network, log log.Logger, snapshotLog log.Logger, metrics Metrics, sequencerStateListener SequencerStateListener, syncCfg *sync.Config) *Driver {
+// If derivationRecorder is not nil, the L1 inputs consumed by derivation and the resulting engine API calls are recorded
+// to it, so that derivation can later be replayed offline.
+func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, altSync AltSync, network Network, log log.Logger, snapshotLog log.Logger, metrics Metrics, sequencerStateListener SequencerStateListener, syncCfg *sync.Config, derivationRecorder *replay.Recorder) *Driver {
 	l1 = NewMeteredL1Fetcher(l1, metrics)
+	meteredL2 := NewMeteredL2Engine(l2, metrics, log, driverCfg.SequencerEngineMaxLatency)
+	l2 = meteredL2
 	l1State := NewL1State(log, metrics)
 	sequencerConfDepth := NewConfDepth(driverCfg.SequencerConfDepth, l1State.L1Head, l1)
 	findL1Origin := NewL1OriginSelector(log, cfg, sequencerConfDepth)
 	verifConfDepth := NewConfDepth(driverCfg.VerifierConfDepth, l1State.L1Head, l1)
-	derivationPipeline := derive.NewDerivationPipeline(log, cfg, verifConfDepth, l2, metrics, syncCfg)
+	var derivationL1 derive.L1Fetcher = verifConfDepth
+	var derivationL2 derive.Engine = l2
+	if derivationRecorder != nil {
+		derivationL1 = replay.NewRecordingL1Fetcher(derivationL1, derivationRecorder)
+		derivationL2 = replay.NewRecordingEngine(derivationL2, derivationRecorder)
+	}
+	derivationPipeline := derive.NewDerivationPipeline(log, cfg, derivationL1, derivationL2, metrics, syncCfg)
 	attrBuilder := derive.NewFetchingAttributesBuilder(cfg, l1, l2)
 	engine := derivationPipeline
 	meteredEngine := NewMeteredEngine(cfg, engine, metrics, log)
@@ -146,6 +166,7 @@ func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, al
 		snapshotLog:      snapshotLog,
 		l1:               l1,
 		l2:               l2,
+		engineHealth:     meteredL2,
 		sequencer:        sequencer,
 		network:          network,
 		metrics:          metrics,
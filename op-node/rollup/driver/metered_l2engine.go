@@ -0,0 +1,95 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// engineDegradedPeriod is how long the engine is considered degraded after a slow or failed engine API call.
+const engineDegradedPeriod = 30 * time.Second
+
+type L2EngineMetrics interface {
+	RecordEngineRequestTime(method string, duration time.Duration)
+}
+
+// MeteredL2Engine wraps an L2Chain and records the duration of each engine API call.
+// It also tracks whether the engine is degraded: an engine API call that takes longer than maxLatency, or fails
+// without a response from the engine, marks the engine as degraded for engineDegradedPeriod.
+type MeteredL2Engine struct {
+	L2Chain
+	metrics    L2EngineMetrics
+	log        log.Logger
+	maxLatency time.Duration
+	now        func() time.Time
+
+	lock          sync.Mutex
+	degradedUntil time.Time
+}
+
+// NewMeteredL2Engine creates a new MeteredL2Engine. The engine is never considered degraded if maxLatency is 0.
+func NewMeteredL2Engine(inner L2Chain, metrics L2EngineMetrics, log log.Logger, maxLatency time.Duration) *MeteredL2Engine {
+	return &MeteredL2Engine{
+		L2Chain:    inner,
+		metrics:    metrics,
+		log:        log,
+		maxLatency: maxLatency,
+		now:        time.Now,
+	}
+}
+
+func (m *MeteredL2Engine) ForkchoiceUpdate(ctx context.Context, state *eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error) {
+	start := m.now()
+	result, err := m.L2Chain.ForkchoiceUpdate(ctx, state, attr)
+	m.recordCall("ForkchoiceUpdate", start, err)
+	return result, err
+}
+
+func (m *MeteredL2Engine) NewPayload(ctx context.Context, payload *eth.ExecutionPayload) (*eth.PayloadStatusV1, error) {
+	start := m.now()
+	result, err := m.L2Chain.NewPayload(ctx, payload)
+	m.recordCall("NewPayload", start, err)
+	return result, err
+}
+
+func (m *MeteredL2Engine) GetPayload(ctx context.Context, payloadId eth.PayloadID) (*eth.ExecutionPayload, error) {
+	start := m.now()
+	result, err := m.L2Chain.GetPayload(ctx, payloadId)
+	m.recordCall("GetPayload", start, err)
+	return result, err
+}
+
+// Degraded returns true if an engine API call was recently too slow or failed without a response from the engine.
+func (m *MeteredL2Engine) Degraded() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.now().Before(m.degradedUntil)
+}
+
+func (m *MeteredL2Engine) recordCall(method string, start time.Time, err error) {
+	end := m.now()
+	duration := end.Sub(start)
+	m.metrics.RecordEngineRequestTime(method, duration)
+	if m.maxLatency == 0 {
+		return
+	}
+	var inputErr eth.InputError
+	slow := duration > m.maxLatency
+	failed := err != nil && !errors.As(err, &inputErr) && !errors.Is(err, context.Canceled)
+	if !slow && !failed {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if !end.Before(m.degradedUntil) {
+		m.log.Warn("Engine degraded", "method", method, "duration", duration, "maxLatency", m.maxLatency, "err", err)
+	}
+	m.degradedUntil = end.Add(engineDegradedPeriod)
+}
+
+var _ L2Chain = (*MeteredL2Engine)(nil)
@@ -0,0 +1,159 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestMeteredL2EngineDurationRecorded(t *testing.T) {
+	tests := []struct {
+		method string
+		call   func(engine *MeteredL2Engine) error
+	}{
+		{
+			method: "ForkchoiceUpdate",
+			call: func(engine *MeteredL2Engine) error {
+				_, err := engine.ForkchoiceUpdate(context.Background(), &eth.ForkchoiceState{}, nil)
+				return err
+			},
+		},
+		{
+			method: "NewPayload",
+			call: func(engine *MeteredL2Engine) error {
+				_, err := engine.NewPayload(context.Background(), &eth.ExecutionPayload{})
+				return err
+			},
+		},
+		{
+			method: "GetPayload",
+			call: func(engine *MeteredL2Engine) error {
+				_, err := engine.GetPayload(context.Background(), eth.PayloadID{})
+				return err
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.method, func(t *testing.T) {
+			engine, inner, metrics := createL2Engine(t, 0)
+			inner.delay = 200 * time.Millisecond
+			inner.err = errors.New("test error")
+
+			require.ErrorIs(t, test.call(engine), inner.err)
+			require.Equal(t, []recordedRequest{{test.method, inner.delay}}, metrics.requests)
+		})
+	}
+}
+
+func TestMeteredL2EngineDegraded(t *testing.T) {
+	maxLatency := time.Second
+	newPayload := func(engine *MeteredL2Engine) {
+		_, _ = engine.NewPayload(context.Background(), &eth.ExecutionPayload{})
+	}
+
+	t.Run("HealthyWhenFast", func(t *testing.T) {
+		engine, inner, _ := createL2Engine(t, maxLatency)
+		inner.delay = maxLatency
+		newPayload(engine)
+		require.False(t, engine.Degraded())
+	})
+
+	t.Run("DegradedWhenSlow", func(t *testing.T) {
+		engine, inner, _ := createL2Engine(t, maxLatency)
+		inner.delay = maxLatency + 1
+		newPayload(engine)
+		require.True(t, engine.Degraded())
+	})
+
+	t.Run("DegradedWhenFailed", func(t *testing.T) {
+		engine, inner, _ := createL2Engine(t, maxLatency)
+		inner.err = context.DeadlineExceeded
+		newPayload(engine)
+		require.True(t, engine.Degraded())
+	})
+
+	t.Run("HealthyWhenInputRejected", func(t *testing.T) {
+		engine, inner, _ := createL2Engine(t, maxLatency)
+		inner.err = eth.InputError{Inner: errors.New("invalid"), Code: eth.InvalidForkchoiceState}
+		newPayload(engine)
+		require.False(t, engine.Degraded())
+	})
+
+	t.Run("RecoversAfterDegradedPeriod", func(t *testing.T) {
+		engine, inner, _ := createL2Engine(t, maxLatency)
+		inner.delay = maxLatency + 1
+		newPayload(engine)
+		require.True(t, engine.Degraded())
+
+		inner.delay = 0
+		newPayload(engine)
+		*inner.clock = inner.clock.Add(engineDegradedPeriod - 1)
+		require.True(t, engine.Degraded())
+		*inner.clock = inner.clock.Add(1)
+		require.False(t, engine.Degraded())
+	})
+
+	t.Run("NeverDegradedWhenDisabled", func(t *testing.T) {
+		engine, inner, _ := createL2Engine(t, 0)
+		inner.delay = time.Hour
+		inner.err = context.DeadlineExceeded
+		newPayload(engine)
+		require.False(t, engine.Degraded())
+	})
+}
+
+// createL2Engine creates a MeteredL2Engine with a stub inner engine.
+// The clock used to calculate the current time only advances when the stub is called, by the stub's delay.
+func createL2Engine(t *testing.T, maxLatency time.Duration) (*MeteredL2Engine, *stubL2Engine, *engineMetrics) {
+	clock := time.UnixMilli(1294812934000000)
+	inner := &stubL2Engine{clock: &clock}
+	metrics := &engineMetrics{}
+	engine := NewMeteredL2Engine(inner, metrics, testlog.Logger(t, log.LvlInfo), maxLatency)
+	engine.now = func() time.Time {
+		return clock
+	}
+	return engine, inner, metrics
+}
+
+type stubL2Engine struct {
+	L2Chain
+	clock *time.Time
+	delay time.Duration
+	err   error
+}
+
+func (s *stubL2Engine) ForkchoiceUpdate(_ context.Context, _ *eth.ForkchoiceState, _ *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error) {
+	*s.clock = s.clock.Add(s.delay)
+	return &eth.ForkchoiceUpdatedResult{}, s.err
+}
+
+func (s *stubL2Engine) NewPayload(_ context.Context, _ *eth.ExecutionPayload) (*eth.PayloadStatusV1, error) {
+	*s.clock = s.clock.Add(s.delay)
+	return &eth.PayloadStatusV1{}, s.err
+}
+
+func (s *stubL2Engine) GetPayload(_ context.Context, _ eth.PayloadID) (*eth.ExecutionPayload, error) {
+	*s.clock = s.clock.Add(s.delay)
+	return &eth.ExecutionPayload{}, s.err
+}
+
+type recordedRequest struct {
+	method   string
+	duration time.Duration
+}
+
+type engineMetrics struct {
+	requests []recordedRequest
+}
+
+func (m *engineMetrics) RecordEngineRequestTime(method string, duration time.Duration) {
+	m.requests = append(m.requests, recordedRequest{method, duration})
+}
@@ -13,6 +13,10 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
+// ErrL1OriginUnavailable is returned when the next L2 block can only be built on a new L1 origin,
+// because it is past the max sequencer drift, but the L1 source failed to return the next L1 block.
+var ErrL1OriginUnavailable = errors.New("L1 origin unavailable")
+
 type L1Blocks interface {
 	derive.L1BlockRefByHashFetcher
 	derive.L1BlockRefByNumberFetcher
@@ -57,13 +61,21 @@ func (los *L1OriginSelector) FindL1Origin(ctx context.Context, l2Head eth.L2Bloc
 	// The L1 source can be shimmed to hide new L1 blocks and enforce a sequencer confirmation distance.
 	nextOrigin, err := los.l1.L1BlockRefByNumber(ctx, currentOrigin.Number+1)
 	if err != nil {
+		notFound := errors.Is(err, ethereum.NotFound)
 		if pastSeqDrift {
+			if !notFound {
+				// The L1 source is unavailable, so we cannot know if the next L2 block may repeat the current origin.
+				// Building it anyway risks producing an unsafe block that is later replaced by the verifiers.
+				return eth.L1BlockRef{}, derive.NewTemporaryError(fmt.Errorf("%w: cannot build next L2 block past current L1 origin %s by more than sequencer time drift: %w", ErrL1OriginUnavailable, currentOrigin, err))
+			}
 			return eth.L1BlockRef{}, fmt.Errorf("cannot build next L2 block past current L1 origin %s by more than sequencer time drift, and failed to find next L1 origin: %w", currentOrigin, err)
 		}
-		if errors.Is(err, ethereum.NotFound) {
+		if notFound {
 			log.Debug("No next L1 block found, repeating current origin")
 		} else {
-			log.Error("Failed to get next origin. Falling back to current origin", "err", err)
+			// The current origin remains valid until the sequencer drift is exhausted.
+			remainingDrift := currentOrigin.Time + los.cfg.MaxSequencerDrift - (l2Head.Time + los.cfg.BlockTime)
+			log.Error("Failed to get next origin. Falling back to current origin", "err", err, "remaining_drift", remainingDrift)
 		}
 		return currentOrigin, nil
 	}
@@ -2,9 +2,11 @@ package driver
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
@@ -282,3 +284,49 @@ func TestOriginSelectorHandlesLateL1Blocks(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, a, next, "must stay on a because the L1 time may not be higher than the L2 time")
 }
+
+// TestOriginSelectorL1Unavailable ensures that the origin selector only falls back to the current origin
+// during an L1 outage while the next L2 block is still within the sequencer drift.
+//
+// There is 1 L1 block at time 20 and the next L1 block cannot be retrieved.
+// With the L2 head at time 25, the next L2 block at time 27 is within the sequencer drift of 8
+// and repeats origin `a`. With the L2 head at time 27, the next L2 block at time 29 is past the
+// drift, so the sequencer cannot know if it may repeat `a` and the selection must fail.
+func TestOriginSelectorL1Unavailable(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	cfg := &rollup.Config{
+		MaxSequencerDrift: 8,
+		BlockTime:         2,
+	}
+	a := eth.L1BlockRef{
+		Hash:   common.Hash{'a'},
+		Number: 10,
+		Time:   20,
+	}
+	errUnavailable := errors.New("connection refused")
+
+	t.Run("WithinSeqDrift", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		defer l1.AssertExpectations(t)
+		l1.ExpectL1BlockRefByHash(a.Hash, a, nil)
+		l1.ExpectL1BlockRefByNumber(a.Number+1, eth.L1BlockRef{}, errUnavailable)
+
+		s := NewL1OriginSelector(log, cfg, l1)
+		next, err := s.FindL1Origin(context.Background(), eth.L2BlockRef{L1Origin: a.ID(), Time: 25})
+		require.NoError(t, err)
+		require.Equal(t, a, next)
+	})
+
+	t.Run("PastSeqDrift", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		defer l1.AssertExpectations(t)
+		l1.ExpectL1BlockRefByHash(a.Hash, a, nil)
+		l1.ExpectL1BlockRefByNumber(a.Number+1, eth.L1BlockRef{}, errUnavailable)
+
+		s := NewL1OriginSelector(log, cfg, l1)
+		_, err := s.FindL1Origin(context.Background(), eth.L2BlockRef{L1Origin: a.ID(), Time: 27})
+		require.ErrorIs(t, err, ErrL1OriginUnavailable)
+		require.ErrorIs(t, err, derive.ErrTemporary)
+		require.ErrorIs(t, err, errUnavailable)
+	})
+}
@@ -27,6 +27,7 @@ type L1OriginSelectorIface interface {
 type SequencerMetrics interface {
 	RecordSequencerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordSequencerReset()
+	RecordSequencerL1OriginUnavailable()
 }
 
 // Sequencer implements the sequencing interface of the driver: it starts and completes block building jobs.
@@ -45,6 +46,9 @@ type Sequencer struct {
 	timeNow func() time.Time
 
 	nextAction time.Time
+
+	// l1OriginUnavailable is set while sequencing is stopped because the next L1 origin cannot be retrieved.
+	l1OriginUnavailable bool
 }
 
 func NewSequencer(log log.Logger, cfg *rollup.Config, engine derive.ResettableEngineControl, attributesBuilder derive.AttributesBuilder, l1OriginSelector L1OriginSelectorIface, metrics SequencerMetrics) *Sequencer {
@@ -234,6 +238,13 @@ func (d *Sequencer) RunNextSequencerAction(ctx context.Context) (*eth.ExecutionP
 		if err != nil {
 			if errors.Is(err, derive.ErrCritical) {
 				return nil, err
+			} else if errors.Is(err, ErrL1OriginUnavailable) {
+				if !d.l1OriginUnavailable {
+					d.log.Error("sequencer stopped: L1 origin cannot advance within the max sequencer drift", "err", err)
+					d.metrics.RecordSequencerL1OriginUnavailable()
+					d.l1OriginUnavailable = true
+				}
+				d.nextAction = d.timeNow().Add(time.Second * time.Duration(d.config.BlockTime))
 			} else if errors.Is(err, derive.ErrReset) {
 				d.log.Error("sequencer failed to seal new block, requiring derivation reset", "err", err)
 				d.metrics.RecordSequencerReset()
@@ -247,6 +258,10 @@ func (d *Sequencer) RunNextSequencerAction(ctx context.Context) (*eth.ExecutionP
 				d.nextAction = d.timeNow().Add(time.Second)
 			}
 		} else {
+			if d.l1OriginUnavailable {
+				d.log.Info("sequencer resumed: L1 origin available again")
+				d.l1OriginUnavailable = false
+			}
 			parent, buildingID, _ := d.engine.BuildingPayload() // we should have a new payload ID now that we're building a block
 			d.log.Info("sequencer started building new block", "payload_id", buildingID, "l2_parent_block", parent, "l2_parent_block_time", parent.Time)
 		}
@@ -376,3 +376,56 @@ func TestSequencerChaosMonkey(t *testing.T) {
 	require.Greater(t, engControl.avgBuildingTime(), time.Second, "With 2 second block time and 1 second error backoff and healthy-on-average errors, building time should at least be a second")
 	require.Greater(t, engControl.avgTxsPerBlock(), 3.0, "We expect at least 1 system tx per block, but with a mocked 0-10 txs we expect an higher avg")
 }
+
+type l1OriginUnavailableMetrics struct {
+	metrics.Metricer
+	unavailable int
+}
+
+func (m *l1OriginUnavailableMetrics) RecordSequencerL1OriginUnavailable() {
+	m.unavailable++
+}
+
+func TestSequencerStopsWhenL1OriginUnavailable(t *testing.T) {
+	cfg := &rollup.Config{
+		BlockTime:         2,
+		MaxSequencerDrift: 30,
+	}
+	origin := eth.L1BlockRef{Hash: common.Hash{'a'}, Number: 10, Time: 100}
+	head := eth.L2BlockRef{Hash: common.Hash{'b'}, Number: 20, Time: 130, L1Origin: origin.ID()}
+	now := time.Unix(int64(head.Time+cfg.BlockTime), 0)
+	engControl := &FakeEngineControl{
+		unsafe:  head,
+		cfg:     cfg,
+		timeNow: func() time.Time { return now },
+	}
+	attrBuilder := testAttrBuilderFn(func(ctx context.Context, l2Parent eth.L2BlockRef, epoch eth.BlockID) (*eth.PayloadAttributes, error) {
+		return &eth.PayloadAttributes{Timestamp: eth.Uint64Quantity(l2Parent.Time + cfg.BlockTime)}, nil
+	})
+	originErr := derive.NewTemporaryError(fmt.Errorf("%w: mock L1 outage", ErrL1OriginUnavailable))
+	originSelector := testOriginSelectorFn(func(ctx context.Context, l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		if originErr != nil {
+			return eth.L1BlockRef{}, originErr
+		}
+		return origin, nil
+	})
+	m := &l1OriginUnavailableMetrics{Metricer: metrics.NoopMetrics}
+	seq := NewSequencer(testlog.Logger(t, log.LvlCrit), cfg, engControl, attrBuilder, originSelector, m)
+	seq.timeNow = engControl.timeNow
+
+	for i := 0; i < 3; i++ {
+		payload, err := seq.RunNextSequencerAction(context.Background())
+		require.NoError(t, err)
+		require.Nil(t, payload)
+		require.Equal(t, eth.PayloadID{}, engControl.buildingID, "must not start building a block")
+		require.Equal(t, now.Add(time.Duration(cfg.BlockTime)*time.Second), seq.nextAction, "must hold off for a block")
+	}
+	require.True(t, seq.l1OriginUnavailable)
+	require.Equal(t, 1, m.unavailable, "stopping is recorded once per outage")
+
+	originErr = nil
+	_, err := seq.RunNextSequencerAction(context.Background())
+	require.NoError(t, err)
+	require.NotEqual(t, eth.PayloadID{}, engControl.buildingID, "must resume building blocks")
+	require.False(t, seq.l1OriginUnavailable)
+}
@@ -83,6 +83,9 @@ type Driver struct {
 	sequencer SequencerIface
 	network   Network // may be nil, network for is optional
 
+	// engineHealth reports whether the engine is degraded, in which case sequencing is paused but verification continues.
+	engineHealth EngineHealth
+
 	metrics     Metrics
 	log         log.Logger
 	snapshotLog log.Logger
@@ -252,11 +255,18 @@ func (s *Driver) eventLoop() {
 					)
 					sequencerCh = nil
 				}
-			} else if s.sequencer.BuildingOnto().ID() != s.derivation.UnsafeL2Head().ID() {
+			} else if s.engineHealth.Degraded() {
+				// If the engine is too slow, stop creating new blocks until it recovers.
+				// Derivation continues, so unsafe blocks from other sources and safe blocks are still processed.
+				if sequencerCh != nil {
+					s.log.Warn("Delay creating new block since the engine is degraded", "unsafe_l2", s.derivation.UnsafeL2Head())
+					sequencerCh = nil
+				}
+			} else if sequencerCh == nil || s.sequencer.BuildingOnto().ID() != s.derivation.UnsafeL2Head().ID() {
 				// If we are sequencing, and the L1 state is ready, update the trigger for the next sequencer action.
 				// This may adjust at any time based on fork-choice changes or previous errors.
 				//
-				// update sequencer time if the head changed
+				// update sequencer time if the head changed, or resume sequencing if it was delayed
 				planSequencerAction()
 			}
 		} else {
@@ -523,6 +533,21 @@ func (s *Driver) BlockRefWithStatus(ctx context.Context, num uint64) (eth.L2Bloc
 	}
 }
 
+// DerivationStatus blocks the driver event loop and captures the status of the derivation pipeline,
+// including the pending-safe block and the batches that were recently dropped.
+// If the event loop is too busy and the context expires, a context error is returned.
+func (s *Driver) DerivationStatus(ctx context.Context) (*derive.DerivationStatus, error) {
+	wait := make(chan struct{})
+	select {
+	case s.stateReq <- wait:
+		resp := s.derivation.DerivationStatus()
+		<-wait
+		return resp, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // deferJSONString helps avoid a JSON-encoding performance hit if the snapshot logger does not run
 type deferJSONString struct {
 	x any
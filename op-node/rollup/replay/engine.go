@@ -0,0 +1,137 @@
+package replay
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+type forkchoiceArgs struct {
+	State      *eth.ForkchoiceState   `json:"state"`
+	Attributes *eth.PayloadAttributes `json:"attributes"`
+}
+
+// RecordingEngine records every engine API call made by derivation, along with the engine's response.
+type RecordingEngine struct {
+	inner derive.Engine
+	rec   *Recorder
+}
+
+var _ derive.Engine = (*RecordingEngine)(nil)
+
+func NewRecordingEngine(inner derive.Engine, rec *Recorder) *RecordingEngine {
+	return &RecordingEngine{inner: inner, rec: rec}
+}
+
+func (e *RecordingEngine) GetPayload(ctx context.Context, payloadId eth.PayloadID) (*eth.ExecutionPayload, error) {
+	payload, err := e.inner.GetPayload(ctx, payloadId)
+	e.rec.Record("GetPayload", payloadId, payload, err)
+	return payload, err
+}
+
+func (e *RecordingEngine) ForkchoiceUpdate(ctx context.Context, state *eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error) {
+	result, err := e.inner.ForkchoiceUpdate(ctx, state, attr)
+	e.rec.Record("ForkchoiceUpdate", &forkchoiceArgs{State: state, Attributes: attr}, result, err)
+	return result, err
+}
+
+func (e *RecordingEngine) NewPayload(ctx context.Context, payload *eth.ExecutionPayload) (*eth.PayloadStatusV1, error) {
+	status, err := e.inner.NewPayload(ctx, payload)
+	e.rec.Record("NewPayload", payload, status, err)
+	return status, err
+}
+
+func (e *RecordingEngine) PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayload, error) {
+	payload, err := e.inner.PayloadByHash(ctx, hash)
+	e.rec.Record("PayloadByHash", hash, payload, err)
+	return payload, err
+}
+
+func (e *RecordingEngine) PayloadByNumber(ctx context.Context, num uint64) (*eth.ExecutionPayload, error) {
+	payload, err := e.inner.PayloadByNumber(ctx, num)
+	e.rec.Record("PayloadByNumber", num, payload, err)
+	return payload, err
+}
+
+func (e *RecordingEngine) L2BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L2BlockRef, error) {
+	ref, err := e.inner.L2BlockRefByLabel(ctx, label)
+	e.rec.Record("L2BlockRefByLabel", label, ref, err)
+	return ref, err
+}
+
+func (e *RecordingEngine) L2BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L2BlockRef, error) {
+	ref, err := e.inner.L2BlockRefByHash(ctx, hash)
+	e.rec.Record("L2BlockRefByHash", hash, ref, err)
+	return ref, err
+}
+
+func (e *RecordingEngine) L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error) {
+	ref, err := e.inner.L2BlockRefByNumber(ctx, num)
+	e.rec.Record("L2BlockRefByNumber", num, ref, err)
+	return ref, err
+}
+
+func (e *RecordingEngine) SystemConfigByL2Hash(ctx context.Context, hash common.Hash) (eth.SystemConfig, error) {
+	cfg, err := e.inner.SystemConfigByL2Hash(ctx, hash)
+	e.rec.Record("SystemConfigByL2Hash", hash, cfg, err)
+	return cfg, err
+}
+
+// ReplayEngine is a dry-run engine that answers engine API calls from a recording, without contacting an
+// execution engine. Every call made during the replay, and the response given, is written to the sink in the same
+// format as the recording so the two can be compared.
+type ReplayEngine struct {
+	rec  *Recording
+	sink *Recorder
+}
+
+var _ derive.Engine = (*ReplayEngine)(nil)
+
+func NewReplayEngine(rec *Recording, sink *Recorder) *ReplayEngine {
+	return &ReplayEngine{rec: rec, sink: sink}
+}
+
+func (e *ReplayEngine) GetPayload(_ context.Context, payloadId eth.PayloadID) (*eth.ExecutionPayload, error) {
+	return replayToSink[*eth.ExecutionPayload](e, "GetPayload", payloadId)
+}
+
+func (e *ReplayEngine) ForkchoiceUpdate(_ context.Context, state *eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error) {
+	return replayToSink[*eth.ForkchoiceUpdatedResult](e, "ForkchoiceUpdate", &forkchoiceArgs{State: state, Attributes: attr})
+}
+
+func (e *ReplayEngine) NewPayload(_ context.Context, payload *eth.ExecutionPayload) (*eth.PayloadStatusV1, error) {
+	return replayToSink[*eth.PayloadStatusV1](e, "NewPayload", payload)
+}
+
+func (e *ReplayEngine) PayloadByHash(_ context.Context, hash common.Hash) (*eth.ExecutionPayload, error) {
+	return replayToSink[*eth.ExecutionPayload](e, "PayloadByHash", hash)
+}
+
+func (e *ReplayEngine) PayloadByNumber(_ context.Context, num uint64) (*eth.ExecutionPayload, error) {
+	return replayToSink[*eth.ExecutionPayload](e, "PayloadByNumber", num)
+}
+
+func (e *ReplayEngine) L2BlockRefByLabel(_ context.Context, label eth.BlockLabel) (eth.L2BlockRef, error) {
+	return replayToSink[eth.L2BlockRef](e, "L2BlockRefByLabel", label)
+}
+
+func (e *ReplayEngine) L2BlockRefByHash(_ context.Context, hash common.Hash) (eth.L2BlockRef, error) {
+	return replayToSink[eth.L2BlockRef](e, "L2BlockRefByHash", hash)
+}
+
+func (e *ReplayEngine) L2BlockRefByNumber(_ context.Context, num uint64) (eth.L2BlockRef, error) {
+	return replayToSink[eth.L2BlockRef](e, "L2BlockRefByNumber", num)
+}
+
+func (e *ReplayEngine) SystemConfigByL2Hash(_ context.Context, hash common.Hash) (eth.SystemConfig, error) {
+	return replayToSink[eth.SystemConfig](e, "SystemConfigByL2Hash", hash)
+}
+
+func replayToSink[T any](e *ReplayEngine, method string, args any) (T, error) {
+	result, err := replayCall[T](e.rec, method, args)
+	e.sink.Record(method, args, result, err)
+	return result, err
+}
@@ -0,0 +1,165 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// Block info is recorded as the header RLP so the replayed info, including its hash, is identical to the original.
+type infoAndReceipts struct {
+	Header   hexutil.Bytes  `json:"header"`
+	Receipts types.Receipts `json:"receipts"`
+}
+
+type infoAndTxs struct {
+	Header       hexutil.Bytes   `json:"header"`
+	Transactions []hexutil.Bytes `json:"transactions"`
+}
+
+// RecordingL1Fetcher records every L1 input consumed by derivation.
+type RecordingL1Fetcher struct {
+	inner derive.L1Fetcher
+	rec   *Recorder
+}
+
+var _ derive.L1Fetcher = (*RecordingL1Fetcher)(nil)
+
+func NewRecordingL1Fetcher(inner derive.L1Fetcher, rec *Recorder) *RecordingL1Fetcher {
+	return &RecordingL1Fetcher{inner: inner, rec: rec}
+}
+
+func (f *RecordingL1Fetcher) L1BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L1BlockRef, error) {
+	ref, err := f.inner.L1BlockRefByLabel(ctx, label)
+	f.rec.Record("L1BlockRefByLabel", label, ref, err)
+	return ref, err
+}
+
+func (f *RecordingL1Fetcher) L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error) {
+	ref, err := f.inner.L1BlockRefByNumber(ctx, num)
+	f.rec.Record("L1BlockRefByNumber", num, ref, err)
+	return ref, err
+}
+
+func (f *RecordingL1Fetcher) L1BlockRefByHash(ctx context.Context, hash common.Hash) (eth.L1BlockRef, error) {
+	ref, err := f.inner.L1BlockRefByHash(ctx, hash)
+	f.rec.Record("L1BlockRefByHash", hash, ref, err)
+	return ref, err
+}
+
+func (f *RecordingL1Fetcher) InfoByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, error) {
+	info, err := f.inner.InfoByHash(ctx, hash)
+	header, recErr := headerRLP(info, err)
+	f.rec.Record("InfoByHash", hash, header, recErr)
+	return info, err
+}
+
+func (f *RecordingL1Fetcher) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	info, receipts, err := f.inner.FetchReceipts(ctx, blockHash)
+	header, recErr := headerRLP(info, err)
+	f.rec.Record("FetchReceipts", blockHash, &infoAndReceipts{Header: header, Receipts: receipts}, recErr)
+	return info, receipts, err
+}
+
+func (f *RecordingL1Fetcher) InfoAndTxsByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error) {
+	info, txs, err := f.inner.InfoAndTxsByHash(ctx, hash)
+	header, recErr := headerRLP(info, err)
+	result := &infoAndTxs{Header: header}
+	for _, tx := range txs {
+		if recErr != nil {
+			break
+		}
+		var data []byte
+		data, recErr = tx.MarshalBinary()
+		result.Transactions = append(result.Transactions, data)
+	}
+	f.rec.Record("InfoAndTxsByHash", hash, result, recErr)
+	return info, txs, err
+}
+
+// headerRLP encodes info for recording. An error is returned if the call failed or info could not be encoded,
+// in which case the error is recorded in place of the result.
+func headerRLP(info eth.BlockInfo, err error) (hexutil.Bytes, error) {
+	if err != nil {
+		return nil, err
+	}
+	return info.HeaderRLP()
+}
+
+// ReplayL1Fetcher serves L1 inputs from a recording, without contacting an L1 node.
+type ReplayL1Fetcher struct {
+	rec *Recording
+}
+
+var _ derive.L1Fetcher = (*ReplayL1Fetcher)(nil)
+
+func NewReplayL1Fetcher(rec *Recording) *ReplayL1Fetcher {
+	return &ReplayL1Fetcher{rec: rec}
+}
+
+func (f *ReplayL1Fetcher) L1BlockRefByLabel(_ context.Context, label eth.BlockLabel) (eth.L1BlockRef, error) {
+	return replayCall[eth.L1BlockRef](f.rec, "L1BlockRefByLabel", label)
+}
+
+func (f *ReplayL1Fetcher) L1BlockRefByNumber(_ context.Context, num uint64) (eth.L1BlockRef, error) {
+	return replayCall[eth.L1BlockRef](f.rec, "L1BlockRefByNumber", num)
+}
+
+func (f *ReplayL1Fetcher) L1BlockRefByHash(_ context.Context, hash common.Hash) (eth.L1BlockRef, error) {
+	return replayCall[eth.L1BlockRef](f.rec, "L1BlockRefByHash", hash)
+}
+
+func (f *ReplayL1Fetcher) InfoByHash(_ context.Context, hash common.Hash) (eth.BlockInfo, error) {
+	header, err := replayCall[hexutil.Bytes](f.rec, "InfoByHash", hash)
+	if err != nil {
+		return nil, err
+	}
+	return decodeInfo(header)
+}
+
+func (f *ReplayL1Fetcher) FetchReceipts(_ context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	result, err := replayCall[infoAndReceipts](f.rec, "FetchReceipts", blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := decodeInfo(result.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+	return info, result.Receipts, nil
+}
+
+func (f *ReplayL1Fetcher) InfoAndTxsByHash(_ context.Context, hash common.Hash) (eth.BlockInfo, types.Transactions, error) {
+	result, err := replayCall[infoAndTxs](f.rec, "InfoAndTxsByHash", hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := decodeInfo(result.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+	txs := make(types.Transactions, len(result.Transactions))
+	for i, data := range result.Transactions {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(data); err != nil {
+			return nil, nil, fmt.Errorf("invalid recorded transaction %v in block %v: %w", i, hash, err)
+		}
+		txs[i] = tx
+	}
+	return info, txs, nil
+}
+
+func decodeInfo(data hexutil.Bytes) (eth.BlockInfo, error) {
+	var header types.Header
+	if err := rlp.DecodeBytes(data, &header); err != nil {
+		return nil, fmt.Errorf("invalid recorded header: %w", err)
+	}
+	return eth.HeaderBlockInfo(&header), nil
+}
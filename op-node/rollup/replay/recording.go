@@ -0,0 +1,177 @@
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ErrNotRecorded is returned when replaying a call that is not in the recording.
+// This happens once the replay reaches the end of the recording, or when derivation diverged from the recorded run.
+var ErrNotRecorded = errors.New("call not recorded")
+
+// Entry is a single call made by the derivation pipeline, together with its result.
+// Recordings are stored as newline-delimited JSON entries, in the order the calls were made.
+type Entry struct {
+	Method string          `json:"method"`
+	Args   json.RawMessage `json:"args"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	// NotFound is set if the error was an ethereum.NotFound error, which derivation handles differently to other errors.
+	NotFound bool `json:"notFound,omitempty"`
+	// InputCode is set to the error code if the error was an eth.InputError.
+	InputCode eth.ErrorCode `json:"inputCode,omitempty"`
+}
+
+func newEntry(method string, args any, result any, callErr error) (Entry, error) {
+	argsData, err := json.Marshal(args)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to encode args: %w", err)
+	}
+	entry := Entry{Method: method, Args: argsData}
+	if callErr != nil {
+		var inputErr eth.InputError
+		if errors.As(callErr, &inputErr) {
+			entry.Error = inputErr.Inner.Error()
+			entry.InputCode = inputErr.Code
+		} else {
+			entry.Error = callErr.Error()
+		}
+		entry.NotFound = errors.Is(callErr, ethereum.NotFound)
+		return entry, nil
+	}
+	entry.Result, err = json.Marshal(result)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to encode result: %w", err)
+	}
+	return entry, nil
+}
+
+// err reconstructs the error returned by the recorded call, if any.
+func (e Entry) err() error {
+	if e.Error == "" {
+		return nil
+	}
+	if e.InputCode != 0 {
+		return eth.InputError{Inner: &recordedError{msg: e.Error, notFound: e.NotFound}, Code: e.InputCode}
+	}
+	return &recordedError{msg: e.Error, notFound: e.NotFound}
+}
+
+type recordedError struct {
+	msg      string
+	notFound bool
+}
+
+func (e *recordedError) Error() string {
+	return e.msg
+}
+
+func (e *recordedError) Is(target error) bool {
+	return e.notFound && target == ethereum.NotFound
+}
+
+// Recorder writes entries to an output as newline-delimited JSON. It is safe for concurrent use.
+// Failing to record an entry is logged but does not fail the call being recorded.
+type Recorder struct {
+	log log.Logger
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func NewRecorder(logger log.Logger, w io.Writer) *Recorder {
+	return &Recorder{
+		log: logger,
+		enc: json.NewEncoder(w),
+	}
+}
+
+// Record writes an entry for a call to method with args, returning either result or callErr.
+func (r *Recorder) Record(method string, args any, result any, callErr error) {
+	entry, err := newEntry(method, args, result, callErr)
+	if err != nil {
+		r.log.Warn("Failed to encode derivation call", "method", method, "err", err)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.enc.Encode(entry); err != nil {
+		r.log.Warn("Failed to record derivation call", "method", method, "err", err)
+	}
+}
+
+// Recording is a previously recorded set of entries that can be replayed.
+// Each call is answered by the oldest unused entry with the same method and arguments.
+type Recording struct {
+	mu        sync.Mutex
+	entries   map[string][]Entry
+	remaining int
+}
+
+// ReadRecording reads all entries written by a [Recorder].
+func ReadRecording(r io.Reader) (*Recording, error) {
+	rec := &Recording{entries: make(map[string][]Entry)}
+	dec := json.NewDecoder(r)
+	for {
+		var entry Entry
+		if err := dec.Decode(&entry); errors.Is(err, io.EOF) {
+			return rec, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode entry %v: %w", rec.remaining, err)
+		}
+		k := entryKey(entry.Method, entry.Args)
+		rec.entries[k] = append(rec.entries[k], entry)
+		rec.remaining++
+	}
+}
+
+// Remaining returns the number of entries that have not yet been replayed.
+func (r *Recording) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.remaining
+}
+
+func (r *Recording) next(method string, args any) (Entry, error) {
+	argsData, err := json.Marshal(args)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to encode args: %w", err)
+	}
+	k := entryKey(method, argsData)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	queue := r.entries[k]
+	if len(queue) == 0 {
+		return Entry{}, fmt.Errorf("%w: %v(%s)", ErrNotRecorded, method, argsData)
+	}
+	r.entries[k] = queue[1:]
+	r.remaining--
+	return queue[0], nil
+}
+
+func entryKey(method string, args []byte) string {
+	return method + string(args)
+}
+
+// replayCall answers a call to method with args from the recording, decoding the recorded result into T.
+func replayCall[T any](rec *Recording, method string, args any) (T, error) {
+	var result T
+	entry, err := rec.next(method, args)
+	if err != nil {
+		return result, err
+	}
+	if err := entry.err(); err != nil {
+		return result, err
+	}
+	if err := json.Unmarshal(entry.Result, &result); err != nil {
+		return result, fmt.Errorf("failed to decode recorded %v result: %w", method, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,50 @@
+// Package replay records the inputs consumed by the derivation pipeline so that derivation can later be replayed
+// offline, reproducing the original run exactly without access to the L1 node or execution engine.
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// Run replays the derivation captured in rec, writing every engine API call made to sink.
+// Derivation runs until it requests a call that was not recorded, and the resulting safe head is returned.
+func Run(ctx context.Context, logger log.Logger, cfg *rollup.Config, rec *Recording, sink io.Writer) (eth.L2BlockRef, error) {
+	l1 := NewReplayL1Fetcher(rec)
+	engine := NewReplayEngine(rec, NewRecorder(logger, sink))
+	pipeline := derive.NewDerivationPipeline(logger, cfg, l1, engine, metrics.NoopMetrics, &sync.Config{})
+	pipeline.Reset()
+	for {
+		if err := ctx.Err(); err != nil {
+			return pipeline.SafeL2Head(), err
+		}
+		remaining := rec.Remaining()
+		err := pipeline.Step(ctx)
+		if errors.Is(err, ErrNotRecorded) {
+			logger.Info("Replay reached end of recording", "remaining", rec.Remaining(), "safe", pipeline.SafeL2Head(), "err", err)
+			return pipeline.SafeL2Head(), nil
+		} else if errors.Is(err, derive.ErrCritical) {
+			return pipeline.SafeL2Head(), fmt.Errorf("critical derivation error: %w", err)
+		} else if err != nil && !errors.Is(err, derive.NotEnoughData) && rec.Remaining() == remaining {
+			// The recorded node went idle here, waiting for new L1 data or retrying the same request.
+			// Stop once retrying no longer consumes any recorded calls.
+			logger.Info("Replay went idle", "remaining", remaining, "safe", pipeline.SafeL2Head(), "err", err)
+			return pipeline.SafeL2Head(), nil
+		} else if errors.Is(err, derive.ErrReset) {
+			logger.Warn("Derivation pipeline is reset", "err", err)
+			pipeline.Reset()
+		} else if err != nil && err != io.EOF && !errors.Is(err, derive.NotEnoughData) {
+			logger.Warn("Derivation error", "err", err)
+		}
+	}
+}
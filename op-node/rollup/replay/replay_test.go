@@ -0,0 +1,147 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestReplayL1Fetcher(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(1234))
+	logger := testlog.Logger(t, log.LvlInfo)
+	block, receipts := testutils.RandomBlock(rng, 3)
+	info := eth.BlockToInfo(block)
+	ref := eth.InfoToL1BlockRef(info)
+	head := testutils.RandomBlockRef(rng)
+	missing := testutils.RandomHash(rng)
+
+	l1 := &testutils.MockL1Source{}
+	l1.ExpectL1BlockRefByLabel(eth.Unsafe, head, nil)
+	l1.ExpectL1BlockRefByNumber(ref.Number, ref, nil)
+	l1.ExpectL1BlockRefByNumber(ref.Number+1, eth.L1BlockRef{}, ethereum.NotFound)
+	l1.ExpectL1BlockRefByHash(ref.Hash, ref, nil)
+	l1.ExpectInfoByHash(ref.Hash, info, nil)
+	l1.ExpectFetchReceipts(ref.Hash, info, receipts, nil)
+	l1.ExpectInfoAndTxsByHash(ref.Hash, info, block.Transactions(), nil)
+	l1.ExpectL1BlockRefByHash(missing, eth.L1BlockRef{}, errors.New("connection refused"))
+
+	var out bytes.Buffer
+	recording := NewRecordingL1Fetcher(l1, NewRecorder(logger, &out))
+	calls := func(t *testing.T, f derive.L1Fetcher) {
+		actualHead, err := f.L1BlockRefByLabel(ctx, eth.Unsafe)
+		require.NoError(t, err)
+		require.Equal(t, head, actualHead)
+
+		actualRef, err := f.L1BlockRefByNumber(ctx, ref.Number)
+		require.NoError(t, err)
+		require.Equal(t, ref, actualRef)
+		_, err = f.L1BlockRefByNumber(ctx, ref.Number+1)
+		require.ErrorIs(t, err, ethereum.NotFound)
+
+		actualRef, err = f.L1BlockRefByHash(ctx, ref.Hash)
+		require.NoError(t, err)
+		require.Equal(t, ref, actualRef)
+
+		actualInfo, err := f.InfoByHash(ctx, ref.Hash)
+		require.NoError(t, err)
+		require.Equal(t, info.Hash(), actualInfo.Hash())
+
+		actualInfo, actualReceipts, err := f.FetchReceipts(ctx, ref.Hash)
+		require.NoError(t, err)
+		require.Equal(t, info.Hash(), actualInfo.Hash())
+		require.Len(t, actualReceipts, len(receipts))
+		for i, receipt := range receipts {
+			require.Equal(t, receipt.Status, actualReceipts[i].Status)
+			require.Equal(t, receipt.Logs, actualReceipts[i].Logs)
+		}
+
+		actualInfo, actualTxs, err := f.InfoAndTxsByHash(ctx, ref.Hash)
+		require.NoError(t, err)
+		require.Equal(t, info.Hash(), actualInfo.Hash())
+		require.Len(t, actualTxs, len(block.Transactions()))
+		for i, tx := range block.Transactions() {
+			require.Equal(t, tx.Hash(), actualTxs[i].Hash())
+		}
+
+		_, err = f.L1BlockRefByHash(ctx, missing)
+		require.ErrorContains(t, err, "connection refused")
+		require.NotErrorIs(t, err, ethereum.NotFound)
+	}
+	calls(t, recording)
+	l1.AssertExpectations(t)
+
+	rec, err := ReadRecording(&out)
+	require.NoError(t, err)
+	require.Equal(t, 8, rec.Remaining())
+	calls(t, NewReplayL1Fetcher(rec))
+	require.Zero(t, rec.Remaining())
+
+	_, err = NewReplayL1Fetcher(rec).L1BlockRefByNumber(ctx, ref.Number)
+	require.ErrorIs(t, err, ErrNotRecorded)
+}
+
+func TestReplayEngineSink(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(1234))
+	logger := testlog.Logger(t, log.LvlInfo)
+	safe := testutils.RandomL2BlockRef(rng)
+	state := &eth.ForkchoiceState{HeadBlockHash: safe.Hash, SafeBlockHash: safe.Hash}
+	attrs := &eth.PayloadAttributes{Timestamp: eth.Uint64Quantity(safe.Time + 2), NoTxPool: true}
+	invalidErr := eth.InputError{Inner: errors.New("invalid attributes"), Code: eth.InvalidPayloadAttributes}
+
+	engine := &testutils.MockEngine{}
+	engine.ExpectL2BlockRefByLabel(eth.Safe, safe, nil)
+	engine.ExpectForkchoiceUpdate(state, attrs, nil, invalidErr)
+	engine.ExpectForkchoiceUpdate(state, nil, &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionValid}}, nil)
+
+	var out bytes.Buffer
+	recording := NewRecordingEngine(engine, NewRecorder(logger, &out))
+	calls := func(t *testing.T, e derive.Engine) {
+		actualSafe, err := e.L2BlockRefByLabel(ctx, eth.Safe)
+		require.NoError(t, err)
+		require.Equal(t, safe, actualSafe)
+
+		_, err = e.ForkchoiceUpdate(ctx, state, attrs)
+		var inputErr eth.InputError
+		require.ErrorAs(t, err, &inputErr)
+		require.Equal(t, eth.InvalidPayloadAttributes, inputErr.Code)
+
+		result, err := e.ForkchoiceUpdate(ctx, state, nil)
+		require.NoError(t, err)
+		require.Equal(t, eth.ExecutionValid, result.PayloadStatus.Status)
+	}
+	calls(t, recording)
+	engine.AssertExpectations(t)
+
+	recorded := out.String()
+	rec, err := ReadRecording(strings.NewReader(recorded))
+	require.NoError(t, err)
+	var sink bytes.Buffer
+	calls(t, NewReplayEngine(rec, NewRecorder(logger, &sink)))
+	require.Equal(t, recorded, sink.String(), "replayed engine calls should match the recording exactly")
+}
+
+func TestRunEmptyRecording(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	rec, err := ReadRecording(strings.NewReader(""))
+	require.NoError(t, err)
+	var sink bytes.Buffer
+	safe, err := Run(context.Background(), logger, &rollup.Config{}, rec, &sink)
+	require.NoError(t, err)
+	require.Equal(t, eth.L2BlockRef{}, safe)
+	require.Contains(t, sink.String(), ErrNotRecorded.Error(), "divergence from the recording should be visible in the sink")
+}
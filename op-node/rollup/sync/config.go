@@ -1,8 +1,11 @@
 package sync
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type Mode int
@@ -70,4 +73,46 @@ type Config struct {
 	// Note: We probably need to detect the condition that snap sync has not complete when we do a restart prior to running sync-start if we are doing
 	// snap sync with a genesis finalization data.
 	SkipSyncStartCheck bool `json:"skip_sync_start_check"`
+	// RecoverySafeHead, if set, enables recovery mode: the first pipeline reset rewinds derivation to this
+	// L2 block instead of the safe head of the execution engine. It must be a canonical L2 block that is not older
+	// than the finalized head and not newer than the safe head of the execution engine.
+	// Recovery is skipped if RecoveryStore records that the same target was already applied.
+	RecoverySafeHead common.Hash `json:"recovery_safe_head"`
+	// RecoveryL1Start is the L1 block the pipeline restarts reading batch data from in recovery mode.
+	// It must be the L1 origin of RecoverySafeHead or of one of its ancestors.
+	// If unset, the pipeline walks back from the recovery safe head by the channel timeout, as during a normal reset.
+	RecoveryL1Start common.Hash `json:"recovery_l1_start"`
+	// RecoveryStore records the applied recovery target across restarts. If nil, recovery is applied on every start.
+	RecoveryStore RecoveryStore `json:"-"`
+}
+
+// RecoveryTarget identifies a recovery mode request.
+type RecoveryTarget struct {
+	SafeHead common.Hash `json:"safeHead"`
+	L1Start  common.Hash `json:"l1Start"`
+}
+
+// RecoveryStore persists the last applied recovery target, so restarting with the same recovery flags resets as
+// normal instead of rewinding the safe head again.
+type RecoveryStore interface {
+	// RecoveryApplied returns the last applied recovery target, or the zero target if none was applied.
+	RecoveryApplied() (RecoveryTarget, error)
+	SetRecoveryApplied(target RecoveryTarget) error
+}
+
+// RecoveryMode returns true if derivation should restart from an explicit safe head.
+func (c *Config) RecoveryMode() bool {
+	return c.RecoverySafeHead != (common.Hash{})
+}
+
+// RecoveryTarget returns the recovery target requested by the config.
+func (c *Config) RecoveryTarget() RecoveryTarget {
+	return RecoveryTarget{SafeHead: c.RecoverySafeHead, L1Start: c.RecoveryL1Start}
+}
+
+func (c *Config) Check() error {
+	if c.RecoveryL1Start != (common.Hash{}) && !c.RecoveryMode() {
+		return errors.New("recovery L1 start requires a recovery L2 safe head")
+	}
+	return nil
 }
@@ -96,6 +96,8 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		P2P:                         p2pConfig,
 		P2PSigner:                   p2pSignerSetup,
 		L1EpochPollInterval:         ctx.Duration(flags.L1EpochPollIntervalFlag.Name),
+		L1BeaconAddr:                ctx.String(flags.L1BeaconAddr.Name),
+		L1BeaconFinality:            ctx.Bool(flags.L1BeaconFinality.Name),
 		RuntimeConfigReloadInterval: ctx.Duration(flags.RuntimeConfigReloadIntervalFlag.Name),
 		Heartbeat: node.HeartbeatConfig{
 			Enabled: ctx.Bool(flags.HeartbeatEnabledFlag.Name),
@@ -106,6 +108,7 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		Sync:              *syncConfig,
 		RollupHalt:        haltOption,
 		RethDBPath:        ctx.String(flags.L1RethDBPath.Name),
+		RecordDerivation:  ctx.String(flags.RecordDerivation.Name),
 	}
 
 	if err := cfg.LoadPersisted(log); err != nil {
@@ -155,8 +158,10 @@ func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConf
 	}
 
 	return &node.L2EndpointConfig{
-		L2EngineAddr:      l2Addr,
-		L2EngineJWTSecret: secret,
+		L2EngineAddr:         l2Addr,
+		L2EngineJWTSecret:    secret,
+		L2EngineCallTimeout:  ctx.Duration(flags.L2EngineCallTimeout.Name),
+		L2EngineCallAttempts: ctx.Int(flags.L2EngineCallAttempts.Name),
 	}, nil
 }
 
@@ -170,11 +175,12 @@ func NewConfigPersistence(ctx *cli.Context) node.ConfigPersistence {
 
 func NewDriverConfig(ctx *cli.Context) *driver.Config {
 	return &driver.Config{
-		VerifierConfDepth:   ctx.Uint64(flags.VerifierL1Confs.Name),
-		SequencerConfDepth:  ctx.Uint64(flags.SequencerL1Confs.Name),
-		SequencerEnabled:    ctx.Bool(flags.SequencerEnabledFlag.Name),
-		SequencerStopped:    ctx.Bool(flags.SequencerStoppedFlag.Name),
-		SequencerMaxSafeLag: ctx.Uint64(flags.SequencerMaxSafeLagFlag.Name),
+		VerifierConfDepth:         ctx.Uint64(flags.VerifierL1Confs.Name),
+		SequencerConfDepth:        ctx.Uint64(flags.SequencerL1Confs.Name),
+		SequencerEnabled:          ctx.Bool(flags.SequencerEnabledFlag.Name),
+		SequencerStopped:          ctx.Bool(flags.SequencerStoppedFlag.Name),
+		SequencerMaxSafeLag:       ctx.Uint64(flags.SequencerMaxSafeLagFlag.Name),
+		SequencerEngineMaxLatency: ctx.Duration(flags.SequencerEngineMaxLatencyFlag.Name),
 	}
 }
 
@@ -257,6 +263,40 @@ func NewSyncConfig(ctx *cli.Context, log log.Logger) (*sync.Config, error) {
 	if ctx.Bool(flags.L2EngineSyncEnabled.Name) {
 		cfg.SyncMode = sync.ELSync
 	}
+	if ctx.IsSet(flags.RecoveryL2SafeHead.Name) {
+		hash, err := parseRecoveryHash(ctx.String(flags.RecoveryL2SafeHead.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", flags.RecoveryL2SafeHead.Name, err)
+		}
+		cfg.RecoverySafeHead = hash
+	}
+	if ctx.IsSet(flags.RecoveryL1Start.Name) {
+		hash, err := parseRecoveryHash(ctx.String(flags.RecoveryL1Start.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", flags.RecoveryL1Start.Name, err)
+		}
+		cfg.RecoveryL1Start = hash
+	}
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+	if cfg.RecoveryMode() && ctx.String(flags.RPCAdminPersistence.Name) == "" {
+		return nil, fmt.Errorf("%s requires %s to record that recovery was applied", flags.RecoveryL2SafeHead.Name, flags.RPCAdminPersistence.Name)
+	}
+	if cfg.RecoveryMode() {
+		log.Warn("Recovery mode enabled, derivation will restart from the given safe head", "safe_head", cfg.RecoverySafeHead, "l1_start", cfg.RecoveryL1Start)
+	}
 
 	return cfg, nil
 }
+
+func parseRecoveryHash(s string) (common.Hash, error) {
+	var hash common.Hash
+	if err := hash.UnmarshalText([]byte(s)); err != nil {
+		return common.Hash{}, err
+	}
+	if hash == (common.Hash{}) {
+		return common.Hash{}, errors.New("hash must not be zero")
+	}
+	return hash, nil
+}
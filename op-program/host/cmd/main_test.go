@@ -290,6 +290,28 @@ func TestServerMode(t *testing.T) {
 	})
 }
 
+func TestPreimageHTTPAddr(t *testing.T) {
+	t.Run("DefaultDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, "", cfg.PreimageHTTPAddr)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--preimage.http.addr", "127.0.0.1:7300"))
+		require.Equal(t, "127.0.0.1:7300", cfg.PreimageHTTPAddr)
+	})
+}
+
+func TestPreimageRemote(t *testing.T) {
+	t.Run("DefaultDisabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, "", cfg.PreimageRemote)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--preimage.remote", "http://localhost:7300"))
+		require.Equal(t, "http://localhost:7300", cfg.PreimageRemote)
+	})
+}
+
 func verifyArgsInvalid(t *testing.T, messageContains string, cliArgs []string) {
 	_, _, err := runWithArgs(cliArgs)
 	require.ErrorContains(t, err, messageContains)
@@ -65,6 +65,13 @@ type Config struct {
 	// No client program is run.
 	ServerMode bool
 
+	// PreimageHTTPAddr is the address to serve the pre-image store on over HTTP while the host runs.
+	// The pre-image store is not served if empty.
+	PreimageHTTPAddr string
+	// PreimageRemote is the URL of another host serving its pre-image store over HTTP.
+	// Pre-images missing from the local store are read from it before being fetched.
+	PreimageRemote string
+
 	// IsCustomChainConfig indicates that the program uses a custom chain configuration
 	IsCustomChainConfig bool
 }
@@ -97,7 +104,7 @@ func (c *Config) Check() error {
 	if (c.L1URL != "") != (c.L2URL != "") {
 		return ErrL1AndL2Inconsistent
 	}
-	if !c.FetchingEnabled() && c.DataDir == "" {
+	if !c.FetchingEnabled() && c.DataDir == "" && c.PreimageRemote == "" {
 		return ErrDataDirRequired
 	}
 	if c.ServerMode && c.ExecCmd != "" {
@@ -196,6 +203,8 @@ func NewConfigFromCLI(log log.Logger, ctx *cli.Context) (*Config, error) {
 		L1RPCKind:           sources.RPCProviderKind(ctx.String(flags.L1RPCProviderKind.Name)),
 		ExecCmd:             ctx.String(flags.Exec.Name),
 		ServerMode:          ctx.Bool(flags.Server.Name),
+		PreimageHTTPAddr:    ctx.String(flags.PreimageHTTPAddr.Name),
+		PreimageRemote:      ctx.String(flags.PreimageRemote.Name),
 		IsCustomChainConfig: isCustomConfig,
 	}, nil
 }
@@ -152,6 +152,15 @@ func TestRequireDataDirInNonFetchingMode(t *testing.T) {
 	require.ErrorIs(t, err, ErrDataDirRequired)
 }
 
+func TestAllowNoDataDirWithPreimageRemote(t *testing.T) {
+	cfg := validConfig()
+	cfg.DataDir = ""
+	cfg.L1URL = ""
+	cfg.L2URL = ""
+	cfg.PreimageRemote = "http://localhost:7300"
+	require.NoError(t, cfg.Check())
+}
+
 func TestRejectExecAndServerMode(t *testing.T) {
 	cfg := validConfig()
 	cfg.ServerMode = true
@@ -100,6 +100,16 @@ var (
 		Usage:   "Run in pre-image server mode without executing any client program.",
 		EnvVars: prefixEnvVars("SERVER"),
 	}
+	PreimageHTTPAddr = &cli.StringFlag{
+		Name:    "preimage.http.addr",
+		Usage:   "Address to serve the pre-image store on over HTTP while the host runs, so other hosts can reuse prefetched pre-images (e.g. 0.0.0.0:7300). Disabled if empty.",
+		EnvVars: prefixEnvVars("PREIMAGE_HTTP_ADDR"),
+	}
+	PreimageRemote = &cli.StringFlag{
+		Name:    "preimage.remote",
+		Usage:   "URL of another host serving its pre-image store over HTTP. Pre-images missing from the local store are read from it before being fetched.",
+		EnvVars: prefixEnvVars("PREIMAGE_REMOTE"),
+	}
 )
 
 // Flags contains the list of configuration options available to the binary.
@@ -123,6 +133,8 @@ var programFlags = []cli.Flag{
 	L1RPCProviderKind,
 	Exec,
 	Server,
+	PreimageHTTPAddr,
+	PreimageRemote,
 }
 
 func init() {
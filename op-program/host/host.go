@@ -20,6 +20,7 @@ import (
 	oppio "github.com/ethereum-optimism/optimism/op-program/io"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -151,6 +152,22 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 		}
 		kv = kvstore.NewDiskKV(cfg.DataDir)
 	}
+	if cfg.PreimageRemote != "" {
+		logger.Info("Reading missing pre-images from remote host", "url", cfg.PreimageRemote)
+		kv = kvstore.NewFallbackKV(kv, kvstore.NewHTTPSource(cfg.PreimageRemote).Get)
+	}
+	if cfg.PreimageHTTPAddr != "" {
+		srv, err := httputil.StartHTTPServer(cfg.PreimageHTTPAddr, kvstore.NewHTTPHandler(kv))
+		if err != nil {
+			return fmt.Errorf("failed to start pre-image HTTP server: %w", err)
+		}
+		logger.Info("Serving pre-images over HTTP", "addr", srv.Addr())
+		defer func() {
+			if err := srv.Stop(context.Background()); err != nil {
+				logger.Error("Failed to stop pre-image HTTP server", "err", err)
+			}
+		}()
+	}
 
 	var (
 		getPreimage kvstore.PreimageSource
@@ -0,0 +1,117 @@
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// HTTPPreimagePath is the path prefix pre-images are served from.
+// A pre-image is requested with GET <HTTPPreimagePath><0x-prefixed hex key>.
+const HTTPPreimagePath = "/preimage/"
+
+// httpFetchTimeout is the maximum duration of a single pre-image request to a remote host.
+const httpFetchTimeout = 30 * time.Second
+
+// NewHTTPHandler creates a read-only http.Handler that serves pre-images from the given KV store.
+// This allows other hosts to reuse pre-images already fetched for the same disputed range instead of fetching
+// them again from L1 and L2 nodes.
+func NewHTTPHandler(kv KV) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(HTTPPreimagePath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		keyStr := strings.TrimPrefix(r.URL.Path, HTTPPreimagePath)
+		var key common.Hash
+		if err := key.UnmarshalText([]byte(keyStr)); err != nil {
+			http.Error(w, "invalid pre-image key", http.StatusBadRequest)
+			return
+		}
+		value, err := kv.Get(key)
+		if errors.Is(err, ErrNotFound) {
+			http.Error(w, "pre-image not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			http.Error(w, "failed to read pre-image", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(value)
+	})
+	return mux
+}
+
+// HTTPSource reads pre-images from another host serving them with NewHTTPHandler.
+type HTTPSource struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewHTTPSource(baseURL string) *HTTPSource {
+	return &HTTPSource{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: httpFetchTimeout},
+	}
+}
+
+// Get retrieves the pre-image for the given key from the remote host.
+// Returns ErrNotFound if the remote host does not have the pre-image.
+func (s *HTTPSource) Get(key common.Hash) ([]byte, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, s.baseURL+HTTPPreimagePath+key.Hex(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pre-image request: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request pre-image %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		value, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pre-image %s: %w", key, err)
+		}
+		return value, nil
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("unexpected status fetching pre-image %s: %v", key, resp.Status)
+	}
+}
+
+// FallbackKV is a KV store that reads pre-images missing from a local store from a fallback source,
+// storing any pre-images found in the local store.
+type FallbackKV struct {
+	KV
+	fallback PreimageSource
+}
+
+var _ KV = (*FallbackKV)(nil)
+
+func NewFallbackKV(local KV, fallback PreimageSource) *FallbackKV {
+	return &FallbackKV{KV: local, fallback: fallback}
+}
+
+func (f *FallbackKV) Get(k common.Hash) ([]byte, error) {
+	value, err := f.KV.Get(k)
+	if !errors.Is(err, ErrNotFound) {
+		return value, err
+	}
+	value, err = f.fallback(k)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.KV.Put(k, value); err != nil {
+		return nil, fmt.Errorf("failed to store pre-image %s: %w", k, err)
+	}
+	return value, nil
+}
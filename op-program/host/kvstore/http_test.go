@@ -0,0 +1,72 @@
+package kvstore
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	kv := NewMemKV()
+	key := common.Hash{0xaa}
+	require.NoError(t, kv.Put(key, []byte{1, 2, 3}))
+	srv := httptest.NewServer(NewHTTPHandler(kv))
+	t.Cleanup(srv.Close)
+
+	t.Run("Found", func(t *testing.T) {
+		value, err := NewHTTPSource(srv.URL).Get(key)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 2, 3}, value)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := NewHTTPSource(srv.URL + "/").Get(common.Hash{0xbb})
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + HTTPPreimagePath + "0x1234")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		resp, err := http.Post(srv.URL+HTTPPreimagePath+key.Hex(), "application/octet-stream", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+func TestFallbackKV(t *testing.T) {
+	remote := NewMemKV()
+	remoteKey := common.Hash{0xaa}
+	require.NoError(t, remote.Put(remoteKey, []byte{1}))
+	local := NewMemKV()
+	kv := NewFallbackKV(local, remote.Get)
+
+	t.Run("KV", func(t *testing.T) {
+		kvTest(t, NewFallbackKV(NewMemKV(), NewMemKV().Get))
+	})
+
+	t.Run("StoresFallbackResult", func(t *testing.T) {
+		value, err := kv.Get(remoteKey)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1}, value)
+		value, err = local.Get(remoteKey)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1}, value)
+	})
+
+	t.Run("FallbackError", func(t *testing.T) {
+		fallbackErr := errors.New("boom")
+		kv := NewFallbackKV(NewMemKV(), func(key common.Hash) ([]byte, error) { return nil, fallbackErr })
+		_, err := kv.Get(common.Hash{0xcc})
+		require.ErrorIs(t, err, fallbackErr)
+	})
+}
@@ -50,6 +50,32 @@ var (
 		Usage:   "Allow the proposer to submit proposals for L2 blocks derived from non-finalized L1 blocks.",
 		EnvVars: prefixEnvVars("ALLOW_NON_FINALIZED"),
 	}
+	RollupRpcQuorumFlag = &cli.UintFlag{
+		Name: "rollup-rpc-quorum",
+		Usage: "Treat the comma-separated rollup-rpc list as a trusted set of independent rollup nodes, " +
+			"and only propose outputs that at least this many of them agree on. 0 disables quorum mode.",
+		EnvVars: prefixEnvVars("ROLLUP_RPC_QUORUM"),
+	}
+	ChainsConfigFlag = &cli.PathFlag{
+		Name: "chains-config",
+		Usage: "Path to a JSON file listing additional chains to propose outputs for from this process. " +
+			"Each chain specifies its own rollup RPC, L2OutputOracle address, poll interval and remote signer, " +
+			"which must use a different address to the tx manager flags and the other chains.",
+		EnvVars: prefixEnvVars("CHAINS_CONFIG"),
+	}
+	BalanceReserveFlag = &cli.Float64Flag{
+		Name: "balance-reserve",
+		Usage: "Amount of ETH that must remain in the proposer account after paying for a proposal, " +
+			"including any bond. Proposals that would take the balance below it are skipped.",
+		EnvVars: prefixEnvVars("BALANCE_RESERVE"),
+	}
+	ProposalLagAlertFactorFlag = &cli.Float64Flag{
+		Name: "proposal-lag-alert-factor",
+		Usage: "Raise the proposal lag alert metric when the L2 safe head is more than this many submission intervals " +
+			"ahead of the latest proposed output. 0 disables the alert.",
+		Value:   2,
+		EnvVars: prefixEnvVars("PROPOSAL_LAG_ALERT_FACTOR"),
+	}
 	// Legacy Flags
 	L2OutputHDPathFlag = txmgr.L2OutputHDPathFlag
 )
@@ -63,6 +89,10 @@ var requiredFlags = []cli.Flag{
 var optionalFlags = []cli.Flag{
 	PollIntervalFlag,
 	AllowNonFinalizedFlag,
+	RollupRpcQuorumFlag,
+	ChainsConfigFlag,
+	BalanceReserveFlag,
+	ProposalLagAlertFactorFlag,
 	L2OutputHDPathFlag,
 }
 
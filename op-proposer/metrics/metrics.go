@@ -2,6 +2,8 @@ package metrics
 
 import (
 	"io"
+	"math/big"
+	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -34,6 +36,20 @@ type Metricer interface {
 	StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer
 
 	RecordL2BlocksProposed(l2ref eth.L2BlockRef)
+
+	// RecordRollupDivergence records a rollup node returning an output that differs from the quorum output.
+	RecordRollupDivergence(node int)
+
+	// RecordBalanceShortfall records how much the proposer balance falls short of paying for the next proposal
+	// plus the balance reserve. Zero means the proposal could be paid for.
+	RecordBalanceShortfall(shortfall *big.Int)
+
+	// RecordProposalLag records how far the latest proposed output trails the L2 safe head, and whether that exceeds
+	// the configured alert threshold.
+	RecordProposalLag(blocks uint64, seconds uint64, alert bool)
+
+	// ForChain returns the metrics for an additional chain proposed for by the same process.
+	ForChain(name string) Metricer
 }
 
 type Metrics struct {
@@ -47,6 +63,14 @@ type Metrics struct {
 
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
+
+	rollupDivergence *prometheus.CounterVec
+
+	balanceShortfall prometheus.Gauge
+
+	proposalLagBlocks  prometheus.Gauge
+	proposalLagSeconds prometheus.Gauge
+	proposalLagAlert   prometheus.Gauge
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -55,9 +79,10 @@ func NewMetrics(procName string) *Metrics {
 	if procName == "" {
 		procName = "default"
 	}
-	ns := Namespace + "_" + procName
+	return newMetrics(Namespace+"_"+procName, opmetrics.NewRegistry())
+}
 
-	registry := opmetrics.NewRegistry()
+func newMetrics(ns string, registry *prometheus.Registry) *Metrics {
 	factory := opmetrics.With(registry)
 
 	return &Metrics{
@@ -81,9 +106,42 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "up",
 			Help:      "1 if the op-proposer has finished starting up",
 		}),
+		rollupDivergence: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "rollup_divergence_total",
+			Help:      "Number of outputs returned by each rollup node that differ from the quorum output",
+		}, []string{
+			"node",
+		}),
+		balanceShortfall: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "balance_shortfall_wei",
+			Help:      "Amount of wei the proposer balance is short of paying for the last proposal plus the balance reserve",
+		}),
+		proposalLagBlocks: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proposal_lag_blocks",
+			Help:      "Number of L2 blocks the L2 safe head is ahead of the latest proposed output",
+		}),
+		proposalLagSeconds: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proposal_lag_seconds",
+			Help:      "Number of seconds of L2 time the L2 safe head is ahead of the latest proposed output",
+		}),
+		proposalLagAlert: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proposal_lag_alert",
+			Help:      "1 if the proposal lag exceeds the submission interval by more than the configured factor, 0 otherwise",
+		}),
 	}
 }
 
+// ForChain returns metrics registered on the same registry in a namespace suffixed with the chain name, so the metrics
+// of each chain are reported separately rather than overwriting each other.
+func (m *Metrics) ForChain(name string) Metricer {
+	return newMetrics(m.ns+"_"+name, m.registry)
+}
+
 func (m *Metrics) Registry() *prometheus.Registry {
 	return m.registry
 }
@@ -113,6 +171,25 @@ func (m *Metrics) RecordL2BlocksProposed(l2ref eth.L2BlockRef) {
 	m.RecordL2Ref(BlockProposed, l2ref)
 }
 
+func (m *Metrics) RecordRollupDivergence(node int) {
+	m.rollupDivergence.WithLabelValues(strconv.Itoa(node)).Inc()
+}
+
+func (m *Metrics) RecordBalanceShortfall(shortfall *big.Int) {
+	wei, _ := new(big.Float).SetInt(shortfall).Float64()
+	m.balanceShortfall.Set(wei)
+}
+
+func (m *Metrics) RecordProposalLag(blocks uint64, seconds uint64, alert bool) {
+	m.proposalLagBlocks.Set(float64(blocks))
+	m.proposalLagSeconds.Set(float64(seconds))
+	if alert {
+		m.proposalLagAlert.Set(1)
+	} else {
+		m.proposalLagAlert.Set(0)
+	}
+}
+
 func (m *Metrics) Document() []opmetrics.DocumentedMetric {
 	return m.factory.Document()
 }
@@ -0,0 +1,148 @@
+package proposer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	opsigner "github.com/ethereum-optimism/optimism/op-service/signer"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+// ChainConfig configures proposing outputs for an additional chain from the same proposer process.
+// The L1 RPC, metrics and RPC servers are shared with the primary chain. Each chain has its own transaction manager,
+// using the tx manager flags other than the signer, and signs its proposals with its own remote signer so the chains
+// do not compete for the same nonces.
+type ChainConfig struct {
+	// Name identifies the chain in logs and is appended to the namespace of the chain's metrics.
+	Name string `json:"name"`
+
+	// RollupRpc is the HTTP provider URL for the chain's rollup node. A comma-separated list enables the active rollup provider.
+	RollupRpc string `json:"rollupRpc"`
+
+	// L2OOAddress is the chain's L2OutputOracle contract address.
+	L2OOAddress string `json:"l2ooAddress"`
+
+	// PollInterval is how frequently to poll the chain's rollup node for new outputs, e.g. "12s".
+	// Defaults to the poll interval of the primary chain if empty.
+	PollInterval string `json:"pollInterval,omitempty"`
+
+	// AllowNonFinalized can be set to true to propose outputs for L2 blocks derived from non-finalized L1 data.
+	AllowNonFinalized bool `json:"allowNonFinalized,omitempty"`
+
+	// Signer is the remote signer used to sign the chain's proposals.
+	Signer ChainSignerConfig `json:"signer"`
+}
+
+// ChainSignerConfig configures the remote signer for a chain. Private keys are not supported in the chains config.
+type ChainSignerConfig struct {
+	// Endpoint is the URL of the remote signer.
+	Endpoint string `json:"endpoint"`
+
+	// Address is the address the signer signs the chain's proposals with.
+	Address string `json:"address"`
+
+	// TLSCaCert, TLSCert and TLSKey are the paths of the TLS files used to connect to the signer.
+	// TLS is disabled if none are set.
+	TLSCaCert string `json:"tlsCaCert,omitempty"`
+	TLSCert   string `json:"tlsCert,omitempty"`
+	TLSKey    string `json:"tlsKey,omitempty"`
+}
+
+// CLIConfig returns the signer config in the form used by the tx manager.
+func (c ChainSignerConfig) CLIConfig() opsigner.CLIConfig {
+	return opsigner.CLIConfig{
+		Endpoint: c.Endpoint,
+		Address:  c.Address,
+		TLSConfig: optls.CLIConfig{
+			TLSCaCert: c.TLSCaCert,
+			TLSCert:   c.TLSCert,
+			TLSKey:    c.TLSKey,
+		},
+	}
+}
+
+// chainNameRegexp matches the names that can be used in a metrics namespace.
+var chainNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+func (c *ChainConfig) Check() error {
+	if c.Name == "" {
+		return errors.New("chain name must be set")
+	}
+	if !chainNameRegexp.MatchString(c.Name) {
+		return fmt.Errorf("chain %v: name must only contain letters, digits and underscores", c.Name)
+	}
+	if c.RollupRpc == "" {
+		return fmt.Errorf("chain %v: empty rollup RPC URL", c.Name)
+	}
+	if _, err := opservice.ParseAddress(c.L2OOAddress); err != nil {
+		return fmt.Errorf("chain %v: invalid L2OutputOracle address: %w", c.Name, err)
+	}
+	if _, err := c.pollInterval(time.Second); err != nil {
+		return fmt.Errorf("chain %v: invalid poll interval: %w", c.Name, err)
+	}
+	signer := c.Signer.CLIConfig()
+	if !signer.Enabled() {
+		return fmt.Errorf("chain %v: signer endpoint and address must be set", c.Name)
+	}
+	if err := signer.Check(); err != nil {
+		return fmt.Errorf("chain %v: invalid signer: %w", c.Name, err)
+	}
+	if _, err := opservice.ParseAddress(c.Signer.Address); err != nil {
+		return fmt.Errorf("chain %v: invalid signer address: %w", c.Name, err)
+	}
+	return nil
+}
+
+// pollInterval returns the parsed PollInterval, or defaultInterval if it is not set.
+func (c *ChainConfig) pollInterval(defaultInterval time.Duration) (time.Duration, error) {
+	if c.PollInterval == "" {
+		return defaultInterval, nil
+	}
+	interval, err := time.ParseDuration(c.PollInterval)
+	if err != nil {
+		return 0, err
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("must be positive: %v", c.PollInterval)
+	}
+	return interval, nil
+}
+
+// LoadChainConfigs reads and validates the list of additional chains from the JSON file at path.
+func LoadChainConfigs(path string) ([]ChainConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chains config: %w", err)
+	}
+	var chains []ChainConfig
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// Reject unknown fields, such as private keys, rather than silently ignoring them.
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&chains); err != nil {
+		return nil, fmt.Errorf("failed to parse chains config: %w", err)
+	}
+	names := make(map[string]bool)
+	signers := make(map[string]string)
+	for _, chain := range chains {
+		if err := chain.Check(); err != nil {
+			return nil, err
+		}
+		if names[chain.Name] {
+			return nil, fmt.Errorf("duplicate chain name: %v", chain.Name)
+		}
+		names[chain.Name] = true
+		// Chains sharing a signer would compete for the same nonces.
+		signer, _ := opservice.ParseAddress(chain.Signer.Address)
+		if other, ok := signers[signer.Hex()]; ok {
+			return nil, fmt.Errorf("chains %v and %v use the same signer address: %v", other, chain.Name, signer)
+		}
+		signers[signer.Hex()] = chain.Name
+	}
+	return chains, nil
+}
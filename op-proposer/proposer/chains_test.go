@@ -0,0 +1,121 @@
+package proposer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadChainConfigs(t *testing.T) {
+	writeConfig := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "chains.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return path
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		path := writeConfig(t, `[
+			{"name": "a", "rollupRpc": "http://a:8545", "l2ooAddress": "0x1111111111111111111111111111111111111111",
+			 "signer": {"endpoint": "http://signer-a:8080", "address": "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}},
+			{"name": "b", "rollupRpc": "http://b:8545", "l2ooAddress": "0x2222222222222222222222222222222222222222",
+			 "pollInterval": "12s", "allowNonFinalized": true,
+			 "signer": {"endpoint": "http://signer-b:8080", "address": "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "tlsCaCert": "ca.crt", "tlsCert": "tls.crt", "tlsKey": "tls.key"}}
+		]`)
+		chains, err := LoadChainConfigs(path)
+		require.NoError(t, err)
+		require.Equal(t, []ChainConfig{
+			{Name: "a", RollupRpc: "http://a:8545", L2OOAddress: "0x1111111111111111111111111111111111111111",
+				Signer: ChainSignerConfig{Endpoint: "http://signer-a:8080", Address: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}},
+			{Name: "b", RollupRpc: "http://b:8545", L2OOAddress: "0x2222222222222222222222222222222222222222",
+				PollInterval: "12s", AllowNonFinalized: true,
+				Signer: ChainSignerConfig{Endpoint: "http://signer-b:8080", Address: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+					TLSCaCert: "ca.crt", TLSCert: "tls.crt", TLSKey: "tls.key"}},
+		}, chains)
+
+		interval, err := chains[0].pollInterval(6 * time.Second)
+		require.NoError(t, err)
+		require.Equal(t, 6*time.Second, interval)
+		interval, err = chains[1].pollInterval(6 * time.Second)
+		require.NoError(t, err)
+		require.Equal(t, 12*time.Second, interval)
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		_, err := LoadChainConfigs(filepath.Join(t.TempDir(), "missing.json"))
+		require.ErrorContains(t, err, "failed to read chains config")
+	})
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := LoadChainConfigs(writeConfig(t, `{`))
+		require.ErrorContains(t, err, "failed to parse chains config")
+	})
+
+	t.Run("PrivateKey", func(t *testing.T) {
+		_, err := LoadChainConfigs(writeConfig(t, `[
+			{"name": "a", "rollupRpc": "http://a:8545", "l2ooAddress": "0x1111111111111111111111111111111111111111", "privateKey": "0xabcd"}
+		]`))
+		require.ErrorContains(t, err, "unknown field \"privateKey\"")
+	})
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		_, err := LoadChainConfigs(writeConfig(t, `[
+			{"name": "a", "rollupRpc": "http://a:8545", "l2ooAddress": "0x1111111111111111111111111111111111111111",
+			 "signer": {"endpoint": "http://signer-a:8080", "address": "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}},
+			{"name": "a", "rollupRpc": "http://b:8545", "l2ooAddress": "0x2222222222222222222222222222222222222222",
+			 "signer": {"endpoint": "http://signer-b:8080", "address": "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "tlsCaCert": "ca.crt", "tlsCert": "tls.crt", "tlsKey": "tls.key"}}
+		]`))
+		require.ErrorContains(t, err, "duplicate chain name: a")
+	})
+
+	t.Run("DuplicateSigner", func(t *testing.T) {
+		_, err := LoadChainConfigs(writeConfig(t, `[
+			{"name": "a", "rollupRpc": "http://a:8545", "l2ooAddress": "0x1111111111111111111111111111111111111111",
+			 "signer": {"endpoint": "http://signer-a:8080", "address": "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}},
+			{"name": "b", "rollupRpc": "http://b:8545", "l2ooAddress": "0x2222222222222222222222222222222222222222",
+			 "signer": {"endpoint": "http://signer-b:8080", "address": "0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}}
+		]`))
+		require.ErrorContains(t, err, "chains a and b use the same signer address")
+	})
+}
+
+func TestChainConfigCheck(t *testing.T) {
+	valid := func() ChainConfig {
+		return ChainConfig{
+			Name:        "a",
+			RollupRpc:   "http://a:8545",
+			L2OOAddress: "0x1111111111111111111111111111111111111111",
+			Signer: ChainSignerConfig{
+				Endpoint: "http://signer:8080",
+				Address:  "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			},
+		}
+	}
+	tests := []struct {
+		name      string
+		override  func(c *ChainConfig)
+		errString string
+	}{
+		{"EmptyName", func(c *ChainConfig) { c.Name = "" }, "chain name must be set"},
+		{"InvalidName", func(c *ChainConfig) { c.Name = "chain-a" }, "name must only contain letters, digits and underscores"},
+		{"EmptyRollupRpc", func(c *ChainConfig) { c.RollupRpc = "" }, "empty rollup RPC URL"},
+		{"InvalidAddress", func(c *ChainConfig) { c.L2OOAddress = "foo" }, "invalid L2OutputOracle address"},
+		{"InvalidPollInterval", func(c *ChainConfig) { c.PollInterval = "soon" }, "invalid poll interval"},
+		{"NegativePollInterval", func(c *ChainConfig) { c.PollInterval = "-1s" }, "invalid poll interval"},
+		{"NoSignerEndpoint", func(c *ChainConfig) { c.Signer.Endpoint = "" }, "signer endpoint and address must be set"},
+		{"NoSignerAddress", func(c *ChainConfig) { c.Signer.Address = "" }, "signer endpoint and address must be set"},
+		{"InvalidSignerAddress", func(c *ChainConfig) { c.Signer.Address = "foo" }, "invalid signer address"},
+		{"PartialSignerTLS", func(c *ChainConfig) { c.Signer.TLSCert = "tls.crt" }, "invalid signer"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			cfg := valid()
+			require.NoError(t, cfg.Check())
+			test.override(&cfg)
+			require.ErrorContains(t, cfg.Check(), test.errString)
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package proposer
 
 import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-proposer/flags"
@@ -22,7 +27,8 @@ type CLIConfig struct {
 	// L1EthRpc is the HTTP provider URL for L1.
 	L1EthRpc string
 
-	// RollupRpc is the HTTP provider URL for the rollup node. A comma-separated list enables the active rollup provider.
+	// RollupRpc is the HTTP provider URL for the rollup node. A comma-separated list enables the active rollup provider,
+	// or with RollupRpcQuorum set, is the set of rollup nodes that must reach quorum.
 	RollupRpc string
 
 	// L2OOAddress is the L2OutputOracle contract address.
@@ -36,6 +42,20 @@ type CLIConfig struct {
 	// for L2 blocks derived from non-finalized L1 data.
 	AllowNonFinalized bool
 
+	// RollupRpcQuorum is the number of rollup nodes in RollupRpc that must agree on an output before it is proposed.
+	// Zero disables quorum mode.
+	RollupRpcQuorum uint
+
+	// ChainsConfig is the path to a JSON file listing additional chains to propose outputs for.
+	ChainsConfig string
+
+	// BalanceReserve is the amount of ETH that must remain in the proposer account after paying for a proposal.
+	BalanceReserve float64
+
+	// ProposalLagAlertFactor is the number of submission intervals the L2 safe head may be ahead of the latest proposed
+	// output before the proposal lag alert metric is raised. Zero disables the alert.
+	ProposalLagAlertFactor float64
+
 	TxMgrConfig txmgr.CLIConfig
 
 	RPCConfig oprpc.CLIConfig
@@ -60,6 +80,17 @@ func (c *CLIConfig) Check() error {
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
+	if c.RollupRpcQuorum != 0 {
+		if err := checkQuorum(len(strings.Split(c.RollupRpc, ",")), c.RollupRpcQuorum); err != nil {
+			return err
+		}
+	}
+	if math.IsNaN(c.BalanceReserve) || math.IsInf(c.BalanceReserve, 0) || c.BalanceReserve < 0 {
+		return fmt.Errorf("invalid balance reserve: %v", c.BalanceReserve)
+	}
+	if math.IsNaN(c.ProposalLagAlertFactor) || math.IsInf(c.ProposalLagAlertFactor, 0) || c.ProposalLagAlertFactor < 0 {
+		return fmt.Errorf("invalid proposal lag alert factor: %v", c.ProposalLagAlertFactor)
+	}
 	return nil
 }
 
@@ -73,10 +104,23 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		PollInterval: ctx.Duration(flags.PollIntervalFlag.Name),
 		TxMgrConfig:  txmgr.ReadCLIConfig(ctx),
 		// Optional Flags
-		AllowNonFinalized: ctx.Bool(flags.AllowNonFinalizedFlag.Name),
-		RPCConfig:         oprpc.ReadCLIConfig(ctx),
-		LogConfig:         oplog.ReadCLIConfig(ctx),
-		MetricsConfig:     opmetrics.ReadCLIConfig(ctx),
-		PprofConfig:       oppprof.ReadCLIConfig(ctx),
+		AllowNonFinalized:      ctx.Bool(flags.AllowNonFinalizedFlag.Name),
+		RollupRpcQuorum:        ctx.Uint(flags.RollupRpcQuorumFlag.Name),
+		ChainsConfig:           ctx.Path(flags.ChainsConfigFlag.Name),
+		BalanceReserve:         ctx.Float64(flags.BalanceReserveFlag.Name),
+		ProposalLagAlertFactor: ctx.Float64(flags.ProposalLagAlertFactorFlag.Name),
+		RPCConfig:              oprpc.ReadCLIConfig(ctx),
+		LogConfig:              oplog.ReadCLIConfig(ctx),
+		MetricsConfig:          opmetrics.ReadCLIConfig(ctx),
+		PprofConfig:            oppprof.ReadCLIConfig(ctx),
 	}
 }
+
+// BalanceReserveWei returns the configured balance reserve converted from ETH to wei.
+func (c *CLIConfig) BalanceReserveWei() *big.Int {
+	reserve, _ := new(big.Float).Mul(
+		big.NewFloat(c.BalanceReserve),
+		big.NewFloat(params.Ether)).
+		Int(nil)
+	return reserve
+}
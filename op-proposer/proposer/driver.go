@@ -35,6 +35,11 @@ type L1Client interface {
 	// CallContract executes an Ethereum contract call with the specified data as the
 	// input.
 	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+
+	// EstimateGas, SuggestGasTipCap and BalanceAt are used to check the proposer can pay for a proposal before sending it.
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 }
 
 type RollupClient interface {
@@ -68,6 +73,11 @@ type L2OutputSubmitter struct {
 
 	l2ooContract *bindings.L2OutputOracleCaller
 	l2ooABI      *abi.ABI
+
+	// submissionInterval and l2BlockTime are the L2OutputOracle's immutable proposal interval in L2 blocks and
+	// L2 block time in seconds, used to report the proposal lag.
+	submissionInterval uint64
+	l2BlockTime        uint64
 }
 
 // NewL2OutputSubmitter creates a new L2 Output Submitter
@@ -89,6 +99,17 @@ func NewL2OutputSubmitter(setup DriverSetup) (*L2OutputSubmitter, error) {
 	}
 	log.Info("Connected to L2OutputOracle", "address", setup.Cfg.L2OutputOracleAddr, "version", version)
 
+	submissionInterval, err := l2ooContract.SubmissionInterval(&bind.CallOpts{Context: cCtx})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to fetch submission interval: %w", err)
+	}
+	l2BlockTime, err := l2ooContract.L2BlockTime(&bind.CallOpts{Context: cCtx})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to fetch L2 block time: %w", err)
+	}
+
 	parsed, err := bindings.L2OutputOracleMetaData.GetAbi()
 	if err != nil {
 		cancel()
@@ -103,6 +124,9 @@ func NewL2OutputSubmitter(setup DriverSetup) (*L2OutputSubmitter, error) {
 
 		l2ooContract: l2ooContract,
 		l2ooABI:      parsed,
+
+		submissionInterval: submissionInterval.Uint64(),
+		l2BlockTime:        l2BlockTime.Uint64(),
 	}, nil
 }
 
@@ -178,6 +202,7 @@ func (l *L2OutputSubmitter) FetchNextOutputInfo(ctx context.Context) (*eth.Outpu
 		l.Log.Error("proposer unable to get sync status", "err", err)
 		return nil, false, err
 	}
+	l.recordProposalLag(nextCheckpointBlock.Uint64(), status.SafeL2)
 
 	// Use either the finalized or safe head depending on the config. Finalized head is default & safer.
 	var currentBlockNumber *big.Int
@@ -284,11 +309,16 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	if err != nil {
 		return err
 	}
-	receipt, err := l.Txmgr.Send(ctx, txmgr.TxCandidate{
+	candidate := txmgr.TxCandidate{
 		TxData:   data,
 		To:       &l.Cfg.L2OutputOracleAddr,
 		GasLimit: 0,
-	})
+		Label:    "proposal",
+	}
+	if err := l.preflightProposal(ctx, candidate); err != nil {
+		return fmt.Errorf("skipping proposal: %w", err)
+	}
+	receipt, err := l.Txmgr.Send(ctx, candidate)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,51 @@
+package proposer
+
+import (
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ProposalLag is how far the latest proposed output trails the L2 safe head.
+type ProposalLag struct {
+	// LatestProposed is the L2 block number of the latest proposed output.
+	LatestProposed uint64
+	Blocks         uint64
+	Seconds        uint64
+	// Alert is true if Blocks exceeds the submission interval by more than the configured alert factor.
+	Alert bool
+}
+
+// proposalLag calculates the lag between the latest proposed output and the safe head, given the block number the
+// next output must be proposed for.
+func (l *L2OutputSubmitter) proposalLag(nextCheckpointBlock uint64, safeHead eth.L2BlockRef) ProposalLag {
+	// The L2OutputOracle requires the next output to be exactly one submission interval after the latest, which is the
+	// starting block if no outputs have been proposed yet.
+	var lag ProposalLag
+	if nextCheckpointBlock > l.submissionInterval {
+		lag.LatestProposed = nextCheckpointBlock - l.submissionInterval
+	}
+	if safeHead.Number > lag.LatestProposed {
+		lag.Blocks = safeHead.Number - lag.LatestProposed
+		lag.Seconds = lag.Blocks * l.l2BlockTime
+	}
+	if l.Cfg.ProposalLagAlertFactor > 0 {
+		threshold := float64(l.submissionInterval) * l.Cfg.ProposalLagAlertFactor
+		lag.Alert = float64(lag.Blocks) > threshold
+	}
+	return lag
+}
+
+// recordProposalLag records the lag between the latest proposed output and the safe head, logging a warning if it
+// exceeds the alert threshold.
+func (l *L2OutputSubmitter) recordProposalLag(nextCheckpointBlock uint64, safeHead eth.L2BlockRef) {
+	lag := l.proposalLag(nextCheckpointBlock, safeHead)
+	l.Metr.RecordProposalLag(lag.Blocks, lag.Seconds, lag.Alert)
+	if lag.Alert {
+		l.Log.Warn("Proposals are lagging behind the L2 safe head",
+			"latest_proposed", lag.LatestProposed,
+			"l2_safe", safeHead,
+			"lag_blocks", lag.Blocks,
+			"lag_seconds", lag.Seconds,
+			"submission_interval", l.submissionInterval,
+			"alert_factor", l.Cfg.ProposalLagAlertFactor)
+	}
+}
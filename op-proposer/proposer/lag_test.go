@@ -0,0 +1,64 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestProposalLag(t *testing.T) {
+	tests := []struct {
+		name     string
+		next     uint64
+		safe     uint64
+		factor   float64
+		expected ProposalLag
+	}{
+		{name: "NoOutputsProposed", next: 100, safe: 50, factor: 2, expected: ProposalLag{Blocks: 50, Seconds: 100}},
+		{name: "SafeHeadBehindLatestProposed", next: 300, safe: 150, factor: 2, expected: ProposalLag{LatestProposed: 200}},
+		{name: "WithinInterval", next: 300, safe: 250, factor: 2, expected: ProposalLag{LatestProposed: 200, Blocks: 50, Seconds: 100}},
+		{name: "AtThreshold", next: 300, safe: 400, factor: 2, expected: ProposalLag{LatestProposed: 200, Blocks: 200, Seconds: 400}},
+		{name: "ExceedsThreshold", next: 300, safe: 401, factor: 2, expected: ProposalLag{LatestProposed: 200, Blocks: 201, Seconds: 402, Alert: true}},
+		{name: "FractionalFactor", next: 300, safe: 351, factor: 1.5, expected: ProposalLag{LatestProposed: 200, Blocks: 151, Seconds: 302, Alert: true}},
+		{name: "AlertDisabled", next: 300, safe: 1000, factor: 0, expected: ProposalLag{LatestProposed: 200, Blocks: 800, Seconds: 1600}},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			m := &lagMetrics{Metricer: metrics.NoopMetrics}
+			l := &L2OutputSubmitter{
+				DriverSetup: DriverSetup{
+					Log:  testlog.Logger(t, log.LvlCrit),
+					Metr: m,
+					Cfg:  ProposerConfig{ProposalLagAlertFactor: test.factor},
+				},
+				submissionInterval: 100,
+				l2BlockTime:        2,
+			}
+			require.Equal(t, test.expected, l.proposalLag(test.next, eth.L2BlockRef{Number: test.safe}))
+
+			l.recordProposalLag(test.next, eth.L2BlockRef{Number: test.safe})
+			require.Equal(t, test.expected.Blocks, m.blocks)
+			require.Equal(t, test.expected.Seconds, m.seconds)
+			require.Equal(t, test.expected.Alert, m.alert)
+		})
+	}
+}
+
+type lagMetrics struct {
+	metrics.Metricer
+	blocks  uint64
+	seconds uint64
+	alert   bool
+}
+
+func (m *lagMetrics) RecordProposalLag(blocks uint64, seconds uint64, alert bool) {
+	m.blocks = blocks
+	m.seconds = seconds
+	m.alert = alert
+}
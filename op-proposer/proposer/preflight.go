@@ -0,0 +1,103 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+var (
+	ErrProposalSimulationFailed = errors.New("proposal simulation failed")
+	ErrInsufficientBalance      = errors.New("insufficient balance to propose")
+)
+
+// ProposalCost is the estimated worst-case cost of sending a proposal transaction.
+type ProposalCost struct {
+	Gas       uint64
+	GasFeeCap *big.Int
+	// Value is the amount transferred with the proposal, such as a bond.
+	Value *big.Int
+	// Total is the maximum amount the proposal transaction can deduct from the account balance.
+	Total *big.Int
+}
+
+// estimateProposalCost simulates the candidate transaction and returns the maximum it may cost, using the same fee cap
+// the transaction manager applies to a fresh transaction.
+func (l *L2OutputSubmitter) estimateProposalCost(ctx context.Context, candidate txmgr.TxCandidate) (ProposalCost, error) {
+	value := new(big.Int)
+	if candidate.Value != nil {
+		value.Set(candidate.Value)
+	}
+	gas, err := l.L1Client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  l.Txmgr.From(),
+		To:    candidate.To,
+		Data:  candidate.TxData,
+		Value: value,
+	})
+	if err != nil {
+		return ProposalCost{}, fmt.Errorf("%w: %w", ErrProposalSimulationFailed, err)
+	}
+	tip, err := l.L1Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return ProposalCost{}, fmt.Errorf("failed to fetch gas tip cap: %w", err)
+	}
+	head, err := l.L1Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return ProposalCost{}, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	if head.BaseFee == nil {
+		return ProposalCost{}, errors.New("L1 head has no base fee")
+	}
+	gasFeeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	total := new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(gas))
+	total.Add(total, value)
+	return ProposalCost{
+		Gas:       gas,
+		GasFeeCap: gasFeeCap,
+		Value:     value,
+		Total:     total,
+	}, nil
+}
+
+// preflightProposal checks that the proposal transaction would succeed and that the proposer account can pay for it
+// while keeping the configured balance reserve. The shortfall is recorded and included in the returned error if not.
+func (l *L2OutputSubmitter) preflightProposal(ctx context.Context, candidate txmgr.TxCandidate) error {
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	cost, err := l.estimateProposalCost(cCtx, candidate)
+	if err != nil {
+		return err
+	}
+	from := l.Txmgr.From()
+	balance, err := l.L1Client.BalanceAt(cCtx, from, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch balance of %v: %w", from, err)
+	}
+	reserve := new(big.Int)
+	if l.Cfg.BalanceReserve != nil {
+		reserve.Set(l.Cfg.BalanceReserve)
+	}
+	required := new(big.Int).Add(cost.Total, reserve)
+	shortfall := new(big.Int).Sub(required, balance)
+	if shortfall.Sign() <= 0 {
+		l.Metr.RecordBalanceShortfall(new(big.Int))
+		return nil
+	}
+	l.Metr.RecordBalanceShortfall(shortfall)
+	l.Log.Error("Proposer balance too low to propose output",
+		"account", from,
+		"balance", balance,
+		"gas", cost.Gas,
+		"gas_fee_cap", cost.GasFeeCap,
+		"value", cost.Value,
+		"cost", cost.Total,
+		"reserve", reserve,
+		"shortfall", shortfall)
+	return fmt.Errorf("%w: balance %v wei is %v wei short of cost %v wei plus reserve %v wei",
+		ErrInsufficientBalance, balance, shortfall, cost.Total, reserve)
+}
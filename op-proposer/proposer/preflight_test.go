@@ -0,0 +1,130 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr/mocks"
+)
+
+func TestPreflightProposal(t *testing.T) {
+	// Gas fee cap is 2*10 + 1 = 21 wei per gas, so a 100,000 gas proposal costs at most 2,100,000 wei.
+	const proposalCost = 2_100_000
+
+	tests := []struct {
+		name      string
+		balance   int64
+		reserve   int64
+		value     int64
+		shortfall int64
+	}{
+		{name: "ExactlyCoversCost", balance: proposalCost},
+		{name: "CoversCostAndReserve", balance: proposalCost + 1000, reserve: 1000},
+		{name: "CannotCoverCost", balance: proposalCost - 5, shortfall: 5},
+		{name: "CannotCoverReserve", balance: proposalCost + 1000, reserve: 1500, shortfall: 500},
+		{name: "CannotCoverBond", balance: proposalCost + 1000, value: 3000, shortfall: 2000},
+		{name: "CannotCoverBondAndReserve", balance: proposalCost + 1000, value: 3000, reserve: 100, shortfall: 2100},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			l1 := &stubPreflightL1{gas: 100_000, tip: big.NewInt(1), baseFee: big.NewInt(10), balance: big.NewInt(test.balance)}
+			m := &shortfallMetrics{Metricer: metrics.NoopMetrics}
+			l := newPreflightSubmitter(t, l1, m, big.NewInt(test.reserve))
+
+			err := l.preflightProposal(context.Background(), txmgr.TxCandidate{
+				TxData: []byte{1, 2, 3},
+				To:     &l.Cfg.L2OutputOracleAddr,
+				Value:  big.NewInt(test.value),
+			})
+			require.Equal(t, big.NewInt(test.shortfall), m.shortfall)
+			require.Equal(t, big.NewInt(test.value), l1.call.Value, "should simulate the bond transfer")
+			require.Equal(t, []byte{1, 2, 3}, l1.call.Data)
+			if test.shortfall == 0 {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInsufficientBalance)
+				require.ErrorContains(t, err, big.NewInt(test.shortfall).String()+" wei short")
+			}
+		})
+	}
+}
+
+func TestPreflightProposalSimulationFails(t *testing.T) {
+	revertErr := errors.New("execution reverted")
+	l1 := &stubPreflightL1{estimateErr: revertErr}
+	m := &shortfallMetrics{Metricer: metrics.NoopMetrics}
+	l := newPreflightSubmitter(t, l1, m, nil)
+
+	err := l.preflightProposal(context.Background(), txmgr.TxCandidate{To: &l.Cfg.L2OutputOracleAddr})
+	require.ErrorIs(t, err, ErrProposalSimulationFailed)
+	require.ErrorIs(t, err, revertErr)
+	require.Nil(t, m.shortfall, "should not record a shortfall when the cost is unknown")
+}
+
+func newPreflightSubmitter(t *testing.T, l1 L1Client, m metrics.Metricer, reserve *big.Int) *L2OutputSubmitter {
+	txMgr := &mocks.TxManager{}
+	txMgr.On("From").Return(common.Address{0xaa})
+	return &L2OutputSubmitter{
+		DriverSetup: DriverSetup{
+			Log:      testlog.Logger(t, log.LvlCrit),
+			Metr:     m,
+			Txmgr:    txMgr,
+			L1Client: l1,
+			Cfg: ProposerConfig{
+				NetworkTimeout:     time.Second,
+				L2OutputOracleAddr: common.Address{0xbb},
+				BalanceReserve:     reserve,
+			},
+		},
+	}
+}
+
+type shortfallMetrics struct {
+	metrics.Metricer
+	shortfall *big.Int
+}
+
+func (m *shortfallMetrics) RecordBalanceShortfall(shortfall *big.Int) {
+	m.shortfall = shortfall
+}
+
+type stubPreflightL1 struct {
+	L1Client
+	gas         uint64
+	estimateErr error
+	tip         *big.Int
+	baseFee     *big.Int
+	balance     *big.Int
+
+	call ethereum.CallMsg
+}
+
+func (s *stubPreflightL1) EstimateGas(_ context.Context, call ethereum.CallMsg) (uint64, error) {
+	s.call = call
+	return s.gas, s.estimateErr
+}
+
+func (s *stubPreflightL1) SuggestGasTipCap(_ context.Context) (*big.Int, error) {
+	return s.tip, nil
+}
+
+func (s *stubPreflightL1) HeaderByNumber(_ context.Context, _ *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: s.baseFee}, nil
+}
+
+func (s *stubPreflightL1) BalanceAt(_ context.Context, _ common.Address, _ *big.Int) (*big.Int, error) {
+	return s.balance, nil
+}
@@ -0,0 +1,222 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+var (
+	ErrNoQuorum          = errors.New("rollup nodes did not reach quorum")
+	ErrQuorumUnsupported = errors.New("not supported by the quorum rollup client")
+)
+
+// QuorumRollupProvider is a RollupProvider for a trusted set of independent rollup nodes.
+// Outputs are only used if at least quorum of the nodes agree on them, so a single faulty node cannot cause an
+// invalid proposal.
+type QuorumRollupProvider struct {
+	clients []*sources.RollupClient
+	client  *quorumRollupClient
+}
+
+// NewQuorumRollupProvider dials each of rollupUrls and returns a provider requiring quorum of them to agree.
+func NewQuorumRollupProvider(ctx context.Context, logger log.Logger, m metrics.Metricer, rollupUrls []string, quorum uint) (*QuorumRollupProvider, error) {
+	if err := checkQuorum(len(rollupUrls), quorum); err != nil {
+		return nil, err
+	}
+	p := &QuorumRollupProvider{}
+	var clients []RollupClient
+	for i, url := range rollupUrls {
+		client, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, logger, url)
+		if err != nil {
+			p.Close()
+			return nil, fmt.Errorf("failed to dial rollup node %d: %w", i, err)
+		}
+		p.clients = append(p.clients, client)
+		clients = append(clients, client)
+	}
+	p.client = newQuorumRollupClient(logger, m, clients, quorum)
+	return p, nil
+}
+
+func (p *QuorumRollupProvider) RollupClient(context.Context) (dial.RollupClientInterface, error) {
+	return p.client, nil
+}
+
+func (p *QuorumRollupProvider) Close() {
+	for _, client := range p.clients {
+		client.Close()
+	}
+}
+
+func checkQuorum(endpoints int, quorum uint) error {
+	if quorum == 0 {
+		return errors.New("rollup quorum must be at least 1")
+	}
+	if uint(endpoints) < quorum {
+		return fmt.Errorf("rollup quorum %d exceeds the number of rollup nodes %d", quorum, endpoints)
+	}
+	return nil
+}
+
+// quorumRollupClient implements the parts of dial.RollupClientInterface used by the proposer across a set of
+// rollup nodes. Nodes are identified by their index in logs and metrics, as the URLs may contain credentials.
+type quorumRollupClient struct {
+	log     log.Logger
+	metr    metrics.Metricer
+	clients []RollupClient
+	quorum  int
+}
+
+var _ dial.RollupClientInterface = (*quorumRollupClient)(nil)
+
+func newQuorumRollupClient(logger log.Logger, m metrics.Metricer, clients []RollupClient, quorum uint) *quorumRollupClient {
+	return &quorumRollupClient{
+		log:     logger,
+		metr:    m,
+		clients: clients,
+		quorum:  int(quorum),
+	}
+}
+
+// SyncStatus returns the sync status of the first node with the safe and finalized heads replaced by the highest
+// heads that at least quorum of the nodes have reached.
+func (q *quorumRollupClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	statuses := make([]*eth.SyncStatus, len(q.clients))
+	q.forEach(func(i int, client RollupClient) {
+		status, err := client.SyncStatus(ctx)
+		if err != nil {
+			q.log.Warn("Failed to fetch sync status from rollup node", "node", i, "err", err)
+			return
+		}
+		statuses[i] = status
+	})
+	var available []*eth.SyncStatus
+	for _, status := range statuses {
+		if status != nil {
+			available = append(available, status)
+		}
+	}
+	if len(available) < q.quorum {
+		return nil, fmt.Errorf("%w: only %d of %d required nodes returned a sync status", ErrNoQuorum, len(available), q.quorum)
+	}
+	result := *available[0]
+	result.SafeL2 = q.quorumHead(available, func(s *eth.SyncStatus) eth.L2BlockRef { return s.SafeL2 })
+	result.FinalizedL2 = q.quorumHead(available, func(s *eth.SyncStatus) eth.L2BlockRef { return s.FinalizedL2 })
+	return &result, nil
+}
+
+// quorumHead returns the highest head that at least quorum of the statuses have reached.
+func (q *quorumRollupClient) quorumHead(statuses []*eth.SyncStatus, head func(s *eth.SyncStatus) eth.L2BlockRef) eth.L2BlockRef {
+	heads := make([]eth.L2BlockRef, len(statuses))
+	for i, status := range statuses {
+		heads[i] = head(status)
+	}
+	sort.Slice(heads, func(i, j int) bool { return heads[i].Number > heads[j].Number })
+	return heads[q.quorum-1]
+}
+
+// OutputAtBlock returns the output at blockNum that at least quorum of the nodes agree on.
+// The response reports the lowest safe and finalized heads of the agreeing nodes, each computed separately, so that
+// the proposer only treats the output as safe or finalized if the agreeing nodes all do. Nodes returning a different
+// output are recorded as diverging.
+func (q *quorumRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	outputs := make([]*eth.OutputResponse, len(q.clients))
+	q.forEach(func(i int, client RollupClient) {
+		output, err := client.OutputAtBlock(ctx, blockNum)
+		if err != nil {
+			q.log.Warn("Failed to fetch output from rollup node", "node", i, "block", blockNum, "err", err)
+			return
+		}
+		outputs[i] = output
+	})
+
+	votes := make(map[common.Hash][]int)
+	for i, output := range outputs {
+		if output != nil {
+			root := common.Hash(output.OutputRoot)
+			votes[root] = append(votes[root], i)
+		}
+	}
+	var agreed []common.Hash
+	var summary []string
+	for root, nodes := range votes {
+		if len(nodes) >= q.quorum {
+			agreed = append(agreed, root)
+		}
+		summary = append(summary, fmt.Sprintf("%v: nodes %v", root, nodes))
+	}
+	sort.Strings(summary)
+	// With a quorum of less than a majority, different outputs may each reach quorum. Don't propose either of them.
+	if len(agreed) != 1 {
+		return nil, fmt.Errorf("%w: %d outputs at block %d agreed by %d nodes (%s)", ErrNoQuorum, len(agreed), blockNum, q.quorum, strings.Join(summary, ", "))
+	}
+
+	var result *eth.OutputResponse
+	var safe, finalized eth.L2BlockRef
+	for i, output := range outputs {
+		if output == nil {
+			continue
+		}
+		if common.Hash(output.OutputRoot) != agreed[0] {
+			q.log.Error("Rollup node diverged from quorum output", "node", i, "block", blockNum, "output", output.OutputRoot, "quorum_output", agreed[0])
+			q.metr.RecordRollupDivergence(i)
+			continue
+		}
+		if result == nil || output.Status.SafeL2.Number < safe.Number {
+			safe = output.Status.SafeL2
+		}
+		if result == nil || output.Status.FinalizedL2.Number < finalized.Number {
+			finalized = output.Status.FinalizedL2
+			result = output
+		}
+	}
+	// The safe and finalized heads may come from different nodes, so are set on a copy of the response.
+	status := *result.Status
+	status.SafeL2 = safe
+	status.FinalizedL2 = finalized
+	agreedOutput := *result
+	agreedOutput.Status = &status
+	return &agreedOutput, nil
+}
+
+// forEach calls fn concurrently for each client and waits for them all to complete.
+func (q *quorumRollupClient) forEach(fn func(i int, client RollupClient)) {
+	var wg sync.WaitGroup
+	wg.Add(len(q.clients))
+	for i, client := range q.clients {
+		i, client := i, client
+		go func() {
+			defer wg.Done()
+			fn(i, client)
+		}()
+	}
+	wg.Wait()
+}
+
+func (q *quorumRollupClient) RollupConfig(ctx context.Context) (*rollup.Config, error) {
+	return nil, fmt.Errorf("rollup config: %w", ErrQuorumUnsupported)
+}
+
+func (q *quorumRollupClient) StartSequencer(ctx context.Context, unsafeHead common.Hash) error {
+	return fmt.Errorf("start sequencer: %w", ErrQuorumUnsupported)
+}
+
+func (q *quorumRollupClient) SequencerActive(ctx context.Context) (bool, error) {
+	return false, fmt.Errorf("sequencer active: %w", ErrQuorumUnsupported)
+}
+
+// Close does nothing as the underlying clients are owned by the QuorumRollupProvider.
+func (q *quorumRollupClient) Close() {}
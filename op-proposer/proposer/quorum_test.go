@@ -0,0 +1,177 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubRollupClient struct {
+	status *eth.SyncStatus
+	output *eth.OutputResponse
+	err    error
+}
+
+func (s *stubRollupClient) SyncStatus(context.Context) (*eth.SyncStatus, error) {
+	return s.status, s.err
+}
+
+func (s *stubRollupClient) OutputAtBlock(context.Context, uint64) (*eth.OutputResponse, error) {
+	return s.output, s.err
+}
+
+type divergenceMetrics struct {
+	metrics.Metricer
+	diverged []int
+}
+
+func (m *divergenceMetrics) RecordRollupDivergence(node int) {
+	m.diverged = append(m.diverged, node)
+}
+
+func outputWithRoot(root byte, safe uint64, finalized uint64) *eth.OutputResponse {
+	return &eth.OutputResponse{
+		OutputRoot: eth.Bytes32{root},
+		BlockRef:   eth.L2BlockRef{Number: 100},
+		Status: &eth.SyncStatus{
+			SafeL2:      eth.L2BlockRef{Number: safe},
+			FinalizedL2: eth.L2BlockRef{Number: finalized},
+		},
+	}
+}
+
+func TestQuorumOutputAtBlock(t *testing.T) {
+	errFailed := errors.New("failed")
+	tests := []struct {
+		name           string
+		quorum         uint
+		clients        []*stubRollupClient
+		expectErr      error
+		expectOutput   *eth.OutputResponse
+		expectDiverged []int
+	}{
+		{
+			name:   "AllAgree",
+			quorum: 2,
+			clients: []*stubRollupClient{
+				{output: outputWithRoot(1, 120, 110)},
+				{output: outputWithRoot(1, 115, 105)},
+				{output: outputWithRoot(1, 130, 120)},
+			},
+			expectOutput: outputWithRoot(1, 115, 105),
+		},
+		{
+			name:   "SafeAndFinalizedFromDifferentNodes",
+			quorum: 2,
+			clients: []*stubRollupClient{
+				{output: outputWithRoot(1, 130, 105)},
+				{output: outputWithRoot(1, 115, 110)},
+				{output: outputWithRoot(2, 100, 100)},
+			},
+			expectOutput:   outputWithRoot(1, 115, 105),
+			expectDiverged: []int{2},
+		},
+		{
+			name:   "QuorumWithDivergingNode",
+			quorum: 2,
+			clients: []*stubRollupClient{
+				{output: outputWithRoot(2, 120, 110)},
+				{output: outputWithRoot(1, 120, 110)},
+				{output: outputWithRoot(1, 120, 110)},
+			},
+			expectOutput:   outputWithRoot(1, 120, 110),
+			expectDiverged: []int{0},
+		},
+		{
+			name:   "QuorumWithFailedNode",
+			quorum: 2,
+			clients: []*stubRollupClient{
+				{output: outputWithRoot(1, 120, 110)},
+				{err: errFailed},
+				{output: outputWithRoot(1, 120, 110)},
+			},
+			expectOutput: outputWithRoot(1, 120, 110),
+		},
+		{
+			name:   "NoQuorum",
+			quorum: 2,
+			clients: []*stubRollupClient{
+				{output: outputWithRoot(1, 120, 110)},
+				{output: outputWithRoot(2, 120, 110)},
+				{err: errFailed},
+			},
+			expectErr: ErrNoQuorum,
+		},
+		{
+			name:   "ConflictingQuorums",
+			quorum: 1,
+			clients: []*stubRollupClient{
+				{output: outputWithRoot(1, 120, 110)},
+				{output: outputWithRoot(2, 120, 110)},
+			},
+			expectErr: ErrNoQuorum,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			m := &divergenceMetrics{Metricer: metrics.NoopMetrics}
+			var clients []RollupClient
+			for _, client := range test.clients {
+				clients = append(clients, client)
+			}
+			q := newQuorumRollupClient(testlog.Logger(t, log.LvlInfo), m, clients, test.quorum)
+			output, err := q.OutputAtBlock(context.Background(), 100)
+			if test.expectErr != nil {
+				require.ErrorIs(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectOutput, output)
+			require.Equal(t, test.expectDiverged, m.diverged)
+		})
+	}
+}
+
+func TestQuorumSyncStatus(t *testing.T) {
+	status := func(safe, finalized uint64) *eth.SyncStatus {
+		return &eth.SyncStatus{
+			SafeL2:      eth.L2BlockRef{Number: safe},
+			FinalizedL2: eth.L2BlockRef{Number: finalized},
+		}
+	}
+	clients := []RollupClient{
+		&stubRollupClient{status: status(30, 10)},
+		&stubRollupClient{status: status(20, 15)},
+		&stubRollupClient{err: errors.New("failed")},
+		&stubRollupClient{status: status(40, 5)},
+	}
+
+	t.Run("QuorumHeads", func(t *testing.T) {
+		q := newQuorumRollupClient(testlog.Logger(t, log.LvlInfo), metrics.NoopMetrics, clients, 2)
+		result, err := q.SyncStatus(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(30), result.SafeL2.Number)
+		require.Equal(t, uint64(10), result.FinalizedL2.Number)
+	})
+
+	t.Run("NoQuorum", func(t *testing.T) {
+		q := newQuorumRollupClient(testlog.Logger(t, log.LvlInfo), metrics.NoopMetrics, clients, 4)
+		_, err := q.SyncStatus(context.Background())
+		require.ErrorIs(t, err, ErrNoQuorum)
+	})
+}
+
+func TestCheckQuorum(t *testing.T) {
+	require.NoError(t, checkQuorum(3, 2))
+	require.NoError(t, checkQuorum(3, 3))
+	require.ErrorContains(t, checkQuorum(3, 0), "at least 1")
+	require.ErrorContains(t, checkQuorum(2, 3), "exceeds the number of rollup nodes")
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	"strconv"
 	"strings"
@@ -30,6 +31,15 @@ var (
 	ErrAlreadyStopped = errors.New("already stopped")
 )
 
+// chainProposer holds the resources used to propose outputs for an additional chain.
+type chainProposer struct {
+	name            string
+	driver          *L2OutputSubmitter
+	rollupProvider  dial.RollupProvider
+	txMgr           txmgr.TxManager
+	balanceMetricer io.Closer
+}
+
 type ProposerConfig struct {
 	// How frequently to poll L2 for new finalized outputs
 	PollInterval   time.Duration
@@ -41,6 +51,14 @@ type ProposerConfig struct {
 	// is never valid on an alternative L1 chain that would produce different L2 data.
 	// This option is not necessary when higher proposal latency is acceptable and L1 is healthy.
 	AllowNonFinalized bool
+
+	// BalanceReserve is the amount in wei that must remain in the proposer account after paying for a proposal.
+	// Proposals are skipped if the balance would fall below it.
+	BalanceReserve *big.Int
+
+	// ProposalLagAlertFactor is the number of submission intervals the L2 safe head may be ahead of the latest proposed
+	// output before the proposal lag alert metric is raised. Zero disables the alert.
+	ProposalLagAlertFactor float64
 }
 
 type ProposerService struct {
@@ -55,6 +73,9 @@ type ProposerService struct {
 
 	driver *L2OutputSubmitter
 
+	// chains are the additional chains proposed for by this process, see ChainConfig.
+	chains []*chainProposer
+
 	Version string
 
 	pprofSrv   *httputil.HTTPServer
@@ -86,6 +107,8 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	ps.PollInterval = cfg.PollInterval
 	ps.NetworkTimeout = cfg.TxMgrConfig.NetworkTimeout
 	ps.AllowNonFinalized = cfg.AllowNonFinalized
+	ps.BalanceReserve = cfg.BalanceReserveWei()
+	ps.ProposalLagAlertFactor = cfg.ProposalLagAlertFactor
 
 	if err := ps.initRPCClients(ctx, cfg); err != nil {
 		return err
@@ -106,6 +129,9 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	if err := ps.initDriver(); err != nil {
 		return fmt.Errorf("failed to init Driver: %w", err)
 	}
+	if err := ps.initChains(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init additional chains: %w", err)
+	}
 	if err := ps.initRPCServer(cfg); err != nil {
 		return fmt.Errorf("failed to start RPC server: %w", err)
 	}
@@ -123,19 +149,29 @@ func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) e
 	ps.L1Client = l1Client
 
 	var rollupProvider dial.RollupProvider
-	if strings.Contains(cfg.RollupRpc, ",") {
-		rollupUrls := strings.Split(cfg.RollupRpc, ",")
-		rollupProvider, err = dial.NewActiveL2RollupProvider(ctx, rollupUrls, dial.DefaultActiveSequencerFollowerCheckDuration, dial.DefaultDialTimeout, ps.Log)
+	if cfg.RollupRpcQuorum != 0 {
+		rollupProvider, err = NewQuorumRollupProvider(ctx, ps.Log, ps.Metrics, strings.Split(cfg.RollupRpc, ","), cfg.RollupRpcQuorum)
 	} else {
-		rollupProvider, err = dial.NewStaticL2RollupProvider(ctx, ps.Log, cfg.RollupRpc)
+		rollupProvider, err = newRollupProvider(ctx, ps.Log, cfg.RollupRpc)
 	}
 	if err != nil {
 		return fmt.Errorf("failed to build L2 endpoint provider: %w", err)
 	}
+	if cfg.RollupRpcQuorum != 0 {
+		ps.Log.Info("Proposing outputs agreed by a quorum of rollup nodes", "quorum", cfg.RollupRpcQuorum)
+	}
 	ps.RollupProvider = rollupProvider
 	return nil
 }
 
+func newRollupProvider(ctx context.Context, log log.Logger, rollupRpc string) (dial.RollupProvider, error) {
+	if strings.Contains(rollupRpc, ",") {
+		rollupUrls := strings.Split(rollupRpc, ",")
+		return dial.NewActiveL2RollupProvider(ctx, rollupUrls, dial.DefaultActiveSequencerFollowerCheckDuration, dial.DefaultDialTimeout, log)
+	}
+	return dial.NewStaticL2RollupProvider(ctx, log, rollupRpc)
+}
+
 func (ps *ProposerService) initMetrics(cfg *CLIConfig) {
 	if cfg.MetricsConfig.Enabled {
 		procName := "default"
@@ -219,6 +255,82 @@ func (ps *ProposerService) initDriver() error {
 	return nil
 }
 
+// initChains creates a driver for each additional chain in the chains config.
+// The chains share the L1 client. Each chain has its own transaction manager, signing with the chain's signer, and
+// records its metrics in its own namespace.
+func (ps *ProposerService) initChains(ctx context.Context, cfg *CLIConfig) error {
+	if cfg.ChainsConfig == "" {
+		return nil
+	}
+	chainCfgs, err := LoadChainConfigs(cfg.ChainsConfig)
+	if err != nil {
+		return err
+	}
+	for _, chainCfg := range chainCfgs {
+		chain, err := ps.initChain(ctx, cfg, chainCfg)
+		if err != nil {
+			return fmt.Errorf("chain %v: %w", chainCfg.Name, err)
+		}
+		ps.Log.Info("Configured additional chain", "chain", chainCfg.Name, "l2oo", chain.driver.Cfg.L2OutputOracleAddr, "pollInterval", chain.driver.Cfg.PollInterval, "signer", chain.txMgr.From())
+	}
+	return nil
+}
+
+func (ps *ProposerService) initChain(ctx context.Context, cfg *CLIConfig, chainCfg ChainConfig) (*chainProposer, error) {
+	logger := ps.Log.New("chain", chainCfg.Name)
+	pollInterval, err := chainCfg.pollInterval(ps.PollInterval)
+	if err != nil {
+		return nil, err
+	}
+	l2ooAddress, err := opservice.ParseAddress(chainCfg.L2OOAddress)
+	if err != nil {
+		return nil, err
+	}
+	chain := &chainProposer{name: chainCfg.Name}
+	// Register the chain before creating resources so they are cleaned up by Stop if a later step fails.
+	ps.chains = append(ps.chains, chain)
+
+	metr := ps.Metrics.ForChain(chainCfg.Name)
+	txMgrCfg := cfg.TxMgrConfig
+	txMgrCfg.PrivateKey = ""
+	txMgrCfg.Mnemonic = ""
+	txMgrCfg.SignerCLIConfig = chainCfg.Signer.CLIConfig()
+	chain.txMgr, err = txmgr.NewSimpleTxManager("proposer_"+chainCfg.Name, logger, metr, txMgrCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init Tx manager: %w", err)
+	}
+	if chain.txMgr.From() == ps.TxManager.From() {
+		return nil, fmt.Errorf("signer %v is already used by the primary chain", chain.txMgr.From())
+	}
+	if cfg.MetricsConfig.Enabled {
+		chain.balanceMetricer = metr.StartBalanceMetrics(logger, ps.L1Client, chain.txMgr.From())
+	}
+
+	chain.rollupProvider, err = newRollupProvider(ctx, logger, chainCfg.RollupRpc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build L2 endpoint provider: %w", err)
+	}
+	chain.driver, err = NewL2OutputSubmitter(DriverSetup{
+		Log:      logger,
+		Metr:     metr,
+		Txmgr:    chain.txMgr,
+		L1Client: ps.L1Client,
+		Cfg: ProposerConfig{
+			PollInterval:           pollInterval,
+			NetworkTimeout:         ps.NetworkTimeout,
+			L2OutputOracleAddr:     l2ooAddress,
+			AllowNonFinalized:      chainCfg.AllowNonFinalized,
+			BalanceReserve:         ps.BalanceReserve,
+			ProposalLagAlertFactor: ps.ProposalLagAlertFactor,
+		},
+		RollupProvider: chain.rollupProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
 func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 	server := oprpc.NewServer(
 		cfg.RPCConfig.ListenAddr,
@@ -231,6 +343,9 @@ func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
 		ps.Log.Info("Admin RPC enabled")
 	}
+	if usage, ok := ps.TxManager.(txmgr.GasUsageSource); ok {
+		server.AddAPI(txmgr.GetGasUsageAPI(usage))
+	}
 	ps.Log.Info("Starting JSON-RPC server")
 	if err := server.Start(); err != nil {
 		return fmt.Errorf("unable to start RPC server: %w", err)
@@ -244,7 +359,15 @@ func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 func (ps *ProposerService) Start(_ context.Context) error {
 	ps.driver.Log.Info("Starting Proposer")
 
-	return ps.driver.StartL2OutputSubmitting()
+	if err := ps.driver.StartL2OutputSubmitting(); err != nil {
+		return err
+	}
+	for _, chain := range ps.chains {
+		if err := chain.driver.StartL2OutputSubmitting(); err != nil {
+			return fmt.Errorf("failed to start proposer for chain %v: %w", chain.name, err)
+		}
+	}
+	return nil
 }
 
 func (ps *ProposerService) Stopped() bool {
@@ -272,6 +395,24 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to stop L2Output submitting: %w", err))
 		}
 	}
+	for _, chain := range ps.chains {
+		if chain.driver != nil {
+			if err := chain.driver.StopL2OutputSubmittingIfRunning(); err != nil {
+				result = errors.Join(result, fmt.Errorf("failed to stop L2Output submitting for chain %v: %w", chain.name, err))
+			}
+		}
+		if chain.rollupProvider != nil {
+			chain.rollupProvider.Close()
+		}
+		if chain.balanceMetricer != nil {
+			if err := chain.balanceMetricer.Close(); err != nil {
+				result = errors.Join(result, fmt.Errorf("failed to close balance metricer for chain %v: %w", chain.name, err))
+			}
+		}
+		if chain.txMgr != nil {
+			chain.txMgr.Close()
+		}
+	}
 
 	if ps.rpcServer != nil {
 		// TODO(7685): the op-service RPC server is not built on top of op-service httputil Server, and has poor shutdown
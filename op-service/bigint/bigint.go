@@ -0,0 +1,88 @@
+// Package bigint provides helpers to convert between big.Int values and the fixed width unsigned integers used
+// in contract storage words. Unlike the big.Int methods, values that do not fit are reported as errors rather
+// than being silently truncated.
+package bigint
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+var (
+	ErrNegative = errors.New("negative value")
+	ErrOverflow = errors.New("value overflows")
+)
+
+var (
+	maxUint64  = new(big.Int).SetUint64(math.MaxUint64)
+	maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+// ToUint64 returns x as a uint64 or an error if x is nil, negative or larger than 64 bits.
+func ToUint64(x *big.Int) (uint64, error) {
+	if err := checkBits(x, 64, maxUint64); err != nil {
+		return 0, err
+	}
+	return x.Uint64(), nil
+}
+
+// CheckUint128 returns an error if x is nil, negative or larger than 128 bits.
+// Bond values and positions are stored as uint128 by the dispute game contracts.
+func CheckUint128(x *big.Int) error {
+	return checkBits(x, 128, maxUint128)
+}
+
+// PackUint64Pair packs high into the upper 64 bits and low into the lower 64 bits of a uint128.
+func PackUint64Pair(high uint64, low uint64) *big.Int {
+	packed := new(big.Int).Lsh(new(big.Int).SetUint64(high), 64)
+	return packed.Or(packed, new(big.Int).SetUint64(low))
+}
+
+// UnpackUint64Pair is the inverse of PackUint64Pair.
+// Returns an error if word is nil, negative or larger than 128 bits.
+func UnpackUint64Pair(word *big.Int) (high uint64, low uint64, err error) {
+	if err := CheckUint128(word); err != nil {
+		return 0, 0, err
+	}
+	high = new(big.Int).Rsh(word, 64).Uint64()
+	low = new(big.Int).And(word, maxUint64).Uint64()
+	return high, low, nil
+}
+
+// PackUint128Pair packs high into the upper 128 bits and low into the lower 128 bits of a uint256.
+// Returns an error if either value is nil, negative or larger than 128 bits.
+func PackUint128Pair(high *big.Int, low *big.Int) (*big.Int, error) {
+	if err := CheckUint128(high); err != nil {
+		return nil, fmt.Errorf("high: %w", err)
+	}
+	if err := CheckUint128(low); err != nil {
+		return nil, fmt.Errorf("low: %w", err)
+	}
+	packed := new(big.Int).Lsh(high, 128)
+	return packed.Or(packed, low), nil
+}
+
+// UnpackUint128Pair is the inverse of PackUint128Pair.
+// Returns an error if word is nil, negative or larger than 256 bits.
+func UnpackUint128Pair(word *big.Int) (high *big.Int, low *big.Int, err error) {
+	if err := checkBits(word, 256, maxUint256); err != nil {
+		return nil, nil, err
+	}
+	return new(big.Int).Rsh(word, 128), new(big.Int).And(word, maxUint128), nil
+}
+
+func checkBits(x *big.Int, bits int, max *big.Int) error {
+	if x == nil {
+		return fmt.Errorf("%w: nil", ErrOverflow)
+	}
+	if x.Sign() < 0 {
+		return fmt.Errorf("%w: %v", ErrNegative, x)
+	}
+	if x.Cmp(max) > 0 {
+		return fmt.Errorf("%w: %v does not fit in %v bits", ErrOverflow, x, bits)
+	}
+	return nil
+}
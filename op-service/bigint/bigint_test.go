@@ -0,0 +1,61 @@
+package bigint
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToUint64(t *testing.T) {
+	v, err := ToUint64(big.NewInt(42))
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), v)
+
+	v, err = ToUint64(new(big.Int).SetUint64(math.MaxUint64))
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), v)
+
+	_, err = ToUint64(new(big.Int).Add(maxUint64, big.NewInt(1)))
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = ToUint64(big.NewInt(-1))
+	require.ErrorIs(t, err, ErrNegative)
+	_, err = ToUint64(nil)
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestCheckUint128(t *testing.T) {
+	require.NoError(t, CheckUint128(big.NewInt(0)))
+	require.NoError(t, CheckUint128(maxUint128))
+	require.ErrorIs(t, CheckUint128(new(big.Int).Add(maxUint128, big.NewInt(1))), ErrOverflow)
+	require.ErrorIs(t, CheckUint128(big.NewInt(-1)), ErrNegative)
+}
+
+func TestUint64Pair(t *testing.T) {
+	packed := PackUint64Pair(3, math.MaxUint64)
+	require.Equal(t, "0x3ffffffffffffffff", "0x"+packed.Text(16))
+	high, low, err := UnpackUint64Pair(packed)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), high)
+	require.Equal(t, uint64(math.MaxUint64), low)
+
+	_, _, err = UnpackUint64Pair(new(big.Int).Lsh(big.NewInt(1), 128))
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestUint128Pair(t *testing.T) {
+	packed, err := PackUint128Pair(big.NewInt(5), maxUint128)
+	require.NoError(t, err)
+	high, low, err := UnpackUint128Pair(packed)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), high)
+	require.Equal(t, maxUint128, low)
+
+	_, err = PackUint128Pair(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(0))
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = PackUint128Pair(big.NewInt(0), big.NewInt(-1))
+	require.ErrorIs(t, err, ErrNegative)
+	_, _, err = UnpackUint128Pair(new(big.Int).Lsh(big.NewInt(1), 256))
+	require.ErrorIs(t, err, ErrOverflow)
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type ConnectionMetrics interface {
+	RecordRPCClientConnectionState(client string, connected bool)
+}
+
+// ConnectionStateRPC tracks whether the underlying RPC is connected to its endpoint, based on the errors returned
+// by requests made through it. Websocket and IPC connections are re-established automatically by the go-ethereum
+// RPC client when a request is made after the connection is lost, so the state returns to connected as soon as a
+// request succeeds again.
+// Subscriptions are not restored when the connection is lost and must be re-established by the subscriber,
+// typically with event.ResubscribeErr.
+type ConnectionStateRPC struct {
+	c    RPC
+	log  log.Logger
+	name string
+	m    ConnectionMetrics
+
+	mu        sync.Mutex
+	connected bool
+}
+
+var _ RPC = (*ConnectionStateRPC)(nil)
+
+// NewConnectionStateRPC wraps the connected RPC c, reporting changes in connection state to m under the given name.
+func NewConnectionStateRPC(lgr log.Logger, name string, m ConnectionMetrics, c RPC) *ConnectionStateRPC {
+	m.RecordRPCClientConnectionState(name, true)
+	return &ConnectionStateRPC{
+		c:         c,
+		log:       lgr.New("rpc", name),
+		name:      name,
+		m:         m,
+		connected: true,
+	}
+}
+
+// Connected returns true unless the last request failed because the connection to the endpoint was lost.
+func (s *ConnectionStateRPC) Connected() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connected
+}
+
+func (s *ConnectionStateRPC) Close() {
+	s.c.Close()
+}
+
+func (s *ConnectionStateRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	err := s.c.CallContext(ctx, result, method, args...)
+	s.update(err)
+	return err
+}
+
+func (s *ConnectionStateRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	err := s.c.BatchCallContext(ctx, b)
+	s.update(err)
+	return err
+}
+
+func (s *ConnectionStateRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	sub, err := s.c.EthSubscribe(ctx, channel, args...)
+	s.update(err)
+	return sub, err
+}
+
+// update records the connection state implied by the result of a request.
+// Errors that aren't caused by the connection, such as errors returned by the endpoint, don't change the state.
+func (s *ConnectionStateRPC) update(err error) {
+	var connected bool
+	if err == nil {
+		connected = true
+	} else if isConnectionError(err) {
+		connected = false
+	} else {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.connected == connected {
+		return
+	}
+	s.connected = connected
+	if connected {
+		s.log.Info("RPC connection re-established")
+	} else {
+		s.log.Warn("RPC connection lost", "err", err)
+	}
+	s.m.RecordRPCClientConnectionState(s.name, connected)
+}
+
+// isConnectionError returns true if err indicates the connection to the endpoint failed, rather than the request.
+func isConnectionError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE)
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"syscall"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type stubConnRPC struct {
+	err error
+}
+
+func (s *stubConnRPC) Close() {}
+
+func (s *stubConnRPC) CallContext(_ context.Context, _ any, _ string, _ ...any) error {
+	return s.err
+}
+
+func (s *stubConnRPC) BatchCallContext(_ context.Context, _ []rpc.BatchElem) error {
+	return s.err
+}
+
+func (s *stubConnRPC) EthSubscribe(_ context.Context, _ any, _ ...any) (ethereum.Subscription, error) {
+	return nil, s.err
+}
+
+type stubConnMetrics struct {
+	states []bool
+}
+
+func (s *stubConnMetrics) RecordRPCClientConnectionState(client string, connected bool) {
+	s.states = append(s.states, connected)
+}
+
+func TestConnectionStateRPC(t *testing.T) {
+	lgr := log.New()
+	lgr.SetHandler(log.DiscardHandler())
+	ctx := context.Background()
+	inner := &stubConnRPC{}
+	m := &stubConnMetrics{}
+	c := NewConnectionStateRPC(lgr, "l1", m, inner)
+	require.True(t, c.Connected())
+	require.Equal(t, []bool{true}, m.states)
+
+	// Errors from the endpoint don't indicate a lost connection
+	inner.err = errors.New("execution reverted")
+	require.ErrorIs(t, c.CallContext(ctx, nil, "eth_call"), inner.err)
+	require.True(t, c.Connected())
+
+	inner.err = syscall.ECONNREFUSED
+	require.ErrorIs(t, c.BatchCallContext(ctx, nil), inner.err)
+	require.False(t, c.Connected())
+
+	// Further failures don't record additional disconnects
+	inner.err = io.EOF
+	_, err := c.EthSubscribe(ctx, nil)
+	require.ErrorIs(t, err, inner.err)
+	require.False(t, c.Connected())
+
+	inner.err = nil
+	require.NoError(t, c.CallContext(ctx, nil, "eth_chainId"))
+	require.True(t, c.Connected())
+	require.Equal(t, []bool{true, false, true}, m.states)
+}
@@ -3,6 +3,8 @@ package client
 import (
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -27,6 +29,22 @@ func TestIsURLAvailableLocal(t *testing.T) {
 
 }
 
+func TestIsURLAvailableIPC(t *testing.T) {
+	// Unix socket paths have a short length limit so avoid the long paths from t.TempDir
+	dir, err := os.MkdirTemp("", "ipc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.ipc")
+	require.False(t, IsURLAvailable(path))
+
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	require.True(t, IsURLAvailable(path))
+
+	require.NoError(t, listener.Close())
+	require.False(t, IsURLAvailable(path))
+}
+
 func TestIsURLAvailableNonLocal(t *testing.T) {
 	if !IsURLAvailable("http://example.com") {
 		t.Skip("No internet connection found, skipping this test")
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"os"
 	"regexp"
+	"runtime"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/retry"
@@ -128,6 +130,10 @@ func IsURLAvailable(address string) bool {
 	if err != nil {
 		return false
 	}
+	if u.Scheme == "" && address != "" && address != "stdio" {
+		// No scheme means the address is the path of an IPC socket.
+		return isIPCAvailable(address)
+	}
 	addr := u.Host
 	if u.Port() == "" {
 		switch u.Scheme {
@@ -148,6 +154,23 @@ func IsURLAvailable(address string) bool {
 	return true
 }
 
+// isIPCAvailable returns true if a connection can be made to the IPC socket at path.
+// Windows named pipes can't be dialled as unix sockets so are only checked for existence.
+func isIPCAvailable(path string) bool {
+	if _, err := os.Stat(path); err != nil {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	conn, err := net.DialTimeout("unix", path, 5*time.Second)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
 // BaseRPCClient is a wrapper around a concrete *rpc.Client instance to make it compliant
 // with the client.RPC interface.
 // It sets a timeout of 10s on CallContext & 20s on BatchCallContext made through it.
@@ -2,6 +2,8 @@ package eth
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -14,6 +16,17 @@ import (
 const (
 	BlobSize        = 4096 * 32
 	MaxBlobDataSize = 4096*31 - 4
+
+	fieldElements = BlobSize / 32
+	// lengthPrefixSize is the size of the little-endian length prefix encoded at the start of the blob data.
+	lengthPrefixSize = 4
+)
+
+var (
+	ErrBlobInputTooLarge     = errors.New("too much data to encode in one blob")
+	ErrBlobInvalidFieldElem  = errors.New("invalid field element")
+	ErrBlobInvalidLength     = errors.New("invalid blob length prefix")
+	ErrBlobNonCanonicalTrail = errors.New("non-zero data after encoded length")
 )
 
 type Blob [BlobSize]byte
@@ -44,6 +57,56 @@ func (b *Blob) TerminalString() string {
 	return fmt.Sprintf("%x..%x", b[:3], b[BlobSize-3:])
 }
 
+// Clear zeroes all bytes of the blob.
+func (b *Blob) Clear() {
+	*b = Blob{}
+}
+
+// FromData encodes the given rollup data into the blob.
+//
+// Every 32 byte field element holds 31 bytes of data in its lower-order bytes, and has a zero high-order
+// byte so that it is always less than the BLS modulus. The data is prefixed with its length, encoded
+// as a 4 byte little-endian integer. The remainder of the blob is zeroed.
+func (b *Blob) FromData(data Data) error {
+	if len(data) > MaxBlobDataSize {
+		return fmt.Errorf("%w: %v bytes exceeds %v", ErrBlobInputTooLarge, len(data), MaxBlobDataSize)
+	}
+	b.Clear()
+	var prefix [lengthPrefixSize]byte
+	binary.LittleEndian.PutUint32(prefix[:], uint32(len(data)))
+	// The first field element holds the length prefix, followed by the first 27 bytes of data.
+	copy(b[1:1+lengthPrefixSize], prefix[:])
+	offset := copy(b[1+lengthPrefixSize:32], data)
+	for i := 1; i < fieldElements && offset < len(data); i++ {
+		offset += copy(b[i*32+1:(i+1)*32], data[offset:])
+	}
+	return nil
+}
+
+// ToData decodes the rollup data encoded into the blob by FromData.
+// An error is returned if the blob is not a canonical encoding: any field element has a non-zero high-order
+// byte, the length prefix exceeds the capacity of the blob, or any byte after the encoded data is non-zero.
+func (b *Blob) ToData() (Data, error) {
+	data := make(Data, fieldElements*31)
+	for i := 0; i < fieldElements; i++ {
+		if b[i*32] != 0 {
+			return nil, fmt.Errorf("%w: non-zero high order byte %x in field element %d", ErrBlobInvalidFieldElem, b[i*32], i)
+		}
+		copy(data[i*31:(i+1)*31], b[i*32+1:(i+1)*32])
+	}
+	length := binary.LittleEndian.Uint32(data[:lengthPrefixSize])
+	data = data[lengthPrefixSize:]
+	if length > uint32(len(data)) {
+		return nil, fmt.Errorf("%w: %d", ErrBlobInvalidLength, length)
+	}
+	for _, v := range data[length:] {
+		if v != 0 {
+			return nil, ErrBlobNonCanonicalTrail
+		}
+	}
+	return data[:length], nil
+}
+
 func (b *Blob) ComputeKZGCommitment() (kzg4844.Commitment, error) {
 	return kzg4844.BlobToCommitment(*b.KZGBlob())
 }
@@ -0,0 +1,104 @@
+package eth
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobEncodeDecode(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	sizes := []int{0, 1, 26, 27, 28, 31, 58, 59, 1000, MaxBlobDataSize - 1, MaxBlobDataSize}
+	for _, size := range sizes {
+		data := make(Data, size)
+		rng.Read(data)
+		var b Blob
+		require.NoError(t, b.FromData(data), "size %d", size)
+		for i := 0; i < BlobSize; i += 32 {
+			require.Zero(t, b[i], "high order byte of field element %d must be zero", i/32)
+		}
+		decoded, err := b.ToData()
+		require.NoError(t, err, "size %d", size)
+		require.Equal(t, data, decoded, "size %d", size)
+	}
+}
+
+func TestBlobFromDataClearsPreviousData(t *testing.T) {
+	var b Blob
+	require.NoError(t, b.FromData(bytes.Repeat([]byte{0xff}, 1000)))
+	require.NoError(t, b.FromData(Data{0x01}))
+	decoded, err := b.ToData()
+	require.NoError(t, err)
+	require.Equal(t, Data{0x01}, decoded)
+}
+
+func TestBlobFromDataTooLarge(t *testing.T) {
+	var b Blob
+	err := b.FromData(make(Data, MaxBlobDataSize+1))
+	require.ErrorIs(t, err, ErrBlobInputTooLarge)
+}
+
+func TestBlobToDataInvalid(t *testing.T) {
+	t.Run("NonZeroHighOrderByte", func(t *testing.T) {
+		var b Blob
+		require.NoError(t, b.FromData(Data{0x01}))
+		b[32*5] = 0x01
+		_, err := b.ToData()
+		require.ErrorIs(t, err, ErrBlobInvalidFieldElem)
+	})
+
+	t.Run("LengthTooLarge", func(t *testing.T) {
+		var b Blob
+		b[1], b[2], b[3], b[4] = 0xff, 0xff, 0xff, 0xff
+		_, err := b.ToData()
+		require.ErrorIs(t, err, ErrBlobInvalidLength)
+	})
+
+	t.Run("TrailingData", func(t *testing.T) {
+		var b Blob
+		require.NoError(t, b.FromData(Data{0x01}))
+		b[BlobSize-1] = 0x01
+		_, err := b.ToData()
+		require.ErrorIs(t, err, ErrBlobNonCanonicalTrail)
+	})
+}
+
+func FuzzBlobEncodeDecode(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0x00})
+	f.Add(bytes.Repeat([]byte{0xff}, 27))
+	f.Add(bytes.Repeat([]byte{0xaa}, 4096))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var b Blob
+		err := b.FromData(data)
+		if len(data) > MaxBlobDataSize {
+			require.ErrorIs(t, err, ErrBlobInputTooLarge)
+			return
+		}
+		require.NoError(t, err)
+		decoded, err := b.ToData()
+		require.NoError(t, err)
+		require.Equal(t, Data(data), decoded)
+	})
+}
+
+// FuzzBlobDecodeEncode checks that any blob ToData accepts is the canonical encoding of the decoded data,
+// so derivation can never accept two different blobs for the same data.
+func FuzzBlobDecodeEncode(f *testing.F) {
+	f.Add([]byte{}, uint16(0))
+	f.Add([]byte{0x00, 0x01, 0x00, 0x00, 0x00, 0xab}, uint16(0))
+	f.Add([]byte{0x01}, uint16(100))
+	f.Fuzz(func(t *testing.T, content []byte, offset uint16) {
+		var b Blob
+		copy(b[int(offset)%BlobSize:], content)
+		decoded, err := b.ToData()
+		if err != nil {
+			return
+		}
+		var encoded Blob
+		require.NoError(t, encoded.FromData(decoded))
+		require.Equal(t, b, encoded)
+	})
+}
@@ -0,0 +1,42 @@
+package eth
+
+import "github.com/ethereum/go-ethereum/common"
+
+type Checkpoint struct {
+	Epoch Uint64String `json:"epoch"`
+	Root  common.Hash  `json:"root"`
+}
+
+type FinalityCheckpoints struct {
+	PreviousJustified Checkpoint `json:"previous_justified"`
+	CurrentJustified  Checkpoint `json:"current_justified"`
+	Finalized         Checkpoint `json:"finalized"`
+}
+
+type APIFinalityCheckpointsResponse struct {
+	Data FinalityCheckpoints `json:"data"`
+}
+
+// ReducedExecutionPayload is the subset of the execution payload of a beacon block that identifies the execution block.
+type ReducedExecutionPayload struct {
+	BlockHash   common.Hash  `json:"block_hash"`
+	BlockNumber Uint64String `json:"block_number"`
+}
+
+type ReducedBeaconBlockBody struct {
+	// ExecutionPayload is nil for blocks from before the merge.
+	ExecutionPayload *ReducedExecutionPayload `json:"execution_payload"`
+}
+
+type ReducedBeaconBlock struct {
+	Slot Uint64String           `json:"slot"`
+	Body ReducedBeaconBlockBody `json:"body"`
+}
+
+type ReducedSignedBeaconBlock struct {
+	Message ReducedBeaconBlock `json:"message"`
+}
+
+type APIGetBlockResponse struct {
+	Data ReducedSignedBeaconBlock `json:"data"`
+}
@@ -217,6 +217,19 @@ func marshalTransactions(out []byte, txs []Data) {
 	}
 }
 
+// payloadTimestampOffset is the offset of the timestamp in an SSZ encoded ExecutionPayload, following the parent hash,
+// fee recipient, state root, receipts root, logs bloom, prev randao, block number, gas limit and gas used.
+const payloadTimestampOffset = 32 + 20 + 32 + 32 + 256 + 32 + 8 + 8 + 8
+
+// PayloadTimestampSSZ reads the timestamp of an SSZ encoded ExecutionPayload from its fixed offset, without decoding
+// the rest of the payload.
+func PayloadTimestampSSZ(data []byte) (uint64, error) {
+	if len(data) < payloadTimestampOffset+8 {
+		return 0, fmt.Errorf("payload too small to contain timestamp: %d", len(data))
+	}
+	return binary.LittleEndian.Uint64(data[payloadTimestampOffset : payloadTimestampOffset+8]), nil
+}
+
 // UnmarshalSSZ decodes the ExecutionPayload as SSZ type
 func (payload *ExecutionPayload) UnmarshalSSZ(version BlockVersion, scope uint32, r io.Reader) error {
 	fixedSize := executionPayloadFixedPart(version)
@@ -337,3 +337,24 @@ func TestMarshalUnmarshalWithdrawals(t *testing.T) {
 		})
 	}
 }
+
+func TestPayloadTimestampSSZ(t *testing.T) {
+	payload := &ExecutionPayload{
+		BlockNumber: 100,
+		GasLimit:    200,
+		GasUsed:     300,
+		Timestamp:   1234567890,
+		ExtraData:   make([]byte, 32),
+	}
+	var buf bytes.Buffer
+	_, err := payload.MarshalSSZ(&buf)
+	require.NoError(t, err)
+	data := buf.Bytes()
+
+	timestamp, err := PayloadTimestampSSZ(data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(payload.Timestamp), timestamp)
+
+	_, err = PayloadTimestampSSZ(data[:payloadTimestampOffset+7])
+	require.Error(t, err)
+}
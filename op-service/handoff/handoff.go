@@ -0,0 +1,360 @@
+// Package handoff restarts a running service as a new process, such as an upgraded binary, without a gap in which its
+// listening sockets refuse connections.
+//
+// The running process (the parent) re-executes itself with the same arguments, passing its listening sockets to the
+// new process (the child). The child reuses the inherited sockets rather than binding new ones, so connections made
+// during the restart are queued rather than refused. Once the child has completed its setup it reports that it is
+// ready and waits for the parent to release state that only one process can hold at a time, such as a datadir.
+// The parent stops gracefully, flushing its state, and exits, at which point the child continues.
+//
+// The parent exits once the handoff completes so services must be run by a supervisor that does not treat the exit of
+// the original process as the service stopping.
+package handoff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// envHandoff is set in the environment of a child process started by a handoff.
+	envHandoff = "OP_HANDOFF"
+	// envListeners lists the keys of the listeners inherited by a child process, in the order of their file
+	// descriptors.
+	envListeners = "OP_HANDOFF_LISTENERS"
+
+	// The file descriptors passed to the child process. Inherited listeners follow from listenersFd.
+	readyFd     = 3
+	releaseFd   = 4
+	listenersFd = 5
+
+	// DefaultUpgradeTimeout is the default maximum time a new process may take to become ready.
+	DefaultUpgradeTimeout = 5 * time.Minute
+)
+
+var (
+	ErrUpgradeInProgress  = errors.New("upgrade already in progress")
+	ErrChildExitedEarly   = errors.New("new process exited before it was ready")
+	ErrListenerNotHandoff = errors.New("listener can not be handed off")
+)
+
+type fileListener interface {
+	net.Listener
+	File() (*os.File, error)
+}
+
+type ownedListener struct {
+	key      string
+	listener *trackedListener
+}
+
+// trackedListener stops passing the listener on to a child process once it is closed.
+type trackedListener struct {
+	fileListener
+	h *Handoff
+}
+
+func (l *trackedListener) Close() error {
+	l.h.untrack(l)
+	return l.fileListener.Close()
+}
+
+// Handoff manages the listeners inherited from a parent process, and those to pass on to a child process.
+type Handoff struct {
+	lock      sync.Mutex
+	inherited map[string]*os.File
+	owned     []ownedListener
+	upgrading bool
+
+	// ready and release are the pipes shared with the parent process. They are nil if there is no parent.
+	ready   *os.File
+	release *os.File
+}
+
+var (
+	processOnce    sync.Once
+	processHandoff *Handoff
+)
+
+// Process returns the Handoff for the current process. File descriptors are inherited from a parent process once,
+// the first time it is called.
+func Process() *Handoff {
+	processOnce.Do(func() {
+		processHandoff = fromEnv(os.Getenv)
+	})
+	return processHandoff
+}
+
+func fromEnv(getenv func(string) string) *Handoff {
+	h := &Handoff{inherited: make(map[string]*os.File)}
+	if getenv(envHandoff) == "" {
+		return h
+	}
+	h.ready = os.NewFile(readyFd, "handoff-ready")
+	h.release = os.NewFile(releaseFd, "handoff-release")
+	if keys := getenv(envListeners); keys != "" {
+		for i, key := range strings.Split(keys, ",") {
+			h.inherited[key] = os.NewFile(uintptr(listenersFd+i), key)
+		}
+	}
+	return h
+}
+
+// HasParent returns true if the process was started by a handoff and the parent has not yet released its state.
+func (h *Handoff) HasParent() bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.release != nil
+}
+
+// Listen returns the listener inherited from the parent process for network and addr, or binds a new listener if
+// there is none. The listener is passed on to the child process if the process is upgraded.
+func (h *Handoff) Listen(network string, addr string) (net.Listener, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	key := network + "|" + addr
+	var listener net.Listener
+	if file, ok := h.inherited[key]; ok {
+		delete(h.inherited, key)
+		inherited, err := net.FileListener(file)
+		// FileListener duplicates the file descriptor so the inherited file is no longer required.
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to use inherited listener for %v: %w", addr, err)
+		}
+		listener = inherited
+	} else {
+		bound, err := net.Listen(network, addr)
+		if err != nil {
+			return nil, err
+		}
+		listener = bound
+	}
+	l, ok := listener.(fileListener)
+	if !ok {
+		return listener, nil
+	}
+	tracked := &trackedListener{fileListener: l, h: h}
+	h.owned = append(h.owned, ownedListener{key: key, listener: tracked})
+	return tracked, nil
+}
+
+func (h *Handoff) untrack(l *trackedListener) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for i, owned := range h.owned {
+		if owned.listener == l {
+			h.owned = append(h.owned[:i], h.owned[i+1:]...)
+			return
+		}
+	}
+}
+
+// Ready tells the parent process that this process has completed its setup and is ready to take over once the parent
+// has stopped. It does nothing if there is no parent.
+func (h *Handoff) Ready() error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.ready == nil {
+		return nil
+	}
+	_, err := h.ready.Write([]byte{1})
+	err = errors.Join(err, h.ready.Close())
+	h.ready = nil
+	if err != nil {
+		return fmt.Errorf("failed to notify parent process: %w", err)
+	}
+	return nil
+}
+
+// WaitForParent waits until the parent process has stopped and released its state. It returns immediately if there
+// is no parent. Ready must be called first, otherwise the parent does not stop.
+func (h *Handoff) WaitForParent(ctx context.Context) error {
+	h.lock.Lock()
+	release := h.release
+	h.lock.Unlock()
+	if release == nil {
+		return nil
+	}
+	done := make(chan error, 1)
+	go func() {
+		// The parent never writes to the pipe, so the read completes when the parent closes it or exits.
+		_, err := release.Read(make([]byte, 1))
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to wait for parent process: %w", err)
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.release = nil
+	return release.Close()
+}
+
+// Child is a process started by Upgrade that is ready to take over from the current process.
+type Child struct {
+	Pid     int
+	release *os.File
+}
+
+// Release tells the child that the current process has released its state. It is released automatically when the
+// current process exits.
+func (c *Child) Release() error {
+	return c.release.Close()
+}
+
+// Upgrade starts a new instance of the current executable with the same arguments, passing it all listeners opened
+// with Listen. It waits until the new process is ready, then returns so that the current process can stop.
+// If the new process exits or ctx is done before it is ready, it is killed and an error returned. The current process
+// should then continue running.
+func (h *Handoff) Upgrade(ctx context.Context) (*Child, error) {
+	h.lock.Lock()
+	if h.upgrading {
+		h.lock.Unlock()
+		return nil, ErrUpgradeInProgress
+	}
+	h.upgrading = true
+	owned := append([]ownedListener(nil), h.owned...)
+	h.lock.Unlock()
+	defer func() {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		h.upgrading = false
+	}()
+
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find executable: %w", err)
+	}
+	readyR, readyW, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ready pipe: %w", err)
+	}
+	defer readyR.Close()
+	releaseR, releaseW, err := os.Pipe()
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to create release pipe: %w", err), readyW.Close())
+	}
+	// The child's copies of the files are only required until it has started.
+	childFiles := []*os.File{readyW, releaseR}
+	closeChildFiles := func() {
+		for _, f := range childFiles {
+			_ = f.Close()
+		}
+	}
+	keys := make([]string, 0, len(owned))
+	for _, l := range owned {
+		f, err := l.listener.File()
+		if err != nil {
+			closeChildFiles()
+			_ = releaseW.Close()
+			return nil, fmt.Errorf("%w: %v: %w", ErrListenerNotHandoff, l.key, err)
+		}
+		childFiles = append(childFiles, f)
+		keys = append(keys, l.key)
+	}
+
+	cmd := exec.Command(exe, os.Args[1:]...)
+	cmd.Env = append(childEnv(os.Environ()), envHandoff+"=1", envListeners+"="+strings.Join(keys, ","))
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = childFiles
+	err = cmd.Start()
+	closeChildFiles()
+	if err != nil {
+		_ = releaseW.Close()
+		return nil, fmt.Errorf("failed to start new process: %w", err)
+	}
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	ready := make(chan error, 1)
+	go func() {
+		// The child writes a byte once ready. The read fails with EOF if it exits without doing so.
+		_, err := readyR.Read(make([]byte, 1))
+		ready <- err
+	}()
+	select {
+	case err := <-ready:
+		if err == nil {
+			return &Child{Pid: cmd.Process.Pid, release: releaseW}, nil
+		}
+		err = fmt.Errorf("%w: %w", ErrChildExitedEarly, err)
+		return nil, errors.Join(err, h.abort(cmd, releaseW, exited))
+	case <-ctx.Done():
+		return nil, errors.Join(ctx.Err(), h.abort(cmd, releaseW, exited))
+	}
+}
+
+func (h *Handoff) abort(cmd *exec.Cmd, releaseW *os.File, exited <-chan error) error {
+	err := releaseW.Close()
+	if killErr := cmd.Process.Kill(); killErr != nil && !errors.Is(killErr, os.ErrProcessDone) {
+		err = errors.Join(err, fmt.Errorf("failed to kill new process: %w", killErr))
+	}
+	<-exited
+	return err
+}
+
+// childEnv returns env without any handoff variables inherited from the current process's own parent.
+func childEnv(env []string) []string {
+	result := make([]string, 0, len(env))
+	for _, kv := range env {
+		if strings.HasPrefix(kv, envHandoff+"=") || strings.HasPrefix(kv, envListeners+"=") {
+			continue
+		}
+		result = append(result, kv)
+	}
+	return result
+}
+
+// UpgradeOnSignal upgrades the process each time one of UpgradeSignals is received, until an upgrade succeeds or ctx
+// is done. Each upgrade fails if the new process is not ready within timeout. onUpgraded is called once the new
+// process is ready, and must stop the current process.
+func (h *Handoff) UpgradeOnSignal(ctx context.Context, logger log.Logger, timeout time.Duration, onUpgraded func()) {
+	if len(UpgradeSignals) == 0 {
+		logger.Warn("Upgrades are not supported on this platform")
+		return
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, UpgradeSignals...)
+	defer signal.Stop(signals)
+	for {
+		select {
+		case <-signals:
+		case <-ctx.Done():
+			return
+		}
+		logger.Info("Upgrade requested, starting new process")
+		upgradeCtx, cancel := context.WithTimeout(ctx, timeout)
+		child, err := h.Upgrade(upgradeCtx)
+		cancel()
+		if err != nil {
+			logger.Error("Upgrade failed, continuing to run", "err", err)
+			continue
+		}
+		logger.Info("New process ready, stopping", "pid", child.Pid)
+		onUpgraded()
+		return
+	}
+}
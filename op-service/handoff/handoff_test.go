@@ -0,0 +1,122 @@
+package handoff
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// envTestChild selects the behaviour of the test binary when it is started as a child process by Upgrade.
+	envTestChild = "HANDOFF_TEST_CHILD"
+	envTestAddr  = "HANDOFF_TEST_ADDR"
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv(envHandoff) != "" {
+		os.Exit(runTestChild())
+	}
+	os.Exit(m.Run())
+}
+
+// runTestChild acts as the new process started by an upgrade.
+func runTestChild() int {
+	if os.Getenv(envTestChild) == "fail" {
+		return 1
+	}
+	h := Process()
+	listener, err := h.Listen("tcp", os.Getenv(envTestAddr))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := h.Ready(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	conn, err := listener.Accept()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := h.WaitForParent(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	_, _ = fmt.Fprintln(conn, "child")
+	return 0
+}
+
+func TestUpgrade(t *testing.T) {
+	t.Run("HandsOffListener", func(t *testing.T) {
+		h := fromEnv(func(string) string { return "" })
+		listener, err := h.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := listener.Addr().String()
+		// Bind to the now known port so the child can find the listener by address.
+		require.NoError(t, listener.Close())
+		listener, err = h.Listen("tcp", addr)
+		require.NoError(t, err)
+		t.Setenv(envTestAddr, addr)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		child, err := h.Upgrade(ctx)
+		require.NoError(t, err)
+		require.NoError(t, listener.Close())
+
+		// The socket remains open in the child so connections are accepted after the parent stops listening.
+		conn, err := net.Dial("tcp", addr)
+		require.NoError(t, err)
+		defer conn.Close()
+		require.NoError(t, child.Release())
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(30*time.Second)))
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "child\n", line)
+	})
+
+	t.Run("ChildExitsBeforeReady", func(t *testing.T) {
+		h := fromEnv(func(string) string { return "" })
+		t.Setenv(envTestChild, "fail")
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		_, err := h.Upgrade(ctx)
+		require.ErrorIs(t, err, ErrChildExitedEarly)
+	})
+}
+
+func TestListenTracksOpenListeners(t *testing.T) {
+	h := fromEnv(func(string) string { return "" })
+	l1, err := h.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l2, err := h.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l2.Close()
+	require.Len(t, h.owned, 2)
+
+	require.NoError(t, l1.Close())
+	require.Len(t, h.owned, 1)
+	require.Same(t, l2, net.Listener(h.owned[0].listener))
+}
+
+func TestNoParent(t *testing.T) {
+	h := fromEnv(func(string) string { return "" })
+	require.False(t, h.HasParent())
+	require.NoError(t, h.Ready())
+	require.NoError(t, h.WaitForParent(context.Background()))
+}
+
+func TestChildEnvRemovesHandoffVariables(t *testing.T) {
+	env := childEnv([]string{"A=1", envHandoff + "=1", envListeners + "=tcp|127.0.0.1:80", "B=2"})
+	require.Equal(t, []string{"A=1", "B=2"}, env)
+}
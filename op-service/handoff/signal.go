@@ -0,0 +1,11 @@
+//go:build !windows
+
+package handoff
+
+import (
+	"os"
+	"syscall"
+)
+
+// UpgradeSignals are the signals that trigger an upgrade in UpgradeOnSignal.
+var UpgradeSignals = []os.Signal{syscall.SIGUSR2}
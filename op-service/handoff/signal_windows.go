@@ -0,0 +1,6 @@
+package handoff
+
+import "os"
+
+// UpgradeSignals is empty as listeners can not be passed to a new process on Windows.
+var UpgradeSignals []os.Signal
@@ -13,6 +13,7 @@ import (
 // like exposing the running state and address.
 type HTTPServer struct {
 	listener net.Listener
+	listen   ListenFunc
 	srv      *http.Server
 	closed   atomic.Bool
 }
@@ -20,12 +21,10 @@ type HTTPServer struct {
 // HTTPOption applies a change to an HTTP server
 type HTTPOption func(srv *HTTPServer) error
 
-func StartHTTPServer(addr string, handler http.Handler, opts ...HTTPOption) (*HTTPServer, error) {
-	listener, err := net.Listen("tcp", addr)
-	if err != nil {
-		return nil, fmt.Errorf("failed to bind to address %q: %w", addr, err)
-	}
+// ListenFunc binds a listener to an address, like net.Listen.
+type ListenFunc func(network string, addr string) (net.Listener, error)
 
+func StartHTTPServer(addr string, handler http.Handler, opts ...HTTPOption) (*HTTPServer, error) {
 	srvCtx, srvCancel := context.WithCancel(context.Background())
 	srv := &http.Server{
 		Handler:           handler,
@@ -37,13 +36,19 @@ func StartHTTPServer(addr string, handler http.Handler, opts ...HTTPOption) (*HT
 			return srvCtx
 		},
 	}
-	out := &HTTPServer{listener: listener, srv: srv}
+	out := &HTTPServer{srv: srv, listen: net.Listen}
 	for _, opt := range opts {
 		if err := opt(out); err != nil {
 			srvCancel()
-			return nil, errors.Join(fmt.Errorf("failed to apply HTTP option: %w", err), listener.Close())
+			return nil, fmt.Errorf("failed to apply HTTP option: %w", err)
 		}
 	}
+	listener, err := out.listen("tcp", addr)
+	if err != nil {
+		srvCancel()
+		return nil, fmt.Errorf("failed to bind to address %q: %w", addr, err)
+	}
+	out.listener = listener
 	go func() {
 		err := out.srv.Serve(listener)
 		srvCancel()
@@ -98,3 +103,12 @@ func WithMaxHeaderBytes(max int) HTTPOption {
 		return nil
 	}
 }
+
+// WithListenFunc binds the server's listener with listen instead of net.Listen, for example to reuse a listener
+// inherited from another process.
+func WithListenFunc(listen ListenFunc) HTTPOption {
+	return func(srv *HTTPServer) error {
+		srv.listen = listen
+		return nil
+	}
+}
@@ -2,6 +2,7 @@ package httputil
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"sync"
 	"testing"
@@ -98,3 +99,21 @@ func TestStartHTTPServer(t *testing.T) {
 		require.True(t, srv.Closed())
 	})
 }
+
+func TestStartHTTPServerWithListenFunc(t *testing.T) {
+	var listened string
+	listen := func(network string, addr string) (net.Listener, error) {
+		listened = addr
+		return net.Listen(network, addr)
+	}
+	srv, err := StartHTTPServer("localhost:0", http.NotFoundHandler(), WithListenFunc(listen))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, srv.Close())
+	}()
+	require.Equal(t, "localhost:0", listened)
+	resp, err := http.Get("http://" + srv.Addr().String() + "/")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
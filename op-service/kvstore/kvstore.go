@@ -0,0 +1,280 @@
+// Package kvstore provides a small pebble backed key-value store for services that need local persistence.
+// Data is split into namespaces, each of which has its own schema version and migrations, so multiple components
+// of a service can share a single database without coordinating their key layouts.
+package kvstore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidName     = errors.New("invalid namespace name")
+	ErrNewerSchema     = errors.New("namespace schema is newer than supported")
+	ErrMigrationFailed = errors.New("migration failed")
+)
+
+// metaNamespace holds the schema version of every other namespace.
+// Namespace names supplied by users may not start with an underscore so cannot collide with it.
+const metaNamespace = "_meta"
+
+// Store is a key-value store persisted to disk.
+type Store struct {
+	log  log.Logger
+	metr Metricer
+	db   *pebble.DB
+}
+
+// Open opens, or creates, the store in the directory at path.
+func Open(logger log.Logger, m Metricer, path string) (*Store, error) {
+	return open(logger, m, path, &pebble.Options{})
+}
+
+// OpenInMemory creates a store that is not persisted, for use in tests.
+func OpenInMemory(logger log.Logger, m Metricer) (*Store, error) {
+	return open(logger, m, "", &pebble.Options{FS: vfs.NewMem()})
+}
+
+func open(logger log.Logger, m Metricer, path string, opts *pebble.Options) (*Store, error) {
+	opts.Logger = &pebbleLogger{logger}
+	db, err := pebble.Open(path, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database at %v: %w", path, err)
+	}
+	s := &Store{
+		log:  logger,
+		metr: m,
+		db:   db,
+	}
+	s.recordDiskUsage()
+	return s, nil
+}
+
+// Namespace returns the namespace with the given name, migrating its data to the latest schema version first.
+// The schema version of a namespace is the number of migrations that have been applied to it. Migrations that have
+// not yet been applied are run in order, each atomically with updating the schema version, so an interrupted upgrade
+// resumes from the last completed migration when the store is next opened.
+// Names must be non-empty, at most 255 bytes and may not start with an underscore.
+func (s *Store) Namespace(name string, migrations ...Migration) (*Namespace, error) {
+	if name == "" || len(name) > 255 || name[0] == '_' {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	ns := s.namespace(name)
+	meta := s.namespace(metaNamespace)
+	version, err := meta.getVersion(name)
+	if err != nil {
+		return nil, err
+	}
+	if version > uint64(len(migrations)) {
+		return nil, fmt.Errorf("%w: namespace %v is at version %d but only %d migrations are known", ErrNewerSchema, name, version, len(migrations))
+	}
+	for ; version < uint64(len(migrations)); version++ {
+		s.log.Info("Migrating namespace", "namespace", name, "from", version, "to", version+1)
+		batch := ns.NewBatch()
+		if err := migrations[version](ns, batch); err != nil {
+			_ = batch.batch.Close()
+			return nil, fmt.Errorf("%w: namespace %v to version %d: %w", ErrMigrationFailed, name, version+1, err)
+		}
+		batch.batch.Set(meta.key([]byte(name)), encodeVersion(version+1), nil)
+		if err := batch.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit migration of namespace %v to version %d: %w", name, version+1, err)
+		}
+	}
+	return ns, nil
+}
+
+func (s *Store) namespace(name string) *Namespace {
+	prefix := make([]byte, 0, len(name)+1)
+	prefix = append(prefix, byte(len(name)))
+	prefix = append(prefix, name...)
+	return &Namespace{
+		store:  s,
+		name:   name,
+		prefix: prefix,
+	}
+}
+
+// Close flushes any pending writes and closes the store.
+func (s *Store) Close() error {
+	s.recordDiskUsage()
+	return s.db.Close()
+}
+
+func (s *Store) recordDiskUsage() {
+	s.metr.RecordDiskUsage(s.db.Metrics().DiskSpaceUsage())
+}
+
+// Migration upgrades the data in a namespace by one schema version.
+// Changes must be written to batch rather than ns so they are applied atomically with the version update.
+type Migration func(ns *Namespace, batch *Batch) error
+
+// Namespace is a set of keys isolated from the other namespaces in the store.
+type Namespace struct {
+	store  *Store
+	name   string
+	prefix []byte
+}
+
+// Get returns the value for key or ErrNotFound if the key does not exist.
+func (n *Namespace) Get(key []byte) ([]byte, error) {
+	start := time.Now()
+	value, closer, err := n.store.db.Get(n.key(key))
+	if errors.Is(err, pebble.ErrNotFound) {
+		n.store.metr.RecordOperation(n.name, "get", time.Since(start), nil)
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		n.store.metr.RecordOperation(n.name, "get", time.Since(start), err)
+		return nil, fmt.Errorf("failed to get key %x from namespace %v: %w", key, n.name, err)
+	}
+	// The value is only valid until the closer is called
+	result := bytes.Clone(value)
+	err = closer.Close()
+	n.store.metr.RecordOperation(n.name, "get", time.Since(start), err)
+	return result, err
+}
+
+// Put sets the value for key, replacing any existing value.
+func (n *Namespace) Put(key []byte, value []byte) error {
+	start := time.Now()
+	err := n.store.db.Set(n.key(key), value, pebble.Sync)
+	n.store.metr.RecordOperation(n.name, "put", time.Since(start), err)
+	if err != nil {
+		return fmt.Errorf("failed to put key %x in namespace %v: %w", key, n.name, err)
+	}
+	return nil
+}
+
+// Delete removes key. Deleting a key that does not exist is not an error.
+func (n *Namespace) Delete(key []byte) error {
+	start := time.Now()
+	err := n.store.db.Delete(n.key(key), pebble.Sync)
+	n.store.metr.RecordOperation(n.name, "delete", time.Since(start), err)
+	if err != nil {
+		return fmt.Errorf("failed to delete key %x from namespace %v: %w", key, n.name, err)
+	}
+	return nil
+}
+
+// Iterate calls fn for each key with the given prefix in the namespace, in key order.
+// The key and value passed to fn are only valid until fn returns. Iteration stops if fn returns an error.
+func (n *Namespace) Iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	start := time.Now()
+	err := n.iterate(prefix, fn)
+	n.store.metr.RecordOperation(n.name, "iterate", time.Since(start), err)
+	return err
+}
+
+func (n *Namespace) iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	lower := n.key(prefix)
+	iter, err := n.store.db.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: upperBound(lower),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to iterate namespace %v: %w", n.name, err)
+	}
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := fn(iter.Key()[len(n.prefix):], iter.Value()); err != nil {
+			_ = iter.Close()
+			return err
+		}
+	}
+	return iter.Close()
+}
+
+// NewBatch creates a batch of writes to the namespace that are applied atomically when committed.
+func (n *Namespace) NewBatch() *Batch {
+	return &Batch{
+		ns:    n,
+		batch: n.store.db.NewBatch(),
+	}
+}
+
+func (n *Namespace) key(key []byte) []byte {
+	result := make([]byte, 0, len(n.prefix)+len(key))
+	result = append(result, n.prefix...)
+	return append(result, key...)
+}
+
+func (n *Namespace) getVersion(name string) (uint64, error) {
+	value, err := n.Get([]byte(name))
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to read schema version of namespace %v: %w", name, err)
+	}
+	if len(value) != 8 {
+		return 0, fmt.Errorf("invalid schema version for namespace %v: %x", name, value)
+	}
+	return decodeVersion(value), nil
+}
+
+// Batch is a set of writes to a namespace that are applied atomically.
+type Batch struct {
+	ns    *Namespace
+	batch *pebble.Batch
+}
+
+func (b *Batch) Put(key []byte, value []byte) {
+	// Writes to a batch only fail if it has already been committed
+	_ = b.batch.Set(b.ns.key(key), value, nil)
+}
+
+func (b *Batch) Delete(key []byte) {
+	_ = b.batch.Delete(b.ns.key(key), nil)
+}
+
+// Commit applies the writes in the batch. The batch may not be used after it is committed.
+func (b *Batch) Commit() error {
+	start := time.Now()
+	err := b.batch.Commit(pebble.Sync)
+	b.ns.store.metr.RecordOperation(b.ns.name, "commit", time.Since(start), err)
+	closeErr := b.batch.Close()
+	if err != nil {
+		return fmt.Errorf("failed to commit batch to namespace %v: %w", b.ns.name, err)
+	}
+	b.ns.store.recordDiskUsage()
+	return closeErr
+}
+
+func encodeVersion(version uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, version)
+}
+
+func decodeVersion(value []byte) uint64 {
+	return binary.BigEndian.Uint64(value)
+}
+
+// upperBound returns the smallest key that is greater than every key starting with prefix.
+func upperBound(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	// Prefix is all 0xff bytes so there is no upper bound
+	return nil
+}
+
+type pebbleLogger struct {
+	log log.Logger
+}
+
+func (l *pebbleLogger) Infof(format string, args ...interface{}) {
+	l.log.Debug(fmt.Sprintf(format, args...))
+}
+
+func (l *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	l.log.Crit(fmt.Sprintf(format, args...))
+}
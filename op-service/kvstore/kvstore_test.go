@@ -0,0 +1,150 @@
+package kvstore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestNamespaces(t *testing.T) {
+	store, err := OpenInMemory(testlog.Logger(t, log.LvlInfo), NoopMetrics)
+	require.NoError(t, err)
+	defer store.Close()
+
+	a, err := store.Namespace("a")
+	require.NoError(t, err)
+	// Prefixes of other namespace names must not see each other's keys
+	ab, err := store.Namespace("ab")
+	require.NoError(t, err)
+
+	require.NoError(t, a.Put([]byte("bkey"), []byte("a-value")))
+	require.NoError(t, ab.Put([]byte("key"), []byte("ab-value")))
+
+	value, err := a.Get([]byte("bkey"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a-value"), value)
+	_, err = ab.Get([]byte("bkey"))
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = a.Get([]byte("key"))
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.Equal(t, map[string]string{"bkey": "a-value"}, collect(t, a, nil))
+	require.Equal(t, map[string]string{"key": "ab-value"}, collect(t, ab, nil))
+
+	require.NoError(t, a.Delete([]byte("bkey")))
+	_, err = a.Get([]byte("bkey"))
+	require.ErrorIs(t, err, ErrNotFound)
+	require.NoError(t, a.Delete([]byte("bkey")), "deleting a missing key is not an error")
+}
+
+func TestInvalidNamespaceNames(t *testing.T) {
+	store, err := OpenInMemory(testlog.Logger(t, log.LvlInfo), NoopMetrics)
+	require.NoError(t, err)
+	defer store.Close()
+
+	for _, name := range []string{"", "_meta", "_other", string(make([]byte, 256))} {
+		_, err := store.Namespace(name)
+		require.ErrorIs(t, err, ErrInvalidName)
+	}
+}
+
+func TestIteratePrefix(t *testing.T) {
+	store, err := OpenInMemory(testlog.Logger(t, log.LvlInfo), NoopMetrics)
+	require.NoError(t, err)
+	defer store.Close()
+	ns, err := store.Namespace("test")
+	require.NoError(t, err)
+
+	batch := ns.NewBatch()
+	batch.Put([]byte("a1"), []byte("1"))
+	batch.Put([]byte("a2"), []byte("2"))
+	batch.Put([]byte("b1"), []byte("3"))
+	batch.Put([]byte{0xff, 0xff}, []byte("4"))
+	require.NoError(t, batch.Commit())
+
+	require.Equal(t, map[string]string{"a1": "1", "a2": "2"}, collect(t, ns, []byte("a")))
+	require.Equal(t, map[string]string{"\xff\xff": "4"}, collect(t, ns, []byte{0xff}))
+	require.Len(t, collect(t, ns, nil), 4)
+
+	errStop := errors.New("stop")
+	count := 0
+	err = ns.Iterate(nil, func(key []byte, value []byte) error {
+		count++
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 1, count)
+}
+
+func TestMigrations(t *testing.T) {
+	dir := t.TempDir()
+	logger := testlog.Logger(t, log.LvlInfo)
+	var applied []int
+	migration := func(i int) Migration {
+		return func(ns *Namespace, batch *Batch) error {
+			applied = append(applied, i)
+			batch.Put([]byte{byte(i)}, []byte("migrated"))
+			return nil
+		}
+	}
+
+	store, err := Open(logger, NoopMetrics, dir)
+	require.NoError(t, err)
+	_, err = store.Namespace("test", migration(0), migration(1))
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1}, applied)
+	require.NoError(t, store.Close())
+
+	t.Run("OnlyApplyNewMigrations", func(t *testing.T) {
+		applied = nil
+		store, err := Open(logger, NoopMetrics, dir)
+		require.NoError(t, err)
+		defer store.Close()
+		ns, err := store.Namespace("test", migration(0), migration(1), migration(2))
+		require.NoError(t, err)
+		require.Equal(t, []int{2}, applied)
+		require.Len(t, collect(t, ns, nil), 3)
+	})
+
+	t.Run("RejectNewerSchema", func(t *testing.T) {
+		store, err := Open(logger, NoopMetrics, dir)
+		require.NoError(t, err)
+		defer store.Close()
+		_, err = store.Namespace("test", migration(0))
+		require.ErrorIs(t, err, ErrNewerSchema)
+	})
+
+	t.Run("FailedMigrationNotApplied", func(t *testing.T) {
+		store, err := Open(logger, NoopMetrics, dir)
+		require.NoError(t, err)
+		defer store.Close()
+		errFail := errors.New("fail")
+		failing := func(ns *Namespace, batch *Batch) error {
+			batch.Put([]byte("partial"), []byte("value"))
+			return errFail
+		}
+		_, err = store.Namespace("test", migration(0), migration(1), migration(2), failing)
+		require.ErrorIs(t, err, ErrMigrationFailed)
+		require.ErrorIs(t, err, errFail)
+
+		applied = nil
+		ns, err := store.Namespace("test", migration(0), migration(1), migration(2), migration(3))
+		require.NoError(t, err)
+		require.Equal(t, []int{3}, applied, "failed migration must be retried")
+		_, err = ns.Get([]byte("partial"))
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func collect(t *testing.T, ns *Namespace, prefix []byte) map[string]string {
+	result := make(map[string]string)
+	require.NoError(t, ns.Iterate(prefix, func(key []byte, value []byte) error {
+		result[string(key)] = string(value)
+		return nil
+	}))
+	return result
+}
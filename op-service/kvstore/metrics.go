@@ -0,0 +1,80 @@
+package kvstore
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+const Subsystem = "kvstore"
+
+type Metricer interface {
+	RecordOperation(namespace string, op string, duration time.Duration, err error)
+	RecordDiskUsage(bytes uint64)
+}
+
+// Metrics tracks the operations and disk usage of a Store.
+type Metrics struct {
+	OperationsTotal          *prometheus.CounterVec
+	OperationDurationSeconds *prometheus.HistogramVec
+	DiskUsageBytes           prometheus.Gauge
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+// MakeMetrics creates a new Metrics instance with the given namespace for the service.
+func MakeMetrics(ns string, factory opmetrics.Factory) *Metrics {
+	return &Metrics{
+		OperationsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: Subsystem,
+			Name:      "operations_total",
+			Help:      "Total operations performed on the local key-value store",
+		}, []string{
+			"namespace",
+			"op",
+			"error",
+		}),
+		OperationDurationSeconds: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Subsystem: Subsystem,
+			Name:      "operation_duration_seconds",
+			Buckets:   []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+			Help:      "Histogram of local key-value store operation durations",
+		}, []string{
+			"namespace",
+			"op",
+		}),
+		DiskUsageBytes: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: Subsystem,
+			Name:      "disk_usage_bytes",
+			Help:      "Disk space used by the local key-value store",
+		}),
+	}
+}
+
+func (m *Metrics) RecordOperation(namespace string, op string, duration time.Duration, err error) {
+	m.OperationsTotal.WithLabelValues(namespace, op, boolString(err != nil)).Inc()
+	m.OperationDurationSeconds.WithLabelValues(namespace, op).Observe(duration.Seconds())
+}
+
+func (m *Metrics) RecordDiskUsage(bytes uint64) {
+	m.DiskUsageBytes.Set(float64(bytes))
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+type noopMetrics struct{}
+
+var NoopMetrics Metricer = noopMetrics{}
+
+func (noopMetrics) RecordOperation(string, string, time.Duration, error) {}
+func (noopMetrics) RecordDiskUsage(uint64)                               {}
@@ -27,6 +27,8 @@ type RPCMetrics struct {
 	RPCClientRequestsTotal          *prometheus.CounterVec
 	RPCClientRequestDurationSeconds *prometheus.HistogramVec
 	RPCClientResponsesTotal         *prometheus.CounterVec
+	RPCClientConnected              *prometheus.GaugeVec
+	RPCClientDisconnectsTotal       *prometheus.CounterVec
 }
 
 // MakeRPCMetrics creates a new RPCMetrics instance with the given process name, and
@@ -76,6 +78,22 @@ func MakeRPCMetrics(ns string, factory Factory) RPCMetrics {
 			"method",
 			"error",
 		}),
+		RPCClientConnected: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: RPCClientSubsystem,
+			Name:      "connected",
+			Help:      "1 if the RPC client is connected to its endpoint, 0 if the connection has been lost",
+		}, []string{
+			"client",
+		}),
+		RPCClientDisconnectsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: RPCClientSubsystem,
+			Name:      "disconnects_total",
+			Help:      "Total number of times the RPC client lost the connection to its endpoint",
+		}, []string{
+			"client",
+		}),
 	}
 }
 
@@ -126,6 +144,17 @@ func (m *RPCMetrics) RecordRPCClientResponse(method string, err error) {
 	m.RPCClientResponsesTotal.WithLabelValues(method, errStr).Inc()
 }
 
+// RecordRPCClientConnectionState records whether the named RPC client is currently connected to its endpoint.
+// It should only be called when the connection state changes so that disconnects are counted correctly.
+func (m *RPCMetrics) RecordRPCClientConnectionState(client string, connected bool) {
+	if connected {
+		m.RPCClientConnected.WithLabelValues(client).Set(1)
+	} else {
+		m.RPCClientConnected.WithLabelValues(client).Set(0)
+		m.RPCClientDisconnectsTotal.WithLabelValues(client).Inc()
+	}
+}
+
 type NoopRPCMetrics struct{}
 
 func (n *NoopRPCMetrics) RecordRPCServerRequest(method string) func() {
@@ -137,5 +166,7 @@ func (n *NoopRPCMetrics) RecordRPCClientRequest(method string) func(err error) {
 }
 func (n *NoopRPCMetrics) RecordRPCClientResponse(method string, err error) {
 }
+func (n *NoopRPCMetrics) RecordRPCClientConnectionState(client string, connected bool) {
+}
 
 var _ RPCMetricer = (*NoopRPCMetrics)(nil)
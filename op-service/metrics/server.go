@@ -9,10 +9,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func StartServer(r *prometheus.Registry, hostname string, port int) (*httputil.HTTPServer, error) {
+func StartServer(r *prometheus.Registry, hostname string, port int, opts ...httputil.HTTPOption) (*httputil.HTTPServer, error) {
 	addr := net.JoinHostPort(hostname, strconv.Itoa(port))
 	h := promhttp.InstrumentMetricHandler(
 		r, promhttp.HandlerFor(r, promhttp.HandlerOpts{}),
 	)
-	return httputil.StartHTTPServer(addr, h)
+	return httputil.StartHTTPServer(addr, h, opts...)
 }
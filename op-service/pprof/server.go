@@ -9,7 +9,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 )
 
-func StartServer(hostname string, port int) (*httputil.HTTPServer, error) {
+func StartServer(hostname string, port int, opts ...httputil.HTTPOption) (*httputil.HTTPServer, error) {
 	mux := http.NewServeMux()
 
 	// have to do below to support multiple servers, since the
@@ -21,5 +21,5 @@ func StartServer(hostname string, port int) (*httputil.HTTPServer, error) {
 	mux.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
 
 	addr := net.JoinHostPort(hostname, strconv.Itoa(port))
-	return httputil.StartHTTPServer(addr, mux)
+	return httputil.StartHTTPServer(addr, mux, opts...)
 }
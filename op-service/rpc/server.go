@@ -36,6 +36,7 @@ type Server struct {
 	log            log.Logger
 	tls            *ServerTLSConfig
 	middlewares    []Middleware
+	listen         func(network string, addr string) (net.Listener, error)
 }
 
 type ServerTLSConfig struct {
@@ -47,6 +48,14 @@ type ServerOption func(b *Server)
 
 type Middleware func(next http.Handler) http.Handler
 
+// WithListenFunc binds the server's listener with listen instead of net.Listen, for example to reuse a listener
+// inherited from another process.
+func WithListenFunc(listen func(network string, addr string) (net.Listener, error)) ServerOption {
+	return func(b *Server) {
+		b.listen = listen
+	}
+}
+
 func WithAPIs(apis []rpc.API) ServerOption {
 	return func(b *Server) {
 		b.apis = apis
@@ -131,7 +140,8 @@ func NewServer(host string, port int, appVersion string, opts ...ServerOption) *
 		httpServer: &http.Server{
 			Addr: endpoint,
 		},
-		log: log.Root(),
+		log:    log.Root(),
+		listen: net.Listen,
 	}
 	for _, opt := range opts {
 		opt(bs)
@@ -180,14 +190,18 @@ func (b *Server) Start() error {
 	handler = oplog.NewLoggingMiddleware(b.log, handler)
 	b.httpServer.Handler = handler
 
+	listener, err := b.listen("tcp", b.endpoint)
+	if err != nil {
+		return fmt.Errorf("http server failed: %w", err)
+	}
 	errCh := make(chan error, 1)
 	go func() {
 		if b.tls != nil {
-			if err := b.httpServer.ListenAndServeTLS("", ""); err != nil {
+			if err := b.httpServer.ServeTLS(listener, "", ""); err != nil {
 				errCh <- err
 			}
 		} else {
-			if err := b.httpServer.ListenAndServe(); err != nil {
+			if err := b.httpServer.Serve(listener); err != nil {
 				errCh <- err
 			}
 		}
@@ -30,6 +30,11 @@ func (c *LRUCache[K, V]) Add(key K, value V) (evicted bool) {
 	return evicted
 }
 
+// Contains returns true if the key is in the cache, without updating its recency or recording metrics.
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	return c.inner.Contains(key)
+}
+
 // NewLRUCache creates a LRU cache with the given metrics, labeling the cache adds/gets.
 // Metrics are optional: no metrics will be tracked if m == nil.
 func NewLRUCache[K comparable, V any](m Metrics, label string, maxSize int) *LRUCache[K, V] {
@@ -2,6 +2,7 @@ package sources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -16,20 +17,46 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+const (
+	// DefaultEngineCallTimeout is the default time limit for each attempt of an engine API call.
+	DefaultEngineCallTimeout = 5 * time.Second
+	// DefaultEngineCallAttempts is the default maximum number of attempts of an engine API call.
+	DefaultEngineCallAttempts = 2
+
+	// engineRetryDelay is the delay before retrying a failed engine API call.
+	engineRetryDelay = 100 * time.Millisecond
+)
+
 type EngineClientConfig struct {
 	L2ClientConfig
+
+	// ForkchoiceUpdateTimeout, NewPayloadTimeout and GetPayloadTimeout limit the duration of each attempt of the
+	// corresponding engine API call. No limit is applied if 0.
+	ForkchoiceUpdateTimeout time.Duration
+	NewPayloadTimeout       time.Duration
+	GetPayloadTimeout       time.Duration
+
+	// CallAttempts is the maximum number of times an engine API call is attempted.
+	// Only failures to get a response, such as timeouts, are retried. Errors returned by the engine are not.
+	CallAttempts int
 }
 
 func EngineClientDefaultConfig(config *rollup.Config) *EngineClientConfig {
 	return &EngineClientConfig{
 		// engine is trusted, no need to recompute responses etc.
-		L2ClientConfig: *L2ClientDefaultConfig(config, true),
+		L2ClientConfig:          *L2ClientDefaultConfig(config, true),
+		ForkchoiceUpdateTimeout: DefaultEngineCallTimeout,
+		NewPayloadTimeout:       DefaultEngineCallTimeout,
+		GetPayloadTimeout:       DefaultEngineCallTimeout,
+		CallAttempts:            DefaultEngineCallAttempts,
 	}
 }
 
 // EngineClient extends L2Client with engine API bindings.
 type EngineClient struct {
 	*L2Client
+
+	config EngineClientConfig
 }
 
 func NewEngineClient(client client.RPC, log log.Logger, metrics caching.Metrics, config *EngineClientConfig) (*EngineClient, error) {
@@ -40,9 +67,46 @@ func NewEngineClient(client client.RPC, log log.Logger, metrics caching.Metrics,
 
 	return &EngineClient{
 		L2Client: l2Client,
+		config:   *config,
 	}, nil
 }
 
+// callEngine calls the engine API method, limiting each attempt to timeout. Calls that fail without a response from
+// the engine, such as timeouts, are retried up to the configured number of attempts. Errors returned by the engine
+// are returned immediately, as are any errors once ctx is done.
+func (s *EngineClient) callEngine(ctx context.Context, timeout time.Duration, result any, method string, args ...any) error {
+	attempts := max(s.config.CallAttempts, 1)
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			s.log.Warn("Retrying engine API call", "method", method, "attempt", i+1, "err", err)
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(engineRetryDelay):
+			}
+		}
+		err = s.callWithTimeout(ctx, timeout, result, method, args...)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *EngineClient) callWithTimeout(ctx context.Context, timeout time.Duration, result any, method string, args ...any) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return s.client.CallContext(ctx, result, method, args...)
+}
+
 // ForkchoiceUpdate updates the forkchoice on the execution client. If attributes is not nil, the engine client will also begin building a block
 // based on attributes after the new head block and return the payload ID.
 //
@@ -54,10 +118,8 @@ func (s *EngineClient) ForkchoiceUpdate(ctx context.Context, fc *eth.ForkchoiceS
 	llog := s.log.New("state", fc)       // local logger
 	tlog := llog.New("attr", attributes) // trace logger
 	tlog.Trace("Sharing forkchoice-updated signal")
-	fcCtx, cancel := context.WithTimeout(ctx, time.Second*5)
-	defer cancel()
 	var result eth.ForkchoiceUpdatedResult
-	err := s.client.CallContext(fcCtx, &result, "engine_forkchoiceUpdatedV2", fc, attributes)
+	err := s.callEngine(ctx, s.config.ForkchoiceUpdateTimeout, &result, "engine_forkchoiceUpdatedV2", fc, attributes)
 	if err == nil {
 		tlog.Trace("Shared forkchoice-updated signal")
 		if attributes != nil { // block building is optional, we only get a payload ID if we are building a block
@@ -89,10 +151,8 @@ func (s *EngineClient) NewPayload(ctx context.Context, payload *eth.ExecutionPay
 	e := s.log.New("block_hash", payload.BlockHash)
 	e.Trace("sending payload for execution")
 
-	execCtx, cancel := context.WithTimeout(ctx, time.Second*5)
-	defer cancel()
 	var result eth.PayloadStatusV1
-	err := s.client.CallContext(execCtx, &result, "engine_newPayloadV2", payload)
+	err := s.callEngine(ctx, s.config.NewPayloadTimeout, &result, "engine_newPayloadV2", payload)
 	e.Trace("Received payload execution result", "status", result.Status, "latestValidHash", result.LatestValidHash, "message", result.ValidationError)
 	if err != nil {
 		e.Error("Payload execution failed", "err", err)
@@ -109,7 +169,7 @@ func (s *EngineClient) GetPayload(ctx context.Context, payloadId eth.PayloadID)
 	e := s.log.New("payload_id", payloadId)
 	e.Trace("getting payload")
 	var result eth.ExecutionPayloadEnvelope
-	err := s.client.CallContext(ctx, &result, "engine_getPayloadV2", payloadId)
+	err := s.callEngine(ctx, s.config.GetPayloadTimeout, &result, "engine_getPayloadV2", payloadId)
 	if err != nil {
 		e.Warn("Failed to get payload", "payload_id", payloadId, "err", err)
 		if rpcErr, ok := err.(rpc.Error); ok {
@@ -0,0 +1,79 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type testRPCError struct {
+	code int
+}
+
+func (e testRPCError) Error() string {
+	return "rpc error"
+}
+
+func (e testRPCError) ErrorCode() int {
+	return e.code
+}
+
+func newTestEngineClient(t *testing.T, m *mockRPC, attempts int) *EngineClient {
+	cfg := EngineClientDefaultConfig(&rollup.Config{})
+	cfg.CallAttempts = attempts
+	s, err := NewEngineClient(m, testlog.Logger(t, log.LvlInfo), nil, cfg)
+	require.NoError(t, err)
+	return s
+}
+
+func TestEngineClient_RetriesFailedCalls(t *testing.T) {
+	m := new(mockRPC)
+	payload := &eth.ExecutionPayload{BlockNumber: 5}
+	m.On("CallContext", mock.Anything, new(eth.PayloadStatusV1), "engine_newPayloadV2", []any{payload}).
+		Return([]error{context.DeadlineExceeded}).Once()
+	m.On("CallContext", mock.Anything, new(eth.PayloadStatusV1), "engine_newPayloadV2", []any{payload}).
+		Run(func(args mock.Arguments) {
+			args[1].(*eth.PayloadStatusV1).Status = eth.ExecutionValid
+		}).
+		Return([]error{nil}).Once()
+
+	s := newTestEngineClient(t, m, 2)
+	status, err := s.NewPayload(context.Background(), payload)
+	require.NoError(t, err)
+	require.Equal(t, eth.ExecutionValid, status.Status)
+	m.AssertExpectations(t)
+}
+
+func TestEngineClient_BoundsRetries(t *testing.T) {
+	m := new(mockRPC)
+	id := eth.PayloadID{0x01}
+	m.On("CallContext", mock.Anything, new(eth.ExecutionPayloadEnvelope), "engine_getPayloadV2", []any{id}).
+		Return([]error{context.DeadlineExceeded}).Times(3)
+
+	s := newTestEngineClient(t, m, 3)
+	_, err := s.GetPayload(context.Background(), id)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	m.AssertExpectations(t)
+}
+
+func TestEngineClient_DoesNotRetryEngineErrors(t *testing.T) {
+	m := new(mockRPC)
+	fc := &eth.ForkchoiceState{}
+	m.On("CallContext", mock.Anything, new(eth.ForkchoiceUpdatedResult), "engine_forkchoiceUpdatedV2", []any{fc, (*eth.PayloadAttributes)(nil)}).
+		Return([]error{testRPCError{code: int(eth.InvalidForkchoiceState)}}).Once()
+
+	s := newTestEngineClient(t, m, 3)
+	_, err := s.ForkchoiceUpdate(context.Background(), fc, nil)
+	var inputErr eth.InputError
+	require.True(t, errors.As(err, &inputErr))
+	require.Equal(t, eth.InvalidForkchoiceState, inputErr.Code)
+	m.AssertExpectations(t)
+}
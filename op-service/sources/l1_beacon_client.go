@@ -0,0 +1,81 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	finalityCheckpointsMethod = "eth/v1/beacon/states/head/finality_checkpoints"
+	blockMethodPrefix         = "eth/v2/beacon/blocks/"
+)
+
+var (
+	ErrNoFinalizedCheckpoint = errors.New("beacon chain has not finalized a checkpoint")
+	ErrNoExecutionPayload    = errors.New("finalized beacon block has no execution payload")
+)
+
+// L1BeaconClient reads data from the standard beacon node API of an L1 consensus client.
+type L1BeaconClient struct {
+	addr string
+	cl   *http.Client
+}
+
+// NewL1BeaconClient returns a client for the beacon node API served at addr.
+func NewL1BeaconClient(addr string) *L1BeaconClient {
+	return &L1BeaconClient{
+		addr: strings.TrimSuffix(addr, "/") + "/",
+		cl:   http.DefaultClient,
+	}
+}
+
+func (cl *L1BeaconClient) apiReq(ctx context.Context, dest any, method string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cl.addr+method, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := cl.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf("http Get failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed request with status %d: %s", resp.StatusCode, method)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", method, err)
+	}
+	return nil
+}
+
+// FinalizedExecutionBlock returns the execution block included in the beacon block of the latest finalized checkpoint.
+// The beacon node's view of finality is derived from validator attestations rather than being reported by the
+// execution client, so it can be used to verify the finalized block reported by an untrusted L1 execution RPC.
+func (cl *L1BeaconClient) FinalizedExecutionBlock(ctx context.Context) (eth.BlockID, error) {
+	var checkpoints eth.APIFinalityCheckpointsResponse
+	if err := cl.apiReq(ctx, &checkpoints, finalityCheckpointsMethod); err != nil {
+		return eth.BlockID{}, err
+	}
+	root := checkpoints.Data.Finalized.Root
+	if root == (common.Hash{}) {
+		return eth.BlockID{}, ErrNoFinalizedCheckpoint
+	}
+	var block eth.APIGetBlockResponse
+	if err := cl.apiReq(ctx, &block, blockMethodPrefix+root.Hex()); err != nil {
+		return eth.BlockID{}, err
+	}
+	payload := block.Data.Message.Body.ExecutionPayload
+	if payload == nil {
+		return eth.BlockID{}, fmt.Errorf("%w: %v", ErrNoExecutionPayload, root)
+	}
+	return eth.BlockID{Hash: payload.BlockHash, Number: uint64(payload.BlockNumber)}, nil
+}
@@ -0,0 +1,68 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func TestL1BeaconClientFinalizedExecutionBlock(t *testing.T) {
+	root := common.Hash{0xaa}
+	blockHash := common.Hash{0xbb}
+	responses := map[string]string{
+		"/" + finalityCheckpointsMethod: `{"data":{
+			"previous_justified":{"epoch":"11","root":"0x00000000000000000000000000000000000000000000000000000000000000cc"},
+			"current_justified":{"epoch":"12","root":"0x00000000000000000000000000000000000000000000000000000000000000dd"},
+			"finalized":{"epoch":"10","root":"` + root.Hex() + `"}}}`,
+		"/" + blockMethodPrefix + root.Hex(): `{"version":"capella","data":{"message":{"slot":"320","body":{
+			"execution_payload":{"block_hash":"` + blockHash.Hex() + `","block_number":"1234"}}}}}`,
+	}
+	setup := func(t *testing.T, responses map[string]string) *L1BeaconClient {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			resp, ok := responses[r.URL.Path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write([]byte(resp))
+		}))
+		t.Cleanup(srv.Close)
+		return NewL1BeaconClient(srv.URL)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		cl := setup(t, responses)
+		block, err := cl.FinalizedExecutionBlock(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, eth.BlockID{Hash: blockHash, Number: 1234}, block)
+	})
+
+	t.Run("NotFinalized", func(t *testing.T) {
+		cl := setup(t, map[string]string{
+			"/" + finalityCheckpointsMethod: `{"data":{"finalized":{"epoch":"0","root":"` + (common.Hash{}).Hex() + `"}}}`,
+		})
+		_, err := cl.FinalizedExecutionBlock(context.Background())
+		require.ErrorIs(t, err, ErrNoFinalizedCheckpoint)
+	})
+
+	t.Run("PreMerge", func(t *testing.T) {
+		cl := setup(t, map[string]string{
+			"/" + finalityCheckpointsMethod:      responses["/"+finalityCheckpointsMethod],
+			"/" + blockMethodPrefix + root.Hex(): `{"version":"phase0","data":{"message":{"slot":"320","body":{}}}}`,
+		})
+		_, err := cl.FinalizedExecutionBlock(context.Background())
+		require.ErrorIs(t, err, ErrNoExecutionPayload)
+	})
+
+	t.Run("RequestFails", func(t *testing.T) {
+		cl := setup(t, map[string]string{})
+		_, err := cl.FinalizedExecutionBlock(context.Background())
+		require.ErrorContains(t, err, "404")
+	})
+}
@@ -56,6 +56,12 @@ func (t *TestDerivationMetrics) RecordFrame() {
 func (n *TestDerivationMetrics) RecordDerivedBatches(batchType string) {
 }
 
+func (n *TestDerivationMetrics) RecordDerivedChannel(compression string) {
+}
+
+func (n *TestDerivationMetrics) RecordChannelReaderError(reason string) {
+}
+
 type TestRPCMetrics struct{}
 
 func (n *TestRPCMetrics) RecordRPCServerRequest(method string) func() {
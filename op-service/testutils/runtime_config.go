@@ -4,8 +4,16 @@ import "github.com/ethereum/go-ethereum/common"
 
 type MockRuntimeConfig struct {
 	P2PSeqAddress common.Address
+
+	PrevP2PSeqAddress common.Address
+	P2PRotationAfter  uint64
+	P2PRotationBefore uint64
 }
 
 func (m *MockRuntimeConfig) P2PSequencerAddress() common.Address {
 	return m.P2PSeqAddress
 }
+
+func (m *MockRuntimeConfig) P2PSignerRotation() (common.Address, uint64, uint64) {
+	return m.PrevP2PSeqAddress, m.P2PRotationAfter, m.P2PRotationBefore
+}
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"errors"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	EnabledFlagName     = "tracing.enabled"
+	EndpointFlagName    = "tracing.endpoint"
+	InsecureFlagName    = "tracing.insecure"
+	SampleRatioFlagName = "tracing.sample-ratio"
+	defaultEndpoint     = "localhost:4318"
+	defaultSampleRatio  = 1.0
+)
+
+func DefaultCLIConfig() CLIConfig {
+	return CLIConfig{
+		Enabled:     false,
+		Endpoint:    defaultEndpoint,
+		SampleRatio: defaultSampleRatio,
+	}
+}
+
+func CLIFlags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:    EnabledFlagName,
+			Usage:   "Enable OpenTelemetry tracing, exported via OTLP over HTTP",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "TRACING_ENABLED"),
+		},
+		&cli.StringFlag{
+			Name:    EndpointFlagName,
+			Usage:   "Host and port of the OTLP HTTP collector to export traces to",
+			Value:   defaultEndpoint,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "TRACING_ENDPOINT"),
+		},
+		&cli.BoolFlag{
+			Name:    InsecureFlagName,
+			Usage:   "Export traces over HTTP instead of HTTPS",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "TRACING_INSECURE"),
+		},
+		&cli.Float64Flag{
+			Name:    SampleRatioFlagName,
+			Usage:   "Fraction of traces to sample, between 0 and 1",
+			Value:   defaultSampleRatio,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "TRACING_SAMPLE_RATIO"),
+		},
+	}
+}
+
+type CLIConfig struct {
+	Enabled     bool
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64
+}
+
+func (c CLIConfig) Check() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Endpoint == "" {
+		return errors.New("tracing endpoint must be set")
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return errors.New("tracing sample ratio must be between 0 and 1")
+	}
+	return nil
+}
+
+func ReadCLIConfig(ctx *cli.Context) CLIConfig {
+	return CLIConfig{
+		Enabled:     ctx.Bool(EnabledFlagName),
+		Endpoint:    ctx.String(EndpointFlagName),
+		Insecure:    ctx.Bool(InsecureFlagName),
+		SampleRatio: ctx.Float64(SampleRatioFlagName),
+	}
+}
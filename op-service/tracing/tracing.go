@@ -0,0 +1,55 @@
+// Package tracing configures OpenTelemetry tracing for services.
+//
+// Services call Start once during initialisation to install the global tracer provider. Packages then create
+// spans from a tracer obtained with otel.Tracer. Until Start is called, or if tracing is disabled, spans are no-ops.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ShutdownFunc flushes any buffered spans and stops exporting.
+type ShutdownFunc func(ctx context.Context) error
+
+// Start installs a global tracer provider that exports spans to the OTLP collector in cfg.
+// If tracing is disabled, the global provider is left unchanged and the returned ShutdownFunc does nothing.
+func Start(ctx context.Context, cfg CLIConfig, serviceName string, version string) (ShutdownFunc, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version))
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// EndSpan records err on span, if it is not nil, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
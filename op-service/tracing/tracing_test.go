@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestEndSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, span := tracer.Start(context.Background(), "success")
+	EndSpan(span, nil)
+	_, span = tracer.Start(context.Background(), "failure")
+	EndSpan(span, errors.New("boom"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, codes.Unset, spans[0].Status().Code)
+	require.Empty(t, spans[0].Events())
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+	require.Equal(t, "boom", spans[1].Status().Description)
+	require.Len(t, spans[1].Events(), 1)
+}
+
+func TestStartDisabled(t *testing.T) {
+	shutdown, err := Start(context.Background(), DefaultCLIConfig(), "test", "v0.0.0")
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestCheck(t *testing.T) {
+	cfg := DefaultCLIConfig()
+	require.NoError(t, cfg.Check())
+
+	cfg.Enabled = true
+	require.NoError(t, cfg.Check())
+
+	cfg.Endpoint = ""
+	require.ErrorContains(t, cfg.Check(), "endpoint")
+
+	cfg = DefaultCLIConfig()
+	cfg.Enabled = true
+	cfg.SampleRatio = -0.1
+	require.ErrorContains(t, cfg.Check(), "sample ratio")
+}
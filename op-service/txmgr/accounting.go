@@ -0,0 +1,107 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// UnlabelledAction is the label gas usage is recorded against when a TxCandidate does not specify one.
+const UnlabelledAction = "unlabelled"
+
+// GasUsage is the total gas used and ETH spent by confirmed transactions for a single action label.
+type GasUsage struct {
+	Txs     hexutil.Uint64 `json:"txs"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	// Cost is the total fee paid, in wei.
+	Cost *hexutil.Big `json:"cost"`
+}
+
+// GasUsageSource provides a summary of the gas used by transactions, keyed by action label.
+type GasUsageSource interface {
+	GasUsage() map[string]GasUsage
+}
+
+// gasAccounting tracks gas used and fees paid per action label.
+type gasAccounting struct {
+	lock  sync.Mutex
+	usage map[string]GasUsage
+}
+
+func (a *gasAccounting) record(label string, receipt *types.Receipt) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.usage == nil {
+		a.usage = make(map[string]GasUsage)
+	}
+	usage := a.usage[label]
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), metrics.ReceiptGasPrice(receipt))
+	if usage.Cost != nil {
+		cost.Add(cost, usage.Cost.ToInt())
+	}
+	a.usage[label] = GasUsage{
+		Txs:     usage.Txs + 1,
+		GasUsed: usage.GasUsed + hexutil.Uint64(receipt.GasUsed),
+		Cost:    (*hexutil.Big)(cost),
+	}
+}
+
+func (a *gasAccounting) summary() map[string]GasUsage {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	summary := make(map[string]GasUsage, len(a.usage))
+	for label, usage := range a.usage {
+		usage.Cost = (*hexutil.Big)(new(big.Int).Set(usage.Cost.ToInt()))
+		summary[label] = usage
+	}
+	return summary
+}
+
+// CombineGasUsage sums the gas usage reported by each of sources, keyed by action label.
+func CombineGasUsage(sources ...GasUsageSource) map[string]GasUsage {
+	combined := make(map[string]GasUsage)
+	for _, source := range sources {
+		for label, usage := range source.GasUsage() {
+			total := combined[label]
+			cost := new(big.Int).Set(usage.Cost.ToInt())
+			if total.Cost != nil {
+				cost.Add(cost, total.Cost.ToInt())
+			}
+			combined[label] = GasUsage{
+				Txs:     total.Txs + usage.Txs,
+				GasUsed: total.GasUsed + usage.GasUsed,
+				Cost:    (*hexutil.Big)(cost),
+			}
+		}
+	}
+	return combined
+}
+
+func candidateLabel(candidate TxCandidate) string {
+	if candidate.Label == "" {
+		return UnlabelledAction
+	}
+	return candidate.Label
+}
+
+type gasUsageAPI struct {
+	source GasUsageSource
+}
+
+// GasUsage returns the gas used and ETH spent by confirmed transactions, keyed by action label.
+func (a *gasUsageAPI) GasUsage(_ context.Context) (map[string]GasUsage, error) {
+	return a.source.GasUsage(), nil
+}
+
+// GetGasUsageAPI returns the txmgr RPC namespace which exposes txmgr_gasUsage.
+func GetGasUsageAPI(source GasUsageSource) rpc.API {
+	return rpc.API{
+		Namespace: "txmgr",
+		Service:   &gasUsageAPI{source: source},
+	}
+}
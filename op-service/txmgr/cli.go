@@ -35,6 +35,8 @@ const (
 	TxSendTimeoutFlagName             = "txmgr.send-timeout"
 	TxNotInMempoolTimeoutFlagName     = "txmgr.not-in-mempool-timeout"
 	ReceiptQueryIntervalFlagName      = "txmgr.receipt-query-interval"
+	PrivateRelayURLFlagName           = "txmgr.private-relay-url"
+	PrivateRelayTimeoutFlagName       = "txmgr.private-relay-timeout"
 )
 
 var (
@@ -62,6 +64,7 @@ type DefaultFlagValues struct {
 	TxSendTimeout             time.Duration
 	TxNotInMempoolTimeout     time.Duration
 	ReceiptQueryInterval      time.Duration
+	PrivateRelayTimeout       time.Duration
 }
 
 var (
@@ -75,6 +78,7 @@ var (
 		TxSendTimeout:             0 * time.Second,
 		TxNotInMempoolTimeout:     2 * time.Minute,
 		ReceiptQueryInterval:      12 * time.Second,
+		PrivateRelayTimeout:       2 * time.Minute,
 	}
 	DefaultChallengerFlagValues = DefaultFlagValues{
 		NumConfirmations:          uint64(3),
@@ -86,6 +90,7 @@ var (
 		TxSendTimeout:             2 * time.Minute,
 		TxNotInMempoolTimeout:     1 * time.Minute,
 		ReceiptQueryInterval:      12 * time.Second,
+		PrivateRelayTimeout:       1 * time.Minute,
 	}
 )
 
@@ -167,6 +172,17 @@ func CLIFlagsWithDefaults(envPrefix string, defaults DefaultFlagValues) []cli.Fl
 			Value:   defaults.ReceiptQueryInterval,
 			EnvVars: prefixEnvVars("TXMGR_RECEIPT_QUERY_INTERVAL"),
 		},
+		&cli.StringFlag{
+			Name:    PrivateRelayURLFlagName,
+			Usage:   "RPC URL of a private transaction relay to submit sensitive transactions to instead of the public mempool. Disabled if not set.",
+			EnvVars: prefixEnvVars("TXMGR_PRIVATE_RELAY_URL"),
+		},
+		&cli.DurationFlag{
+			Name:    PrivateRelayTimeoutFlagName,
+			Usage:   "Duration a transaction is only submitted to the private relay before falling back to the public mempool",
+			Value:   defaults.PrivateRelayTimeout,
+			EnvVars: prefixEnvVars("TXMGR_PRIVATE_RELAY_TIMEOUT"),
+		},
 	}, opsigner.CLIFlags(envPrefix)...)
 }
 
@@ -187,6 +203,8 @@ type CLIConfig struct {
 	NetworkTimeout            time.Duration
 	TxSendTimeout             time.Duration
 	TxNotInMempoolTimeout     time.Duration
+	PrivateRelayURL           string
+	PrivateRelayTimeout       time.Duration
 }
 
 func NewCLIConfig(l1RPCURL string, defaults DefaultFlagValues) CLIConfig {
@@ -201,6 +219,7 @@ func NewCLIConfig(l1RPCURL string, defaults DefaultFlagValues) CLIConfig {
 		TxSendTimeout:             defaults.TxSendTimeout,
 		TxNotInMempoolTimeout:     defaults.TxNotInMempoolTimeout,
 		ReceiptQueryInterval:      defaults.ReceiptQueryInterval,
+		PrivateRelayTimeout:       defaults.PrivateRelayTimeout,
 		SignerCLIConfig:           opsigner.NewCLIConfig(),
 	}
 }
@@ -230,6 +249,9 @@ func (m CLIConfig) Check() error {
 	if m.SafeAbortNonceTooLowCount == 0 {
 		return errors.New("SafeAbortNonceTooLowCount must not be 0")
 	}
+	if m.PrivateRelayURL != "" && m.PrivateRelayTimeout == 0 {
+		return errors.New("must provide PrivateRelayTimeout when using a private relay")
+	}
 	if err := m.SignerCLIConfig.Check(); err != nil {
 		return err
 	}
@@ -254,6 +276,8 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 		NetworkTimeout:            ctx.Duration(NetworkTimeoutFlagName),
 		TxSendTimeout:             ctx.Duration(TxSendTimeoutFlagName),
 		TxNotInMempoolTimeout:     ctx.Duration(TxNotInMempoolTimeoutFlagName),
+		PrivateRelayURL:           ctx.String(PrivateRelayURLFlagName),
+		PrivateRelayTimeout:       ctx.Duration(PrivateRelayTimeoutFlagName),
 	}
 }
 
@@ -293,6 +317,17 @@ func NewConfig(cfg CLIConfig, l log.Logger) (Config, error) {
 		return Config{}, fmt.Errorf("invalid fee limit threshold: %v", thr)
 	}
 
+	var privateRelay TxPublisher
+	if cfg.PrivateRelayURL != "" {
+		ctx, cancel = context.WithTimeout(context.Background(), cfg.NetworkTimeout)
+		defer cancel()
+		relay, err := ethclient.DialContext(ctx, cfg.PrivateRelayURL)
+		if err != nil {
+			return Config{}, fmt.Errorf("could not dial private relay: %w", err)
+		}
+		privateRelay = relay
+	}
+
 	// convert float GWei value into integer Wei value
 	feeLimitThreshold, _ := new(big.Float).Mul(
 		big.NewFloat(cfg.FeeLimitThresholdGwei),
@@ -311,6 +346,8 @@ func NewConfig(cfg CLIConfig, l log.Logger) (Config, error) {
 		ReceiptQueryInterval:      cfg.ReceiptQueryInterval,
 		NumConfirmations:          cfg.NumConfirmations,
 		SafeAbortNonceTooLowCount: cfg.SafeAbortNonceTooLowCount,
+		PrivateRelay:              privateRelay,
+		PrivateRelayTimeout:       cfg.PrivateRelayTimeout,
 		Signer:                    signerFactory(chainID),
 		From:                      from,
 	}, nil
@@ -362,6 +399,15 @@ type Config struct {
 	// confirmation.
 	SafeAbortNonceTooLowCount uint64
 
+	// PrivateRelay, if set, is used to submit transactions that request private submission instead of the Backend,
+	// so they are not visible in the public mempool before they are included.
+	PrivateRelay TxPublisher
+
+	// PrivateRelayTimeout is how long a private transaction is only submitted to the PrivateRelay before it is
+	// also published to the public mempool. If the send has a deadline, the fallback happens early enough to leave
+	// the transaction PrivateRelayTimeout in the public mempool before the deadline.
+	PrivateRelayTimeout time.Duration
+
 	// Signer is used to sign transactions when the gas price is increased.
 	Signer opcrypto.SignerFn
 	From   common.Address
@@ -392,6 +438,9 @@ func (m Config) Check() error {
 	if m.SafeAbortNonceTooLowCount == 0 {
 		return errors.New("SafeAbortNonceTooLowCount must not be 0")
 	}
+	if m.PrivateRelay != nil && m.PrivateRelayTimeout == 0 {
+		return errors.New("must provide PrivateRelayTimeout when using a private relay")
+	}
 	if m.Signer == nil {
 		return errors.New("must provide the Signer")
 	}
@@ -22,6 +22,14 @@ func TestDefaultConfigIsValid(t *testing.T) {
 	require.NoError(t, cfg.Check())
 }
 
+func TestPrivateRelayRequiresTimeout(t *testing.T) {
+	cfg := NewCLIConfig(l1EthRpcValue, DefaultBatcherFlagValues)
+	cfg.PrivateRelayURL = "http://localhost:8545"
+	require.NoError(t, cfg.Check())
+	cfg.PrivateRelayTimeout = 0
+	require.ErrorContains(t, cfg.Check(), "PrivateRelayTimeout")
+}
+
 func configForArgs(args ...string) CLIConfig {
 	app := cli.NewApp()
 	// txmgr expects the --l1-eth-rpc option to be declared externally
@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"math/big"
+
 	"github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
@@ -14,6 +16,7 @@ type TxMetricer interface {
 	RecordNonce(uint64)
 	RecordPendingTx(pending int64)
 	TxConfirmed(*types.Receipt)
+	RecordTxUsage(label string, receipt *types.Receipt)
 	TxPublished(string)
 	RPCError()
 }
@@ -23,6 +26,8 @@ type TxMetrics struct {
 	txFees             prometheus.Counter
 	TxGasBump          prometheus.Gauge
 	txFeeHistogram     prometheus.Histogram
+	txGasUsedByLabel   *prometheus.CounterVec
+	txFeesByLabel      *prometheus.CounterVec
 	LatencyConfirmedTx prometheus.Gauge
 	currentNonce       prometheus.Gauge
 	pendingTxs         prometheus.Gauge
@@ -66,6 +71,18 @@ func MakeTxMetrics(ns string, factory metrics.Factory) TxMetrics {
 			Subsystem: "txmgr",
 			Buckets:   []float64{0.5, 1, 2, 5, 10, 20, 40, 60, 80, 100, 200, 400, 800, 1600},
 		}),
+		txGasUsedByLabel: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "tx_gas_used_total",
+			Help:      "Sum of gas used by confirmed transactions, labeled by the action the tx performed",
+			Subsystem: "txmgr",
+		}, []string{"label"}),
+		txFeesByLabel: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "tx_fee_gwei_by_label_total",
+			Help:      "Sum of fees spent by confirmed transactions in GWEI, labeled by the action the tx performed",
+			Subsystem: "txmgr",
+		}, []string{"label"}),
 		TxGasBump: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
 			Name:      "tx_gas_bump",
@@ -125,6 +142,22 @@ func (t *TxMetrics) TxConfirmed(receipt *types.Receipt) {
 
 }
 
+// RecordTxUsage attributes the gas used and fee paid by a confirmed transaction to label.
+func (t *TxMetrics) RecordTxUsage(label string, receipt *types.Receipt) {
+	fee := new(big.Float).Quo(new(big.Float).SetInt(new(big.Int).Mul(ReceiptGasPrice(receipt), new(big.Int).SetUint64(receipt.GasUsed))), big.NewFloat(params.GWei))
+	feeGwei, _ := fee.Float64()
+	t.txGasUsedByLabel.WithLabelValues(label).Add(float64(receipt.GasUsed))
+	t.txFeesByLabel.WithLabelValues(label).Add(feeGwei)
+}
+
+// ReceiptGasPrice returns the effective gas price of the receipt, or zero if the node did not report it.
+func ReceiptGasPrice(receipt *types.Receipt) *big.Int {
+	if receipt.EffectiveGasPrice == nil {
+		return new(big.Int)
+	}
+	return receipt.EffectiveGasPrice
+}
+
 func (t *TxMetrics) RecordGasBumpCount(times int) {
 	t.TxGasBump.Set(float64(times))
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/ethereum-optimism/optimism/op-service/retry"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
@@ -33,6 +34,9 @@ var (
 	ninetyNine       = big.NewInt(99)
 )
 
+// ErrTxCancelled is returned by Send when the tx was cancelled through TxCandidate.Cancel and replaced onchain.
+var ErrTxCancelled = errors.New("tx cancelled")
+
 // TxManager is an interface that allows callers to reliably publish txs,
 // bumping the gas price if needed, and obtain the receipt of the resulting tx.
 //
@@ -90,6 +94,13 @@ type ETHBackend interface {
 	Close()
 }
 
+// TxPublisher submits signed transactions without waiting for them to be included.
+// A private transaction relay only needs to support submitting transactions; inclusion is still checked with the
+// [ETHBackend].
+type TxPublisher interface {
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
 // SimpleTxManager is a implementation of TxManager that performs linear fee
 // bumping of a tx until it confirms.
 type SimpleTxManager struct {
@@ -105,6 +116,8 @@ type SimpleTxManager struct {
 	nonceLock sync.RWMutex
 
 	pending atomic.Int64
+
+	usage gasAccounting
 }
 
 // NewSimpleTxManager initializes a new SimpleTxManager with the passed Config.
@@ -141,6 +154,9 @@ func (m *SimpleTxManager) BlockNumber(ctx context.Context) (uint64, error) {
 
 func (m *SimpleTxManager) Close() {
 	m.backend.Close()
+	if relay, ok := m.cfg.PrivateRelay.(interface{ Close() }); ok {
+		relay.Close()
+	}
 }
 
 // TxCandidate is a transaction candidate that can be submitted to ask the
@@ -154,6 +170,16 @@ type TxCandidate struct {
 	GasLimit uint64
 	// Value is the value to be used in the constructed tx.
 	Value *big.Int
+	// Label identifies the logical action the tx performs (e.g. move, step, batch) so gas usage
+	// can be attributed to it. Empty means the usage is recorded as unlabelled.
+	Label string
+	// Private requests that the tx is submitted through the private relay, if one is configured, to avoid it being
+	// frontrun while in the public mempool. The tx falls back to the public mempool if it is not included in time.
+	Private bool
+	// Cancel, if set, cancels the tx when closed. The pending tx is replaced by a 0 value transfer to the sender with
+	// the same nonce and bumped fees, so it can no longer be included. Send returns ErrTxCancelled once the replacement
+	// is included, or the receipt of the tx if it was included first.
+	Cancel <-chan struct{}
 }
 
 // Send is used to publish a transaction with incrementally higher gas prices
@@ -171,10 +197,23 @@ func (m *SimpleTxManager) Send(ctx context.Context, candidate TxCandidate) (*typ
 		m.metr.RecordPendingTx(m.pending.Add(-1))
 	}()
 	receipt, err := m.send(ctx, candidate)
+	if receipt != nil {
+		// Cancellation txs are attributed to the action of the tx they replaced.
+		label := candidateLabel(candidate)
+		m.usage.record(label, receipt)
+		m.metr.RecordTxUsage(label, receipt)
+	}
 	if err != nil {
 		m.resetNonce()
+		return nil, err
 	}
-	return receipt, err
+	return receipt, nil
+}
+
+// GasUsage returns the gas used and ETH spent by transactions confirmed by this tx manager, keyed by
+// the label of the TxCandidate that created them.
+func (m *SimpleTxManager) GasUsage() map[string]GasUsage {
+	return m.usage.summary()
 }
 
 // send performs the actual transaction creation and sending.
@@ -194,7 +233,7 @@ func (m *SimpleTxManager) send(ctx context.Context, candidate TxCandidate) (*typ
 	if err != nil {
 		return nil, fmt.Errorf("failed to create the tx: %w", err)
 	}
-	return m.sendTx(ctx, tx)
+	return m.sendTx(ctx, tx, candidate.Private, candidate.Cancel)
 }
 
 // craftTx creates the signed transaction
@@ -290,18 +329,41 @@ func (m *SimpleTxManager) resetNonce() {
 
 // send submits the same transaction several times with increasing gas prices as necessary.
 // It waits for the transaction to be confirmed on chain.
-func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+// If private is set and a private relay is configured, the transaction is only submitted to the private relay until
+// the fallback delay has passed, after which it is also submitted to the public mempool.
+// If cancelTx is closed before the transaction is included, it is replaced by a cancellation transaction. The receipt
+// of the cancellation transaction is returned with ErrTxCancelled if it is included.
+func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction, private bool, cancelTx <-chan struct{}) (*types.Receipt, error) {
 	var wg sync.WaitGroup
 	defer wg.Wait()
+
+	var fallback <-chan time.Time
+	if private {
+		if delay := m.privateRelayFallbackDelay(ctx); delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			fallback = timer.C
+		} else {
+			private = false
+		}
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	sendState := NewSendState(m.cfg.SafeAbortNonceTooLowCount, m.cfg.TxNotInMempoolTimeout)
 	receiptChan := make(chan *types.Receipt, 1)
+	// cancelling is set once the tx is cancelled, after which only cancellation txs are published.
+	// cancellations records the hashes of the published cancellation txs.
+	var cancelRequested, cancelling bool
+	cancellations := make(map[common.Hash]bool)
 	publishAndWait := func(tx *types.Transaction, bumpFees bool) *types.Transaction {
 		wg.Add(1)
-		tx, published := m.publishTx(ctx, tx, sendState, bumpFees)
+		tx, published := m.publishTx(ctx, tx, sendState, bumpFees, private)
 		if published {
+			if cancelling {
+				cancellations[tx.Hash()] = true
+			}
 			go func() {
 				defer wg.Done()
 				m.waitForTx(ctx, tx, sendState, receiptChan)
@@ -312,6 +374,22 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 		return tx
 	}
 
+	// replaceWithCancellation publishes a cancellation tx to replace tx. If it can't be created, it is retried at the
+	// next resubmission.
+	replaceWithCancellation := func() {
+		cancellation, err := m.cancellationTx(ctx, tx)
+		if err != nil {
+			m.l.Warn("Failed to create cancellation tx, will retry", "hash", tx.Hash(), "err", err)
+			return
+		}
+		m.l.Info("Cancelling tx", "hash", tx.Hash(), "nonce", tx.Nonce())
+		cancelling = true
+		// The cancellation tx must replace the tx in the public mempool.
+		private = false
+		fallback = nil
+		tx = publishAndWait(cancellation, false)
+	}
+
 	// Immediately publish a transaction before starting the resumbission loop
 	tx = publishAndWait(tx, false)
 
@@ -330,25 +408,92 @@ func (m *SimpleTxManager) sendTx(ctx context.Context, tx *types.Transaction) (*t
 				m.l.Warn("Aborting transaction submission")
 				return nil, errors.New("aborted transaction sending")
 			}
+			if cancelRequested && !cancelling {
+				replaceWithCancellation()
+				continue
+			}
 			tx = publishAndWait(tx, true)
 
+		case <-cancelTx:
+			cancelTx = nil
+			cancelRequested = true
+			// Once the tx is included it can no longer be replaced, so its receipt is returned as normal.
+			if !sendState.IsWaitingForConfirmation() {
+				replaceWithCancellation()
+			}
+
+		case <-fallback:
+			m.l.Warn("Private transaction not included in time, publishing to public mempool", "hash", tx.Hash())
+			private = false
+			fallback = nil
+			if !sendState.IsWaitingForConfirmation() {
+				tx = publishAndWait(tx, false)
+			}
+
 		case <-ctx.Done():
 			return nil, ctx.Err()
 
 		case receipt := <-receiptChan:
 			m.metr.RecordGasBumpCount(sendState.bumpCount)
 			m.metr.TxConfirmed(receipt)
+			if cancellations[receipt.TxHash] {
+				return receipt, ErrTxCancelled
+			}
 			return receipt, nil
 		}
 	}
 }
 
-// publishTx publishes the transaction to the transaction pool. If it receives any underpriced errors
-// it will bump the fees and retry.
+// cancellationTx returns a 0 value transfer to the sender with the same nonce as tx and fees bumped enough to
+// replace it in the mempool.
+func (m *SimpleTxManager) cancellationTx(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	tip, basefee, err := m.suggestGasPriceCaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bumpedTip, bumpedFee := updateFees(tx.GasTipCap(), tx.GasFeeCap(), tip, basefee, m.l)
+	if err := m.checkLimits(tip, basefee, bumpedTip, bumpedFee); err != nil {
+		return nil, err
+	}
+	to := m.cfg.From
+	rawTx := &types.DynamicFeeTx{
+		ChainID:   tx.ChainId(),
+		Nonce:     tx.Nonce(),
+		GasTipCap: bumpedTip,
+		GasFeeCap: bumpedFee,
+		Gas:       params.TxGas,
+		To:        &to,
+		Value:     big.NewInt(0),
+	}
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	defer cancel()
+	return m.cfg.Signer(ctx, m.cfg.From, types.NewTx(rawTx))
+}
+
+// privateRelayFallbackDelay returns how long a private transaction is only submitted to the private relay.
+// This is PrivateRelayTimeout, shortened if needed so the transaction has at least PrivateRelayTimeout in the public
+// mempool before the ctx deadline. Returns 0 if there is no private relay or no time to use it.
+func (m *SimpleTxManager) privateRelayFallbackDelay(ctx context.Context) time.Duration {
+	if m.cfg.PrivateRelay == nil {
+		return 0
+	}
+	delay := m.cfg.PrivateRelayTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		delay = min(delay, time.Until(deadline)-m.cfg.PrivateRelayTimeout)
+	}
+	return max(delay, 0)
+}
+
+// publishTx publishes the transaction to the transaction pool, or to the private relay if private is set.
+// If it receives any underpriced errors it will bump the fees and retry.
 // Returns the latest fee bumped tx, and a boolean indicating whether the tx was sent or not
-func (m *SimpleTxManager) publishTx(ctx context.Context, tx *types.Transaction, sendState *SendState, bumpFeesImmediately bool) (*types.Transaction, bool) {
+func (m *SimpleTxManager) publishTx(ctx context.Context, tx *types.Transaction, sendState *SendState, bumpFeesImmediately bool, private bool) (*types.Transaction, bool) {
+	var publisher TxPublisher = m.backend
+	if private {
+		publisher = m.cfg.PrivateRelay
+	}
 	updateLogFields := func(tx *types.Transaction) log.Logger {
-		return m.l.New("hash", tx.Hash(), "nonce", tx.Nonce(), "gasTipCap", tx.GasTipCap(), "gasFeeCap", tx.GasFeeCap())
+		return m.l.New("hash", tx.Hash(), "nonce", tx.Nonce(), "gasTipCap", tx.GasTipCap(), "gasFeeCap", tx.GasFeeCap(), "private", private)
 	}
 	l := updateLogFields(tx)
 
@@ -375,7 +520,7 @@ func (m *SimpleTxManager) publishTx(ctx context.Context, tx *types.Transaction,
 		}
 
 		cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
-		err := m.backend.SendTransaction(cCtx, tx)
+		err := publisher.SendTransaction(cCtx, tx)
 		cancel()
 		sendState.ProcessSendError(err)
 
@@ -16,6 +16,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
@@ -24,6 +25,10 @@ import (
 
 type sendTransactionFunc func(ctx context.Context, tx *types.Transaction) error
 
+func (f sendTransactionFunc) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return f(ctx, tx)
+}
+
 func testSendState() *SendState {
 	return NewSendState(100, time.Hour)
 }
@@ -293,7 +298,7 @@ func TestTxMgrConfirmAtMinGasPrice(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, false, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -321,11 +326,88 @@ func TestTxMgrNeverConfirmCancel(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, false, nil)
 	require.Equal(t, err, context.DeadlineExceeded)
 	require.Nil(t, receipt)
 }
 
+// TestTxMgrCancelReplacesTx asserts that cancelling a pending tx replaces it with a 0 value transfer to the sender
+// at the same nonce, and that ErrTxCancelled is returned with its receipt once it is mined.
+func TestTxMgrCancelReplacesTx(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	gasTipCap, gasFeeCap := h.gasPricer.sample()
+	inbox := common.Address{0xaa}
+	tx := types.NewTx(&types.DynamicFeeTx{
+		Nonce:     5,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		To:        &inbox,
+		Data:      []byte{0x01},
+	})
+	cancelTx := make(chan struct{})
+	var cancellation *types.Transaction
+	h.backend.setTxSender(func(ctx context.Context, sent *types.Transaction) error {
+		if *sent.To() == inbox {
+			// Cancel once the tx is pending and never mine it.
+			close(cancelTx)
+			return nil
+		}
+		cancellation = sent
+		txHash := sent.Hash()
+		h.backend.mine(&txHash, sent.GasFeeCap())
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	receipt, err := h.mgr.sendTx(ctx, tx, false, cancelTx)
+	require.ErrorIs(t, err, ErrTxCancelled)
+	require.NotNil(t, receipt)
+	require.NotNil(t, cancellation)
+	require.Equal(t, cancellation.Hash(), receipt.TxHash)
+	require.Equal(t, tx.Nonce(), cancellation.Nonce())
+	require.Equal(t, h.cfg.From, *cancellation.To())
+	require.Zero(t, cancellation.Value().Sign())
+	require.Empty(t, cancellation.Data())
+	require.Positive(t, cancellation.GasFeeCap().Cmp(tx.GasFeeCap()))
+	require.Positive(t, cancellation.GasTipCap().Cmp(tx.GasTipCap()))
+}
+
+// TestTxMgrCancelAfterTxMined asserts that the receipt of the tx is returned as normal if it is mined before the
+// cancellation tx.
+func TestTxMgrCancelAfterTxMined(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	gasTipCap, gasFeeCap := h.gasPricer.sample()
+	inbox := common.Address{0xaa}
+	tx := types.NewTx(&types.DynamicFeeTx{
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		To:        &inbox,
+	})
+	cancelTx := make(chan struct{})
+	h.backend.setTxSender(func(ctx context.Context, sent *types.Transaction) error {
+		if *sent.To() == inbox {
+			close(cancelTx)
+			return nil
+		}
+		// The tx is mined instead of the cancellation tx.
+		txHash := tx.Hash()
+		h.backend.mine(&txHash, tx.GasFeeCap())
+		return core.ErrNonceTooLow
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	receipt, err := h.mgr.sendTx(ctx, tx, false, cancelTx)
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	require.Equal(t, tx.Hash(), receipt.TxHash)
+}
+
 // TestTxMgrConfirmsAtMaxGasPrice asserts that Send properly returns the max gas
 // price receipt if none of the lower gas price txs were mined.
 func TestTxMgrConfirmsAtHigherGasPrice(t *testing.T) {
@@ -350,7 +432,7 @@ func TestTxMgrConfirmsAtHigherGasPrice(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, false, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -381,7 +463,7 @@ func TestTxMgrBlocksOnFailingRpcCalls(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, false, nil)
 	require.Equal(t, err, context.DeadlineExceeded)
 	require.Nil(t, receipt)
 }
@@ -518,7 +600,7 @@ func TestTxMgrOnlyOnePublicationSucceeds(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, false, nil)
 	require.Nil(t, err)
 
 	require.NotNil(t, receipt)
@@ -553,7 +635,7 @@ func TestTxMgrConfirmsMinGasPriceAfterBumping(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, false, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -598,7 +680,7 @@ func TestTxMgrDoesntAbortNonceTooLowAfterMiningTx(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	receipt, err := h.mgr.sendTx(ctx, tx)
+	receipt, err := h.mgr.sendTx(ctx, tx, false, nil)
 	require.Nil(t, err)
 	require.NotNil(t, receipt)
 	require.Equal(t, h.gasPricer.expGasFeeCap().Uint64(), receipt.GasUsed)
@@ -1036,3 +1118,154 @@ func TestNonceReset(t *testing.T) {
 	// internal nonce tracking should be reset every 3rd tx
 	require.Equal(t, []uint64{0, 0, 1, 2, 0, 1, 2, 0}, nonces)
 }
+
+func TestGasUsageByLabel(t *testing.T) {
+	h := newTestHarness(t)
+	sendTx := func(ctx context.Context, tx *types.Transaction) error {
+		txHash := tx.Hash()
+		h.backend.mine(&txHash, tx.GasFeeCap())
+		return nil
+	}
+	h.backend.setTxSender(sendTx)
+
+	ctx := context.Background()
+	expectedGas := make(map[string]uint64)
+	for _, label := range []string{"move", "step", "move", ""} {
+		candidate := h.createTxCandidate()
+		candidate.Label = label
+		receipt, err := h.mgr.Send(ctx, candidate)
+		require.NoError(t, err)
+		if label == "" {
+			label = UnlabelledAction
+		}
+		expectedGas[label] += receipt.GasUsed
+	}
+
+	usage := h.mgr.GasUsage()
+	require.Len(t, usage, 3)
+	require.EqualValues(t, 2, usage["move"].Txs)
+	require.EqualValues(t, 1, usage["step"].Txs)
+	require.EqualValues(t, 1, usage[UnlabelledAction].Txs)
+	for label, gas := range expectedGas {
+		require.EqualValues(t, gas, usage[label].GasUsed, label)
+	}
+}
+
+func TestSendPrivate(t *testing.T) {
+	t.Run("UsesPrivateRelay", func(t *testing.T) {
+		conf := configWithNumConfs(1)
+		var relayed []common.Hash
+		h := newTestHarnessWithConfig(t, conf)
+		h.mgr.cfg.PrivateRelay = sendTransactionFunc(func(ctx context.Context, tx *types.Transaction) error {
+			txHash := tx.Hash()
+			relayed = append(relayed, txHash)
+			h.backend.mine(&txHash, tx.GasFeeCap())
+			return nil
+		})
+		h.mgr.cfg.PrivateRelayTimeout = time.Hour
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			return errors.New("private tx sent to public mempool")
+		})
+
+		candidate := h.createTxCandidate()
+		candidate.Private = true
+		receipt, err := h.mgr.Send(context.Background(), candidate)
+		require.NoError(t, err)
+		require.Equal(t, []common.Hash{receipt.TxHash}, relayed)
+	})
+
+	t.Run("FallsBackToPublicMempool", func(t *testing.T) {
+		conf := configWithNumConfs(1)
+		conf.ResubmissionTimeout = time.Hour
+		h := newTestHarnessWithConfig(t, conf)
+		var relayed, published int
+		h.mgr.cfg.PrivateRelay = sendTransactionFunc(func(ctx context.Context, tx *types.Transaction) error {
+			// The relay accepts the tx but never gets it included.
+			relayed++
+			return nil
+		})
+		h.mgr.cfg.PrivateRelayTimeout = 100 * time.Millisecond
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			published++
+			txHash := tx.Hash()
+			h.backend.mine(&txHash, tx.GasFeeCap())
+			return nil
+		})
+
+		candidate := h.createTxCandidate()
+		candidate.Private = true
+		_, err := h.mgr.Send(context.Background(), candidate)
+		require.NoError(t, err)
+		require.Equal(t, 1, relayed)
+		require.Equal(t, 1, published)
+	})
+
+	t.Run("SkipsRelayWhenDeadlineTooClose", func(t *testing.T) {
+		conf := configWithNumConfs(1)
+		h := newTestHarnessWithConfig(t, conf)
+		h.mgr.cfg.PrivateRelay = sendTransactionFunc(func(ctx context.Context, tx *types.Transaction) error {
+			return errors.New("tx sent to private relay")
+		})
+		h.mgr.cfg.PrivateRelayTimeout = time.Minute
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			txHash := tx.Hash()
+			h.backend.mine(&txHash, tx.GasFeeCap())
+			return nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		candidate := h.createTxCandidate()
+		candidate.Private = true
+		_, err := h.mgr.Send(ctx, candidate)
+		require.NoError(t, err)
+	})
+
+	t.Run("PublicWithoutRelay", func(t *testing.T) {
+		h := newTestHarness(t)
+		h.backend.setTxSender(func(ctx context.Context, tx *types.Transaction) error {
+			txHash := tx.Hash()
+			h.backend.mine(&txHash, tx.GasFeeCap())
+			return nil
+		})
+		candidate := h.createTxCandidate()
+		candidate.Private = true
+		_, err := h.mgr.Send(context.Background(), candidate)
+		require.NoError(t, err)
+	})
+}
+
+func TestGasUsageCost(t *testing.T) {
+	var accounting gasAccounting
+	accounting.record("batch", &types.Receipt{GasUsed: 100, EffectiveGasPrice: big.NewInt(7)})
+	accounting.record("batch", &types.Receipt{GasUsed: 50, EffectiveGasPrice: big.NewInt(3)})
+	accounting.record("proposal", &types.Receipt{GasUsed: 10, EffectiveGasPrice: big.NewInt(2)})
+
+	summary := accounting.summary()
+	require.Equal(t, GasUsage{Txs: 2, GasUsed: 150, Cost: (*hexutil.Big)(big.NewInt(850))}, summary["batch"])
+	require.Equal(t, GasUsage{Txs: 1, GasUsed: 10, Cost: (*hexutil.Big)(big.NewInt(20))}, summary["proposal"])
+
+	// The summary must not be affected by later updates.
+	accounting.record("batch", &types.Receipt{GasUsed: 1, EffectiveGasPrice: big.NewInt(1)})
+	require.Equal(t, (*hexutil.Big)(big.NewInt(850)), summary["batch"].Cost)
+}
+
+func TestCombineGasUsage(t *testing.T) {
+	var first, second gasAccounting
+	first.record("move", &types.Receipt{GasUsed: 100, EffectiveGasPrice: big.NewInt(7)})
+	first.record("step", &types.Receipt{GasUsed: 10, EffectiveGasPrice: big.NewInt(2)})
+	second.record("move", &types.Receipt{GasUsed: 50, EffectiveGasPrice: big.NewInt(3)})
+
+	combined := CombineGasUsage(gasUsageFunc(first.summary), gasUsageFunc(second.summary))
+	require.Equal(t, map[string]GasUsage{
+		"move": {Txs: 2, GasUsed: 150, Cost: (*hexutil.Big)(big.NewInt(850))},
+		"step": {Txs: 1, GasUsed: 10, Cost: (*hexutil.Big)(big.NewInt(20))},
+	}, combined)
+	require.Empty(t, CombineGasUsage())
+}
+
+type gasUsageFunc func() map[string]GasUsage
+
+func (f gasUsageFunc) GasUsage() map[string]GasUsage {
+	return f()
+}